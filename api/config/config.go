@@ -3,13 +3,18 @@ package config
 import (
 	"flag"
 	"fmt"
+	"math/rand"
 	"os"
+	"slices"
 	"strings"
+	"time"
 )
 
 const (
 	DefaultAccountKey = "C2y6yDjf5/R+ob0N8A7Cgv30VRDJIWEHLM+4QDU5DE2nQ9nDuVTqobD4b8mGGyPMbIZnqyMsEcaGQy67XIw/Jw=="
 	EnvPrefix         = "COSMIUM_"
+
+	DefaultMaxDocumentSize = 2 * 1024 * 1024
 )
 
 var Config = ServerConfig{}
@@ -17,34 +22,94 @@ var Config = ServerConfig{}
 func ParseFlags() {
 	host := flag.String("Host", "localhost", "Hostname")
 	port := flag.Int("Port", 8081, "Listen port")
+	bindAddress := flag.String("BindAddress", "", "Address to listen on, all interfaces when empty")
 	explorerPath := flag.String("ExplorerDir", "", "Path to cosmos-explorer files")
 	tlsCertificatePath := flag.String("Cert", "", "Hostname")
 	tlsCertificateKey := flag.String("CertKey", "", "Hostname")
 	initialDataPath := flag.String("InitialData", "", "Path to JSON containing initial state")
 	accountKey := flag.String("AccountKey", DefaultAccountKey, "Account key for authentication")
 	disableAuthentication := flag.Bool("DisableAuth", false, "Disable authentication")
+	disableDateCheck := flag.Bool("DisableDateCheck", false, "Accepts signed requests whose x-ms-date is more than 15 minutes off the server time")
 	disableTls := flag.Bool("DisableTls", false, "Disable TLS, serve over HTTP")
+	generateCertificate := flag.Bool("GenerateCert", false, "Generate a self-signed certificate for localhost on startup")
+	generatedCertificatePath := flag.String("GeneratedCertPath", "", "Path to write the generated certificate PEM to")
 	persistDataPath := flag.String("Persist", "", "Saves data to given path on application exit")
 	debug := flag.Bool("Debug", false, "Runs application in debug mode, this provides additional logging")
+	logLevel := LogLevelInfo
+	flag.Func("LogLevel", "Minimum level of logged messages, one of debug, info, warn or error (default info)", oneOfFlag(&logLevel, LogLevelDebug, LogLevelInfo, LogLevelWarn, LogLevelError))
+	logFormat := LogFormatText
+	flag.Func("LogFormat", "Format of the logs, text or json for one JSON object per line (default text)", oneOfFlag(&logFormat, LogFormatText, LogFormatJSON))
+	traceQueries := flag.Bool("TraceQueries", false, "Logs the text, parameters, parsed statement and matched documents of executed queries at the debug level")
+	enableStateEndpoint := flag.Bool("EnableStateEndpoint", false, "Exposes the in-memory state at GET /_state")
+	queryTimeout := flag.Duration("QueryTimeout", 30*time.Second, "Maximum duration of a query execution, 0 disables the timeout")
+	shutdownTimeout := flag.Duration("ShutdownTimeout", 10*time.Second, "Maximum duration to wait for in-flight requests on shutdown")
+	ttlPurgeInterval := flag.Duration("TTLPurgeInterval", 10*time.Second, "Interval at which expired documents are removed, 0 disables the background removal")
+	maxDocumentSize := flag.Int("MaxDocumentSize", DefaultMaxDocumentSize, "Maximum serialized size of a document in bytes, 0 or less disables the limit")
+	prettyJSON := flag.Bool("Pretty", false, "Indents the JSON of responses, for debugging")
+	requirePartitionKey := flag.Bool("RequirePartitionKey", false, "Rejects documents without a value at the partition key path of their collection")
+	maxDocumentCount := flag.Int("MaxDocumentCount", 0, "Documents a collection can hold before creating more fails with 403 Forbidden, 0 disables the limit")
+	partitionKeyRangeCount := flag.Int("PartitionKeyRangeCount", 1, "Partition key ranges every collection is served by, documents are assigned to them by a hash of their partition key")
+	throttleRate := flag.Float64("ThrottleRate", 0, "Fraction of requests, between 0 and 1, answered with 429 Too Many Requests")
+	throttleRUBudget := flag.Float64("ThrottleRUBudget", 0, "Request units per second of each collection after which its requests are answered with 429 Too Many Requests, 0 disables the budget")
+	throttleEveryNthWrite := flag.Int("ThrottleEveryNthWrite", 0, "Answers every nth write to a collection with 429 Too Many Requests, 0 disables it")
+	throttleRetryAfter := flag.Duration("ThrottleRetryAfter", 100*time.Millisecond, "Retry delay advertised by requests throttled randomly or as the nth write")
+	throttleSeed := flag.Int64("ThrottleSeed", 0, "Seed picking the randomly throttled requests, 0 uses a random seed")
+	var readLatency, writeLatency, queryLatency LatencyRange
+	flag.Func("ReadLatency", "Artificial delay of reads, a duration like 100ms or a range like 50ms-200ms", latencyRangeFlag(&readLatency))
+	flag.Func("WriteLatency", "Artificial delay of writes, a duration like 100ms or a range like 50ms-200ms", latencyRangeFlag(&writeLatency))
+	flag.Func("QueryLatency", "Artificial delay of queries, a duration like 100ms or a range like 50ms-200ms", latencyRangeFlag(&queryLatency))
 
 	flag.Parse()
 	setFlagsFromEnvironment()
 
 	Config.Host = *host
-	Config.Port = *port
+	Config.BindAddress = *bindAddress
 	Config.ExplorerPath = *explorerPath
 	Config.TLS_CertificatePath = *tlsCertificatePath
 	Config.TLS_CertificateKey = *tlsCertificateKey
 	Config.InitialDataFilePath = *initialDataPath
 	Config.PersistDataFilePath = *persistDataPath
 	Config.DisableAuth = *disableAuthentication
+	Config.DisableDateCheck = *disableDateCheck
 	Config.DisableTls = *disableTls
+	Config.TLS_GenerateCertificate = *generateCertificate
+	Config.TLS_GeneratedCertificatePath = *generatedCertificatePath
 	Config.Debug = *debug
+	Config.LogLevel = logLevel
+	Config.LogFormat = logFormat
+	Config.TraceQueries = *traceQueries
+	Config.EnableStateEndpoint = *enableStateEndpoint
+	Config.QueryTimeout = *queryTimeout
+	Config.ShutdownTimeout = *shutdownTimeout
+	Config.TTLPurgeInterval = *ttlPurgeInterval
+	Config.MaxDocumentSize = *maxDocumentSize
+	Config.PrettyJSON = *prettyJSON
+	Config.RequirePartitionKey = *requirePartitionKey
+	Config.MaxDocumentCount = *maxDocumentCount
+	Config.PartitionKeyRangeCount = *partitionKeyRangeCount
+	Config.ReadLatency = readLatency
+	Config.WriteLatency = writeLatency
+	Config.QueryLatency = queryLatency
+	Config.ThrottleRate = *throttleRate
+	Config.ThrottleRUBudget = *throttleRUBudget
+	Config.ThrottleEveryNthWrite = *throttleEveryNthWrite
+	Config.ThrottleRetryAfter = *throttleRetryAfter
+	Config.ThrottleSeed = *throttleSeed
 
 	Config.DatabaseAccount = Config.Host
 	Config.DatabaseDomain = Config.Host
-	Config.DatabaseEndpoint = fmt.Sprintf("https://%s:%d/", Config.Host, Config.Port)
 	Config.AccountKey = *accountKey
+	SetPort(*port)
+}
+
+// Sets the listen port and the account endpoint advertised to clients
+func SetPort(port int) {
+	Config.Port = port
+	scheme := "https"
+	if Config.DisableTls {
+		scheme = "http"
+	}
+	Config.DatabaseEndpoint = fmt.Sprintf("%s://%s:%d/", scheme, Config.Host, Config.Port)
 }
 
 func setFlagsFromEnvironment() (err error) {
@@ -60,3 +125,58 @@ func setFlagsFromEnvironment() (err error) {
 
 	return
 }
+
+// Parses a latency like "100ms", or a range like "50ms-200ms" of which a random delay is picked
+func ParseLatencyRange(value string) (LatencyRange, error) {
+	minValue, maxValue, isRange := strings.Cut(strings.TrimSpace(value), "-")
+	minLatency, err := time.ParseDuration(strings.TrimSpace(minValue))
+	if err != nil {
+		return LatencyRange{}, fmt.Errorf("invalid latency %q: %w", value, err)
+	}
+
+	maxLatency := minLatency
+	if isRange {
+		if maxLatency, err = time.ParseDuration(strings.TrimSpace(maxValue)); err != nil {
+			return LatencyRange{}, fmt.Errorf("invalid latency %q: %w", value, err)
+		}
+	}
+
+	if minLatency < 0 || maxLatency < minLatency {
+		return LatencyRange{}, fmt.Errorf("invalid latency %q: the delay can't be negative and the range must be ascending", value)
+	}
+
+	return LatencyRange{Min: minLatency, Max: maxLatency}, nil
+}
+
+// Picks the delay of a request
+func (r LatencyRange) Delay() time.Duration {
+	if r.Max <= r.Min {
+		return r.Min
+	}
+
+	return r.Min + time.Duration(rand.Int63n(int64(r.Max-r.Min)+1))
+}
+
+func latencyRangeFlag(target *LatencyRange) func(string) error {
+	return func(value string) error {
+		latencyRange, err := ParseLatencyRange(value)
+		if err != nil {
+			return err
+		}
+
+		*target = latencyRange
+		return nil
+	}
+}
+
+func oneOfFlag(target *string, values ...string) func(string) error {
+	return func(value string) error {
+		value = strings.ToLower(value)
+		if !slices.Contains(values, value) {
+			return fmt.Errorf("must be one of %s", strings.Join(values, ", "))
+		}
+
+		*target = value
+		return nil
+	}
+}
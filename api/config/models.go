@@ -1,19 +1,69 @@
 package config
 
+import "time"
+
 type ServerConfig struct {
 	DatabaseAccount  string
 	DatabaseDomain   string
 	DatabaseEndpoint string
 	AccountKey       string
 
-	ExplorerPath        string
-	Port                int
-	Host                string
-	TLS_CertificatePath string
-	TLS_CertificateKey  string
-	InitialDataFilePath string
-	PersistDataFilePath string
-	DisableAuth         bool
-	DisableTls          bool
-	Debug               bool
+	ExplorerPath          string
+	Port                  int
+	BindAddress           string
+	Host                  string
+	TLS_CertificatePath   string
+	TLS_CertificateKey    string
+	InitialDataFilePath   string
+	PersistDataFilePath   string
+	DisableAuth           bool
+	DisableDateCheck      bool
+	DisableTls            bool
+	Debug                 bool
+	EnableStateEndpoint   bool
+	QueryTimeout          time.Duration
+	ShutdownTimeout       time.Duration
+	TTLPurgeInterval      time.Duration
+	MaxDocumentSize       int
+	PrettyJSON            bool
+	RequirePartitionKey   bool
+	MaxDocumentCount      int
+	ReadLatency           LatencyRange
+	WriteLatency          LatencyRange
+	QueryLatency          LatencyRange
+	ThrottleRate          float64
+	ThrottleRUBudget      float64
+	ThrottleEveryNthWrite int
+	ThrottleRetryAfter    time.Duration
+	ThrottleSeed          int64
+
+	PartitionKeyRangeCount int
+
+	LogLevel     string
+	LogFormat    string
+	TraceQueries bool
+
+	TLS_GenerateCertificate      bool
+	TLS_GeneratedCertificatePath string
+}
+
+// Levels of the LogLevel flag, messages below the level are not logged
+const (
+	LogLevelDebug = "debug"
+	LogLevelInfo  = "info"
+	LogLevelWarn  = "warn"
+	LogLevelError = "error"
+)
+
+// Formats of the LogFormat flag, the json format writes one object per line
+const (
+	LogFormatText = "text"
+	LogFormatJSON = "json"
+)
+
+// Artificial delay applied to requests before they are handled, a fixed delay of Min
+// or a random one between Min and Max. The zero value doesn't delay requests
+type LatencyRange struct {
+	Min time.Duration
+	Max time.Duration
 }
@@ -0,0 +1,370 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+)
+
+type batchOperation struct {
+	OperationType string                 `json:"operationType"`
+	Id            string                 `json:"id"`
+	IfMatch       string                 `json:"ifMatch"`
+	ResourceBody  map[string]interface{} `json:"resourceBody"`
+}
+
+type batchOperationResult struct {
+	StatusCode    int                    `json:"statusCode"`
+	SubStatusCode int                    `json:"subStatusCode,omitempty"`
+	ResourceBody  map[string]interface{} `json:"resourceBody,omitempty"`
+	ETag          string                 `json:"eTag,omitempty"`
+	Message       string                 `json:"message,omitempty"`
+	RequestCharge float64                `json:"requestCharge"`
+}
+
+type batchContext struct {
+	collection   repositorymodels.Collection
+	partitionKey []interface{}
+	tx           repositories.DocumentTransaction
+}
+
+func BatchDocuments(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+
+	var operations []batchOperation
+	if !bindRequestBody(c, &operations) {
+		return
+	}
+
+	collection, status := repositories.GetCollection(databaseId, collectionId)
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	batch := batchContext{collection: collection}
+
+	if partitionKeyHeader := c.GetHeader("x-ms-documentdb-partitionkey"); partitionKeyHeader != "" {
+		partitionKey, err := parsePartitionKeyHeader(collection, partitionKeyHeader)
+		if err != nil {
+			writeBadRequest(c, err.Error())
+			return
+		}
+		batch.partitionKey = partitionKey
+	}
+
+	isAtomic, _ := strconv.ParseBool(c.GetHeader("x-ms-cosmos-batch-atomic"))
+	if !isAtomic && isCreateOnlyBatch(operations) {
+		batch.createDocuments(c, databaseId, collectionId, operations)
+		return
+	}
+
+	// The operations run in a single transaction, the changes of an
+	// atomic batch are discarded when one of its operations fails
+	results := make([]batchOperationResult, len(operations))
+	failedIndex := -1
+	requestCharge := 0.0
+	status = repositories.RunDocumentTransaction(databaseId, collectionId, func(tx repositories.DocumentTransaction) bool {
+		batch.tx = tx
+		for i, operation := range operations {
+			results[i] = batch.executeOperation(operation)
+			results[i].RequestCharge = batchOperationRequestCharge(operation.OperationType, results[i])
+			requestCharge += results[i].RequestCharge
+
+			if results[i].StatusCode >= 400 && isAtomic {
+				failedIndex = i
+				return false
+			}
+		}
+
+		return true
+	})
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	setRequestCharge(c, requestCharge)
+	setSessionToken(c, databaseId, collectionId)
+	if failedIndex < 0 {
+		writeJSON(c, http.StatusOK, results)
+		return
+	}
+
+	// Atomic batch failed, mark every other operation as a failed dependency
+	for i := range results {
+		if i != failedIndex {
+			results[i] = batchOperationResult{StatusCode: http.StatusFailedDependency}
+		}
+	}
+
+	writeJSON(c, http.StatusMultiStatus, results)
+}
+
+func (b batchContext) executeOperation(operation batchOperation) batchOperationResult {
+	switch operation.OperationType {
+	case "Create":
+		if result, ok := b.validateResourceBody(operation.ResourceBody, ""); !ok {
+			return result
+		}
+		return b.createDocument(operation.ResourceBody, http.StatusCreated)
+	case "Upsert":
+		if result, ok := b.validateResourceBody(operation.ResourceBody, ""); !ok {
+			return result
+		}
+
+		var checkResult batchOperationResult
+		upsertedDocument, isReplaced, status, err := b.tx.UpsertDocument(operation.ResourceBody, func(existingDocument repositorymodels.Document) error {
+			result, ok := b.checkDocument(existingDocument, operation.IfMatch)
+			if !ok {
+				checkResult = result
+				return errors.New(result.Message)
+			}
+			return nil
+		})
+		switch {
+		case err != nil:
+			return checkResult
+		case status == repositorymodels.StatusOk && isReplaced:
+			return newBatchOperationResult(http.StatusOK, upsertedDocument)
+		case status == repositorymodels.StatusOk:
+			return newBatchOperationResult(http.StatusCreated, upsertedDocument)
+		case status == repositorymodels.MissingPartitionKey:
+			return newMissingPartitionKeyResult()
+		case status == repositorymodels.DocumentLimitReached:
+			return newDocumentLimitReachedResult()
+		}
+		return batchOperationResult{StatusCode: http.StatusInternalServerError, Message: "Unknown error"}
+	case "Read":
+		document, result, ok := b.getDocument(operation.Id)
+		if !ok {
+			return result
+		}
+		return newBatchOperationResult(http.StatusOK, document)
+	case "Replace":
+		if result, ok := b.validateResourceBody(operation.ResourceBody, operation.Id); !ok {
+			return result
+		}
+
+		if result, ok := b.checkExistingDocument(operation.Id, operation.IfMatch); !ok {
+			return result
+		}
+
+		replacedDocument, status := b.tx.ReplaceDocument(operation.Id, operation.ResourceBody)
+		if status == repositorymodels.MissingPartitionKey {
+			return newMissingPartitionKeyResult()
+		}
+		if status != repositorymodels.StatusOk {
+			return batchOperationResult{StatusCode: http.StatusInternalServerError, Message: "Unknown error"}
+		}
+		return newBatchOperationResult(http.StatusOK, replacedDocument)
+	case "Delete":
+		if result, ok := b.checkExistingDocument(operation.Id, operation.IfMatch); !ok {
+			return result
+		}
+
+		b.tx.DeleteDocument(operation.Id)
+		return batchOperationResult{StatusCode: http.StatusNoContent}
+	case "Patch":
+		if result, ok := b.checkExistingDocument(operation.Id, operation.IfMatch); !ok {
+			return result
+		}
+
+		errorStatus := http.StatusBadRequest
+		patchedDocument, status, err := b.tx.PatchDocument(operation.Id, func(document repositorymodels.Document) (map[string]interface{}, error) {
+			modifiedDocument, patchErrorStatus, err := applyPatchOperations(document, operation.ResourceBody)
+			if err != nil {
+				errorStatus = patchErrorStatus
+				return nil, err
+			}
+
+			if !b.isInPartition(modifiedDocument) {
+				return nil, errPartitionKeyModified
+			}
+
+			if err := checkDocumentSize(modifiedDocument); err != nil {
+				errorStatus = http.StatusRequestEntityTooLarge
+				return nil, err
+			}
+
+			return modifiedDocument, nil
+		})
+		if err != nil {
+			return batchOperationResult{StatusCode: errorStatus, Message: err.Error()}
+		}
+		if status == repositorymodels.MissingPartitionKey {
+			return newMissingPartitionKeyResult()
+		}
+		if status != repositorymodels.StatusOk {
+			return batchOperationResult{StatusCode: http.StatusInternalServerError, Message: "Unknown error"}
+		}
+		return newBatchOperationResult(http.StatusOK, patchedDocument)
+	}
+
+	return batchOperationResult{StatusCode: http.StatusBadRequest, Message: "Unknown operation type"}
+}
+
+// Checks that the body is present, carries the expected id
+// and belongs to the partition the batch is scoped to
+func (b batchContext) validateResourceBody(document map[string]interface{}, expectedId string) (batchOperationResult, bool) {
+	if document == nil {
+		return batchOperationResult{StatusCode: http.StatusBadRequest, Message: "Resource body is required"}, false
+	}
+
+	if err := validateDocumentId(document["id"]); err != nil {
+		return batchOperationResult{StatusCode: http.StatusBadRequest, Message: err.Error()}, false
+	}
+
+	if err := checkDocumentSize(document); err != nil {
+		return batchOperationResult{StatusCode: http.StatusRequestEntityTooLarge, Message: err.Error()}, false
+	}
+
+	if expectedId != "" && document["id"] != expectedId {
+		return batchOperationResult{StatusCode: http.StatusBadRequest, Message: "Resource body id does not match the operation id"}, false
+	}
+
+	if !b.isInPartition(document) {
+		return batchOperationResult{
+			StatusCode:    http.StatusBadRequest,
+			SubStatusCode: subStatusPartitionKeyMismatch,
+			Message:       "PartitionKey extracted from document doesn't match the one specified in the header",
+		}, false
+	}
+
+	return batchOperationResult{}, true
+}
+
+// Ensures the document exists within the batch partition and matches the optional etag
+func (b batchContext) checkExistingDocument(documentId string, ifMatch string) (batchOperationResult, bool) {
+	document, result, ok := b.getDocument(documentId)
+	if !ok {
+		return result, false
+	}
+
+	return b.checkDocument(document, ifMatch)
+}
+
+func (b batchContext) checkDocument(document repositorymodels.Document, ifMatch string) (batchOperationResult, bool) {
+	if !b.isInPartition(document) {
+		return batchOperationResult{StatusCode: http.StatusNotFound, Message: "NotFound"}, false
+	}
+
+	if !isETagMatch(ifMatch, document) {
+		return batchOperationResult{StatusCode: http.StatusPreconditionFailed, Message: "PreconditionFailed"}, false
+	}
+
+	return batchOperationResult{}, true
+}
+
+func (b batchContext) getDocument(documentId string) (repositorymodels.Document, batchOperationResult, bool) {
+	document, status := b.tx.GetDocument(documentId)
+	if status == repositorymodels.StatusNotFound || !b.isInPartition(document) {
+		return nil, batchOperationResult{StatusCode: http.StatusNotFound, Message: "NotFound"}, false
+	}
+
+	return document, batchOperationResult{}, true
+}
+
+func (b batchContext) isInPartition(document map[string]interface{}) bool {
+	return repositories.IsInPartition(b.collection, document, b.partitionKey)
+}
+
+func (b batchContext) createDocument(document map[string]interface{}, successStatus int) batchOperationResult {
+	createdDocument, status := b.tx.CreateDocument(document)
+	if status == repositorymodels.Conflict {
+		return batchOperationResult{StatusCode: http.StatusConflict, Message: "Conflict"}
+	}
+
+	if status == repositorymodels.MissingPartitionKey {
+		return newMissingPartitionKeyResult()
+	}
+
+	if status == repositorymodels.DocumentLimitReached {
+		return newDocumentLimitReachedResult()
+	}
+
+	if status == repositorymodels.StatusOk {
+		return newBatchOperationResult(successStatus, createdDocument)
+	}
+
+	return batchOperationResult{StatusCode: http.StatusInternalServerError, Message: "Unknown error"}
+}
+
+// Bulk executions send non-atomic batches of creates, they are inserted in one go
+func isCreateOnlyBatch(operations []batchOperation) bool {
+	for _, operation := range operations {
+		if operation.OperationType != "Create" {
+			return false
+		}
+	}
+
+	return len(operations) > 0
+}
+
+func (b batchContext) createDocuments(c *gin.Context, databaseId string, collectionId string, operations []batchOperation) {
+	results := make([]batchOperationResult, len(operations))
+	documents := make([]map[string]interface{}, 0, len(operations))
+	documentIndexes := make([]int, 0, len(operations))
+	for i, operation := range operations {
+		if result, ok := b.validateResourceBody(operation.ResourceBody, ""); !ok {
+			results[i] = result
+			continue
+		}
+
+		documents = append(documents, operation.ResourceBody)
+		documentIndexes = append(documentIndexes, i)
+	}
+
+	createdDocuments, statuses, status := repositories.CreateDocuments(databaseId, collectionId, documents)
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	for i, index := range documentIndexes {
+		switch statuses[i] {
+		case repositorymodels.StatusOk:
+			results[index] = newBatchOperationResult(http.StatusCreated, createdDocuments[i])
+		case repositorymodels.Conflict:
+			results[index] = batchOperationResult{StatusCode: http.StatusConflict, Message: "Conflict"}
+		case repositorymodels.MissingPartitionKey:
+			results[index] = newMissingPartitionKeyResult()
+		case repositorymodels.DocumentLimitReached:
+			results[index] = newDocumentLimitReachedResult()
+		default:
+			results[index] = batchOperationResult{StatusCode: http.StatusInternalServerError, Message: "Unknown error"}
+		}
+	}
+
+	requestCharge := 0.0
+	for i := range results {
+		results[i].RequestCharge = batchOperationRequestCharge("Create", results[i])
+		requestCharge += results[i].RequestCharge
+	}
+
+	setRequestCharge(c, requestCharge)
+	setSessionToken(c, databaseId, collectionId)
+	writeJSON(c, http.StatusOK, results)
+}
+
+func newBatchOperationResult(statusCode int, document repositorymodels.Document) batchOperationResult {
+	etag, _ := document["_etag"].(string)
+	return batchOperationResult{
+		StatusCode:   statusCode,
+		ResourceBody: document,
+		ETag:         etag,
+	}
+}
+
+func newMissingPartitionKeyResult() batchOperationResult {
+	return batchOperationResult{StatusCode: http.StatusBadRequest, Message: errMissingPartitionKey.Error()}
+}
+
+func newDocumentLimitReachedResult() batchOperationResult {
+	return batchOperationResult{StatusCode: http.StatusForbidden, Message: errDocumentLimitReached.Error()}
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+)
+
+// Change feed requests are read feed requests with the "A-IM: Incremental Feed" header
+func isChangeFeedRequest(c *gin.Context) bool {
+	return strings.EqualFold(c.GetHeader("A-IM"), "Incremental Feed")
+}
+
+// Lists the documents changed after the etag of the previous response, sent in the
+// "If-None-Match" header. Without the header the feed starts at the beginning, with "*"
+// it starts at the current LSN. The etag of the response is the LSN reached, and the
+// response is 304 Not Modified when there were no changes since then
+func getChangeFeed(c *gin.Context, databaseId string, collectionId string, partitionKeyRange *repositorymodels.PartitionKeyRange) {
+	startLSN := int64(-1)
+	switch ifNoneMatch := c.GetHeader("If-None-Match"); ifNoneMatch {
+	case "":
+	case "*":
+		lsn, status := repositories.GetCollectionLSN(databaseId, collectionId)
+		if status != repositorymodels.StatusOk {
+			writeNotFound(c)
+			return
+		}
+		startLSN = lsn
+	default:
+		lsn, err := strconv.ParseInt(strings.Trim(ifNoneMatch, "\""), 10, 64)
+		if err != nil || lsn < 0 {
+			writeBadRequest(c, "The continuation of the change feed is not valid")
+			return
+		}
+		startLSN = lsn
+	}
+
+	documents, lsn, status := repositories.GetChangeFeed(databaseId, collectionId, partitionKeyRange, startLSN)
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	if status != repositorymodels.StatusOk {
+		writeUnknownError(c)
+		return
+	}
+
+	collection, _ := repositories.GetCollection(databaseId, collectionId)
+	if partitionKeyHeader := c.GetHeader("x-ms-documentdb-partitionkey"); partitionKeyHeader != "" {
+		partitionKey, err := parsePartitionKeyPrefixHeader(collection, partitionKeyHeader)
+		if err != nil {
+			writeBadRequest(c, err.Error())
+			return
+		}
+
+		documents = slices.DeleteFunc(documents, func(document repositorymodels.Document) bool {
+			return !repositories.IsInPartitionPrefix(collection, document, partitionKey)
+		})
+	}
+
+	// Pages end after the LSN of their last document and the next page continues from there,
+	// so documents sharing an LSN are kept on the same page even when it gets larger
+	if maxItemCount, err := strconv.Atoi(c.GetHeader("x-ms-max-item-count")); err == nil && maxItemCount > 0 && maxItemCount < len(documents) {
+		end := maxItemCount
+		for end < len(documents) && documents[end]["_lsn"] == documents[end-1]["_lsn"] {
+			end++
+		}
+		if end < len(documents) {
+			documents = documents[:end]
+			lsn = documents[end-1]["_lsn"].(int64)
+		}
+	}
+
+	c.Header("etag", fmt.Sprintf("\"%d\"", max(lsn, startLSN)))
+	setSessionToken(c, databaseId, collectionId)
+	if len(documents) == 0 {
+		c.AbortWithStatus(http.StatusNotModified)
+		return
+	}
+
+	setRequestCharge(c, queryRequestCharge(len(documents)))
+	setCollectionResourceHeaders(c, databaseId, collectionId)
+	c.Header("x-ms-item-count", fmt.Sprintf("%d", len(documents)))
+	writeJSON(c, http.StatusOK, gin.H{
+		"_rid":      collection.ResourceID,
+		"Documents": documents,
+		"_count":    len(documents),
+	})
+}
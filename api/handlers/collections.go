@@ -17,7 +17,7 @@ func GetAllCollections(c *gin.Context) {
 		database, _ := repositories.GetDatabase(databaseId)
 
 		c.Header("x-ms-item-count", fmt.Sprintf("%d", len(collections)))
-		c.IndentedJSON(http.StatusOK, gin.H{
+		writeJSON(c, http.StatusOK, gin.H{
 			"_rid":                database.ResourceID,
 			"DocumentCollections": collections,
 			"_count":              len(collections),
@@ -25,7 +25,7 @@ func GetAllCollections(c *gin.Context) {
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	writeUnknownError(c)
 }
 
 func GetCollection(c *gin.Context) {
@@ -34,16 +34,17 @@ func GetCollection(c *gin.Context) {
 
 	collection, status := repositories.GetCollection(databaseId, id)
 	if status == repositorymodels.StatusOk {
-		c.IndentedJSON(http.StatusOK, collection)
+		setCollectionResourceHeaders(c, databaseId, id)
+		writeJSON(c, http.StatusOK, collection)
 		return
 	}
 
 	if status == repositorymodels.StatusNotFound {
-		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "NotFound"})
+		writeNotFound(c)
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	writeUnknownError(c)
 }
 
 func DeleteCollection(c *gin.Context) {
@@ -57,37 +58,122 @@ func DeleteCollection(c *gin.Context) {
 	}
 
 	if status == repositorymodels.StatusNotFound {
-		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "NotFound"})
+		writeNotFound(c)
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	writeUnknownError(c)
 }
 
 func CreateCollection(c *gin.Context) {
 	databaseId := c.Param("databaseId")
 	var newCollection repositorymodels.Collection
 
-	if err := c.BindJSON(&newCollection); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+	if !bindRequestBody(c, &newCollection) {
 		return
 	}
 
 	if newCollection.ID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"message": "BadRequest"})
+		writeBadRequest(c, "The input content is invalid because the required properties - 'id; ' - are missing")
+		return
+	}
+
+	if err := repositories.ValidateIndexingPolicy(newCollection.IndexingPolicy); err != nil {
+		writeBadRequest(c, err.Error())
+		return
+	}
+
+	if err := repositories.ValidatePartitionKeyDefinition(newCollection.PartitionKey); err != nil {
+		writeBadRequest(c, err.Error())
+		return
+	}
+
+	if err := repositories.ValidateDefaultTimeToLive(newCollection.DefaultTimeToLive); err != nil {
+		writeBadRequest(c, err.Error())
+		return
+	}
+
+	if err := repositories.ValidateGeospatialConfig(newCollection.GeospatialConfig); err != nil {
+		writeBadRequest(c, err.Error())
+		return
+	}
+
+	offerContent, err := getOfferContentHeaders(c)
+	if err != nil {
+		writeBadRequest(c, err.Error())
 		return
 	}
 
 	createdCollection, status := repositories.CreateCollection(databaseId, newCollection)
+	if status == repositorymodels.BadRequest {
+		writeBadRequest(c, "The collection definition is not valid")
+		return
+	}
+
 	if status == repositorymodels.Conflict {
-		c.IndentedJSON(http.StatusConflict, gin.H{"message": "Conflict"})
+		writeConflict(c)
 		return
 	}
 
 	if status == repositorymodels.StatusOk {
-		c.IndentedJSON(http.StatusCreated, createdCollection)
+		if offerContent != nil {
+			repositories.CreateOffer(createdCollection.Self, createdCollection.ResourceID, *offerContent)
+		}
+
+		writeJSON(c, http.StatusCreated, createdCollection)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func ReplaceCollection(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	id := c.Param("collId")
+
+	var collection repositorymodels.Collection
+	if !bindRequestBody(c, &collection) {
+		return
+	}
+
+	if err := repositories.ValidateIndexingPolicy(collection.IndexingPolicy); err != nil {
+		writeBadRequest(c, err.Error())
+		return
+	}
+
+	if err := repositories.ValidateDefaultTimeToLive(collection.DefaultTimeToLive); err != nil {
+		writeBadRequest(c, err.Error())
+		return
+	}
+
+	if err := repositories.ValidateGeospatialConfig(collection.GeospatialConfig); err != nil {
+		writeBadRequest(c, err.Error())
+		return
+	}
+
+	if existingCollection, status := repositories.GetCollection(databaseId, id); status == repositorymodels.StatusOk {
+		if err := repositories.ValidateCollectionReplace(existingCollection, collection); err != nil {
+			writeBadRequest(c, err.Error())
+			return
+		}
+	}
+
+	replacedCollection, status := repositories.ReplaceCollection(databaseId, id, collection)
+	if status == repositorymodels.StatusOk {
+		setCollectionResourceHeaders(c, databaseId, id)
+		writeJSON(c, http.StatusOK, replacedCollection)
+		return
+	}
+
+	if status == repositorymodels.BadRequest {
+		writeBadRequest(c, "The collection definition is not valid")
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	writeUnknownError(c)
 }
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+)
+
+func GetAllConflicts(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+
+	conflicts, status := repositories.GetAllConflicts(databaseId, collectionId)
+	if status == repositorymodels.StatusOk {
+		conflicts, ok := paginateFeed(c, conflicts, func(conflict repositorymodels.ConflictResource) string { return conflict.ID })
+		if !ok {
+			return
+		}
+
+		collection, _ := repositories.GetCollection(databaseId, collectionId)
+
+		c.Header("x-ms-item-count", fmt.Sprintf("%d", len(conflicts)))
+		writeJSON(c, http.StatusOK, gin.H{"_rid": collection.ResourceID, "Conflicts": conflicts, "_count": len(conflicts)})
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func GetConflict(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+	conflictId := c.Param("conflictId")
+
+	conflict, status := repositories.GetConflict(databaseId, collectionId, conflictId)
+	if status == repositorymodels.StatusOk {
+		writeJSON(c, http.StatusOK, conflict)
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func DeleteConflict(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+	conflictId := c.Param("conflictId")
+
+	status := repositories.DeleteConflict(databaseId, collectionId, conflictId)
+	if status == repositorymodels.StatusOk {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
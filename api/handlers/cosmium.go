@@ -1,12 +1,209 @@
 package handlers
 
 import (
+	"errors"
+	"io"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/api/handlers/middleware"
 	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
 )
 
 func CosmiumExport(c *gin.Context) {
-	c.IndentedJSON(http.StatusOK, repositories.GetState())
+	writeJSON(c, http.StatusOK, repositories.GetState())
+}
+
+func CosmiumImport(c *gin.Context) {
+	data, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		writeJSON(c, http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	status, err := repositories.ImportState(data)
+	if status == repositorymodels.BadRequest {
+		writeJSON(c, http.StatusBadRequest, gin.H{
+			"message": "Invalid state snapshot",
+			"errors":  strings.Split(err.Error(), "\n"),
+		})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Adds a synthetic conflict to the conflicts feed of the collection
+func CosmiumCreateConflict(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+
+	var conflict repositorymodels.ConflictResource
+	if !bindRequestBody(c, &conflict) {
+		return
+	}
+
+	createdConflict, status := repositories.CreateConflict(databaseId, collectionId, conflict)
+	switch status {
+	case repositorymodels.StatusOk:
+		writeJSON(c, http.StatusCreated, createdConflict)
+	case repositorymodels.StatusNotFound:
+		writeNotFound(c)
+	case repositorymodels.Conflict:
+		writeConflict(c)
+	default:
+		writeUnknownError(c)
+	}
+}
+
+// Overrides the simulated throughput of the collection
+func CosmiumSetThrottling(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+
+	var settings middleware.ThrottlingSettings
+	if !bindRequestBody(c, &settings) {
+		return
+	}
+
+	if settings.RUBudget < 0 || settings.EveryNthWrite < 0 {
+		writeBadRequest(c, "The throttling settings can't be negative")
+		return
+	}
+
+	if _, status := repositories.GetCollection(databaseId, collectionId); status != repositorymodels.StatusOk {
+		writeNotFound(c)
+		return
+	}
+
+	middleware.SetCollectionThrottling(databaseId, collectionId, settings)
+	writeJSON(c, http.StatusOK, settings)
+}
+
+func CosmiumResetThrottling(c *gin.Context) {
+	middleware.ResetCollectionThrottling(c.Param("databaseId"), c.Param("collId"))
+	c.Status(http.StatusNoContent)
+}
+
+type documentLimitSettings struct {
+	// Documents the collection can hold, 0 removes its limit
+	MaxDocuments int `json:"maxDocuments"`
+}
+
+func CosmiumSetDocumentLimit(c *gin.Context) {
+	var settings documentLimitSettings
+	if !bindRequestBody(c, &settings) {
+		return
+	}
+
+	if settings.MaxDocuments < 0 {
+		writeBadRequest(c, "The document limit can't be negative")
+		return
+	}
+
+	if status := repositories.SetCollectionDocumentLimit(c.Param("databaseId"), c.Param("collId"), settings.MaxDocuments); status != repositorymodels.StatusOk {
+		writeNotFound(c)
+		return
+	}
+
+	writeJSON(c, http.StatusOK, settings)
+}
+
+func CosmiumResetDocumentLimit(c *gin.Context) {
+	if status := repositories.ResetCollectionDocumentLimit(c.Param("databaseId"), c.Param("collId")); status != repositorymodels.StatusOk {
+		writeNotFound(c)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Registers a rule injecting faults into the matching requests
+func CosmiumAddFault(c *gin.Context) {
+	var rule middleware.FaultRule
+	if !bindRequestBody(c, &rule) {
+		return
+	}
+
+	registeredRule, err := middleware.AddFaultRule(rule)
+	if errors.Is(err, middleware.ErrFaultRuleExists) {
+		writeConflict(c)
+		return
+	}
+
+	if err != nil {
+		writeBadRequest(c, err.Error())
+		return
+	}
+
+	writeJSON(c, http.StatusCreated, registeredRule)
+}
+
+func CosmiumGetFaults(c *gin.Context) {
+	writeJSON(c, http.StatusOK, gin.H{"Faults": middleware.GetFaultRules()})
+}
+
+func CosmiumDeleteFault(c *gin.Context) {
+	if !middleware.DeleteFaultRule(c.Param("faultId")) {
+		writeNotFound(c)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func CosmiumDeleteAllFaults(c *gin.Context) {
+	middleware.DeleteAllFaultRules()
+	c.Status(http.StatusNoContent)
+}
+
+// Splits the partition key range into two ranges, to test how clients handle partition splits
+func CosmiumSplitPartitionKeyRange(c *gin.Context) {
+	children, status := repositories.SplitPartitionKeyRange(c.Param("databaseId"), c.Param("collId"), c.Param("pkrangeId"))
+	switch status {
+	case repositorymodels.StatusOk:
+		writeJSON(c, http.StatusOK, gin.H{"PartitionKeyRanges": children})
+	case repositorymodels.StatusNotFound:
+		writeNotFound(c)
+	case repositorymodels.BadRequest:
+		writeBadRequest(c, "The partition key range is too small to be split")
+	default:
+		writeUnknownError(c)
+	}
+}
+
+type partitionKeyRangeSettings struct {
+	// Ranges evenly covering the hash space of the collection
+	Count int `json:"count"`
+}
+
+// Gives the collection a number of partition key ranges, to test how clients fan out over them
+func CosmiumSetPartitionKeyRanges(c *gin.Context) {
+	var settings partitionKeyRangeSettings
+	if !bindRequestBody(c, &settings) {
+		return
+	}
+
+	partitionKeyRanges, status := repositories.SetPartitionKeyRangeCount(c.Param("databaseId"), c.Param("collId"), settings.Count)
+	switch status {
+	case repositorymodels.StatusOk:
+		writeJSON(c, http.StatusOK, gin.H{"PartitionKeyRanges": partitionKeyRanges})
+	case repositorymodels.StatusNotFound:
+		writeNotFound(c)
+	case repositorymodels.BadRequest:
+		writeBadRequest(c, "The partition key range count must be between 1 and 100")
+	default:
+		writeUnknownError(c)
+	}
+}
+
+func CosmiumResetPartitionKeyRanges(c *gin.Context) {
+	if status := repositories.ResetPartitionKeyRanges(c.Param("databaseId"), c.Param("collId")); status != repositorymodels.StatusOk {
+		writeNotFound(c)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
 }
@@ -12,8 +12,13 @@ import (
 func GetAllDatabases(c *gin.Context) {
 	databases, status := repositories.GetAllDatabases()
 	if status == repositorymodels.StatusOk {
+		databases, ok := paginateFeed(c, databases, func(database repositorymodels.Database) string { return database.ID })
+		if !ok {
+			return
+		}
+
 		c.Header("x-ms-item-count", fmt.Sprintf("%d", len(databases)))
-		c.IndentedJSON(http.StatusOK, gin.H{
+		writeJSON(c, http.StatusOK, gin.H{
 			"_rid":      "",
 			"Databases": databases,
 			"_count":    len(databases),
@@ -21,7 +26,7 @@ func GetAllDatabases(c *gin.Context) {
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	writeUnknownError(c)
 }
 
 func GetDatabase(c *gin.Context) {
@@ -29,16 +34,16 @@ func GetDatabase(c *gin.Context) {
 
 	database, status := repositories.GetDatabase(id)
 	if status == repositorymodels.StatusOk {
-		c.IndentedJSON(http.StatusOK, database)
+		writeJSON(c, http.StatusOK, database)
 		return
 	}
 
 	if status == repositorymodels.StatusNotFound {
-		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "NotFound"})
+		writeNotFound(c)
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	writeUnknownError(c)
 }
 
 func DeleteDatabase(c *gin.Context) {
@@ -51,36 +56,45 @@ func DeleteDatabase(c *gin.Context) {
 	}
 
 	if status == repositorymodels.StatusNotFound {
-		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "NotFound"})
+		writeNotFound(c)
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	writeUnknownError(c)
 }
 
 func CreateDatabase(c *gin.Context) {
 	var newDatabase repositorymodels.Database
 
-	if err := c.BindJSON(&newDatabase); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+	if !bindRequestBody(c, &newDatabase) {
 		return
 	}
 
 	if newDatabase.ID == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"message": "BadRequest"})
+		writeBadRequest(c, "The input content is invalid because the required properties - 'id; ' - are missing")
+		return
+	}
+
+	offerContent, err := getOfferContentHeaders(c)
+	if err != nil {
+		writeBadRequest(c, err.Error())
 		return
 	}
 
 	createdDatabase, status := repositories.CreateDatabase(newDatabase)
 	if status == repositorymodels.Conflict {
-		c.IndentedJSON(http.StatusConflict, gin.H{"message": "Conflict"})
+		writeConflict(c)
 		return
 	}
 
 	if status == repositorymodels.StatusOk {
-		c.IndentedJSON(http.StatusCreated, createdDatabase)
+		if offerContent != nil {
+			repositories.CreateOffer(createdDatabase.Self, createdDatabase.ResourceID, *offerContent)
+		}
+
+		writeJSON(c, http.StatusCreated, createdDatabase)
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	writeUnknownError(c)
 }
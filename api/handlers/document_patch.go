@@ -0,0 +1,421 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	jsonnumbers "github.com/pikami/cosmium/internal/json_numbers"
+	"github.com/pikami/cosmium/internal/logger"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	memoryexecutor "github.com/pikami/cosmium/query_executors/memory_executor"
+)
+
+// The service rejects patch requests with more operations
+const maxPatchOperations = 10
+
+var patchOperationTypes = []string{"add", "set", "replace", "remove", "incr", "move"}
+
+type patchOperation struct {
+	Type  string
+	Path  string
+	From  string
+	Value interface{}
+}
+
+// Updates the value stored under the key of a container, containers are returned
+// so arrays that grow or shrink can be stored back into their parents
+type patchContainerUpdate func(container interface{}, key string) (interface{}, error)
+
+// Applies the partial document update operations of Cosmos DB to a copy of the document:
+//   - add sets an object property or inserts an array element, "-" or the array length appends
+//   - set works like add, except that array elements are updated and missing parents are created
+//   - replace and remove require the target to exist
+//   - incr adds the value to a number, a missing property is set to the value
+//   - move removes the value at "from" and adds it at the path
+//
+// The operations are only applied when the document satisfies the optional condition
+func applyPatchOperations(document repositorymodels.Document, patchRequest map[string]interface{}) (map[string]interface{}, int, error) {
+	if condition, ok := patchRequest["condition"]; ok && condition != nil {
+		if status, err := checkPatchCondition(document, condition); err != nil {
+			return nil, status, err
+		}
+	}
+
+	patchOperations, err := parsePatchOperations(patchRequest["operations"])
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	// The document is copied, as it must stay unchanged when an operation fails
+	currentDocumentBytes, err := json.Marshal(document)
+	if err != nil {
+		logger.Error("Failed to marshal existing document:", err)
+		return nil, http.StatusInternalServerError, errors.New("Failed to marshal existing document")
+	}
+
+	var modifiedDocument map[string]interface{}
+	if err := jsonnumbers.Unmarshal(currentDocumentBytes, &modifiedDocument); err != nil {
+		logger.Error("Failed to unmarshal existing document:", err)
+		return nil, http.StatusInternalServerError, errors.New("Failed to unmarshal existing document")
+	}
+
+	for i, operation := range patchOperations {
+		if err := applyPatchOperation(modifiedDocument, operation); err != nil {
+			return nil, http.StatusBadRequest, fmt.Errorf("Operation at index %d failed: %s", i, err)
+		}
+	}
+
+	if modifiedDocument["id"] != document["id"] {
+		return nil, http.StatusUnprocessableEntity, errors.New("The ID field cannot be modified")
+	}
+
+	return modifiedDocument, http.StatusOK, nil
+}
+
+// Validates all operations before any of them is applied,
+// the messages name the index of the offending operation
+func parsePatchOperations(operations interface{}) ([]patchOperation, error) {
+	rawOperations, ok := operations.([]interface{})
+	if !ok {
+		return nil, errors.New("The patch request must contain an array of operations")
+	}
+
+	if len(rawOperations) == 0 {
+		return nil, errors.New("The patch request must contain at least one operation")
+	}
+
+	if len(rawOperations) > maxPatchOperations {
+		return nil, fmt.Errorf("The patch request has %d operations, at most %d operations are allowed", len(rawOperations), maxPatchOperations)
+	}
+
+	patchOperations := make([]patchOperation, len(rawOperations))
+	for i, rawOperation := range rawOperations {
+		fields, ok := rawOperation.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Operation at index %d must be an object", i)
+		}
+
+		operationType, _ := fields["op"].(string)
+		if !slices.Contains(patchOperationTypes, operationType) {
+			return nil, fmt.Errorf("Operation at index %d has an invalid op '%v', must be one of: %s", i, fields["op"], strings.Join(patchOperationTypes, ", "))
+		}
+
+		path, _ := fields["path"].(string)
+		if path == "" {
+			return nil, fmt.Errorf("Operation at index %d is missing the path", i)
+		}
+
+		value, hasValue := fields["value"]
+		if !hasValue && operationType != "remove" && operationType != "move" {
+			return nil, fmt.Errorf("Operation at index %d is missing the value", i)
+		}
+
+		from, _ := fields["from"].(string)
+		if from == "" && operationType == "move" {
+			return nil, fmt.Errorf("Operation at index %d is missing the from path", i)
+		}
+
+		patchOperations[i] = patchOperation{Type: operationType, Path: path, From: from, Value: value}
+	}
+
+	return patchOperations, nil
+}
+
+// Evaluates a condition such as "from c where c.version = 3" against the document
+func checkPatchCondition(document repositorymodels.Document, condition interface{}) (int, error) {
+	conditionText, ok := condition.(string)
+	if !ok {
+		return http.StatusBadRequest, errors.New("The patch condition must be a string")
+	}
+
+	queryText := conditionText
+	if !strings.HasPrefix(strings.ToUpper(strings.TrimSpace(conditionText)), "SELECT") {
+		queryText = "SELECT * " + conditionText
+	}
+
+	selectStmt, status, err := repositories.ParseQuery(queryText)
+	if status != repositorymodels.StatusOk {
+		return http.StatusBadRequest, fmt.Errorf("Failed to parse the patch condition: %s", err)
+	}
+
+	if len(memoryexecutor.Execute(selectStmt, []memoryexecutor.RowType{map[string]interface{}(document)})) == 0 {
+		return http.StatusPreconditionFailed, errPreconditionFailed
+	}
+
+	return http.StatusOK, nil
+}
+
+func applyPatchOperation(document map[string]interface{}, operation patchOperation) error {
+	segments, err := parsePatchPath(operation.Path)
+	if err != nil {
+		return err
+	}
+
+	switch operation.Type {
+	case "add":
+		return updatePatchTarget(document, segments, false, patchAdd(operation.Value))
+	case "set":
+		return updatePatchTarget(document, segments, true, patchSet(operation.Value))
+	case "replace":
+		return updatePatchTarget(document, segments, false, patchReplace(operation.Path, operation.Value))
+	case "remove":
+		return updatePatchTarget(document, segments, false, patchRemove(operation.Path, nil))
+	case "incr":
+		if _, ok := toFloat64(operation.Value); !ok {
+			return fmt.Errorf("Value of increment operation at path %s must be a number", operation.Path)
+		}
+		return updatePatchTarget(document, segments, false, patchIncrement(operation.Path, operation.Value))
+	case "move":
+		fromSegments, err := parsePatchPath(operation.From)
+		if err != nil {
+			return err
+		}
+		if operation.Path == operation.From || strings.HasPrefix(operation.Path, operation.From+"/") {
+			return fmt.Errorf("Cannot move value from %s to %s", operation.From, operation.Path)
+		}
+
+		var value interface{}
+		if err := updatePatchTarget(document, fromSegments, false, patchRemove(operation.From, &value)); err != nil {
+			return err
+		}
+		return updatePatchTarget(document, segments, false, patchAdd(value))
+	default:
+		return fmt.Errorf("Unsupported patch operation %q", operation.Type)
+	}
+}
+
+// Splits a path into its property names and array indexes, "~1" and "~0" stand for "/" and "~"
+func parsePatchPath(path string) ([]string, error) {
+	if !strings.HasPrefix(path, "/") || path == "/" {
+		return nil, fmt.Errorf("Invalid patch path %q", path)
+	}
+
+	segments := strings.Split(path[1:], "/")
+	for i, segment := range segments {
+		segments[i] = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+	}
+
+	return segments, nil
+}
+
+func updatePatchTarget(document map[string]interface{}, segments []string, createParents bool, update patchContainerUpdate) error {
+	_, err := updatePatchContainer(document, segments, createParents, update)
+	return err
+}
+
+func updatePatchContainer(container interface{}, segments []string, createParents bool, update patchContainerUpdate) (interface{}, error) {
+	key := segments[0]
+	if len(segments) == 1 {
+		return update(container, key)
+	}
+
+	switch typedContainer := container.(type) {
+	case map[string]interface{}:
+		child, ok := typedContainer[key]
+		if !ok {
+			if !createParents {
+				return nil, fmt.Errorf("Path /%s does not exist", strings.Join(segments, "/"))
+			}
+			child = make(map[string]interface{})
+		}
+
+		updatedChild, err := updatePatchContainer(child, segments[1:], createParents, update)
+		if err != nil {
+			return nil, err
+		}
+		typedContainer[key] = updatedChild
+		return typedContainer, nil
+	case []interface{}:
+		index, err := parsePatchArrayIndex(key, len(typedContainer)-1)
+		if err != nil {
+			return nil, err
+		}
+
+		updatedChild, err := updatePatchContainer(typedContainer[index], segments[1:], createParents, update)
+		if err != nil {
+			return nil, err
+		}
+		typedContainer[index] = updatedChild
+		return typedContainer, nil
+	default:
+		return nil, fmt.Errorf("Path /%s does not exist", strings.Join(segments, "/"))
+	}
+}
+
+func parsePatchArrayIndex(key string, maxIndex int) (int, error) {
+	index, err := strconv.Atoi(key)
+	if err != nil || index < 0 || index > maxIndex {
+		return 0, fmt.Errorf("Invalid array index %q", key)
+	}
+
+	return index, nil
+}
+
+func patchAdd(value interface{}) patchContainerUpdate {
+	return func(container interface{}, key string) (interface{}, error) {
+		switch typedContainer := container.(type) {
+		case map[string]interface{}:
+			typedContainer[key] = value
+			return typedContainer, nil
+		case []interface{}:
+			if key == "-" {
+				return append(typedContainer, value), nil
+			}
+
+			index, err := parsePatchArrayIndex(key, len(typedContainer))
+			if err != nil {
+				return nil, err
+			}
+
+			typedContainer = append(typedContainer, nil)
+			copy(typedContainer[index+1:], typedContainer[index:])
+			typedContainer[index] = value
+			return typedContainer, nil
+		default:
+			return nil, fmt.Errorf("Cannot add value to property %q of a non container value", key)
+		}
+	}
+}
+
+func patchSet(value interface{}) patchContainerUpdate {
+	return func(container interface{}, key string) (interface{}, error) {
+		typedContainer, ok := container.([]interface{})
+		if !ok {
+			return patchAdd(value)(container, key)
+		}
+
+		if key == "-" || key == strconv.Itoa(len(typedContainer)) {
+			return append(typedContainer, value), nil
+		}
+
+		index, err := parsePatchArrayIndex(key, len(typedContainer)-1)
+		if err != nil {
+			return nil, err
+		}
+
+		typedContainer[index] = value
+		return typedContainer, nil
+	}
+}
+
+func patchReplace(path string, value interface{}) patchContainerUpdate {
+	return func(container interface{}, key string) (interface{}, error) {
+		switch typedContainer := container.(type) {
+		case map[string]interface{}:
+			if _, ok := typedContainer[key]; !ok {
+				return nil, fmt.Errorf("Cannot replace value at path %s as it does not exist", path)
+			}
+			typedContainer[key] = value
+			return typedContainer, nil
+		case []interface{}:
+			index, err := parsePatchArrayIndex(key, len(typedContainer)-1)
+			if err != nil {
+				return nil, err
+			}
+			typedContainer[index] = value
+			return typedContainer, nil
+		default:
+			return nil, fmt.Errorf("Cannot replace value at path %s as it does not exist", path)
+		}
+	}
+}
+
+// Removes the target, its value is stored into removedValue when one is given
+func patchRemove(path string, removedValue *interface{}) patchContainerUpdate {
+	return func(container interface{}, key string) (interface{}, error) {
+		var value interface{}
+		var result interface{}
+
+		switch typedContainer := container.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if value, ok = typedContainer[key]; !ok {
+				return nil, fmt.Errorf("Cannot remove value at path %s as it does not exist", path)
+			}
+			delete(typedContainer, key)
+			result = typedContainer
+		case []interface{}:
+			index, err := parsePatchArrayIndex(key, len(typedContainer)-1)
+			if err != nil {
+				return nil, err
+			}
+			value = typedContainer[index]
+			result = append(typedContainer[:index], typedContainer[index+1:]...)
+		default:
+			return nil, fmt.Errorf("Cannot remove value at path %s as it does not exist", path)
+		}
+
+		if removedValue != nil {
+			*removedValue = value
+		}
+		return result, nil
+	}
+}
+
+// Integers incremented by integers stay integers, other numbers are added as floats
+func patchIncrement(path string, delta interface{}) patchContainerUpdate {
+	increment := func(current interface{}, exists bool) (interface{}, error) {
+		if !exists {
+			return delta, nil
+		}
+
+		currentInt, currentIsInt := current.(int)
+		deltaInt, deltaIsInt := delta.(int)
+		if currentIsInt && deltaIsInt {
+			return currentInt + deltaInt, nil
+		}
+
+		number, ok := toFloat64(current)
+		if !ok {
+			return nil, fmt.Errorf("Cannot increment value at path %s as it is not a number", path)
+		}
+		deltaNumber, _ := toFloat64(delta)
+		return number + deltaNumber, nil
+	}
+
+	return func(container interface{}, key string) (interface{}, error) {
+		switch typedContainer := container.(type) {
+		case map[string]interface{}:
+			current, exists := typedContainer[key]
+			value, err := increment(current, exists)
+			if err != nil {
+				return nil, err
+			}
+			typedContainer[key] = value
+			return typedContainer, nil
+		case []interface{}:
+			index, err := parsePatchArrayIndex(key, len(typedContainer)-1)
+			if err != nil {
+				return nil, err
+			}
+			value, err := increment(typedContainer[index], true)
+			if err != nil {
+				return nil, err
+			}
+			typedContainer[index] = value
+			return typedContainer, nil
+		default:
+			return nil, fmt.Errorf("Cannot increment value at path %s as it does not exist", path)
+		}
+	}
+}
+
+func toFloat64(value interface{}) (float64, bool) {
+	switch typedValue := value.(type) {
+	case int:
+		return float64(typedValue), true
+	case float64:
+		return typedValue, true
+	case json.Number:
+		float, err := typedValue.Float64()
+		return float, err == nil
+	}
+
+	return 0, false
+}
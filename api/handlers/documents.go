@@ -1,14 +1,19 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
-	jsonpatch "github.com/evanphx/json-patch/v5"
 	"github.com/gin-gonic/gin"
-	"github.com/pikami/cosmium/internal/constants"
+	"github.com/pikami/cosmium/api/config"
 	"github.com/pikami/cosmium/internal/logger"
 	"github.com/pikami/cosmium/internal/repositories"
 	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
@@ -18,20 +23,60 @@ func GetAllDocuments(c *gin.Context) {
 	databaseId := c.Param("databaseId")
 	collectionId := c.Param("collId")
 
-	documents, status := repositories.GetAllDocuments(databaseId, collectionId)
+	if !checkSessionToken(c, databaseId, collectionId) {
+		return
+	}
+
+	partitionKeyRange, ok := getPartitionKeyRangeHeader(c, databaseId, collectionId)
+	if !ok {
+		return
+	}
+
+	if isChangeFeedRequest(c) {
+		getChangeFeed(c, databaseId, collectionId, partitionKeyRange)
+		return
+	}
+
+	afterId, maxItemCount, ok := parseFeedPageHeaders(c)
+	if !ok {
+		return
+	}
+
+	collection, _ := repositories.GetCollection(databaseId, collectionId)
+
+	var partitionKey []interface{}
+	if partitionKeyHeader := c.GetHeader("x-ms-documentdb-partitionkey"); partitionKeyHeader != "" {
+		var err error
+		if partitionKey, err = parsePartitionKeyPrefixHeader(collection, partitionKeyHeader); err != nil {
+			writeBadRequest(c, err.Error())
+			return
+		}
+	}
+
+	documents, hasMore, status := repositories.GetDocumentsPage(databaseId, collectionId, afterId, maxItemCount, func(document repositorymodels.Document) bool {
+		if partitionKey != nil && !repositories.IsInPartitionPrefix(collection, document, partitionKey) {
+			return false
+		}
+		return partitionKeyRange == nil || repositories.IsInPartitionKeyRange(collection, document, *partitionKeyRange)
+	})
 	if status == repositorymodels.StatusOk {
-		collection, _ := repositories.GetCollection(databaseId, collectionId)
+		if hasMore {
+			setFeedContinuation(c, documents[len(documents)-1]["id"].(string))
+		}
 
+		setRequestCharge(c, queryRequestCharge(len(documents)))
+		setSessionToken(c, databaseId, collectionId)
+		setCollectionResourceHeaders(c, databaseId, collectionId)
 		c.Header("x-ms-item-count", fmt.Sprintf("%d", len(documents)))
-		c.IndentedJSON(http.StatusOK, gin.H{
-			"_rid":      collection.ID,
+		writeJSON(c, http.StatusOK, gin.H{
+			"_rid":      collection.ResourceID,
 			"Documents": documents,
 			"_count":    len(documents),
 		})
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	writeUnknownError(c)
 }
 
 func GetDocument(c *gin.Context) {
@@ -39,18 +84,38 @@ func GetDocument(c *gin.Context) {
 	collectionId := c.Param("collId")
 	documentId := c.Param("docId")
 
+	scope, ok := newPointReadScope(c, databaseId, collectionId)
+	if !ok {
+		return
+	}
+
+	if !checkSessionToken(c, databaseId, collectionId) {
+		return
+	}
+
 	document, status := repositories.GetDocument(databaseId, collectionId, documentId)
+	if status == repositorymodels.StatusOk && !scope.contains(document) {
+		status = repositorymodels.StatusNotFound
+	}
+
 	if status == repositorymodels.StatusOk {
-		c.IndentedJSON(http.StatusOK, document)
+		setETagHeader(c, document)
+		setSessionToken(c, databaseId, collectionId)
+		if ifNoneMatch := c.GetHeader("If-None-Match"); ifNoneMatch != "" && ifNoneMatch == document["_etag"] {
+			c.Status(http.StatusNotModified)
+			return
+		}
+
+		writeJSON(c, http.StatusOK, document)
 		return
 	}
 
 	if status == repositorymodels.StatusNotFound {
-		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "NotFound"})
+		writeNotFound(c)
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	writeUnknownError(c)
 }
 
 func DeleteDocument(c *gin.Context) {
@@ -58,160 +123,329 @@ func DeleteDocument(c *gin.Context) {
 	collectionId := c.Param("collId")
 	documentId := c.Param("docId")
 
-	status := repositories.DeleteDocument(databaseId, collectionId, documentId)
-	if status == repositorymodels.StatusOk {
-		c.Status(http.StatusNoContent)
+	scope, ok := newPointOperationScope(c, databaseId, collectionId)
+	if !ok {
 		return
 	}
 
-	if status == repositorymodels.StatusNotFound {
-		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "NotFound"})
-		return
-	}
+	// The partition and etag are checked against the document that is deleted
+	status, err := repositories.DeleteDocument(databaseId, collectionId, documentId, func(existingDocument repositorymodels.Document) error {
+		if !scope.contains(existingDocument) {
+			return errDocumentNotInPartition
+		}
+
+		if !isIfMatchSatisfied(c, existingDocument) {
+			return errPreconditionFailed
+		}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+		return nil
+	})
+
+	switch {
+	case status == repositorymodels.StatusOk:
+		setRequestCharge(c, deleteRequestCharge)
+		setSessionToken(c, databaseId, collectionId)
+		c.Status(http.StatusNoContent)
+	case status == repositorymodels.StatusNotFound || errors.Is(err, errDocumentNotInPartition):
+		writeNotFound(c)
+	case errors.Is(err, errPreconditionFailed):
+		writePreconditionFailed(c)
+	default:
+		writeUnknownError(c)
+	}
 }
 
-// TODO: Maybe move "replace" logic to repository
-func ReplaceDocument(c *gin.Context) {
+// Removes all documents of the logical partition given in the partition key header,
+// Cosmos DB deletes them in the background while they are gone once this returns
+func DeletePartitionKey(c *gin.Context) {
 	databaseId := c.Param("databaseId")
 	collectionId := c.Param("collId")
-	documentId := c.Param("docId")
 
-	var requestBody map[string]interface{}
-	if err := c.BindJSON(&requestBody); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+	collection, status := repositories.GetCollection(databaseId, collectionId)
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
 		return
 	}
 
-	status := repositories.DeleteDocument(databaseId, collectionId, documentId)
-	if status == repositorymodels.StatusNotFound {
-		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "NotFound"})
+	partitionKeyHeader := c.GetHeader("x-ms-documentdb-partitionkey")
+	if partitionKeyHeader == "" {
+		writeBadRequest(c, "The partition key to delete must be specified in the x-ms-documentdb-partitionkey header")
 		return
 	}
 
-	createdDocument, status := repositories.CreateDocument(databaseId, collectionId, requestBody)
-	if status == repositorymodels.Conflict {
-		c.IndentedJSON(http.StatusConflict, gin.H{"message": "Conflict"})
+	partitionKey, err := parsePartitionKeyHeader(collection, partitionKeyHeader)
+	if err != nil {
+		writeBadRequest(c, err.Error())
 		return
 	}
 
-	if status == repositorymodels.StatusOk {
-		c.IndentedJSON(http.StatusCreated, createdDocument)
+	if _, status := repositories.DeleteDocumentsByPartitionKey(databaseId, collectionId, partitionKey); status != repositorymodels.StatusOk {
+		writeNotFound(c)
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	setRequestCharge(c, deleteRequestCharge)
+	setSessionToken(c, databaseId, collectionId)
+	c.Status(http.StatusOK)
 }
 
-func PatchDocument(c *gin.Context) {
+func ReplaceDocument(c *gin.Context) {
 	databaseId := c.Param("databaseId")
 	collectionId := c.Param("collId")
 	documentId := c.Param("docId")
 
-	document, status := repositories.GetDocument(databaseId, collectionId, documentId)
-	if status == repositorymodels.StatusNotFound {
-		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "NotFound"})
-		return
-	}
-
 	var requestBody map[string]interface{}
-	if err := c.BindJSON(&requestBody); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+	if !bindRequestBody(c, &requestBody) {
 		return
 	}
 
-	operations := requestBody["operations"]
-	operationsBytes, err := json.Marshal(operations)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": "Could not decode operations"})
+	scope, ok := newPointOperationScope(c, databaseId, collectionId)
+	if !ok {
 		return
 	}
 
-	patch, err := jsonpatch.DecodePatch(operationsBytes)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
-		return
-	}
+	// The partition and etag are checked against the document that is replaced
+	errorStatus := http.StatusBadRequest
+	replacedDocument, status, err := repositories.ReplaceDocument(databaseId, collectionId, documentId, requestBody, func(existingDocument repositorymodels.Document) error {
+		if !scope.contains(existingDocument) {
+			return errDocumentNotInPartition
+		}
 
-	currentDocumentBytes, err := json.Marshal(document)
-	if err != nil {
-		logger.Error("Failed to marshal existing document:", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to marshal existing document"})
-		return
-	}
+		if !isIfMatchSatisfied(c, existingDocument) {
+			return errPreconditionFailed
+		}
 
-	modifiedDocumentBytes, err := patch.Apply(currentDocumentBytes)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
-		return
-	}
+		if err := validateDocumentId(requestBody["id"]); err != nil {
+			return err
+		}
 
-	var modifiedDocument map[string]interface{}
-	err = json.Unmarshal(modifiedDocumentBytes, &modifiedDocument)
-	if err != nil {
-		logger.Error("Failed to unmarshal modified document:", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to unmarshal modified document"})
-		return
-	}
+		if err := checkDocumentSize(requestBody); err != nil {
+			errorStatus = http.StatusRequestEntityTooLarge
+			return err
+		}
 
-	if modifiedDocument["id"] != document["id"] {
-		c.JSON(http.StatusUnprocessableEntity, gin.H{"message": "The ID field cannot be modified"})
-		return
-	}
+		if !scope.contains(requestBody) {
+			return errPartitionKeyMismatch
+		}
 
-	status = repositories.DeleteDocument(databaseId, collectionId, documentId)
-	if status == repositorymodels.StatusNotFound {
-		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "NotFound"})
-		return
+		return nil
+	})
+
+	switch {
+	case status == repositorymodels.StatusOk:
+		setETagHeader(c, replacedDocument)
+		setRequestCharge(c, writeRequestCharge(replacedDocument))
+		setSessionToken(c, databaseId, collectionId)
+		writeJSON(c, http.StatusOK, replacedDocument)
+	case status == repositorymodels.StatusNotFound || errors.Is(err, errDocumentNotInPartition):
+		writeNotFound(c)
+	case errors.Is(err, errPreconditionFailed):
+		writePreconditionFailed(c)
+	case errors.Is(err, errPartitionKeyMismatch):
+		writePartitionKeyMismatch(c)
+	case err != nil && errorStatus == http.StatusBadRequest:
+		writeBadRequest(c, err.Error())
+	case err != nil:
+		writeError(c, errorStatus, err.Error())
+	case status == repositorymodels.BadRequest:
+		writeBadRequest(c, "The id of the document does not match the id in the request path")
+	case status == repositorymodels.MissingPartitionKey:
+		writeBadRequest(c, errMissingPartitionKey.Error())
+	default:
+		writeUnknownError(c)
 	}
+}
 
-	createdDocument, status := repositories.CreateDocument(databaseId, collectionId, modifiedDocument)
-	if status == repositorymodels.Conflict {
-		c.IndentedJSON(http.StatusConflict, gin.H{"message": "Conflict"})
+func PatchDocument(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+	documentId := c.Param("docId")
+
+	var requestBody map[string]interface{}
+	if !bindRequestBody(c, &requestBody) {
 		return
 	}
 
-	if status == repositorymodels.StatusOk {
-		c.IndentedJSON(http.StatusCreated, createdDocument)
+	scope, ok := newPointOperationScope(c, databaseId, collectionId)
+	if !ok {
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	// The partition and etag are checked against the document the patch is applied to
+	errorStatus := http.StatusBadRequest
+	patchedDocument, status, err := repositories.PatchDocument(databaseId, collectionId, documentId, func(document repositorymodels.Document) (map[string]interface{}, error) {
+		if !scope.contains(document) {
+			return nil, errDocumentNotInPartition
+		}
+
+		if !isIfMatchSatisfied(c, document) {
+			errorStatus = http.StatusPreconditionFailed
+			return nil, errPreconditionFailed
+		}
+
+		modifiedDocument, patchErrorStatus, err := applyPatchOperations(document, requestBody)
+		if err != nil {
+			errorStatus = patchErrorStatus
+			return nil, err
+		}
+
+		if !scope.contains(modifiedDocument) {
+			return nil, errPartitionKeyModified
+		}
+
+		if err := validateDocumentId(modifiedDocument["id"]); err != nil {
+			return nil, err
+		}
+
+		if err := checkDocumentSize(modifiedDocument); err != nil {
+			errorStatus = http.StatusRequestEntityTooLarge
+			return nil, err
+		}
+
+		return modifiedDocument, nil
+	})
+
+	switch {
+	case status == repositorymodels.StatusOk:
+		setETagHeader(c, patchedDocument)
+		setRequestCharge(c, writeRequestCharge(patchedDocument))
+		setSessionToken(c, databaseId, collectionId)
+		writeJSON(c, http.StatusOK, patchedDocument)
+	case status == repositorymodels.StatusNotFound || errors.Is(err, errDocumentNotInPartition):
+		writeNotFound(c)
+	case errors.Is(err, errPreconditionFailed):
+		writePreconditionFailed(c)
+	case status == repositorymodels.MissingPartitionKey:
+		writeBadRequest(c, errMissingPartitionKey.Error())
+	case err != nil && errorStatus == http.StatusBadRequest:
+		writeBadRequest(c, err.Error())
+	case err != nil:
+		writeError(c, errorStatus, err.Error())
+	default:
+		writeUnknownError(c)
+	}
 }
 
 func DocumentsPost(c *gin.Context) {
 	databaseId := c.Param("databaseId")
 	collectionId := c.Param("collId")
 
+	if isBatch, _ := strconv.ParseBool(c.GetHeader("x-ms-cosmos-is-batch-request")); isBatch {
+		BatchDocuments(c)
+		return
+	}
+
 	var requestBody map[string]interface{}
-	if err := c.BindJSON(&requestBody); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+	if !bindRequestBody(c, &requestBody) {
 		return
 	}
 
 	query := requestBody["query"]
 	if query != nil {
+		queryText, ok := query.(string)
+		if !ok {
+			writeBadRequest(c, "The query must be a string")
+			return
+		}
+
 		if c.GetHeader("x-ms-cosmos-is-query-plan-request") != "" {
-			c.IndentedJSON(http.StatusOK, constants.QueryPlanResponse)
+			selectStmt, status, err := repositories.ParseQuery(queryText)
+			if status != repositorymodels.StatusOk {
+				handleQueryError(c, status, err)
+				return
+			}
+
+			writeJSON(c, http.StatusOK, buildQueryPlan(queryText, selectStmt))
 			return
 		}
 
+		// Sent by the SDKs when the query plan contained a rewritten query
+		queryText, rewrittenOptions := parseRewrittenQuery(queryText)
+
 		var queryParameters map[string]interface{}
 		if paramsArray, ok := requestBody["parameters"].([]interface{}); ok {
 			queryParameters = parametersToMap(paramsArray)
 		}
 
-		docs, status := repositories.ExecuteQueryDocuments(databaseId, collectionId, query.(string), queryParameters)
+		var partitionKey []interface{}
+		if partitionKeyHeader := c.GetHeader("x-ms-documentdb-partitionkey"); partitionKeyHeader != "" {
+			parsedPartitionKey, err := repositories.ParsePartitionKeyHeader(partitionKeyHeader)
+			if err != nil {
+				writeBadRequest(c, errInvalidPartitionKeyHeader.Error())
+				return
+			}
+			partitionKey = parsedPartitionKey
+		}
+
+		selectStmt, status, err := repositories.ParseQuery(queryText)
 		if status != repositorymodels.StatusOk {
-			// TODO: Currently we return everything if the query fails
-			GetAllDocuments(c)
+			handleQueryError(c, status, err)
+			return
+		}
+		selectStmt.Parameters = queryParameters
+
+		// The limit covers the skipped rows, the top level OFFSET is never applied here
+		if rewrittenOptions.clientOffset && selectStmt.Offset > 0 && selectStmt.Count > 0 {
+			selectStmt.Count += selectStmt.Offset
+		}
+
+		pagination, err := newQueryPagination(c, selectStmt)
+		if err != nil {
+			writeBadRequest(c, err.Error())
+			return
+		}
+		pagination.returnOrderByItems = rewrittenOptions.orderByItems && pagination.isOrdered
+
+		if !checkSessionToken(c, databaseId, collectionId) {
+			return
+		}
+
+		partitionKeyRange, ok := getPartitionKeyRangeHeader(c, databaseId, collectionId)
+		if !ok {
+			return
+		}
+
+		queryCtx, cancel := newQueryContext(c)
+		defer cancel()
+
+		executionStart := time.Now()
+		docs, stats, status := repositories.ExecuteQueryDocuments(queryCtx, databaseId, collectionId, pagination.query, partitionKey, partitionKeyRange)
+		executionTime := time.Since(executionStart)
+		if status == repositorymodels.StatusNotFound {
+			writeNotFound(c)
+			return
+		}
+
+		if status == repositorymodels.QueryCancelled {
+			handleQueryCancelled(c, queryCtx)
+			return
+		}
+
+		traceQuery(queryText, pagination.query, stats.QueryStats, len(docs), executionTime)
+
+		if rewrittenOptions.partialAggregates {
+			docs = toPartialAggregates(getQueryAggregateInfo(selectStmt), docs)
+		}
+
+		docs, continuation, err := pagination.page(docs)
+		if err != nil {
+			writeBadRequest(c, err.Error())
 			return
 		}
 
+		if continuation != "" {
+			c.Header("x-ms-continuation", continuation)
+		}
+
+		if populateMetrics, _ := strconv.ParseBool(c.GetHeader("x-ms-documentdb-populatequerymetrics")); populateMetrics {
+			c.Header("x-ms-documentdb-query-metrics", formatQueryMetrics(executionTime, stats, docs))
+		}
+
 		collection, _ := repositories.GetCollection(databaseId, collectionId)
+		setRequestCharge(c, queryRequestCharge(stats.ScannedRows))
+		setSessionToken(c, databaseId, collectionId)
 		c.Header("x-ms-item-count", fmt.Sprintf("%d", len(docs)))
-		c.IndentedJSON(http.StatusOK, gin.H{
+		writeJSON(c, http.StatusOK, gin.H{
 			"_rid":      collection.ResourceID,
 			"Documents": docs,
 			"_count":    len(docs),
@@ -219,38 +453,331 @@ func DocumentsPost(c *gin.Context) {
 		return
 	}
 
-	if requestBody["id"] == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"message": "BadRequest"})
+	if err := validateDocumentId(requestBody["id"]); err != nil {
+		writeBadRequest(c, err.Error())
+		return
+	}
+
+	if err := checkDocumentSize(requestBody); err != nil {
+		writeRequestEntityTooLarge(c, err.Error())
+		return
+	}
+
+	if !checkPartitionKeyHeader(c, databaseId, collectionId, requestBody) {
 		return
 	}
 
 	isUpsert, _ := strconv.ParseBool(c.GetHeader("x-ms-documentdb-is-upsert"))
 	if isUpsert {
-		repositories.DeleteDocument(databaseId, collectionId, requestBody["id"].(string))
+		upsertDocument(c, databaseId, collectionId, requestBody)
+		return
 	}
 
 	createdDocument, status := repositories.CreateDocument(databaseId, collectionId, requestBody)
 	if status == repositorymodels.Conflict {
-		c.IndentedJSON(http.StatusConflict, gin.H{"message": "Conflict"})
+		writeConflict(c)
+		return
+	}
+
+	if status == repositorymodels.MissingPartitionKey {
+		writeBadRequest(c, errMissingPartitionKey.Error())
+		return
+	}
+
+	if status == repositorymodels.DocumentLimitReached {
+		writeForbidden(c, errDocumentLimitReached.Error())
 		return
 	}
 
 	if status == repositorymodels.StatusOk {
-		c.IndentedJSON(http.StatusCreated, createdDocument)
+		setETagHeader(c, createdDocument)
+		setRequestCharge(c, writeRequestCharge(createdDocument))
+		setSessionToken(c, databaseId, collectionId)
+		writeJSON(c, http.StatusCreated, createdDocument)
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	writeUnknownError(c)
+}
+
+// Replacing an existing document responds with 200, creating one with 201.
+// The etag is only checked when the upsert replaces an existing document
+func upsertDocument(c *gin.Context, databaseId string, collectionId string, document map[string]interface{}) {
+	upsertedDocument, isReplaced, status, err := repositories.UpsertDocument(databaseId, collectionId, document, func(existingDocument repositorymodels.Document) error {
+		if !isIfMatchSatisfied(c, existingDocument) {
+			return errPreconditionFailed
+		}
+		return nil
+	})
+
+	switch {
+	case errors.Is(err, errPreconditionFailed):
+		writePreconditionFailed(c)
+	case status == repositorymodels.StatusOk && isReplaced:
+		setETagHeader(c, upsertedDocument)
+		setRequestCharge(c, writeRequestCharge(upsertedDocument))
+		setSessionToken(c, databaseId, collectionId)
+		writeJSON(c, http.StatusOK, upsertedDocument)
+	case status == repositorymodels.StatusOk:
+		setETagHeader(c, upsertedDocument)
+		setRequestCharge(c, writeRequestCharge(upsertedDocument))
+		setSessionToken(c, databaseId, collectionId)
+		writeJSON(c, http.StatusCreated, upsertedDocument)
+	case status == repositorymodels.StatusNotFound:
+		writeNotFound(c)
+	case status == repositorymodels.MissingPartitionKey:
+		writeBadRequest(c, errMissingPartitionKey.Error())
+	case status == repositorymodels.DocumentLimitReached:
+		writeForbidden(c, errDocumentLimitReached.Error())
+	default:
+		writeUnknownError(c)
+	}
+}
+
+var errPreconditionFailed = errors.New("precondition failed")
+
+const maxDocumentIdLength = 255
+
+// Ids are part of the document links, so characters with
+// a meaning in paths and trailing whitespace are rejected
+func validateDocumentId(id interface{}) error {
+	if id == nil {
+		return errors.New("The input content is invalid because the required properties - 'id; ' - are missing")
+	}
+
+	documentId, isString := id.(string)
+	switch {
+	case !isString:
+		return fmt.Errorf("The input content is invalid because the property 'id' must be a string, got %v", id)
+	case documentId == "":
+		return errors.New("The input content is invalid because the property 'id' must not be empty")
+	case utf8.RuneCountInString(documentId) > maxDocumentIdLength:
+		return fmt.Errorf("The input content is invalid because the property 'id' exceeds the maximum length of %d characters", maxDocumentIdLength)
+	case strings.ContainsAny(documentId, "/\\?#"):
+		return errors.New("The input content is invalid because the property 'id' must not contain the characters '/', '\\', '?' or '#'")
+	case strings.TrimRightFunc(documentId, unicode.IsSpace) != documentId:
+		return errors.New("The input content is invalid because the property 'id' must not end with whitespace")
+	}
+
+	return nil
+}
+
+// The limit applies to the document as sent by the client, without the system properties
+func checkDocumentSize(document map[string]interface{}) error {
+	maxDocumentSize := config.Config.MaxDocumentSize
+	if maxDocumentSize <= 0 {
+		return nil
+	}
+
+	serializedDocument, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	if len(serializedDocument) > maxDocumentSize {
+		return fmt.Errorf("Request size is too large, the document is %d bytes and the maximum allowed size is %d bytes", len(serializedDocument), maxDocumentSize)
+	}
+
+	return nil
+}
+
+// Writes a bad request when the document does not belong
+// to the partition given in the partition key header
+func checkPartitionKeyHeader(c *gin.Context, databaseId string, collectionId string, document map[string]interface{}) bool {
+	partitionKeyHeader := c.GetHeader("x-ms-documentdb-partitionkey")
+	if partitionKeyHeader == "" {
+		return true
+	}
+
+	collection, _ := repositories.GetCollection(databaseId, collectionId)
+	partitionKey, err := parsePartitionKeyHeader(collection, partitionKeyHeader)
+	if err != nil {
+		writeBadRequest(c, err.Error())
+		return false
+	}
+
+	if !repositories.IsInPartition(collection, document, partitionKey) {
+		writePartitionKeyMismatch(c)
+		return false
+	}
+
+	return true
+}
+
+var errInvalidPartitionKeyHeader = errors.New("The partition key header is not valid JSON")
+
+// Only returned when partition key values are required by the RequirePartitionKey flag
+var errMissingPartitionKey = errors.New("PartitionKey value must be supplied for this operation.")
+
+// Cosmos DB rejects writes to collections exceeding their storage quota with 403 Forbidden,
+// collections reaching the document limit set by the MaxDocumentCount flag fail the same way
+var errDocumentLimitReached = errors.New("The size of the collection has exceeded its quota, no more documents can be created.")
+
+var errPartitionKeyComponents = errors.New("Partition key provided either doesn't correspond to definition in the collection or doesn't match partition key field values specified in the document.")
+
+// Parses a partition key addressing a single partition, so a value is needed for every
+// path of hierarchical partition keys. An empty array addresses the documents without
+// partition key values, undefined values are represented as empty objects
+func parsePartitionKeyHeader(collection repositorymodels.Collection, header string) ([]interface{}, error) {
+	partitionKey, err := parsePartitionKeyPrefixHeader(collection, header)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(collection.PartitionKey.Paths) > 0 && len(partitionKey) != len(collection.PartitionKey.Paths) {
+		return nil, errPartitionKeyComponents
+	}
+
+	return partitionKey, nil
+}
+
+// Parses a partition key that may leave out the trailing paths of hierarchical partition
+// keys, reads address every partition starting with the given values then
+func parsePartitionKeyPrefixHeader(collection repositorymodels.Collection, header string) ([]interface{}, error) {
+	partitionKey, err := repositories.ParsePartitionKeyHeader(header)
+	if err != nil {
+		return nil, errInvalidPartitionKeyHeader
+	}
+
+	if len(partitionKey) == 0 {
+		for range collection.PartitionKey.Paths {
+			partitionKey = append(partitionKey, map[string]interface{}{})
+		}
+	}
+
+	if len(collection.PartitionKey.Paths) > 0 && len(partitionKey) > len(collection.PartitionKey.Paths) {
+		return nil, errPartitionKeyComponents
+	}
+
+	return partitionKey, nil
+}
+
+func writePartitionKeyMismatch(c *gin.Context) {
+	writeBadRequestWithSubStatus(c, subStatusPartitionKeyMismatch, "PartitionKey extracted from document doesn't match the one specified in the header")
+}
+
+var errPartitionKeyMismatch = errors.New("partition key mismatch")
+
+var errPartitionKeyModified = errors.New("The partition key of the document cannot be modified")
+
+var errDocumentNotInPartition = errors.New("document not in partition")
+
+// Point operations are scoped to the partition given in the partition key header,
+// so documents of other partitions are not found. The header is required on
+// partitioned collections, an empty key addresses documents without a key value
+type pointOperationScope struct {
+	collection   repositorymodels.Collection
+	partitionKey []interface{}
+}
+
+func newPointOperationScope(c *gin.Context, databaseId string, collectionId string) (pointOperationScope, bool) {
+	return newPartitionScope(c, databaseId, collectionId, parsePartitionKeyHeader)
+}
+
+// Reads may be scoped by a prefix of hierarchical partition keys
+func newPointReadScope(c *gin.Context, databaseId string, collectionId string) (pointOperationScope, bool) {
+	return newPartitionScope(c, databaseId, collectionId, parsePartitionKeyPrefixHeader)
+}
+
+func newPartitionScope(
+	c *gin.Context,
+	databaseId string,
+	collectionId string,
+	parseHeader func(collection repositorymodels.Collection, header string) ([]interface{}, error),
+) (pointOperationScope, bool) {
+	collection, status := repositories.GetCollection(databaseId, collectionId)
+	if status != repositorymodels.StatusOk {
+		writeNotFound(c)
+		return pointOperationScope{}, false
+	}
+
+	scope := pointOperationScope{collection: collection}
+	if len(collection.PartitionKey.Paths) == 0 {
+		return scope, true
+	}
+
+	partitionKeyHeader := c.GetHeader("x-ms-documentdb-partitionkey")
+	if partitionKeyHeader == "" {
+		writeBadRequest(c, errMissingPartitionKey.Error())
+		return pointOperationScope{}, false
+	}
+
+	partitionKey, err := parseHeader(collection, partitionKeyHeader)
+	if err != nil {
+		writeBadRequest(c, err.Error())
+		return pointOperationScope{}, false
+	}
+
+	scope.partitionKey = partitionKey
+	return scope, true
+}
+
+// Full partition keys match a single partition, prefixes every partition starting with them
+func (s pointOperationScope) contains(document map[string]interface{}) bool {
+	return repositories.IsInPartitionPrefix(s.collection, document, s.partitionKey)
+}
+
+// Writes the precondition failure response when the If-Match header
+// is set and doesn't match the etag of the stored document
+func isIfMatchSatisfied(c *gin.Context, document repositorymodels.Document) bool {
+	return isETagMatch(c.GetHeader("If-Match"), document)
+}
+
+// Shared by single and batch operations, "*" matches any existing document
+func isETagMatch(ifMatch string, document repositorymodels.Document) bool {
+	return ifMatch == "" || ifMatch == "*" || ifMatch == document["_etag"]
+}
+
+func writePreconditionFailed(c *gin.Context) {
+	writeError(c, http.StatusPreconditionFailed, "Operation cannot be performed because one of the specified precondition is not met.")
+}
+
+func setETagHeader(c *gin.Context, document repositorymodels.Document) {
+	if etag, ok := document["_etag"].(string); ok {
+		c.Header("etag", etag)
+	}
 }
 
 func parametersToMap(pairs []interface{}) map[string]interface{} {
 	result := make(map[string]interface{})
 
 	for _, pair := range pairs {
+		// Values may be of any JSON type, they are used as they are
 		if pairMap, ok := pair.(map[string]interface{}); ok {
-			result[pairMap["name"].(string)] = pairMap["value"]
+			if name, ok := pairMap["name"].(string); ok {
+				result[name] = pairMap["value"]
+			}
 		}
 	}
 
 	return result
 }
+
+// Query execution is bound to the request, so it stops when the client
+// disconnects, and to the configured timeout when one is set
+func newQueryContext(c *gin.Context) (context.Context, context.CancelFunc) {
+	if config.Config.QueryTimeout > 0 {
+		return context.WithTimeout(c.Request.Context(), config.Config.QueryTimeout)
+	}
+
+	return context.WithCancel(c.Request.Context())
+}
+
+func handleQueryCancelled(c *gin.Context, queryCtx context.Context) {
+	if errors.Is(queryCtx.Err(), context.DeadlineExceeded) {
+		writeError(c, http.StatusRequestTimeout, fmt.Sprintf("Query execution exceeded the timeout of %s", config.Config.QueryTimeout))
+		return
+	}
+
+	logger.Debug("Query execution was cancelled, the client disconnected")
+	writeError(c, http.StatusInternalServerError, "Query execution was cancelled")
+}
+
+func handleQueryError(c *gin.Context, status repositorymodels.RepositoryStatus, err error) {
+	if status == repositorymodels.QueryParseError {
+		writeBadRequest(c, fmt.Sprintf("Syntax error, failed to parse query: %s", err))
+		return
+	}
+
+	writeBadRequest(c, err.Error())
+}
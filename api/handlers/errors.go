@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	jsonnumbers "github.com/pikami/cosmium/internal/json_numbers"
+)
+
+// Sent when the partition key header does not match the partition key value of the document
+const subStatusPartitionKeyMismatch = 1001
+
+// Sent when a session token is ahead of the writes the collection has seen
+const subStatusReadSessionNotAvailable = 1002
+
+// Writes an error in the format of Cosmos DB. The SDKs read the code into their error
+// types, and some of their helpers parse the errors and the activity id out of the message
+func writeError(c *gin.Context, statusCode int, message string) {
+	activityId := c.GetHeader("x-ms-activity-id")
+	if activityId == "" {
+		activityId = uuid.New().String()
+	}
+
+	errors, _ := json.Marshal(gin.H{"Errors": []string{message}})
+	c.Header("x-ms-activity-id", activityId)
+	writeJSON(c, statusCode, gin.H{
+		"code":    strings.ReplaceAll(http.StatusText(statusCode), " ", ""),
+		"message": fmt.Sprintf("Message: %s\r\nActivityId: %s, Request URI: %s", errors, activityId, c.Request.URL.Path),
+	})
+}
+
+func writeErrorWithSubStatus(c *gin.Context, statusCode int, subStatus int, message string) {
+	c.Header("x-ms-substatus", strconv.Itoa(subStatus))
+	writeError(c, statusCode, message)
+}
+
+func writeBadRequest(c *gin.Context, message string) {
+	writeError(c, http.StatusBadRequest, message)
+}
+
+func writeBadRequestWithSubStatus(c *gin.Context, subStatus int, message string) {
+	writeErrorWithSubStatus(c, http.StatusBadRequest, subStatus, message)
+}
+
+func writeNotFound(c *gin.Context) {
+	writeError(c, http.StatusNotFound, "Resource Not Found. Learn more: https://aka.ms/cosmosdb-tsg-not-found")
+}
+
+func writeConflict(c *gin.Context) {
+	writeError(c, http.StatusConflict, "Entity with the specified id already exists in the system.")
+}
+
+func writeForbidden(c *gin.Context, message string) {
+	writeError(c, http.StatusForbidden, message)
+}
+
+func writeRequestEntityTooLarge(c *gin.Context, message string) {
+	writeError(c, http.StatusRequestEntityTooLarge, message)
+}
+
+func writeUnknownError(c *gin.Context) {
+	writeError(c, http.StatusInternalServerError, "Unknown error")
+}
+
+// Decodes the JSON request body, a bad request is written when it is malformed.
+// Integers are kept as they were sent instead of being rounded to floats
+func bindRequestBody(c *gin.Context, body interface{}) bool {
+	if c.Request.Body == nil {
+		writeBadRequest(c, "The request body is not valid JSON: invalid request")
+		return false
+	}
+
+	if err := jsonnumbers.Decode(json.NewDecoder(c.Request.Body), body); err != nil {
+		writeBadRequest(c, fmt.Sprintf("The request body is not valid JSON: %s", err))
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Slices a read feed according to the "x-ms-max-item-count" and "x-ms-continuation" headers.
+// The resources must be sorted by id, the continuation token keeps the id of the last
+// returned resource, so resources created or deleted between page fetches don't shift
+// the ones that were already returned. A bad request is written for invalid tokens
+func paginateFeed[T any](c *gin.Context, resources []T, getId func(T) string) ([]T, bool) {
+	afterId, maxItemCount, ok := parseFeedPageHeaders(c)
+	if !ok {
+		return nil, false
+	}
+
+	start := 0
+	for afterId != "" && start < len(resources) && getId(resources[start]) <= afterId {
+		start++
+	}
+
+	end := len(resources)
+	if maxItemCount > 0 && start+maxItemCount < end {
+		end = start + maxItemCount
+		setFeedContinuation(c, getId(resources[end-1]))
+	}
+
+	return resources[start:end], true
+}
+
+// Returns the id after which the page starts and the maximum number of resources
+// in it, 0 when there is no limit. A bad request is written for invalid tokens
+func parseFeedPageHeaders(c *gin.Context) (string, int, bool) {
+	afterId := ""
+	if continuation := c.GetHeader("x-ms-continuation"); continuation != "" {
+		token, err := decodeContinuationToken(continuation)
+		if err != nil || token.Id == "" {
+			writeBadRequest(c, errInvalidContinuationToken.Error())
+			return "", 0, false
+		}
+		afterId = token.Id
+	}
+
+	maxItemCount, err := strconv.Atoi(c.GetHeader("x-ms-max-item-count"))
+	if err != nil || maxItemCount < 0 {
+		maxItemCount = 0
+	}
+
+	return afterId, maxItemCount, true
+}
+
+func setFeedContinuation(c *gin.Context, lastId string) {
+	c.Header("x-ms-continuation", encodeContinuationToken(queryContinuationToken{Id: lastId}))
+}
@@ -1,13 +1,19 @@
 package middleware
 
 import (
+	"fmt"
+	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pikami/cosmium/api/config"
 	"github.com/pikami/cosmium/internal/authentication"
 	"github.com/pikami/cosmium/internal/logger"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
 )
 
 func Authentication() gin.HandlerFunc {
@@ -15,7 +21,9 @@ func Authentication() gin.HandlerFunc {
 		requestUrl := c.Request.URL.String()
 		if config.Config.DisableAuth ||
 			strings.HasPrefix(requestUrl, "/_explorer") ||
-			strings.HasPrefix(requestUrl, "/cosmium") {
+			strings.HasPrefix(requestUrl, "/cosmium") ||
+			strings.HasPrefix(requestUrl, "/_state") ||
+			strings.HasPrefix(requestUrl, "/_admin") {
 			return
 		}
 
@@ -24,21 +32,142 @@ func Authentication() gin.HandlerFunc {
 
 		authHeader := c.Request.Header.Get("authorization")
 		date := c.Request.Header.Get("x-ms-date")
+		if date == "" {
+			date = c.Request.Header.Get("date")
+		}
+
+		token, err := authentication.ParseAuthorizationHeader(authHeader)
+		if err != nil {
+			abortUnauthorized(c, err.Error())
+			return
+		}
+
+		if token.Type == authentication.TokenTypeResource {
+			decoded, _ := url.QueryUnescape(authHeader)
+			authorizeResourceToken(c, strings.Replace(decoded, " ", "+", -1))
+			return
+		}
+
+		if !config.Config.DisableDateCheck {
+			if err := authentication.ValidateRequestDate(date, time.Now()); err != nil {
+				abortUnauthorized(c, err.Error())
+				return
+			}
+		}
+
 		expectedSignature := authentication.GenerateSignature(
 			c.Request.Method, resourceType, resourceId, date, config.Config.AccountKey)
+		if token.Signature != expectedSignature {
+			logger.Errorf("Got wrong signature from client.\n- Expected: %s\n- Got: %s\n", expectedSignature, token.Signature)
+			abortUnauthorized(c, "Wrong signature.")
+		}
+	}
+}
+
+func abortUnauthorized(c *gin.Context, message string) {
+	c.JSON(http.StatusUnauthorized, gin.H{
+		"code":    "Unauthorized",
+		"message": message,
+	})
+	c.Abort()
+}
+
+func authorizeResourceToken(c *gin.Context, token string) {
+	permission, status := repositories.GetPermissionByToken(token)
+	if status != repositorymodels.StatusOk {
+		abortUnauthorized(c, "Invalid resource token.")
+		return
+	}
+
+	if !isInPermissionScope(c, permission) ||
+		(permission.PermissionMode == repositorymodels.PermissionModeRead && !isReadRequest(c)) {
+		c.JSON(http.StatusForbidden, gin.H{
+			"code":    "Forbidden",
+			"message": "Insufficient permissions provided in the authorization header for the corresponding request.",
+		})
+		c.Abort()
+	}
+}
+
+func isInPermissionScope(c *gin.Context, permission repositorymodels.Permission) bool {
+	scope := strings.Trim(permission.Resource, "/")
+	links := requestToResourceLinks(c)
+
+	// Account level reads, like the initial database account request, carry no link
+	if len(links) == 0 {
+		return isReadRequest(c)
+	}
+
+	for _, link := range links {
+		if link == scope || strings.HasPrefix(link, scope+"/") {
+			return true
+		}
+	}
+
+	return false
+}
 
-		decoded, _ := url.QueryUnescape(authHeader)
-		params, _ := url.ParseQuery(decoded)
-		clientSignature := strings.Replace(params.Get("sig"), " ", "+", -1)
-		if clientSignature != expectedSignature {
-			logger.Errorf("Got wrong signature from client.\n- Expected: %s\n- Got: %s\n", expectedSignature, clientSignature)
-			c.IndentedJSON(401, gin.H{
-				"code":    "Unauthorized",
-				"message": "Wrong signature.",
-			})
-			c.Abort()
+// Returns both the name based and the resource id based links of the requested resource,
+// permissions may reference either of them
+func requestToResourceLinks(c *gin.Context) []string {
+	databaseId := c.Param("databaseId")
+	collId := c.Param("collId")
+	docId := c.Param("docId")
+	if databaseId == "" {
+		return []string{}
+	}
+
+	nameLink := "dbs/" + databaseId
+	ridLink := ""
+	if database, status := repositories.GetDatabase(databaseId); status == repositorymodels.StatusOk {
+		ridLink = "dbs/" + database.ResourceID
+	}
+
+	if collId != "" {
+		nameLink += "/colls/" + collId
+		if collection, status := repositories.GetCollection(databaseId, collId); status == repositorymodels.StatusOk && ridLink != "" {
+			ridLink += "/colls/" + collection.ResourceID
+		} else {
+			ridLink = ""
+		}
+	}
+
+	if docId != "" {
+		nameLink += "/docs/" + docId
+		if document, status := repositories.GetDocument(databaseId, collId, docId); status == repositorymodels.StatusOk && ridLink != "" {
+			ridLink += fmt.Sprintf("/docs/%s", document["_rid"])
+		} else {
+			ridLink = ""
 		}
 	}
+
+	// Feeds like "/docs" or "/pkranges" are addressed below the resource itself
+	if requestPath := strings.TrimSuffix(c.Request.URL.Path, "/"); strings.HasPrefix(requestPath, "/"+nameLink+"/") {
+		feedPath := strings.TrimPrefix(requestPath, "/"+nameLink)
+		nameLink += feedPath
+		if ridLink != "" {
+			ridLink += feedPath
+		}
+	}
+
+	if ridLink == "" {
+		return []string{nameLink}
+	}
+
+	return []string{nameLink, ridLink}
+}
+
+func isReadRequest(c *gin.Context) bool {
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	case http.MethodPost:
+		isQuery, _ := strconv.ParseBool(c.GetHeader("x-ms-documentdb-isquery"))
+		isQueryPlan, _ := strconv.ParseBool(c.GetHeader("x-ms-cosmos-is-query-plan-request"))
+		return isQuery || isQueryPlan || strings.HasPrefix(c.ContentType(), "application/query+json")
+	}
+
+	return false
 }
 
 func urlToResourceType(requestUrl string) string {
@@ -53,6 +182,11 @@ func urlToResourceType(requestUrl string) string {
 		resourceType = parts[5]
 	}
 
+	// Deleting a logical partition is signed as an operation on the partition key
+	if len(parts) == 7 && parts[5] == "operations" && strings.HasPrefix(parts[6], "partitionkeydelete") {
+		resourceType = "partitionkey"
+	}
+
 	return resourceType
 }
 
@@ -60,6 +194,13 @@ func requestToResourceId(c *gin.Context) string {
 	databaseId, _ := c.Params.Get("databaseId")
 	collId, _ := c.Params.Get("collId")
 	docId, _ := c.Params.Get("docId")
+	userId, _ := c.Params.Get("userId")
+	permissionId, _ := c.Params.Get("permissionId")
+	sprocId, _ := c.Params.Get("sprocId")
+	triggerId, _ := c.Params.Get("triggerId")
+	udfId, _ := c.Params.Get("udfId")
+	conflictId, _ := c.Params.Get("conflictId")
+	offerId, _ := c.Params.Get("offerId")
 	resourceType := urlToResourceType(c.Request.URL.String())
 
 	var resourceId string
@@ -72,6 +213,27 @@ func requestToResourceId(c *gin.Context) string {
 	if docId != "" {
 		resourceId += "/docs/" + docId
 	}
+	if userId != "" {
+		resourceId += "/users/" + userId
+	}
+	if permissionId != "" {
+		resourceId += "/permissions/" + permissionId
+	}
+	if sprocId != "" {
+		resourceId += "/sprocs/" + sprocId
+	}
+	if triggerId != "" {
+		resourceId += "/triggers/" + triggerId
+	}
+	if udfId != "" {
+		resourceId += "/udfs/" + udfId
+	}
+	if conflictId != "" {
+		resourceId += "/conflicts/" + conflictId
+	}
+	if offerId != "" {
+		resourceId = offerId
+	}
 
 	isFeed := c.Request.Header.Get("A-Im") == "Incremental Feed"
 	if resourceType == "pkranges" && isFeed {
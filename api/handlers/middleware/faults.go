@@ -0,0 +1,329 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/pikami/cosmium/api/config"
+)
+
+// Rules without an expiry are removed after this duration, so a
+// forgotten rule doesn't break the requests of later test runs
+const defaultFaultRuleExpiry = 5 * time.Minute
+
+// Injects the faults of the registered rules into matching requests to databases and
+// their resources, the first active rule matching a request is applied to it
+func FaultInjection() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !strings.HasPrefix(c.Request.URL.Path, "/dbs") {
+			c.Next()
+			return
+		}
+
+		rule, ok := matchFaultRule(c, time.Now())
+		if !ok {
+			c.Next()
+			return
+		}
+
+		if delay := rule.latency.Delay(); delay > 0 {
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+
+			select {
+			case <-timer.C:
+			case <-c.Request.Context().Done():
+				c.Abort()
+				return
+			}
+		}
+
+		if rule.StatusCode == 0 {
+			c.Next()
+			return
+		}
+
+		abortWithFault(c, rule)
+	}
+}
+
+// Matches requests and the fault injected into them, empty
+// properties match every request and only the latency is required
+type FaultRule struct {
+	ID string `json:"id"`
+
+	// HTTP method of the request, like "DELETE"
+	Method string `json:"method,omitempty"`
+
+	// Type of the requested resource, like "docs" or "colls"
+	ResourceType string `json:"resourceType,omitempty"`
+
+	// One of "read", "query" or "write"
+	Operation string `json:"operation,omitempty"`
+
+	DatabaseID   string `json:"databaseId,omitempty"`
+	CollectionID string `json:"collectionId,omitempty"`
+
+	// Requests the rule is applied to before it is removed, 0 applies it until it expires
+	Count int `json:"count,omitempty"`
+
+	// Applies the rule to the first matching request of every document id only
+	OncePerDocument bool `json:"oncePerDocument,omitempty"`
+
+	// Delays matching requests by a duration like "2s" or a range like "1s-3s"
+	Latency string `json:"latency,omitempty"`
+
+	// Status code answering matching requests, like 503 or 449. Requests are only
+	// delayed and then handled as usual when it is left out
+	StatusCode    int    `json:"statusCode,omitempty"`
+	SubStatusCode int    `json:"subStatusCode,omitempty"`
+	RetryAfter    string `json:"retryAfter,omitempty"`
+	Message       string `json:"message,omitempty"`
+
+	// Duration like "30s" after which the rule is removed, 5 minutes when left out
+	ExpiresAfter string    `json:"expiresAfter,omitempty"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+}
+
+type faultRule struct {
+	FaultRule
+	latency         config.LatencyRange
+	retryAfter      time.Duration
+	seenDocumentIds map[string]bool
+}
+
+var ErrFaultRuleExists = errors.New("A fault rule with the same id already exists")
+
+var faultRulesLock sync.Mutex
+var faultRules = make([]*faultRule, 0)
+
+// Validates the rule and registers it after the existing rules, an id is generated
+// when the rule has none. Returns the registered rule with its expiry
+func AddFaultRule(rule FaultRule) (FaultRule, error) {
+	registeredRule := &faultRule{FaultRule: rule, seenDocumentIds: make(map[string]bool)}
+	if err := registeredRule.parse(time.Now()); err != nil {
+		return FaultRule{}, err
+	}
+
+	faultRulesLock.Lock()
+	defer faultRulesLock.Unlock()
+
+	for _, existingRule := range faultRules {
+		if existingRule.ID == registeredRule.ID {
+			return FaultRule{}, ErrFaultRuleExists
+		}
+	}
+
+	faultRules = append(faultRules, registeredRule)
+	return registeredRule.FaultRule, nil
+}
+
+// Returns the rules that are still active in the order they are matched
+func GetFaultRules() []FaultRule {
+	faultRulesLock.Lock()
+	defer faultRulesLock.Unlock()
+
+	removeExpiredFaultRules(time.Now())
+
+	rules := make([]FaultRule, 0, len(faultRules))
+	for _, rule := range faultRules {
+		rules = append(rules, rule.FaultRule)
+	}
+
+	return rules
+}
+
+// Returns false when no rule with the id is registered
+func DeleteFaultRule(ruleId string) bool {
+	faultRulesLock.Lock()
+	defer faultRulesLock.Unlock()
+
+	for i, rule := range faultRules {
+		if rule.ID == ruleId {
+			faultRules = append(faultRules[:i], faultRules[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+func DeleteAllFaultRules() {
+	faultRulesLock.Lock()
+	defer faultRulesLock.Unlock()
+
+	faultRules = make([]*faultRule, 0)
+}
+
+func (r *faultRule) parse(now time.Time) error {
+	if r.ID == "" {
+		r.ID = uuid.New().String()
+	}
+
+	r.Method = strings.ToUpper(r.Method)
+
+	switch r.Operation {
+	case "", operationRead, operationQuery, operationWrite:
+	default:
+		return fmt.Errorf("Invalid operation '%s', must be one of: read, query, write", r.Operation)
+	}
+
+	if r.Latency == "" && r.StatusCode == 0 {
+		return errors.New("A fault rule requires a latency or a status code")
+	}
+
+	if r.StatusCode != 0 && (r.StatusCode < 400 || r.StatusCode > 599) {
+		return fmt.Errorf("Invalid status code %d, faults must be client or server errors", r.StatusCode)
+	}
+
+	if r.Count < 0 {
+		return errors.New("The count of a fault rule can't be negative")
+	}
+
+	var err error
+	if r.Latency != "" {
+		if r.latency, err = config.ParseLatencyRange(r.Latency); err != nil {
+			return err
+		}
+	}
+
+	if r.RetryAfter != "" {
+		if r.retryAfter, err = time.ParseDuration(r.RetryAfter); err != nil {
+			return fmt.Errorf("invalid retry after %q: %w", r.RetryAfter, err)
+		}
+	}
+
+	expiry := defaultFaultRuleExpiry
+	if r.ExpiresAfter != "" {
+		if expiry, err = time.ParseDuration(r.ExpiresAfter); err != nil || expiry <= 0 {
+			return fmt.Errorf("invalid expiry %q, must be a positive duration", r.ExpiresAfter)
+		}
+	}
+	r.ExpiresAt = now.Add(expiry)
+
+	return nil
+}
+
+// Finds the rule applied to the request and counts the request towards it,
+// rules that were applied to their count of requests are removed
+func matchFaultRule(c *gin.Context, now time.Time) (faultRule, bool) {
+	faultRulesLock.Lock()
+	defer faultRulesLock.Unlock()
+
+	removeExpiredFaultRules(now)
+
+	for i, rule := range faultRules {
+		if !rule.matches(c) {
+			continue
+		}
+
+		if rule.OncePerDocument {
+			documentId := getRequestDocumentId(c)
+			if documentId == "" || rule.seenDocumentIds[documentId] {
+				continue
+			}
+			rule.seenDocumentIds[documentId] = true
+		}
+
+		if rule.Count > 0 {
+			rule.Count--
+			if rule.Count == 0 {
+				faultRules = append(faultRules[:i], faultRules[i+1:]...)
+			}
+		}
+
+		return *rule, true
+	}
+
+	return faultRule{}, false
+}
+
+// Expects the fault rules lock to be held by the caller
+func removeExpiredFaultRules(now time.Time) {
+	activeRules := faultRules[:0]
+	for _, rule := range faultRules {
+		if now.Before(rule.ExpiresAt) {
+			activeRules = append(activeRules, rule)
+		}
+	}
+	faultRules = activeRules
+}
+
+func (r *faultRule) matches(c *gin.Context) bool {
+	return (r.Method == "" || r.Method == c.Request.Method) &&
+		(r.ResourceType == "" || r.ResourceType == urlToResourceType(c.Request.URL.Path)) &&
+		(r.Operation == "" || r.Operation == getOperationKind(c)) &&
+		(r.DatabaseID == "" || r.DatabaseID == c.Param("databaseId")) &&
+		(r.CollectionID == "" || r.CollectionID == c.Param("collId"))
+}
+
+// The id of created documents is only found in the request body, which is
+// read here and restored for the handler
+func getRequestDocumentId(c *gin.Context) string {
+	if documentId := c.Param("docId"); documentId != "" {
+		return documentId
+	}
+
+	if c.Request.Method != http.MethodPost || c.Request.Body == nil {
+		return ""
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	var document struct {
+		ID string `json:"id"`
+	}
+	json.Unmarshal(body, &document)
+
+	return document.ID
+}
+
+// Answers like the Cosmos DB errors written by the handlers
+func abortWithFault(c *gin.Context, rule faultRule) {
+	activityId := c.GetHeader("x-ms-activity-id")
+	if activityId == "" {
+		activityId = uuid.New().String()
+	}
+
+	code := strings.ReplaceAll(http.StatusText(rule.StatusCode), " ", "")
+	if rule.StatusCode == statusRetryWith {
+		code = "RetryWith"
+	}
+
+	message := rule.Message
+	if message == "" {
+		message = fmt.Sprintf("Fault injected by the rule '%s'", rule.ID)
+	}
+
+	if rule.SubStatusCode != 0 {
+		c.Header("x-ms-substatus", strconv.Itoa(rule.SubStatusCode))
+	}
+	if rule.retryAfter > 0 {
+		c.Header("x-ms-retry-after-ms", strconv.FormatInt(rule.retryAfter.Milliseconds(), 10))
+	}
+	c.Header("x-ms-activity-id", activityId)
+	c.Header("x-ms-request-charge", "0.00")
+
+	errors, _ := json.Marshal(gin.H{"Errors": []string{message}})
+	c.AbortWithStatusJSON(rule.StatusCode, gin.H{
+		"code":    code,
+		"message": fmt.Sprintf("Message: %s\r\nActivityId: %s, Request URI: %s", errors, activityId, c.Request.URL.Path),
+	})
+}
+
+// Sent by Cosmos DB when a write conflicts with a concurrent operation, the SDKs retry it
+const statusRetryWith = 449
@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/api/config"
+)
+
+// Delays requests to databases and their resources by the configured latency of
+// the operation. The "x-cosmium-latency" header overrides it for a single request
+// with a duration like "100ms" or a range like "50ms-200ms"
+func ArtificialLatency() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !strings.HasPrefix(c.Request.URL.Path, "/dbs") {
+			c.Next()
+			return
+		}
+
+		latency := getOperationLatency(c)
+		if header := c.GetHeader("x-cosmium-latency"); header != "" {
+			var err error
+			if latency, err = config.ParseLatencyRange(header); err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"code": "BadRequest", "message": err.Error()})
+				return
+			}
+		}
+
+		// Clients giving up on the request are not kept waiting
+		if delay := latency.Delay(); delay > 0 {
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+
+			select {
+			case <-timer.C:
+			case <-c.Request.Context().Done():
+				c.Abort()
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+func getOperationLatency(c *gin.Context) config.LatencyRange {
+	switch getOperationKind(c) {
+	case operationRead:
+		return config.Config.ReadLatency
+	case operationQuery:
+		return config.Config.QueryLatency
+	}
+
+	return config.Config.WriteLatency
+}
+
+const (
+	operationRead  = "read"
+	operationQuery = "query"
+	operationWrite = "write"
+)
+
+func getOperationKind(c *gin.Context) string {
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead:
+		return operationRead
+	}
+
+	isQuery, _ := strconv.ParseBool(c.GetHeader("x-ms-documentdb-isquery"))
+	if c.Request.Method == http.MethodPost && (isQuery || strings.HasPrefix(c.ContentType(), "application/query+json")) {
+		return operationQuery
+	}
+
+	return operationWrite
+}
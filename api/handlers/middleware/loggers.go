@@ -3,24 +3,46 @@ package middleware
 import (
 	"bytes"
 	"io"
+	"log/slog"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pikami/cosmium/internal/logger"
 )
 
+// Logs every request with its method, path, status, duration and activity id at the
+// info level, the bodies of the requests are logged at the debug level before
 func RequestLogger() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		buf, _ := io.ReadAll(c.Request.Body)
-		rdr1 := io.NopCloser(bytes.NewBuffer(buf))
-		rdr2 := io.NopCloser(bytes.NewBuffer(buf))
+		if logger.IsEnabled(slog.LevelDebug) {
+			buf, _ := io.ReadAll(c.Request.Body)
+			rdr1 := io.NopCloser(bytes.NewBuffer(buf))
+			rdr2 := io.NopCloser(bytes.NewBuffer(buf))
 
-		bodyStr := readBody(rdr1)
-		if bodyStr != "" {
-			logger.Debug(bodyStr)
+			bodyStr := readBody(rdr1)
+			if bodyStr != "" {
+				logger.Debugw("Request body", "method", c.Request.Method, "path", c.Request.URL.Path, "body", bodyStr)
+			}
+
+			c.Request.Body = rdr2
 		}
 
-		c.Request.Body = rdr2
+		start := time.Now()
 		c.Next()
+
+		// Error responses carry the activity id of the request, or a generated one without it
+		activityId := c.Writer.Header().Get("x-ms-activity-id")
+		if activityId == "" {
+			activityId = c.GetHeader("x-ms-activity-id")
+		}
+
+		logger.Infow("Request",
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+			"status", c.Writer.Status(),
+			"durationMs", float64(time.Since(start).Microseconds())/1000,
+			"activityId", activityId,
+		)
 	}
 }
 
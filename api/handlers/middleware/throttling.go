@@ -0,0 +1,169 @@
+package middleware
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/api/config"
+)
+
+const subStatusRequestRateTooLarge = 3200
+
+// Throttles requests to databases and their resources with 429 responses, either a
+// random fraction of them, the ones exceeding the request units budget of their collection
+// within a second, or every nth write to a collection. With a seed the same sequence of
+// requests is throttled on every run
+func Throttling() gin.HandlerFunc {
+	seed := config.Config.ThrottleSeed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	throttler := &requestThrottler{
+		random:      rand.New(rand.NewSource(seed)),
+		windows:     make(map[string]*throughputWindow),
+		writeCounts: make(map[string]int),
+	}
+	return func(c *gin.Context) {
+		if !strings.HasPrefix(c.Request.URL.Path, "/dbs") {
+			c.Next()
+			return
+		}
+
+		collectionKey := getThrottlingKey(c.Param("databaseId"), c.Param("collId"))
+		if retryAfter, throttled := throttler.shouldThrottle(time.Now(), collectionKey, !isReadRequest(c)); throttled {
+			// Rounded up, retrying after the advertised delay must not be throttled again
+			retryAfterMs := max((retryAfter + time.Millisecond - 1).Milliseconds(), 1)
+			c.Header("x-ms-retry-after-ms", strconv.FormatInt(retryAfterMs, 10))
+			c.Header("x-ms-substatus", strconv.Itoa(subStatusRequestRateTooLarge))
+			c.Header("x-ms-request-charge", "0.00")
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"code":    "TooManyRequests",
+				"message": "Request rate is large. More Request Units may be needed, so no changes were made. Please retry this request later.",
+			})
+			return
+		}
+
+		c.Next()
+
+		charge, _ := strconv.ParseFloat(c.Writer.Header().Get("x-ms-request-charge"), 64)
+		throttler.consume(time.Now(), collectionKey, charge)
+	}
+}
+
+// Simulated throughput of a collection, the zero value doesn't throttle
+type ThrottlingSettings struct {
+	// Request charges a collection can consume within a second
+	RUBudget float64 `json:"ruBudget"`
+
+	// Throttles every nth write to the collection, so tests can tell which request fails
+	EveryNthWrite int `json:"everyNthWrite"`
+}
+
+var collectionThrottlingLock sync.RWMutex
+var collectionThrottling = make(map[string]ThrottlingSettings)
+
+// Overrides the throttling flags for a single collection
+func SetCollectionThrottling(databaseId string, collectionId string, settings ThrottlingSettings) {
+	collectionThrottlingLock.Lock()
+	defer collectionThrottlingLock.Unlock()
+
+	collectionThrottling[getThrottlingKey(databaseId, collectionId)] = settings
+}
+
+// Throttles the collection by the flags again
+func ResetCollectionThrottling(databaseId string, collectionId string) {
+	collectionThrottlingLock.Lock()
+	defer collectionThrottlingLock.Unlock()
+
+	delete(collectionThrottling, getThrottlingKey(databaseId, collectionId))
+}
+
+func getCollectionThrottling(collectionKey string) ThrottlingSettings {
+	collectionThrottlingLock.RLock()
+	defer collectionThrottlingLock.RUnlock()
+
+	if settings, ok := collectionThrottling[collectionKey]; ok {
+		return settings
+	}
+
+	return ThrottlingSettings{RUBudget: config.Config.ThrottleRUBudget, EveryNthWrite: config.Config.ThrottleEveryNthWrite}
+}
+
+// Requests that don't target a collection are only throttled randomly
+func getThrottlingKey(databaseId string, collectionId string) string {
+	if databaseId == "" || collectionId == "" {
+		return ""
+	}
+
+	return databaseId + "/" + collectionId
+}
+
+type requestThrottler struct {
+	lock        sync.Mutex
+	random      *rand.Rand
+	windows     map[string]*throughputWindow
+	writeCounts map[string]int
+}
+
+type throughputWindow struct {
+	start    time.Time
+	consumed float64
+}
+
+// Returns how long the client should wait before retrying a throttled request
+func (t *requestThrottler) shouldThrottle(now time.Time, collectionKey string, isWrite bool) (time.Duration, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if collectionKey != "" {
+		settings := getCollectionThrottling(collectionKey)
+		if settings.RUBudget > 0 {
+			window := t.getWindow(now, collectionKey)
+			if window.consumed >= settings.RUBudget {
+				return window.start.Add(time.Second).Sub(now), true
+			}
+		}
+
+		if settings.EveryNthWrite > 0 && isWrite {
+			t.writeCounts[collectionKey]++
+			if t.writeCounts[collectionKey]%settings.EveryNthWrite == 0 {
+				return config.Config.ThrottleRetryAfter, true
+			}
+		}
+	}
+
+	// One number is drawn per request, so with a seed the throttled requests only depend on their order
+	if rate := config.Config.ThrottleRate; rate > 0 && t.random.Float64() < rate {
+		return config.Config.ThrottleRetryAfter, true
+	}
+
+	return 0, false
+}
+
+func (t *requestThrottler) consume(now time.Time, collectionKey string, charge float64) {
+	if collectionKey == "" || getCollectionThrottling(collectionKey).RUBudget <= 0 {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.getWindow(now, collectionKey).consumed += charge
+}
+
+// Expects the throttler lock to be held by the caller
+func (t *requestThrottler) getWindow(now time.Time, collectionKey string) *throughputWindow {
+	window, ok := t.windows[collectionKey]
+	if !ok || now.Sub(window.start) >= time.Second {
+		window = &throughputWindow{start: now}
+		t.windows[collectionKey] = window
+	}
+
+	return window
+}
@@ -1,16 +1,163 @@
 package handlers
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
 )
 
+var errOfferMigrationRequired = errors.New("The offer can only be switched between manual throughput and autoscale with the 'x-ms-cosmos-migrate-offer-to-autopilot' or 'x-ms-cosmos-migrate-offer-to-manual-throughput' header")
+
+var errConflictingOfferHeaders = errors.New("Both the 'x-ms-offer-throughput' and the 'x-ms-cosmos-offer-autopilot-settings' headers were specified, only one of them is allowed")
+
 func GetOffers(c *gin.Context) {
-	c.Header("x-ms-item-count", "0")
-	c.IndentedJSON(http.StatusOK, gin.H{
+	writeOffers(c, repositories.GetAllOffers())
+}
+
+// The SDKs look up the offer of a resource with a query on its offerResourceId
+func QueryOffers(c *gin.Context) {
+	selectStmt, ok := parseQueryRequest(c)
+	if !ok {
+		return
+	}
+
+	queryCtx, cancel := newQueryContext(c)
+	defer cancel()
+
+	offers, status := repositories.ExecuteQueryOffers(queryCtx, selectStmt)
+	if status == repositorymodels.QueryCancelled {
+		handleQueryCancelled(c, queryCtx)
+		return
+	}
+
+	if status == repositorymodels.StatusOk {
+		writeOffers(c, offers)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func GetOffer(c *gin.Context) {
+	offerId := c.Param("offerId")
+
+	offer, status := repositories.GetOffer(offerId)
+	if status == repositorymodels.StatusOk {
+		writeJSON(c, http.StatusOK, offer)
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+// Offers are migrated between manual throughput and autoscale with one of the migration
+// headers, the content of the request is ignored and derived from the current one then
+func ReplaceOffer(c *gin.Context) {
+	offerId := c.Param("offerId")
+
+	var offer repositorymodels.Offer
+	if !bindRequestBody(c, &offer) {
+		return
+	}
+
+	migrateToAutoscale, _ := strconv.ParseBool(c.GetHeader("x-ms-cosmos-migrate-offer-to-autopilot"))
+	migrateToManualThroughput, _ := strconv.ParseBool(c.GetHeader("x-ms-cosmos-migrate-offer-to-manual-throughput"))
+
+	var replacedOffer repositorymodels.Offer
+	var status repositorymodels.RepositoryStatus
+	switch {
+	case migrateToAutoscale && migrateToManualThroughput:
+		writeBadRequest(c, "The offer can't be migrated to autoscale and to manual throughput at once")
+		return
+	case migrateToAutoscale:
+		replacedOffer, status = repositories.MigrateOfferToAutoscale(offerId)
+	case migrateToManualThroughput:
+		replacedOffer, status = repositories.MigrateOfferToManualThroughput(offerId)
+	default:
+		if err := repositories.ValidateOfferContent(offer.Content); err != nil {
+			writeBadRequest(c, err.Error())
+			return
+		}
+
+		if existingOffer, existingStatus := repositories.GetOffer(offerId); existingStatus == repositorymodels.StatusOk &&
+			repositories.IsAutoscaleOffer(existingOffer) != repositories.IsAutoscaleOffer(offer) {
+			writeBadRequest(c, errOfferMigrationRequired.Error())
+			return
+		}
+
+		replacedOffer, status = repositories.ReplaceOffer(offerId, offer)
+	}
+
+	if status == repositorymodels.StatusOk {
+		writeJSON(c, http.StatusOK, replacedOffer)
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	if status == repositorymodels.BadRequest && (migrateToAutoscale || migrateToManualThroughput) {
+		writeBadRequest(c, "The offer already has the throughput type it is migrated to")
+		return
+	}
+
+	if status == repositorymodels.BadRequest {
+		writeBadRequest(c, "The id and the offerResourceId of an offer can't be changed")
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func writeOffers[T any](c *gin.Context, offers []T) {
+	c.Header("x-ms-item-count", fmt.Sprintf("%d", len(offers)))
+	writeJSON(c, http.StatusOK, gin.H{
 		"_rid":   "",
-		"_count": 0,
-		"Offers": []interface{}{},
+		"_count": len(offers),
+		"Offers": offers,
 	})
 }
+
+// Reads the throughput databases and collections are created with, nil
+// is returned when the request provisions no throughput for the resource
+func getOfferContentHeaders(c *gin.Context) (*repositorymodels.OfferContent, error) {
+	throughputHeader := c.GetHeader("x-ms-offer-throughput")
+	autopilotSettingsHeader := c.GetHeader("x-ms-cosmos-offer-autopilot-settings")
+
+	var content repositorymodels.OfferContent
+	switch {
+	case throughputHeader != "" && autopilotSettingsHeader != "":
+		return nil, errConflictingOfferHeaders
+	case throughputHeader != "":
+		throughput, err := strconv.Atoi(throughputHeader)
+		if err != nil {
+			return nil, fmt.Errorf("The value of the 'x-ms-offer-throughput' header is not a number: %s", throughputHeader)
+		}
+		content.OfferThroughput = throughput
+	case autopilotSettingsHeader != "":
+		if err := json.Unmarshal([]byte(autopilotSettingsHeader), &content.OfferAutopilotSettings); err != nil || content.OfferAutopilotSettings == nil {
+			return nil, fmt.Errorf("The value of the 'x-ms-cosmos-offer-autopilot-settings' header is not valid: %s", autopilotSettingsHeader)
+		}
+	default:
+		return nil, nil
+	}
+
+	if err := repositories.ValidateOfferContent(content); err != nil {
+		return nil, err
+	}
+
+	return &content, nil
+}
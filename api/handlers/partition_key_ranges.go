@@ -3,6 +3,7 @@ package handlers
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pikami/cosmium/internal/repositories"
@@ -13,14 +14,18 @@ func GetPartitionKeyRanges(c *gin.Context) {
 	databaseId := c.Param("databaseId")
 	collectionId := c.Param("collId")
 
-	if c.Request.Header.Get("if-none-match") != "" {
-		c.AbortWithStatus(http.StatusNotModified)
-		return
-	}
-
 	partitionKeyRanges, status := repositories.GetPartitionKeyRanges(databaseId, collectionId)
 	if status == repositorymodels.StatusOk {
-		c.Header("etag", "\"420\"")
+		// Clients cache the ranges and send the etag of the cached ones,
+		// the ranges only change when the collection is recreated or split
+		etag := partitionKeyRanges[0].Etag
+		if ifNoneMatch := c.GetHeader("if-none-match"); ifNoneMatch != "" && ifNoneMatch == etag {
+			c.Header("etag", etag)
+			c.AbortWithStatus(http.StatusNotModified)
+			return
+		}
+
+		c.Header("etag", etag)
 		c.Header("lsn", "420")
 		c.Header("x-ms-cosmos-llsn", "420")
 		c.Header("x-ms-global-committed-lsn", "420")
@@ -32,7 +37,7 @@ func GetPartitionKeyRanges(c *gin.Context) {
 			collectionRid = collection.ResourceID
 		}
 
-		c.IndentedJSON(http.StatusOK, gin.H{
+		writeJSON(c, http.StatusOK, gin.H{
 			"_rid":               collectionRid,
 			"_count":             len(partitionKeyRanges),
 			"PartitionKeyRanges": partitionKeyRanges,
@@ -41,9 +46,40 @@ func GetPartitionKeyRanges(c *gin.Context) {
 	}
 
 	if status == repositorymodels.StatusNotFound {
-		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "NotFound"})
+		writeNotFound(c)
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	writeUnknownError(c)
+}
+
+// Sent when the partition key range a request is pinned to was split
+const subStatusPartitionKeyRangeGone = 1002
+
+// Returns the range given in the partition key range header, nil when the request
+// isn't pinned to a range. Requests pinned to a split range fail with 410 Gone, so
+// the SDKs refresh their cached ranges and retry on the ranges that replaced it
+func getPartitionKeyRangeHeader(c *gin.Context, databaseId string, collectionId string) (*repositorymodels.PartitionKeyRange, bool) {
+	header := c.GetHeader("x-ms-documentdb-partitionkeyrangeid")
+	if header == "" {
+		return nil, true
+	}
+
+	// Some SDKs prefix the range id with the collection resource id
+	_, partitionKeyRangeId, found := strings.Cut(header, ",")
+	if !found {
+		partitionKeyRangeId = header
+	}
+
+	partitionKeyRange, status := repositories.GetPartitionKeyRange(databaseId, collectionId, partitionKeyRangeId)
+	switch status {
+	case repositorymodels.StatusOk:
+		return &partitionKeyRange, true
+	case repositorymodels.PartitionKeyRangeGone:
+		writeErrorWithSubStatus(c, http.StatusGone, subStatusPartitionKeyRangeGone, "The partition key range is gone, it was split into new partition key ranges")
+	default:
+		writeNotFound(c)
+	}
+
+	return nil, false
 }
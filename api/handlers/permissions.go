@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+)
+
+func GetAllPermissions(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	userId := c.Param("userId")
+
+	permissions, status := repositories.GetAllPermissions(databaseId, userId)
+	if status == repositorymodels.StatusOk {
+		user, _ := repositories.GetUser(databaseId, userId)
+
+		c.Header("x-ms-item-count", fmt.Sprintf("%d", len(permissions)))
+		writeJSON(c, http.StatusOK, gin.H{
+			"_rid":        user.ResourceID,
+			"Permissions": permissions,
+			"_count":      len(permissions),
+		})
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func GetPermission(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	userId := c.Param("userId")
+	permissionId := c.Param("permissionId")
+
+	permission, status := repositories.GetPermission(databaseId, userId, permissionId)
+	if status == repositorymodels.StatusOk {
+		writeJSON(c, http.StatusOK, permission)
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func DeletePermission(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	userId := c.Param("userId")
+	permissionId := c.Param("permissionId")
+
+	status := repositories.DeletePermission(databaseId, userId, permissionId)
+	if status == repositorymodels.StatusOk {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func CreatePermission(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	userId := c.Param("userId")
+	var newPermission repositorymodels.Permission
+
+	if !bindRequestBody(c, &newPermission) {
+		return
+	}
+
+	createdPermission, status := repositories.CreatePermission(databaseId, userId, newPermission)
+	if status == repositorymodels.BadRequest {
+		writeBadRequest(c, "The permission must have an id, a resource and a permission mode of Read or All")
+		return
+	}
+
+	if status == repositorymodels.Conflict {
+		writeConflict(c)
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	if status == repositorymodels.StatusOk {
+		writeJSON(c, http.StatusCreated, createdPermission)
+		return
+	}
+
+	writeUnknownError(c)
+}
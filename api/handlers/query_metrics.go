@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pikami/cosmium/internal/repositories"
+	memoryexecutor "github.com/pikami/cosmium/query_executors/memory_executor"
+)
+
+// Builds the value of the "x-ms-documentdb-query-metrics" header, the timings are
+// measured around the in-memory execution so most of the phases are reported as zero.
+// The retrieved documents are the ones of the partition the query was scoped to
+func formatQueryMetrics(executionTime time.Duration, stats repositories.DocumentQueryStats, outputDocuments []memoryexecutor.RowType) string {
+	executionTimeMs := float64(executionTime.Microseconds()) / 1000
+	metrics := []struct {
+		name  string
+		value interface{}
+	}{
+		{"totalExecutionTimeInMs", executionTimeMs},
+		{"queryCompileTimeInMs", 0.0},
+		{"queryLogicalPlanBuildTimeInMs", 0.0},
+		{"queryPhysicalPlanBuildTimeInMs", 0.0},
+		{"queryOptimizationTimeInMs", 0.0},
+		{"VMExecutionTimeInMs", executionTimeMs},
+		{"indexLookupTimeInMs", 0.0},
+		{"documentLoadTimeInMs", 0.0},
+		{"systemFunctionExecuteTimeInMs", 0.0},
+		{"userFunctionExecuteTimeInMs", 0.0},
+		{"retrievedDocumentCount", stats.ScannedRows},
+		{"retrievedDocumentSize", jsonSize(stats.ScannedDocuments)},
+		{"outputDocumentCount", len(outputDocuments)},
+		{"outputDocumentSize", jsonSize(outputDocuments)},
+		{"writeOutputTimeInMs", 0.0},
+		{"indexUtilizationRatio", 1.0},
+	}
+
+	formattedMetrics := make([]string, len(metrics))
+	for i, metric := range metrics {
+		switch value := metric.value.(type) {
+		case float64:
+			formattedMetrics[i] = fmt.Sprintf("%s=%.2f", metric.name, value)
+		default:
+			formattedMetrics[i] = fmt.Sprintf("%s=%v", metric.name, value)
+		}
+	}
+
+	return strings.Join(formattedMetrics, ";")
+}
+
+func jsonSize(value interface{}) int {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return 0
+	}
+
+	return len(bytes)
+}
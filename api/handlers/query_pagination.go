@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/parsers"
+	memoryexecutor "github.com/pikami/cosmium/query_executors/memory_executor"
+)
+
+// Continuation tokens of ORDER BY queries keep the sort key and id of
+// the last returned document, other queries keep the offset of the next result
+type queryContinuationToken struct {
+	Offset       int           `json:"offset,omitempty"`
+	OrderByItems []interface{} `json:"orderByItems,omitempty"`
+	Id           string        `json:"id,omitempty"`
+}
+
+var errInvalidContinuationToken = errors.New("invalid continuation token")
+
+// Slices the query results according to the "x-ms-max-item-count" and "x-ms-continuation"
+// headers. The query is executed again for every page, so resuming an ORDER BY query
+// seeks past the last returned sort key, which keeps documents inserted or deleted
+// between page fetches from shifting rows that were already returned
+type queryPagination struct {
+	query        parsers.SelectStmt
+	maxItemCount int
+	token        *queryContinuationToken
+	isOrdered    bool
+	// Rows of rewritten ORDER BY queries are returned with their sort key,
+	// so the SDKs can merge the results of all partition ranges
+	returnOrderByItems bool
+}
+
+func newQueryPagination(c *gin.Context, query parsers.SelectStmt) (queryPagination, error) {
+	pagination := queryPagination{query: query, isOrdered: isKeysetPaginated(query)}
+	if maxItemCount, err := strconv.Atoi(c.GetHeader("x-ms-max-item-count")); err == nil && maxItemCount > 0 {
+		pagination.maxItemCount = maxItemCount
+	}
+
+	if continuation := c.GetHeader("x-ms-continuation"); continuation != "" {
+		token, err := decodeContinuationToken(continuation)
+		if err != nil || token.Offset < 0 || pagination.isOrdered != (token.OrderByItems != nil) {
+			return queryPagination{}, errInvalidContinuationToken
+		}
+		pagination.token = &token
+	}
+
+	if pagination.isOrdered {
+		pagination.query = buildOrderedPageQuery(query)
+	}
+
+	return pagination, nil
+}
+
+// Returns the rows of the requested page and the continuation token for the next one
+func (p queryPagination) page(results []memoryexecutor.RowType) ([]memoryexecutor.RowType, string, error) {
+	if p.isOrdered {
+		return p.pageOrdered(results)
+	}
+
+	offset := 0
+	if p.token != nil {
+		if p.token.Offset > len(results) {
+			return nil, "", errInvalidContinuationToken
+		}
+		offset = p.token.Offset
+	}
+
+	if p.maxItemCount == 0 || offset+p.maxItemCount >= len(results) {
+		return results[offset:], "", nil
+	}
+
+	nextOffset := offset + p.maxItemCount
+	return results[offset:nextOffset], encodeContinuationToken(queryContinuationToken{Offset: nextOffset}), nil
+}
+
+func (p queryPagination) pageOrdered(results []memoryexecutor.RowType) ([]memoryexecutor.RowType, string, error) {
+	start := 0
+	if p.token != nil {
+		for start < len(results) && !p.isAfterToken(results[start]) {
+			start++
+		}
+	}
+
+	end := len(results)
+	if p.maxItemCount > 0 && start+p.maxItemCount < len(results) {
+		end = start + p.maxItemCount
+	}
+
+	page := make([]memoryexecutor.RowType, 0, end-start)
+	for _, row := range results[start:end] {
+		// Rows selecting an undefined value are left out of the result
+		typedRow := row.(map[string]interface{})
+		payload, ok := typedRow["payload"]
+		if !ok {
+			continue
+		}
+
+		if p.returnOrderByItems {
+			page = append(page, map[string]interface{}{
+				"_rid":         typedRow["_rid"],
+				"orderByItems": typedRow["orderByItems"],
+				"payload":      payload,
+			})
+		} else {
+			page = append(page, payload)
+		}
+	}
+
+	continuation := ""
+	if end < len(results) {
+		lastRow := results[end-1].(map[string]interface{})
+		orderByItems, _ := lastRow["orderByItems"].([]interface{})
+		id, _ := lastRow["id"].(string)
+		continuation = encodeContinuationToken(queryContinuationToken{OrderByItems: orderByItems, Id: id})
+	}
+
+	return page, continuation, nil
+}
+
+// Rows are sorted by the ORDER BY items and then by id, as the
+// documents are sorted by id before the query is executed
+func (p queryPagination) isAfterToken(row memoryexecutor.RowType) bool {
+	typedRow := row.(map[string]interface{})
+	orderByItems, _ := typedRow["orderByItems"].([]interface{})
+	if cmp := memoryexecutor.CompareOrderByItems(p.query.OrderExpressions, orderByItems, p.token.OrderByItems); cmp != 0 {
+		return cmp > 0
+	}
+
+	id, _ := typedRow["id"].(string)
+	return id > p.token.Id
+}
+
+// Rows of queries with joins share the document id and grouped, distinct or
+// subquery rows have none, such queries fall back to offset based continuations
+func isKeysetPaginated(query parsers.SelectStmt) bool {
+	return len(query.OrderExpressions) > 0 &&
+		query.Table.SubQuery == nil &&
+		len(query.JoinItems) == 0 &&
+		len(query.GroupBy) == 0 &&
+		!query.Distinct &&
+		!getQueryAggregateInfo(query).requiresPartialAggregates()
+}
+
+// Rewrites the selection so every row carries its sort key and document ids
+// next to the originally selected value:
+// SELECT [{"item": <order by>}] AS orderByItems, c.id AS id, c._rid AS _rid, <selection> AS payload
+func buildOrderedPageQuery(query parsers.SelectStmt) parsers.SelectStmt {
+	orderByItems := make([]parsers.SelectItem, len(query.OrderExpressions))
+	for i, orderExpression := range query.OrderExpressions {
+		item := orderExpression.SelectItem
+		item.Alias = "item"
+		orderByItems[i] = parsers.SelectItem{
+			Type:        parsers.SelectItemTypeObject,
+			SelectItems: []parsers.SelectItem{item},
+		}
+	}
+
+	var payload parsers.SelectItem
+	if len(query.SelectItems) == 1 && query.SelectItems[0].IsTopLevel {
+		payload = query.SelectItems[0]
+		payload.IsTopLevel = false
+	} else {
+		payload = parsers.SelectItem{Type: parsers.SelectItemTypeObject}
+		for i, selectItem := range query.SelectItems {
+			selectItem.Alias = getSelectItemAlias(selectItem, i)
+			payload.SelectItems = append(payload.SelectItems, selectItem)
+		}
+	}
+	payload.Alias = "payload"
+
+	pageQuery := query
+	pageQuery.SelectItems = []parsers.SelectItem{
+		{Alias: "orderByItems", Type: parsers.SelectItemTypeArray, SelectItems: orderByItems},
+		{Alias: "id", Path: []string{query.Table.Value, "id"}},
+		{Alias: "_rid", Path: []string{query.Table.Value, "_rid"}},
+		payload,
+	}
+
+	return pageQuery
+}
+
+func encodeContinuationToken(token queryContinuationToken) string {
+	data, _ := json.Marshal(token)
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func decodeContinuationToken(continuation string) (queryContinuationToken, error) {
+	var token queryContinuationToken
+	data, err := base64.StdEncoding.DecodeString(continuation)
+	if err != nil {
+		return token, err
+	}
+
+	err = json.Unmarshal(data, &token)
+	return token, err
+}
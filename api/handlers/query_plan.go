@@ -0,0 +1,286 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/parsers"
+	memoryexecutor "github.com/pikami/cosmium/query_executors/memory_executor"
+)
+
+var queryPlanAggregateNames = map[parsers.FunctionCallType]string{
+	parsers.FunctionCallAggregateAvg:   "Average",
+	parsers.FunctionCallAggregateCount: "Count",
+	parsers.FunctionCallAggregateMax:   "Max",
+	parsers.FunctionCallAggregateMin:   "Min",
+	parsers.FunctionCallAggregateSum:   "Sum",
+}
+
+// Markers of rewritten queries, they are comments so the rewritten query stays valid. The
+// results of aggregate queries are returned as partial aggregates, the rows of ORDER BY
+// queries carry their sort key and the OFFSET is left to the SDK, which skips it after
+// merging the results of all partition ranges
+const (
+	partialAggregatesQueryPrefix = "-- cosmium:partial-aggregates\n"
+	orderByItemsQueryPrefix      = "-- cosmium:order-by-items\n"
+	clientOffsetQueryPrefix      = "-- cosmium:client-offset\n"
+)
+
+type rewrittenQueryOptions struct {
+	partialAggregates bool
+	orderByItems      bool
+	clientOffset      bool
+}
+
+type queryAggregateInfo struct {
+	hasSelectValue              bool
+	aggregates                  []string
+	groupByAliases              []string
+	groupByAliasToAggregateType map[string]interface{}
+}
+
+// Builds the response of a query plan request, the SDKs use the queryInfo
+// to decide how to merge the results returned by each partition range.
+// A single range covering all partition keys is returned, aggregate and ORDER BY
+// queries are rewritten so their results come back in the shape the SDKs merge
+func buildQueryPlan(queryText string, query parsers.SelectStmt) gin.H {
+	distinctType := "None"
+	if query.Distinct {
+		distinctType = "Unordered"
+		if len(query.OrderExpressions) > 0 {
+			distinctType = "Ordered"
+		}
+	}
+
+	var top, offset, limit interface{}
+	if query.Offset > 0 {
+		offset = query.Offset
+		limit = query.Count
+	} else if query.Count > 0 {
+		top = query.Count
+	}
+
+	orderBy := make([]string, 0, len(query.OrderExpressions))
+	orderByExpressions := make([]string, 0, len(query.OrderExpressions))
+	for _, orderExpression := range query.OrderExpressions {
+		direction := "Ascending"
+		if orderExpression.Direction == parsers.OrderDirectionDesc {
+			direction = "Descending"
+		}
+		orderBy = append(orderBy, direction)
+		orderByExpressions = append(orderByExpressions, formatSelectItem(orderExpression.SelectItem))
+	}
+
+	groupByExpressions := make([]string, 0, len(query.GroupBy))
+	for _, groupBy := range query.GroupBy {
+		groupByExpressions = append(groupByExpressions, formatSelectItem(groupBy))
+	}
+
+	aggregateInfo := getQueryAggregateInfo(query)
+
+	return gin.H{
+		"partitionedQueryExecutionInfoVersion": 2,
+		"queryInfo": map[string]interface{}{
+			"distinctType":                distinctType,
+			"top":                         top,
+			"offset":                      offset,
+			"limit":                       limit,
+			"orderBy":                     orderBy,
+			"orderByExpressions":          orderByExpressions,
+			"groupByExpressions":          groupByExpressions,
+			"groupByAliases":              aggregateInfo.groupByAliases,
+			"aggregates":                  aggregateInfo.aggregates,
+			"groupByAliasToAggregateType": aggregateInfo.groupByAliasToAggregateType,
+			"rewrittenQuery":              rewriteQuery(queryText, query),
+			"hasSelectValue":              aggregateInfo.hasSelectValue,
+			"dCountInfo":                  nil,
+		},
+		"queryRanges": []interface{}{
+			map[string]interface{}{
+				"min":            "",
+				"max":            "FF",
+				"isMinInclusive": true,
+				"isMaxInclusive": false,
+			},
+		},
+	}
+}
+
+// Returns the query the SDKs send to each partition range, or an empty string when
+// the original query can be sent as is. ORDER BY queries are only rewritten when
+// their pages can be resumed by sort key, see isKeysetPaginated
+func rewriteQuery(queryText string, query parsers.SelectStmt) string {
+	var prefixes strings.Builder
+	if getQueryAggregateInfo(query).requiresPartialAggregates() {
+		prefixes.WriteString(partialAggregatesQueryPrefix)
+	}
+	if isKeysetPaginated(query) {
+		prefixes.WriteString(orderByItemsQueryPrefix)
+	}
+	if query.Offset > 0 {
+		prefixes.WriteString(clientOffsetQueryPrefix)
+	}
+
+	if prefixes.Len() == 0 {
+		return ""
+	}
+
+	return prefixes.String() + queryText
+}
+
+// Strips the markers added by rewriteQuery
+func parseRewrittenQuery(queryText string) (string, rewrittenQueryOptions) {
+	var options rewrittenQueryOptions
+	markers := map[string]*bool{
+		partialAggregatesQueryPrefix: &options.partialAggregates,
+		orderByItemsQueryPrefix:      &options.orderByItems,
+		clientOffsetQueryPrefix:      &options.clientOffset,
+	}
+
+	for found := true; found; {
+		found = false
+		for prefix, option := range markers {
+			if strings.HasPrefix(queryText, prefix) {
+				queryText = strings.TrimPrefix(queryText, prefix)
+				*option = true
+				found = true
+			}
+		}
+	}
+
+	return queryText, options
+}
+
+func getQueryAggregateInfo(query parsers.SelectStmt) queryAggregateInfo {
+	info := queryAggregateInfo{
+		hasSelectValue:              isSelectValue(query),
+		aggregates:                  make([]string, 0),
+		groupByAliases:              make([]string, 0),
+		groupByAliasToAggregateType: make(map[string]interface{}),
+	}
+
+	if info.hasSelectValue {
+		if aggregate, ok := getAggregateName(query.SelectItems[0]); ok && len(query.GroupBy) == 0 {
+			info.aggregates = append(info.aggregates, aggregate)
+		}
+		return info
+	}
+
+	// Aggregates selected as properties are merged like a GROUP BY without keys
+	hasAggregates := false
+	for i, selectItem := range query.SelectItems {
+		alias := getSelectItemAlias(selectItem, i)
+		if aggregate, ok := getAggregateName(selectItem); ok {
+			hasAggregates = true
+			info.groupByAliasToAggregateType[alias] = aggregate
+		} else {
+			info.groupByAliasToAggregateType[alias] = nil
+		}
+		info.groupByAliases = append(info.groupByAliases, alias)
+	}
+
+	if !hasAggregates && len(query.GroupBy) == 0 {
+		info.groupByAliases = make([]string, 0)
+		info.groupByAliasToAggregateType = make(map[string]interface{})
+	}
+
+	return info
+}
+
+func (info queryAggregateInfo) requiresPartialAggregates() bool {
+	return len(info.aggregates) > 0 || len(info.groupByAliases) > 0
+}
+
+// Wraps the final results of an aggregate query into the partial aggregates
+// the SDKs expect from each partition range. Every row is already a complete
+// group, so rows get a unique group key to keep the SDK from merging them
+func toPartialAggregates(info queryAggregateInfo, rows []memoryexecutor.RowType) []memoryexecutor.RowType {
+	partialRows := make([]memoryexecutor.RowType, 0, len(rows))
+	for i, row := range rows {
+		if info.hasSelectValue {
+			partialRows = append(partialRows, []interface{}{toPartialAggregate(info.aggregates[0], row)})
+			continue
+		}
+
+		object, _ := row.(map[string]interface{})
+		payload := make(map[string]interface{})
+		for _, alias := range info.groupByAliases {
+			value, found := object[alias]
+			if aggregate, ok := info.groupByAliasToAggregateType[alias].(string); ok {
+				payload[alias] = toPartialAggregate(aggregate, value)
+			} else if found {
+				payload[alias] = value
+			}
+		}
+
+		partialRows = append(partialRows, map[string]interface{}{
+			"groupByItems": []interface{}{map[string]interface{}{"item": i}},
+			"payload":      payload,
+		})
+	}
+
+	return partialRows
+}
+
+func toPartialAggregate(aggregate string, value interface{}) map[string]interface{} {
+	if value == nil {
+		if aggregate == "Average" {
+			return map[string]interface{}{"item": map[string]interface{}{"count": 0}}
+		}
+		return map[string]interface{}{}
+	}
+
+	// The average is already final, so it is reported as a sum over a single item
+	if aggregate == "Average" {
+		return map[string]interface{}{"item": map[string]interface{}{"sum": value, "count": 1}}
+	}
+
+	return map[string]interface{}{"item": value}
+}
+
+// "SELECT *" is parsed the same way as "SELECT VALUE c",
+// a plain reference to the root is treated as the former
+func isSelectValue(query parsers.SelectStmt) bool {
+	if len(query.SelectItems) != 1 || !query.SelectItems[0].IsTopLevel {
+		return false
+	}
+
+	selectItem := query.SelectItems[0]
+	return selectItem.Type != parsers.SelectItemTypeField || len(selectItem.Path) > 1
+}
+
+func getAggregateName(selectItem parsers.SelectItem) (string, bool) {
+	if selectItem.Type != parsers.SelectItemTypeFunctionCall {
+		return "", false
+	}
+
+	functionCall, ok := selectItem.Value.(parsers.FunctionCall)
+	if !ok {
+		return "", false
+	}
+
+	name, ok := queryPlanAggregateNames[functionCall.Type]
+	return name, ok
+}
+
+// Mirrors the property names produced by the executor for unaliased select items
+func getSelectItemAlias(selectItem parsers.SelectItem, index int) string {
+	if selectItem.Alias != "" {
+		return selectItem.Alias
+	}
+
+	if len(selectItem.Path) > 0 {
+		return selectItem.Path[len(selectItem.Path)-1]
+	}
+
+	return fmt.Sprintf("$%d", index+1)
+}
+
+func formatSelectItem(selectItem parsers.SelectItem) string {
+	if selectItem.Type != parsers.SelectItemTypeField || len(selectItem.Path) == 0 {
+		return ""
+	}
+
+	return strings.Join(selectItem.Path, ".")
+}
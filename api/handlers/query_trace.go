@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/logger"
+	"github.com/pikami/cosmium/parsers"
+	memoryexecutor "github.com/pikami/cosmium/query_executors/memory_executor"
+)
+
+// Logs the executed query at the debug level when the TraceQueries flag is set, with
+// the parameters, the parsed statement and the numbers of scanned and matched documents
+func traceQuery(queryText string, query parsers.SelectStmt, stats memoryexecutor.QueryStats, returnedDocuments int, executionTime time.Duration) {
+	if !config.Config.TraceQueries || !logger.IsEnabled(slog.LevelDebug) {
+		return
+	}
+
+	parameters, _ := json.Marshal(query.Parameters)
+	query.Parameters = nil
+	ast, _ := json.Marshal(query)
+
+	logger.Debugw("Query executed",
+		"query", queryText,
+		"parameters", json.RawMessage(parameters),
+		"ast", json.RawMessage(ast),
+		"scannedDocuments", stats.ScannedRows,
+		"matchedDocuments", stats.MatchedRows,
+		"returnedDocuments", returnedDocuments,
+		"durationMs", float64(executionTime.Microseconds())/1000,
+	)
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Request charges reported in the "x-ms-request-charge" header, in request units. They are
+// rough estimates of what Cosmos DB charges, a point read costs one unit, writes cost a base
+// amount plus one unit per KB of the written document and queries scale with the number of
+// documents they scan
+const (
+	readRequestCharge             = 1.0
+	deleteRequestCharge           = 5.0
+	writeRequestChargeBase        = 5.0
+	writeRequestChargePerKB       = 1.0
+	queryRequestChargeBase        = 2.0
+	queryRequestChargePerDocument = 0.1
+)
+
+// Charges every request like a point read, handlers of requests that cost
+// more overwrite the header before writing the response
+func DefaultRequestCharge(c *gin.Context) {
+	setRequestCharge(c, readRequestCharge)
+	c.Next()
+}
+
+func setRequestCharge(c *gin.Context, charge float64) {
+	c.Header("x-ms-request-charge", strconv.FormatFloat(charge, 'f', 2, 64))
+}
+
+func writeRequestCharge(document map[string]interface{}) float64 {
+	return writeRequestChargeBase + writeRequestChargePerKB*float64(jsonSize(document))/1024
+}
+
+func queryRequestCharge(scannedDocuments int) float64 {
+	return queryRequestChargeBase + queryRequestChargePerDocument*float64(scannedDocuments)
+}
+
+// Failed operations are charged like point reads
+func batchOperationRequestCharge(operationType string, result batchOperationResult) float64 {
+	switch {
+	case result.StatusCode >= 400 || operationType == "Read":
+		return readRequestCharge
+	case operationType == "Delete":
+		return deleteRequestCharge
+	}
+
+	return writeRequestCharge(result.ResourceBody)
+}
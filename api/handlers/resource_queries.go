@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/pikami/cosmium/parsers"
+	memoryexecutor "github.com/pikami/cosmium/query_executors/memory_executor"
+)
+
+type collectionResourceQuery func(ctx context.Context, databaseId string, collectionId string, query parsers.SelectStmt) ([]memoryexecutor.RowType, repositorymodels.RepositoryStatus)
+
+// Queries are posted to the same feeds resources are created on, the SDKs mark them
+// with the "x-ms-documentdb-isquery" header or the query content type
+func isQueryRequest(c *gin.Context) bool {
+	isQuery, _ := strconv.ParseBool(c.GetHeader("x-ms-documentdb-isquery"))
+	return isQuery || strings.HasPrefix(c.ContentType(), "application/query+json")
+}
+
+// Runs the query on the stored procedures, triggers or user defined functions
+// of a collection, the results are listed under the key of the resource feed
+func queryCollectionResources(c *gin.Context, resourcesKey string, executeQuery collectionResourceQuery) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+
+	selectStmt, ok := parseQueryRequest(c)
+	if !ok {
+		return
+	}
+
+	queryCtx, cancel := newQueryContext(c)
+	defer cancel()
+
+	resources, status := executeQuery(queryCtx, databaseId, collectionId, selectStmt)
+	if status == repositorymodels.QueryCancelled {
+		handleQueryCancelled(c, queryCtx)
+		return
+	}
+
+	if status == repositorymodels.StatusOk {
+		collection, _ := repositories.GetCollection(databaseId, collectionId)
+
+		c.Header("x-ms-item-count", fmt.Sprintf("%d", len(resources)))
+		writeJSON(c, http.StatusOK, gin.H{"_rid": collection.ResourceID, resourcesKey: resources, "_count": len(resources)})
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+// Reads the query and its parameters from the request body, the
+// error response is written when the query can't be parsed
+func parseQueryRequest(c *gin.Context) (parsers.SelectStmt, bool) {
+	var requestBody map[string]interface{}
+	if !bindRequestBody(c, &requestBody) {
+		return parsers.SelectStmt{}, false
+	}
+
+	queryText, ok := requestBody["query"].(string)
+	if !ok {
+		writeBadRequest(c, "The query must be a string")
+		return parsers.SelectStmt{}, false
+	}
+
+	selectStmt, status, err := repositories.ParseQuery(queryText)
+	if status != repositorymodels.StatusOk {
+		handleQueryError(c, status, err)
+		return parsers.SelectStmt{}, false
+	}
+
+	if paramsArray, ok := requestBody["parameters"].([]interface{}); ok {
+		selectStmt.Parameters = parametersToMap(paramsArray)
+	}
+
+	return selectStmt, true
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+)
+
+// Quotas of a collection as reported by Cosmos DB, sizes are in KB and -1 means unlimited
+const (
+	userDefinedFunctionsQuota = 25
+	storedProceduresQuota     = 100
+	triggersQuota             = 25
+	collectionSizeQuota       = 50 * 1024 * 1024
+)
+
+// Sets the "x-ms-resource-quota" and "x-ms-resource-usage" headers of a collection, usages
+// are computed from the store, sizes are the JSON sizes of the documents rounded up to KB.
+// The LSN of the latest write is reported as the quorum acknowledged LSN
+func setCollectionResourceHeaders(c *gin.Context, databaseId string, collectionId string) {
+	documents, status := repositories.GetAllDocuments(databaseId, collectionId)
+	if status != repositorymodels.StatusOk {
+		return
+	}
+
+	userDefinedFunctions, _ := repositories.GetAllUserDefinedFunctions(databaseId, collectionId)
+	storedProcedures, _ := repositories.GetAllStoredProcedures(databaseId, collectionId)
+	triggers, _ := repositories.GetAllTriggers(databaseId, collectionId)
+
+	largestDocumentSize, documentsSize := 0, 0
+	for _, document := range documents {
+		documentSize := jsonSize(document)
+		largestDocumentSize = max(largestDocumentSize, documentSize)
+		documentsSize += documentSize
+	}
+
+	documentSizeQuota := -1
+	if config.Config.MaxDocumentSize > 0 {
+		documentSizeQuota = config.Config.MaxDocumentSize / 1024
+	}
+
+	documentsCountQuota := -1
+	if limit, _ := repositories.GetCollectionDocumentLimit(databaseId, collectionId); limit > 0 {
+		documentsCountQuota = limit
+	}
+
+	c.Header("x-ms-resource-quota", formatResourceValues([]resourceValue{
+		{"functions", userDefinedFunctionsQuota},
+		{"storedProcedures", storedProceduresQuota},
+		{"triggers", triggersQuota},
+		{"documentSize", documentSizeQuota},
+		{"documentsSize", collectionSizeQuota},
+		{"documentsCount", documentsCountQuota},
+		{"collectionSize", collectionSizeQuota},
+	}))
+
+	c.Header("x-ms-resource-usage", formatResourceValues([]resourceValue{
+		{"functions", len(userDefinedFunctions)},
+		{"storedProcedures", len(storedProcedures)},
+		{"triggers", len(triggers)},
+		{"documentSize", kilobytes(largestDocumentSize)},
+		{"documentsSize", kilobytes(documentsSize)},
+		{"documentsCount", len(documents)},
+		{"collectionSize", kilobytes(documentsSize + jsonSize(userDefinedFunctions) + jsonSize(storedProcedures) + jsonSize(triggers))},
+	}))
+
+	if lsn, status := repositories.GetCollectionLSN(databaseId, collectionId); status == repositorymodels.StatusOk {
+		c.Header("x-ms-cosmos-quorum-acked-llsn", fmt.Sprint(lsn))
+	}
+}
+
+type resourceValue struct {
+	name  string
+	value int
+}
+
+func formatResourceValues(values []resourceValue) string {
+	formattedValues := make([]string, len(values))
+	for i, value := range values {
+		formattedValues[i] = fmt.Sprintf("%s=%d", value.name, value.value)
+	}
+
+	return strings.Join(formattedValues, ";")
+}
+
+func kilobytes(bytes int) int {
+	return (bytes + 1023) / 1024
+}
@@ -0,0 +1,17 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/api/config"
+)
+
+// Writes the response as compact JSON, indenting large query results makes
+// them noticeably bigger and slower to serialize, so it is only done with -Pretty
+func writeJSON(c *gin.Context, code int, obj interface{}) {
+	if config.Config.PrettyJSON {
+		c.IndentedJSON(code, obj)
+		return
+	}
+
+	c.JSON(code, obj)
+}
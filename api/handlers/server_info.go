@@ -9,7 +9,7 @@ import (
 )
 
 func GetServerInfo(c *gin.Context) {
-	c.IndentedJSON(http.StatusOK, gin.H{
+	writeJSON(c, http.StatusOK, gin.H{
 		"_self":     "",
 		"id":        config.Config.DatabaseAccount,
 		"_rid":      fmt.Sprintf("%s.%s", config.Config.DatabaseAccount, config.Config.DatabaseDomain),
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+)
+
+// Sets the "x-ms-session-token" header to the token of the latest write to the collection
+func setSessionToken(c *gin.Context, databaseId string, collectionId string) {
+	if sessionToken, status := repositories.GetSessionToken(databaseId, collectionId); status == repositorymodels.StatusOk {
+		c.Header("x-ms-session-token", sessionToken)
+	}
+}
+
+// Checks the session token sent with reads, the error is written when the token
+// is malformed or ahead of the collection. Without a token reads are not checked
+func checkSessionToken(c *gin.Context, databaseId string, collectionId string) bool {
+	sessionToken := c.GetHeader("x-ms-session-token")
+	if sessionToken == "" {
+		return true
+	}
+
+	status, err := repositories.ValidateSessionToken(databaseId, collectionId, sessionToken)
+	switch status {
+	case repositorymodels.BadRequest:
+		writeBadRequest(c, err.Error())
+		return false
+	case repositorymodels.SessionNotAvailable:
+		writeErrorWithSubStatus(c, http.StatusNotFound, subStatusReadSessionNotAvailable, "The read session is not available for the input session token.")
+		return false
+	}
+
+	return true
+}
@@ -16,10 +16,104 @@ func GetAllStoredProcedures(c *gin.Context) {
 	sps, status := repositories.GetAllStoredProcedures(databaseId, collectionId)
 
 	if status == repositorymodels.StatusOk {
+		collection, _ := repositories.GetCollection(databaseId, collectionId)
+
 		c.Header("x-ms-item-count", fmt.Sprintf("%d", len(sps)))
-		c.IndentedJSON(http.StatusOK, gin.H{"_rid": "", "StoredProcedures": sps, "_count": len(sps)})
+		writeJSON(c, http.StatusOK, gin.H{"_rid": collection.ResourceID, "StoredProcedures": sps, "_count": len(sps)})
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func GetStoredProcedure(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+	spId := c.Param("sprocId")
+
+	sp, status := repositories.GetStoredProcedure(databaseId, collectionId, spId)
+	if status == repositorymodels.StatusOk {
+		writeJSON(c, http.StatusOK, sp)
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func DeleteStoredProcedure(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+	spId := c.Param("sprocId")
+
+	status := repositories.DeleteStoredProcedure(databaseId, collectionId, spId)
+	if status == repositorymodels.StatusOk {
+		c.Status(http.StatusNoContent)
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func CreateStoredProcedure(c *gin.Context) {
+	if isQueryRequest(c) {
+		queryCollectionResources(c, "StoredProcedures", repositories.ExecuteQueryStoredProcedures)
+		return
+	}
+
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+
+	var sp repositorymodels.StoredProcedure
+	if !bindRequestBody(c, &sp) {
+		return
+	}
+
+	createdSp, status := repositories.CreateStoredProcedure(databaseId, collectionId, sp)
+	handleScriptResponse(c, createdSp, status, http.StatusCreated)
+}
+
+func ReplaceStoredProcedure(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+	spId := c.Param("sprocId")
+
+	var sp repositorymodels.StoredProcedure
+	if !bindRequestBody(c, &sp) {
+		return
+	}
+
+	replacedSp, status := repositories.ReplaceStoredProcedure(databaseId, collectionId, spId, sp)
+	handleScriptResponse(c, replacedSp, status, http.StatusOK)
+}
+
+// Writes the response for create and replace requests of
+// stored procedures, triggers and user defined functions
+func handleScriptResponse(c *gin.Context, resource interface{}, status repositorymodels.RepositoryStatus, successStatus int) {
+	switch status {
+	case repositorymodels.StatusOk:
+		writeJSON(c, successStatus, resource)
+	case repositorymodels.StatusNotFound:
+		writeNotFound(c)
+	case repositorymodels.Conflict:
+		writeConflict(c)
+	case repositorymodels.BadRequest:
+		writeBadRequest(c, "The id of the resource is missing or does not match the id in the request path")
+	default:
+		writeUnknownError(c)
+	}
 }
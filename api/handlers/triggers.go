@@ -16,10 +16,87 @@ func GetAllTriggers(c *gin.Context) {
 	triggers, status := repositories.GetAllTriggers(databaseId, collectionId)
 
 	if status == repositorymodels.StatusOk {
+		collection, _ := repositories.GetCollection(databaseId, collectionId)
+
 		c.Header("x-ms-item-count", fmt.Sprintf("%d", len(triggers)))
-		c.IndentedJSON(http.StatusOK, gin.H{"_rid": "", "Triggers": triggers, "_count": len(triggers)})
+		writeJSON(c, http.StatusOK, gin.H{"_rid": collection.ResourceID, "Triggers": triggers, "_count": len(triggers)})
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func GetTrigger(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+	triggerId := c.Param("triggerId")
+
+	trigger, status := repositories.GetTrigger(databaseId, collectionId, triggerId)
+	if status == repositorymodels.StatusOk {
+		writeJSON(c, http.StatusOK, trigger)
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func DeleteTrigger(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+	triggerId := c.Param("triggerId")
+
+	status := repositories.DeleteTrigger(databaseId, collectionId, triggerId)
+	if status == repositorymodels.StatusOk {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func CreateTrigger(c *gin.Context) {
+	if isQueryRequest(c) {
+		queryCollectionResources(c, "Triggers", repositories.ExecuteQueryTriggers)
+		return
+	}
+
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+
+	var trigger repositorymodels.Trigger
+	if !bindRequestBody(c, &trigger) {
+		return
+	}
+
+	createdTrigger, status := repositories.CreateTrigger(databaseId, collectionId, trigger)
+	handleScriptResponse(c, createdTrigger, status, http.StatusCreated)
+}
+
+func ReplaceTrigger(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+	triggerId := c.Param("triggerId")
+
+	var trigger repositorymodels.Trigger
+	if !bindRequestBody(c, &trigger) {
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	replacedTrigger, status := repositories.ReplaceTrigger(databaseId, collectionId, triggerId, trigger)
+	handleScriptResponse(c, replacedTrigger, status, http.StatusOK)
 }
@@ -16,10 +16,87 @@ func GetAllUserDefinedFunctions(c *gin.Context) {
 	udfs, status := repositories.GetAllUserDefinedFunctions(databaseId, collectionId)
 
 	if status == repositorymodels.StatusOk {
+		collection, _ := repositories.GetCollection(databaseId, collectionId)
+
 		c.Header("x-ms-item-count", fmt.Sprintf("%d", len(udfs)))
-		c.IndentedJSON(http.StatusOK, gin.H{"_rid": "", "UserDefinedFunctions": udfs, "_count": len(udfs)})
+		writeJSON(c, http.StatusOK, gin.H{"_rid": collection.ResourceID, "UserDefinedFunctions": udfs, "_count": len(udfs)})
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func GetUserDefinedFunction(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+	udfId := c.Param("udfId")
+
+	udf, status := repositories.GetUserDefinedFunction(databaseId, collectionId, udfId)
+	if status == repositorymodels.StatusOk {
+		writeJSON(c, http.StatusOK, udf)
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func DeleteUserDefinedFunction(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+	udfId := c.Param("udfId")
+
+	status := repositories.DeleteUserDefinedFunction(databaseId, collectionId, udfId)
+	if status == repositorymodels.StatusOk {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func CreateUserDefinedFunction(c *gin.Context) {
+	if isQueryRequest(c) {
+		queryCollectionResources(c, "UserDefinedFunctions", repositories.ExecuteQueryUserDefinedFunctions)
+		return
+	}
+
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+
+	var udf repositorymodels.UserDefinedFunction
+	if !bindRequestBody(c, &udf) {
+		return
+	}
+
+	createdUdf, status := repositories.CreateUserDefinedFunction(databaseId, collectionId, udf)
+	handleScriptResponse(c, createdUdf, status, http.StatusCreated)
+}
+
+func ReplaceUserDefinedFunction(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+	udfId := c.Param("udfId")
+
+	var udf repositorymodels.UserDefinedFunction
+	if !bindRequestBody(c, &udf) {
 		return
 	}
 
-	c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Unknown error"})
+	replacedUdf, status := repositories.ReplaceUserDefinedFunction(databaseId, collectionId, udfId, udf)
+	handleScriptResponse(c, replacedUdf, status, http.StatusOK)
 }
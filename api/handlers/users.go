@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+)
+
+func GetAllUsers(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+
+	users, status := repositories.GetAllUsers(databaseId)
+	if status == repositorymodels.StatusOk {
+		database, _ := repositories.GetDatabase(databaseId)
+
+		c.Header("x-ms-item-count", fmt.Sprintf("%d", len(users)))
+		writeJSON(c, http.StatusOK, gin.H{
+			"_rid":   database.ResourceID,
+			"Users":  users,
+			"_count": len(users),
+		})
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func GetUser(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	userId := c.Param("userId")
+
+	user, status := repositories.GetUser(databaseId, userId)
+	if status == repositorymodels.StatusOk {
+		writeJSON(c, http.StatusOK, user)
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func DeleteUser(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	userId := c.Param("userId")
+
+	status := repositories.DeleteUser(databaseId, userId)
+	if status == repositorymodels.StatusOk {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	writeUnknownError(c)
+}
+
+func CreateUser(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	var newUser repositorymodels.User
+
+	if !bindRequestBody(c, &newUser) {
+		return
+	}
+
+	if newUser.ID == "" {
+		writeBadRequest(c, "The input content is invalid because the required properties - 'id; ' - are missing")
+		return
+	}
+
+	createdUser, status := repositories.CreateUser(databaseId, newUser)
+	if status == repositorymodels.Conflict {
+		writeConflict(c)
+		return
+	}
+
+	if status == repositorymodels.StatusNotFound {
+		writeNotFound(c)
+		return
+	}
+
+	if status == repositorymodels.StatusOk {
+		writeJSON(c, http.StatusCreated, createdUser)
+		return
+	}
+
+	writeUnknownError(c)
+}
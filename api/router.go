@@ -1,8 +1,14 @@
 package api
 
 import (
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"slices"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pikami/cosmium/api/config"
@@ -13,16 +19,23 @@ import (
 )
 
 func CreateRouter() *gin.Engine {
-	router := gin.Default(func(e *gin.Engine) {
+	// The route listing of the gin debug mode would break up the json logs
+	if config.Config.LogFormat == config.LogFormatJSON {
+		gin.SetMode(gin.ReleaseMode)
+	}
+
+	router := gin.New(func(e *gin.Engine) {
 		e.RedirectTrailingSlash = false
 	})
 
-	if config.Config.Debug {
-		router.Use(middleware.RequestLogger())
-	}
+	router.Use(middleware.RequestLogger(), gin.Recovery())
 
 	router.Use(middleware.StripTrailingSlashes(router))
+	router.Use(handlers.DefaultRequestCharge)
 	router.Use(middleware.Authentication())
+	router.Use(middleware.ArtificialLatency())
+	router.Use(middleware.Throttling())
+	router.Use(middleware.FaultInjection())
 
 	router.GET("/dbs/:databaseId/colls/:collId/pkranges", handlers.GetPartitionKeyRanges)
 
@@ -32,67 +45,152 @@ func CreateRouter() *gin.Engine {
 	router.PUT("/dbs/:databaseId/colls/:collId/docs/:docId", handlers.ReplaceDocument)
 	router.PATCH("/dbs/:databaseId/colls/:collId/docs/:docId", handlers.PatchDocument)
 	router.DELETE("/dbs/:databaseId/colls/:collId/docs/:docId", handlers.DeleteDocument)
+	router.POST("/dbs/:databaseId/colls/:collId/operations/partitionkeydelete", handlers.DeletePartitionKey)
 
 	router.POST("/dbs/:databaseId/colls", handlers.CreateCollection)
 	router.GET("/dbs/:databaseId/colls", handlers.GetAllCollections)
 	router.GET("/dbs/:databaseId/colls/:collId", handlers.GetCollection)
+	router.PUT("/dbs/:databaseId/colls/:collId", handlers.ReplaceCollection)
 	router.DELETE("/dbs/:databaseId/colls/:collId", handlers.DeleteCollection)
 
+	router.POST("/dbs/:databaseId/users", handlers.CreateUser)
+	router.GET("/dbs/:databaseId/users", handlers.GetAllUsers)
+	router.GET("/dbs/:databaseId/users/:userId", handlers.GetUser)
+	router.DELETE("/dbs/:databaseId/users/:userId", handlers.DeleteUser)
+
+	router.POST("/dbs/:databaseId/users/:userId/permissions", handlers.CreatePermission)
+	router.GET("/dbs/:databaseId/users/:userId/permissions", handlers.GetAllPermissions)
+	router.GET("/dbs/:databaseId/users/:userId/permissions/:permissionId", handlers.GetPermission)
+	router.DELETE("/dbs/:databaseId/users/:userId/permissions/:permissionId", handlers.DeletePermission)
+
 	router.POST("/dbs", handlers.CreateDatabase)
 	router.GET("/dbs", handlers.GetAllDatabases)
 	router.GET("/dbs/:databaseId", handlers.GetDatabase)
 	router.DELETE("/dbs/:databaseId", handlers.DeleteDatabase)
 
+	router.POST("/dbs/:databaseId/colls/:collId/udfs", handlers.CreateUserDefinedFunction)
 	router.GET("/dbs/:databaseId/colls/:collId/udfs", handlers.GetAllUserDefinedFunctions)
+	router.GET("/dbs/:databaseId/colls/:collId/udfs/:udfId", handlers.GetUserDefinedFunction)
+	router.PUT("/dbs/:databaseId/colls/:collId/udfs/:udfId", handlers.ReplaceUserDefinedFunction)
+	router.DELETE("/dbs/:databaseId/colls/:collId/udfs/:udfId", handlers.DeleteUserDefinedFunction)
+
+	router.POST("/dbs/:databaseId/colls/:collId/sprocs", handlers.CreateStoredProcedure)
 	router.GET("/dbs/:databaseId/colls/:collId/sprocs", handlers.GetAllStoredProcedures)
+	router.GET("/dbs/:databaseId/colls/:collId/sprocs/:sprocId", handlers.GetStoredProcedure)
+	router.PUT("/dbs/:databaseId/colls/:collId/sprocs/:sprocId", handlers.ReplaceStoredProcedure)
+	router.DELETE("/dbs/:databaseId/colls/:collId/sprocs/:sprocId", handlers.DeleteStoredProcedure)
+
+	router.POST("/dbs/:databaseId/colls/:collId/triggers", handlers.CreateTrigger)
 	router.GET("/dbs/:databaseId/colls/:collId/triggers", handlers.GetAllTriggers)
+	router.GET("/dbs/:databaseId/colls/:collId/triggers/:triggerId", handlers.GetTrigger)
+	router.PUT("/dbs/:databaseId/colls/:collId/triggers/:triggerId", handlers.ReplaceTrigger)
+	router.DELETE("/dbs/:databaseId/colls/:collId/triggers/:triggerId", handlers.DeleteTrigger)
+
+	router.GET("/dbs/:databaseId/colls/:collId/conflicts", handlers.GetAllConflicts)
+	router.GET("/dbs/:databaseId/colls/:collId/conflicts/:conflictId", handlers.GetConflict)
+	router.DELETE("/dbs/:databaseId/colls/:collId/conflicts/:conflictId", handlers.DeleteConflict)
 
+	router.POST("/offers", handlers.QueryOffers)
 	router.GET("/offers", handlers.GetOffers)
+	router.GET("/offers/:offerId", handlers.GetOffer)
+	router.PUT("/offers/:offerId", handlers.ReplaceOffer)
 	router.GET("/", handlers.GetServerInfo)
 
 	router.GET("/cosmium/export", handlers.CosmiumExport)
 
+	if config.Config.EnableStateEndpoint {
+		router.GET("/_state", handlers.CosmiumExport)
+		router.POST("/_state", handlers.CosmiumImport)
+		router.POST("/_state/dbs/:databaseId/colls/:collId/conflicts", handlers.CosmiumCreateConflict)
+		router.PUT("/_state/dbs/:databaseId/colls/:collId/throttling", handlers.CosmiumSetThrottling)
+		router.DELETE("/_state/dbs/:databaseId/colls/:collId/throttling", handlers.CosmiumResetThrottling)
+		router.PUT("/_state/dbs/:databaseId/colls/:collId/documentlimit", handlers.CosmiumSetDocumentLimit)
+		router.DELETE("/_state/dbs/:databaseId/colls/:collId/documentlimit", handlers.CosmiumResetDocumentLimit)
+		router.PUT("/_state/dbs/:databaseId/colls/:collId/pkranges", handlers.CosmiumSetPartitionKeyRanges)
+		router.DELETE("/_state/dbs/:databaseId/colls/:collId/pkranges", handlers.CosmiumResetPartitionKeyRanges)
+		router.POST("/_state/dbs/:databaseId/colls/:collId/pkranges/:pkrangeId/split", handlers.CosmiumSplitPartitionKeyRange)
+
+		router.POST("/_admin/faults", handlers.CosmiumAddFault)
+		router.GET("/_admin/faults", handlers.CosmiumGetFaults)
+		router.DELETE("/_admin/faults", handlers.CosmiumDeleteAllFaults)
+		router.DELETE("/_admin/faults/:faultId", handlers.CosmiumDeleteFault)
+	}
+
 	handlers.RegisterExplorerHandlers(router)
 
 	return router
 }
 
-func StartAPI() {
+// Binds the listen address and serves the API in the background, an error is
+// returned right away when the address can't be bound. Port 0 binds a free
+// port, which is written to the log and advertised in the account endpoint
+func StartAPI() (*http.Server, error) {
 	if !config.Config.Debug {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
-	router := CreateRouter()
-	listenAddress := fmt.Sprintf(":%d", config.Config.Port)
+	listenAddress := net.JoinHostPort(config.Config.BindAddress, strconv.Itoa(config.Config.Port))
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", listenAddress, err)
+	}
+	config.SetPort(listener.Addr().(*net.TCPAddr).Port)
+
+	server := &http.Server{
+		Handler: CreateRouter().Handler(),
+	}
+
+	go func() {
+		var err error
+		switch {
+		case config.Config.TLS_CertificatePath != "" && config.Config.TLS_CertificateKey != "":
+			logger.Infof("Listening and serving HTTPS on %s\n", listener.Addr())
+			err = server.ServeTLS(listener, config.Config.TLS_CertificatePath, config.Config.TLS_CertificateKey)
+		case config.Config.DisableTls:
+			logger.Infof("Listening and serving HTTP on %s\n", listener.Addr())
+			err = server.Serve(listener)
+		default:
+			server.TLSConfig = tlsprovider.GetDefaultTlsConfig()
+			if config.Config.TLS_GenerateCertificate {
+				server.TLSConfig = generateTlsConfig()
+			}
+
+			logger.Infof("Listening and serving HTTPS on %s\n", listener.Addr())
+			err = server.ServeTLS(listener, "", "")
+		}
 
-	if config.Config.TLS_CertificatePath != "" && config.Config.TLS_CertificateKey != "" {
-		err := router.RunTLS(
-			listenAddress,
-			config.Config.TLS_CertificatePath,
-			config.Config.TLS_CertificateKey)
-		if err != nil {
-			logger.Error("Failed to start HTTPS server:", err)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("Failed to serve API:", err)
 		}
+	}()
 
-		return
+	return server, nil
+}
+
+// Generates a self-signed certificate and makes it available
+// to the user so clients can be configured to trust it
+func generateTlsConfig() *tls.Config {
+	hosts := []string{"localhost", "127.0.0.1", "::1"}
+	if !slices.Contains(hosts, config.Config.Host) {
+		hosts = append(hosts, config.Config.Host)
 	}
 
-	if config.Config.DisableTls {
-		router.Run(listenAddress)
+	tlsConfig, certificatePem, err := tlsprovider.GenerateSelfSignedTlsConfig(hosts)
+	if err != nil {
+		logger.Error("Failed to generate certificate, falling back to the default one:", err)
+		return tlsprovider.GetDefaultTlsConfig()
 	}
 
-	tlsConfig := tlsprovider.GetDefaultTlsConfig()
-	server := &http.Server{
-		Addr:      listenAddress,
-		Handler:   router.Handler(),
-		TLSConfig: tlsConfig,
+	if config.Config.TLS_GeneratedCertificatePath == "" {
+		logger.Infof("Generated self-signed certificate:\n%s", certificatePem)
+		return tlsConfig
 	}
 
-	logger.Infof("Listening and serving HTTPS on %s\n", server.Addr)
-	err := server.ListenAndServeTLS("", "")
-	if err != nil {
-		logger.Error("Failed to start HTTPS server:", err)
+	if err := os.WriteFile(config.Config.TLS_GeneratedCertificatePath, certificatePem, 0644); err != nil {
+		logger.Errorf("Failed to save generated certificate to '%s': %v\n", config.Config.TLS_GeneratedCertificatePath, err)
+	} else {
+		logger.Infof("Generated self-signed certificate saved to %s\n", config.Config.TLS_GeneratedCertificatePath)
 	}
 
-	router.Run()
+	return tlsConfig
 }
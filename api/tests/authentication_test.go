@@ -6,11 +6,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
 	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/authentication"
 	"github.com/pikami/cosmium/internal/repositories"
 	"github.com/stretchr/testify/assert"
 )
@@ -74,6 +77,42 @@ func Test_Authentication(t *testing.T) {
 		}
 	})
 
+	t.Run("Should get 401 when authorization header is missing", func(t *testing.T) {
+		res, err := http.Get(ts.URL + "/dbs")
+		assert.Nil(t, err)
+		defer res.Body.Close()
+
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+
+	sendStaleRequest := func(t *testing.T, key string) int {
+		date := time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat)
+		signature := authentication.GenerateSignature("GET", "dbs", "", date, key)
+		req, _ := http.NewRequest("GET", ts.URL+"/dbs", nil)
+		req.Header.Add("x-ms-date", date)
+		req.Header.Add("authorization", url.QueryEscape("type=master&ver=1.0&sig="+signature))
+
+		res, err := http.DefaultClient.Do(req)
+		assert.Nil(t, err)
+		defer res.Body.Close()
+
+		return res.StatusCode
+	}
+
+	t.Run("Should get 401 when request date is stale", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, sendStaleRequest(t, config.Config.AccountKey))
+	})
+
+	t.Run("Should accept stale request dates when the date check is disabled", func(t *testing.T) {
+		config.Config.DisableDateCheck = true
+		defer func() { config.Config.DisableDateCheck = false }()
+
+		assert.Equal(t, http.StatusOK, sendStaleRequest(t, config.Config.AccountKey))
+
+		// The signature is still checked
+		assert.Equal(t, http.StatusUnauthorized, sendStaleRequest(t, "AAAA"))
+	})
+
 	t.Run("Should allow unauthorized requests to /_explorer", func(t *testing.T) {
 		res, err := http.Get(ts.URL + "/_explorer/config.json")
 		assert.Nil(t, err)
@@ -0,0 +1,186 @@
+package tests_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/stretchr/testify/assert"
+)
+
+// Runs the function for every index on a fixed number of goroutines
+func runConcurrently(count int, workers int, run func(i int)) {
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				run(i)
+			}
+		}()
+	}
+
+	for i := 0; i < count; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+func Test_Bulk(t *testing.T) {
+	ts := runTestServer()
+	defer ts.Close()
+
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+
+	client, err := azcosmos.NewClientFromConnectionString(
+		fmt.Sprintf("AccountEndpoint=%s;AccountKey=%s", ts.URL, config.Config.AccountKey),
+		&azcosmos.ClientOptions{},
+	)
+	assert.Nil(t, err)
+
+	databaseClient, err := client.NewDatabase(testDatabaseName)
+	assert.Nil(t, err)
+
+	createCollection := func(t *testing.T, collectionId string) *azcosmos.ContainerClient {
+		_, err := databaseClient.CreateContainer(context.TODO(), azcosmos.ContainerProperties{
+			ID:                     collectionId,
+			PartitionKeyDefinition: azcosmos.PartitionKeyDefinition{Paths: []string{"/pk"}},
+		}, nil)
+		assert.Nil(t, err)
+
+		collectionClient, err := databaseClient.NewContainer(collectionId)
+		assert.Nil(t, err)
+		return collectionClient
+	}
+
+	t.Run("Should keep all documents of concurrent creates", func(t *testing.T) {
+		collectionClient := createCollection(t, "bulk-coll")
+		defer repositories.DeleteCollection(testDatabaseName, "bulk-coll")
+
+		const documentCount = 10000
+		var failedCount, serverErrorCount atomic.Int32
+		runConcurrently(documentCount, 64, func(i int) {
+			pk := fmt.Sprintf("pk-%d", i%10)
+			_, err := collectionClient.CreateItem(
+				context.TODO(),
+				azcosmos.NewPartitionKeyString(pk),
+				[]byte(fmt.Sprintf(`{"id": "doc-%d", "pk": "%s"}`, i, pk)),
+				nil,
+			)
+			if err != nil {
+				failedCount.Add(1)
+
+				var respErr *azcore.ResponseError
+				if errors.As(err, &respErr) && respErr.StatusCode >= 500 {
+					serverErrorCount.Add(1)
+				}
+			}
+		})
+
+		assert.Equal(t, int32(0), serverErrorCount.Load())
+		assert.Equal(t, int32(0), failedCount.Load())
+
+		documents, status := repositories.GetAllDocuments(testDatabaseName, "bulk-coll")
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+		assert.Len(t, documents, documentCount)
+	})
+
+	t.Run("Should keep all documents of concurrent non-atomic batches", func(t *testing.T) {
+		createCollection(t, "bulk-batch-coll")
+		defer repositories.DeleteCollection(testDatabaseName, "bulk-batch-coll")
+
+		const batchCount = 100
+		const batchSize = 100
+		path := fmt.Sprintf("dbs/%s/colls/bulk-batch-coll/docs", testDatabaseName)
+		resourceId := fmt.Sprintf("dbs/%s/colls/bulk-batch-coll", testDatabaseName)
+
+		var statusCodesLock sync.Mutex
+		statusCodes := make(map[int]int)
+		runConcurrently(batchCount, 16, func(batch int) {
+			pk := fmt.Sprintf("pk-%d", batch)
+			operations := make([]map[string]interface{}, batchSize)
+			for i := range operations {
+				operations[i] = map[string]interface{}{
+					"operationType": "Create",
+					"resourceBody":  map[string]interface{}{"id": fmt.Sprintf("doc-%d-%d", batch, i), "pk": pk},
+				}
+			}
+
+			status, _, _ := sendSignedRequestWithHeaders(t, ts.URL, path, http.MethodPost, "docs", resourceId, map[string]string{
+				"x-ms-cosmos-is-batch-request": "True",
+				"x-ms-cosmos-batch-atomic":     "False",
+				"x-ms-documentdb-partitionkey": fmt.Sprintf(`["%s"]`, pk),
+			}, operations)
+
+			statusCodesLock.Lock()
+			statusCodes[status]++
+			statusCodesLock.Unlock()
+		})
+
+		assert.Equal(t, map[int]int{http.StatusOK: batchCount}, statusCodes)
+
+		documents, status := repositories.GetAllDocuments(testDatabaseName, "bulk-batch-coll")
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+		assert.Len(t, documents, batchCount*batchSize)
+	})
+
+	t.Run("Should create the valid documents of non-atomic create batches", func(t *testing.T) {
+		createCollection(t, "bulk-results-coll")
+		defer repositories.DeleteCollection(testDatabaseName, "bulk-results-coll")
+
+		repositories.CreateDocument(testDatabaseName, "bulk-results-coll", map[string]interface{}{"id": "existing", "pk": "123"})
+
+		operations := []map[string]interface{}{
+			{"operationType": "Create", "resourceBody": map[string]interface{}{"id": "created", "pk": "123"}},
+			{"operationType": "Create", "resourceBody": map[string]interface{}{"id": "existing", "pk": "123"}},
+			{"operationType": "Create", "resourceBody": map[string]interface{}{"id": "other", "pk": "456"}},
+		}
+
+		collectionPath := fmt.Sprintf("dbs/%s/colls/bulk-results-coll", testDatabaseName)
+		statusCode, _, _ := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, map[string]string{
+			"x-ms-cosmos-is-batch-request": "True",
+			"x-ms-cosmos-batch-atomic":     "False",
+			"x-ms-documentdb-partitionkey": `["123"]`,
+		}, operations)
+		assert.Equal(t, http.StatusOK, statusCode)
+
+		_, status := repositories.GetDocument(testDatabaseName, "bulk-results-coll", "created")
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+		_, status = repositories.GetDocument(testDatabaseName, "bulk-results-coll", "other")
+		assert.Equal(t, repositorymodels.StatusNotFound, int(status))
+	})
+
+	t.Run("Should reject patches moving documents out of the batch partition", func(t *testing.T) {
+		collectionClient := createCollection(t, "bulk-patch-coll")
+		defer repositories.DeleteCollection(testDatabaseName, "bulk-patch-coll")
+
+		repositories.CreateDocument(testDatabaseName, "bulk-patch-coll", map[string]interface{}{"id": "patched", "pk": "123"})
+
+		patch := azcosmos.PatchOperations{}
+		patch.AppendSet("/pk", "999")
+		batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("123"))
+		batch.PatchItem("patched", patch, nil)
+
+		response, err := collectionClient.ExecuteTransactionalBatch(context.TODO(), batch, nil)
+		assert.Nil(t, err)
+		assert.False(t, response.Success)
+		if assert.Len(t, response.OperationResults, 1) {
+			assert.Equal(t, int32(http.StatusBadRequest), response.OperationResults[0].StatusCode)
+		}
+
+		document, _ := repositories.GetDocument(testDatabaseName, "bulk-patch-coll", "patched")
+		assert.Equal(t, "123", document["pk"])
+	})
+}
@@ -0,0 +1,169 @@
+package tests_test
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ChangeFeed(t *testing.T) {
+	ts := runTestServer()
+	defer ts.Close()
+
+	config.Config.PartitionKeyRangeCount = 3
+	defer func() { config.Config.PartitionKeyRangeCount = 0 }()
+
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+	repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+		ID:           "changefeed-coll",
+		PartitionKey: repositorymodels.CollectionPartitionKey{Paths: []string{"/pk"}},
+	})
+	defer repositories.DeleteCollection(testDatabaseName, "changefeed-coll")
+
+	documentIds := make([]string, 0)
+	for i := 0; i < 20; i++ {
+		documentId := fmt.Sprintf("doc-%02d", i)
+		documentIds = append(documentIds, documentId)
+		repositories.CreateDocument(testDatabaseName, "changefeed-coll", map[string]interface{}{"id": documentId, "pk": fmt.Sprintf("pk-%d", i)})
+	}
+
+	collectionPath := fmt.Sprintf("dbs/%s/colls/changefeed-coll", testDatabaseName)
+	readChangeFeed := func(t *testing.T, headers map[string]string) (int, http.Header, []string) {
+		requestHeaders := map[string]string{"A-IM": "Incremental Feed"}
+		for key, value := range headers {
+			requestHeaders[key] = value
+		}
+
+		status, responseHeaders, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodGet, "docs", collectionPath, requestHeaders, nil)
+		ids := make([]string, 0)
+		documents, _ := body["Documents"].([]interface{})
+		for _, document := range documents {
+			ids = append(ids, document.(map[string]interface{})["id"].(string))
+		}
+		return status, responseHeaders, ids
+	}
+
+	t.Run("Should serve collections by the configured number of ranges", func(t *testing.T) {
+		_, _, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/pkranges", http.MethodGet, "pkranges", collectionPath, nil, nil)
+		partitionKeyRanges, _ := body["PartitionKeyRanges"].([]interface{})
+		if assert.Len(t, partitionKeyRanges, 3) {
+			assert.Equal(t, "0", partitionKeyRanges[0].(map[string]interface{})["id"])
+			assert.Equal(t, "2", partitionKeyRanges[2].(map[string]interface{})["id"])
+			assert.Equal(t, "FF", partitionKeyRanges[2].(map[string]interface{})["maxExclusive"])
+		}
+
+		_, _, secondBody := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/pkranges", http.MethodGet, "pkranges", collectionPath, nil, nil)
+		assert.Equal(t, body, secondBody)
+	})
+
+	t.Run("Should list the changes of every range separately", func(t *testing.T) {
+		allIds := make([]string, 0)
+		for _, partitionKeyRangeId := range []string{"0", "1", "2"} {
+			status, headers, ids := readChangeFeed(t, map[string]string{"x-ms-documentdb-partitionkeyrangeid": partitionKeyRangeId})
+			assert.Equal(t, http.StatusOK, status)
+			assert.NotEmpty(t, ids)
+			assert.NotEmpty(t, headers.Get("etag"))
+			allIds = append(allIds, ids...)
+		}
+
+		sort.Strings(allIds)
+		assert.Equal(t, documentIds, allIds)
+	})
+
+	t.Run("Should only list the changes after the etag", func(t *testing.T) {
+		etags := make(map[string]string)
+		for _, partitionKeyRangeId := range []string{"0", "1", "2"} {
+			_, headers, _ := readChangeFeed(t, map[string]string{"x-ms-documentdb-partitionkeyrangeid": partitionKeyRangeId})
+			etags[partitionKeyRangeId] = headers.Get("etag")
+
+			status, _, _ := readChangeFeed(t, map[string]string{
+				"x-ms-documentdb-partitionkeyrangeid": partitionKeyRangeId,
+				"If-None-Match":                       etags[partitionKeyRangeId],
+			})
+			assert.Equal(t, http.StatusNotModified, status)
+		}
+
+		repositories.ReplaceDocument(testDatabaseName, "changefeed-coll", "doc-07", map[string]interface{}{"id": "doc-07", "pk": "pk-7", "updated": true}, nil)
+
+		changedIds := make([]string, 0)
+		for _, partitionKeyRangeId := range []string{"0", "1", "2"} {
+			status, _, ids := readChangeFeed(t, map[string]string{
+				"x-ms-documentdb-partitionkeyrangeid": partitionKeyRangeId,
+				"If-None-Match":                       etags[partitionKeyRangeId],
+			})
+			if status == http.StatusOK {
+				changedIds = append(changedIds, ids...)
+			} else {
+				assert.Equal(t, http.StatusNotModified, status)
+			}
+		}
+		assert.Equal(t, []string{"doc-07"}, changedIds)
+	})
+
+	t.Run("Should page the changes in the order they were written", func(t *testing.T) {
+		ids := make([]string, 0)
+		etag := ""
+		for page := 0; page < 10; page++ {
+			headers := map[string]string{"x-ms-max-item-count": "6"}
+			if etag != "" {
+				headers["If-None-Match"] = etag
+			}
+
+			status, responseHeaders, pageIds := readChangeFeed(t, headers)
+			if status == http.StatusNotModified {
+				break
+			}
+			assert.Equal(t, http.StatusOK, status)
+			assert.LessOrEqual(t, len(pageIds), 6)
+			ids = append(ids, pageIds...)
+			etag = responseHeaders.Get("etag")
+		}
+
+		expectedIds := append(append([]string{}, documentIds[:7]...), documentIds[8:]...)
+		expectedIds = append(expectedIds, "doc-07")
+		assert.Equal(t, expectedIds, ids)
+	})
+
+	t.Run("Should start at the current changes", func(t *testing.T) {
+		status, headers, _ := readChangeFeed(t, map[string]string{"If-None-Match": "*"})
+		assert.Equal(t, http.StatusNotModified, status)
+
+		repositories.CreateDocument(testDatabaseName, "changefeed-coll", map[string]interface{}{"id": "doc-20", "pk": "pk-20"})
+
+		status, _, ids := readChangeFeed(t, map[string]string{"If-None-Match": headers.Get("etag")})
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, []string{"doc-20"}, ids)
+	})
+
+	t.Run("Should not list the writes of rolled back transactions", func(t *testing.T) {
+		status, headers, _ := readChangeFeed(t, map[string]string{"If-None-Match": "*"})
+		assert.Equal(t, http.StatusNotModified, status)
+		lsn, _ := repositories.GetCollectionLSN(testDatabaseName, "changefeed-coll")
+
+		repositories.RunDocumentTransaction(testDatabaseName, "changefeed-coll", func(tx repositories.DocumentTransaction) bool {
+			tx.ReplaceDocument("doc-03", map[string]interface{}{"id": "doc-03", "pk": "pk-3", "discarded": true})
+			tx.CreateDocument(map[string]interface{}{"id": "doc-21", "pk": "pk-21"})
+			return false
+		})
+
+		rolledBackLSN, _ := repositories.GetCollectionLSN(testDatabaseName, "changefeed-coll")
+		assert.Equal(t, lsn, rolledBackLSN)
+
+		status, _, _ = readChangeFeed(t, map[string]string{"If-None-Match": headers.Get("etag")})
+		assert.Equal(t, http.StatusNotModified, status)
+
+		changedDocuments, _, _ := repositories.GetChangeFeed(testDatabaseName, "changefeed-coll", nil, lsn)
+		assert.Empty(t, changedDocuments)
+	})
+
+	t.Run("Should reject invalid etags", func(t *testing.T) {
+		status, _, _ := readChangeFeed(t, map[string]string{"If-None-Match": `"invalid"`})
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
@@ -58,6 +59,110 @@ func Test_Collections(t *testing.T) {
 		})
 	})
 
+	t.Run("Collection Indexing Policy", func(t *testing.T) {
+		t.Run("Should return the submitted indexing policy with the excluded _etag path", func(t *testing.T) {
+			repositories.DeleteCollection(testDatabaseName, testCollectionName)
+			indexingPolicy := &azcosmos.IndexingPolicy{
+				Automatic:     false,
+				IndexingMode:  azcosmos.IndexingMode("lazy"),
+				IncludedPaths: []azcosmos.IncludedPath{{Path: "/name/?"}},
+				ExcludedPaths: []azcosmos.ExcludedPath{{Path: "/*"}},
+				CompositeIndexes: [][]azcosmos.CompositeIndex{{
+					{Path: "/name", Order: azcosmos.CompositeIndexAscending},
+					{Path: "/age", Order: azcosmos.CompositeIndexDescending},
+				}},
+			}
+
+			_, err := databaseClient.CreateContainer(context.TODO(), azcosmos.ContainerProperties{
+				ID:             testCollectionName,
+				IndexingPolicy: indexingPolicy,
+			}, &azcosmos.CreateContainerOptions{})
+			assert.Nil(t, err)
+
+			collectionClient, _ := databaseClient.NewContainer(testCollectionName)
+			readResponse, err := collectionClient.Read(context.TODO(), &azcosmos.ReadContainerOptions{})
+			assert.Nil(t, err)
+			indexingPolicy.ExcludedPaths = append(indexingPolicy.ExcludedPaths, azcosmos.ExcludedPath{Path: "/\"_etag\"/?"})
+			assert.Equal(t, indexingPolicy, readResponse.ContainerProperties.IndexingPolicy)
+		})
+
+		t.Run("Should fill in the defaults of a partial indexing policy", func(t *testing.T) {
+			repositories.DeleteCollection(testDatabaseName, testCollectionName)
+
+			_, status := repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+				ID: testCollectionName,
+				IndexingPolicy: repositorymodels.CollectionIndexingPolicy{
+					CompositeIndexes: [][]repositorymodels.CollectionIndexingPolicyCompositeIndex{{{Path: "/name"}, {Path: "/age"}}},
+				},
+			})
+			assert.Equal(t, repositorymodels.StatusOk, int(status))
+
+			collectionClient, _ := databaseClient.NewContainer(testCollectionName)
+			readResponse, err := collectionClient.Read(context.TODO(), &azcosmos.ReadContainerOptions{})
+			assert.Nil(t, err)
+			assert.Equal(t, &azcosmos.IndexingPolicy{
+				Automatic:        true,
+				IndexingMode:     azcosmos.IndexingMode("consistent"),
+				IncludedPaths:    []azcosmos.IncludedPath{{Path: "/*"}},
+				ExcludedPaths:    []azcosmos.ExcludedPath{{Path: "/\"_etag\"/?"}},
+				CompositeIndexes: [][]azcosmos.CompositeIndex{{{Path: "/name"}, {Path: "/age"}}},
+			}, readResponse.ContainerProperties.IndexingPolicy)
+		})
+
+		t.Run("Should use the default indexing policy when none is submitted", func(t *testing.T) {
+			repositories.DeleteCollection(testDatabaseName, testCollectionName)
+
+			createResponse, err := databaseClient.CreateContainer(context.TODO(), azcosmos.ContainerProperties{
+				ID: testCollectionName,
+			}, &azcosmos.CreateContainerOptions{})
+			assert.Nil(t, err)
+
+			indexingPolicy := createResponse.ContainerProperties.IndexingPolicy
+			assert.True(t, indexingPolicy.Automatic)
+			assert.Equal(t, azcosmos.IndexingMode("consistent"), indexingPolicy.IndexingMode)
+			assert.Equal(t, []azcosmos.IncludedPath{{Path: "/*"}}, indexingPolicy.IncludedPaths)
+		})
+
+		for _, invalidPolicy := range []struct {
+			name   string
+			policy azcosmos.IndexingPolicy
+		}{
+			{"indexing mode", azcosmos.IndexingPolicy{IndexingMode: "eventual"}},
+			{"path", azcosmos.IndexingPolicy{IncludedPaths: []azcosmos.IncludedPath{{Path: "/name"}}}},
+			{"conflicting paths", azcosmos.IndexingPolicy{
+				IncludedPaths: []azcosmos.IncludedPath{{Path: "/*"}},
+				ExcludedPaths: []azcosmos.ExcludedPath{{Path: "/*"}},
+			}},
+			{"paths with indexing mode none", azcosmos.IndexingPolicy{
+				IndexingMode:  azcosmos.IndexingModeNone,
+				IncludedPaths: []azcosmos.IncludedPath{{Path: "/*"}},
+			}},
+			{"composite index", azcosmos.IndexingPolicy{
+				CompositeIndexes: [][]azcosmos.CompositeIndex{{{Path: "/name"}}},
+			}},
+		} {
+			t.Run(fmt.Sprintf("Should return bad request for invalid %s", invalidPolicy.name), func(t *testing.T) {
+				repositories.DeleteCollection(testDatabaseName, testCollectionName)
+
+				_, err := databaseClient.CreateContainer(context.TODO(), azcosmos.ContainerProperties{
+					ID:             testCollectionName,
+					IndexingPolicy: &invalidPolicy.policy,
+				}, &azcosmos.CreateContainerOptions{})
+
+				var respErr *azcore.ResponseError
+				if errors.As(err, &respErr) {
+					assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+					assert.Equal(t, "BadRequest", respErr.ErrorCode)
+				} else {
+					panic(err)
+				}
+
+				_, status := repositories.GetCollection(testDatabaseName, testCollectionName)
+				assert.Equal(t, repositorymodels.StatusNotFound, int(status))
+			})
+		}
+	})
+
 	t.Run("Collection Read", func(t *testing.T) {
 		t.Run("Should read collection", func(t *testing.T) {
 			repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
@@ -73,6 +178,155 @@ func Test_Collections(t *testing.T) {
 			assert.Equal(t, readResponse.ContainerProperties.ID, testCollectionName)
 		})
 
+		t.Run("Should report the resource quota and usage", func(t *testing.T) {
+			repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+				ID: testCollectionName,
+			})
+			repositories.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{"id": "usage-1"})
+			repositories.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{"id": "usage-2"})
+
+			collectionResponse, err := databaseClient.NewContainer(testCollectionName)
+			assert.Nil(t, err)
+
+			readResponse, err := collectionResponse.Read(context.TODO(), &azcosmos.ReadContainerOptions{})
+			assert.Nil(t, err)
+
+			headers := readResponse.RawResponse.Header
+			assert.Contains(t, headers.Get("x-ms-resource-quota"), "documentsCount=-1")
+			assert.Contains(t, headers.Get("x-ms-resource-usage"), "documentsSize=1;documentsCount=2;collectionSize=1")
+			assert.NotEmpty(t, headers.Get("x-ms-cosmos-quorum-acked-llsn"))
+		})
+
+		t.Run("Should report the usage when the quota info is requested", func(t *testing.T) {
+			repositories.DeleteCollection(testDatabaseName, testCollectionName)
+			repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+				ID: testCollectionName,
+			})
+			repositories.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{
+				"id":      "usage-1",
+				"payload": strings.Repeat("a", 1500),
+			})
+
+			collectionResponse, err := databaseClient.NewContainer(testCollectionName)
+			assert.Nil(t, err)
+
+			readResponse, err := collectionResponse.Read(context.TODO(), &azcosmos.ReadContainerOptions{PopulateQuotaInfo: true})
+			assert.Nil(t, err)
+
+			headers := readResponse.RawResponse.Header
+			assert.Contains(t, headers.Get("x-ms-resource-quota"), "collectionSize=")
+			assert.Contains(t, headers.Get("x-ms-resource-usage"), "documentsSize=2;documentsCount=1;collectionSize=2")
+		})
+
+		t.Run("Should read the collection with all of its properties", func(t *testing.T) {
+			repositories.DeleteCollection(testDatabaseName, testCollectionName)
+			defaultTimeToLive := int32(120)
+			analyticalTimeToLive := int32(-1)
+			_, err := databaseClient.CreateContainer(context.TODO(), azcosmos.ContainerProperties{
+				ID:                                 testCollectionName,
+				PartitionKeyDefinition:             azcosmos.PartitionKeyDefinition{Paths: []string{"/pk"}},
+				DefaultTimeToLive:                  &defaultTimeToLive,
+				AnalyticalStoreTimeToLiveInSeconds: &analyticalTimeToLive,
+				UniqueKeyPolicy: &azcosmos.UniqueKeyPolicy{
+					UniqueKeys: []azcosmos.UniqueKey{{Paths: []string{"/email"}}, {Paths: []string{"/first", "/last"}}},
+				},
+				ConflictResolutionPolicy: &azcosmos.ConflictResolutionPolicy{
+					Mode:           azcosmos.ConflictResolutionModeLastWriteWins,
+					ResolutionPath: "/version",
+				},
+			}, &azcosmos.CreateContainerOptions{})
+			assert.Nil(t, err)
+
+			collectionClient, _ := databaseClient.NewContainer(testCollectionName)
+			readResponse, err := collectionClient.Read(context.TODO(), &azcosmos.ReadContainerOptions{})
+			assert.Nil(t, err)
+
+			properties := readResponse.ContainerProperties
+			assert.Equal(t, []string{"/pk"}, properties.PartitionKeyDefinition.Paths)
+			assert.Equal(t, &defaultTimeToLive, properties.DefaultTimeToLive)
+			assert.Equal(t, &analyticalTimeToLive, properties.AnalyticalStoreTimeToLiveInSeconds)
+			assert.Equal(t, []azcosmos.UniqueKey{{Paths: []string{"/email"}}, {Paths: []string{"/first", "/last"}}}, properties.UniqueKeyPolicy.UniqueKeys)
+			assert.Equal(t, &azcosmos.ConflictResolutionPolicy{
+				Mode:           azcosmos.ConflictResolutionModeLastWriteWins,
+				ResolutionPath: "/version",
+			}, properties.ConflictResolutionPolicy)
+			assert.Equal(t, azcosmos.IndexingMode("consistent"), properties.IndexingPolicy.IndexingMode)
+			assert.NotEmpty(t, properties.ResourceID)
+			assert.NotEmpty(t, properties.SelfLink)
+			assert.NotNil(t, properties.ETag)
+			assert.False(t, properties.LastModified.IsZero())
+
+			// Read, modify and replace keeps the other properties
+			updatedTimeToLive := int32(300)
+			properties.DefaultTimeToLive = &updatedTimeToLive
+			replaceResponse, err := collectionClient.Replace(context.TODO(), *properties, &azcosmos.ReplaceContainerOptions{})
+			assert.Nil(t, err)
+			assert.Equal(t, &updatedTimeToLive, replaceResponse.ContainerProperties.DefaultTimeToLive)
+			assert.Equal(t, properties.UniqueKeyPolicy, replaceResponse.ContainerProperties.UniqueKeyPolicy)
+			assert.Equal(t, properties.ConflictResolutionPolicy, replaceResponse.ContainerProperties.ConflictResolutionPolicy)
+			assert.Equal(t, &analyticalTimeToLive, replaceResponse.ContainerProperties.AnalyticalStoreTimeToLiveInSeconds)
+
+			properties.UniqueKeyPolicy = &azcosmos.UniqueKeyPolicy{UniqueKeys: []azcosmos.UniqueKey{{Paths: []string{"/other"}}}}
+			_, err = collectionClient.Replace(context.TODO(), *properties, &azcosmos.ReplaceContainerOptions{})
+			var respErr *azcore.ResponseError
+			if assert.True(t, errors.As(err, &respErr)) {
+				assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+			}
+		})
+
+		t.Run("Should fill in the default policies", func(t *testing.T) {
+			repositories.DeleteCollection(testDatabaseName, testCollectionName)
+			createdCollection, _ := repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{ID: testCollectionName})
+
+			assert.Equal(t, []repositorymodels.CollectionUniqueKey{}, createdCollection.UniqueKeyPolicy.UniqueKeys)
+			assert.Equal(t, repositorymodels.CollectionConflictResolutionPolicy{
+				Mode:                   "LastWriterWins",
+				ConflictResolutionPath: "/_ts",
+			}, createdCollection.ConflictResolutionPolicy)
+		})
+
+		t.Run("Should read the child links, partition key version and geospatial config", func(t *testing.T) {
+			repositories.DeleteCollection(testDatabaseName, testCollectionName)
+			repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+				ID:           testCollectionName,
+				PartitionKey: repositorymodels.CollectionPartitionKey{Paths: []string{"/pk"}},
+			})
+
+			collectionPath := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, testCollectionName)
+			status, body := sendSignedRequest(t, ts.URL, collectionPath, http.MethodGet, "colls", collectionPath, nil)
+			assert.Equal(t, http.StatusOK, status)
+			for _, link := range []string{"_docs", "_sprocs", "_triggers", "_udfs", "_conflicts"} {
+				assert.Equal(t, link[1:]+"/", body[link])
+			}
+			assert.Equal(t, map[string]interface{}{
+				"paths":   []interface{}{"/pk"},
+				"kind":    "Hash",
+				"version": float64(2),
+			}, body["partitionKey"])
+			assert.Equal(t, map[string]interface{}{"type": "Geography"}, body["geospatialConfig"])
+		})
+
+		t.Run("Should create collections with the Geometry type and reject other types", func(t *testing.T) {
+			repositories.DeleteCollection(testDatabaseName, testCollectionName)
+			createdCollection, status := repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+				ID:               testCollectionName,
+				GeospatialConfig: repositorymodels.CollectionGeospatialConfig{Type: "Geometry"},
+			})
+			assert.Equal(t, repositorymodels.StatusOk, int(status))
+			assert.Equal(t, "Geometry", createdCollection.GeospatialConfig.Type)
+
+			// Replacements without the geospatial config keep the type
+			replacedCollection, status := repositories.ReplaceCollection(testDatabaseName, testCollectionName, repositorymodels.Collection{ID: testCollectionName})
+			assert.Equal(t, repositorymodels.StatusOk, int(status))
+			assert.Equal(t, "Geometry", replacedCollection.GeospatialConfig.Type)
+
+			_, status = repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+				ID:               "geospatial-coll",
+				GeospatialConfig: repositorymodels.CollectionGeospatialConfig{Type: "Sphere"},
+			})
+			assert.Equal(t, repositorymodels.BadRequest, int(status))
+		})
+
 		t.Run("Should return not found when collection does not exist", func(t *testing.T) {
 			repositories.DeleteCollection(testDatabaseName, testCollectionName)
 
@@ -91,6 +345,77 @@ func Test_Collections(t *testing.T) {
 		})
 	})
 
+	t.Run("Collection Replace", func(t *testing.T) {
+		t.Run("Should replace the indexing policy and default time to live", func(t *testing.T) {
+			repositories.DeleteCollection(testDatabaseName, testCollectionName)
+			createdCollection, _ := repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+				ID:           testCollectionName,
+				PartitionKey: repositorymodels.CollectionPartitionKey{Paths: []string{"/pk"}},
+			})
+
+			collectionClient, _ := databaseClient.NewContainer(testCollectionName)
+			readResponse, err := collectionClient.Read(context.TODO(), &azcosmos.ReadContainerOptions{})
+			assert.Nil(t, err)
+
+			properties := *readResponse.ContainerProperties
+			defaultTimeToLive := int32(60)
+			properties.DefaultTimeToLive = &defaultTimeToLive
+			properties.IndexingPolicy = &azcosmos.IndexingPolicy{
+				Automatic:     true,
+				IndexingMode:  azcosmos.IndexingModeConsistent,
+				IncludedPaths: []azcosmos.IncludedPath{{Path: "/name/?"}},
+				ExcludedPaths: []azcosmos.ExcludedPath{{Path: "/*"}},
+			}
+
+			replaceResponse, err := collectionClient.Replace(context.TODO(), properties, &azcosmos.ReplaceContainerOptions{})
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusOK, replaceResponse.RawResponse.StatusCode)
+			assert.Equal(t, properties.IndexingPolicy.IncludedPaths, replaceResponse.ContainerProperties.IndexingPolicy.IncludedPaths)
+			assert.Equal(t, []azcosmos.ExcludedPath{{Path: "/*"}, {Path: "/\"_etag\"/?"}}, replaceResponse.ContainerProperties.IndexingPolicy.ExcludedPaths)
+			assert.Equal(t, &defaultTimeToLive, replaceResponse.ContainerProperties.DefaultTimeToLive)
+			assert.NotEqual(t, createdCollection.ETag, string(*replaceResponse.ContainerProperties.ETag))
+
+			storedCollection, _ := repositories.GetCollection(testDatabaseName, testCollectionName)
+			assert.Equal(t, createdCollection.ResourceID, storedCollection.ResourceID)
+			assert.Equal(t, []string{"/pk"}, storedCollection.PartitionKey.Paths)
+			assert.Equal(t, 60, *storedCollection.DefaultTimeToLive)
+		})
+
+		t.Run("Should return bad request when the id or partition key changes", func(t *testing.T) {
+			collectionClient, _ := databaseClient.NewContainer(testCollectionName)
+			for expectedMessage, properties := range map[string]azcosmos.ContainerProperties{
+				"cannot be changed to 'renamed'":       {ID: "renamed", PartitionKeyDefinition: azcosmos.PartitionKeyDefinition{Paths: []string{"/pk"}}},
+				"partition key cannot be changed":      {ID: testCollectionName, PartitionKeyDefinition: azcosmos.PartitionKeyDefinition{Paths: []string{"/other"}}},
+				"conflict resolution policy cannot be": {ID: testCollectionName, ConflictResolutionPolicy: &azcosmos.ConflictResolutionPolicy{Mode: azcosmos.ConflictResolutionModeCustom}},
+			} {
+				_, err := collectionClient.Replace(context.TODO(), properties, &azcosmos.ReplaceContainerOptions{})
+
+				var respErr *azcore.ResponseError
+				if errors.As(err, &respErr) {
+					assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+					assert.Contains(t, respErr.Error(), expectedMessage)
+				} else {
+					panic(err)
+				}
+			}
+
+			storedCollection, _ := repositories.GetCollection(testDatabaseName, testCollectionName)
+			assert.Equal(t, []string{"/pk"}, storedCollection.PartitionKey.Paths)
+		})
+
+		t.Run("Should return not found when collection does not exist", func(t *testing.T) {
+			collectionClient, _ := databaseClient.NewContainer("missing-collection")
+			_, err := collectionClient.Replace(context.TODO(), azcosmos.ContainerProperties{ID: "missing-collection"}, &azcosmos.ReplaceContainerOptions{})
+
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) {
+				assert.Equal(t, http.StatusNotFound, respErr.StatusCode)
+			} else {
+				panic(err)
+			}
+		})
+	})
+
 	t.Run("Collection Delete", func(t *testing.T) {
 		t.Run("Should delete collection", func(t *testing.T) {
 			repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
@@ -0,0 +1,115 @@
+package tests_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Conflicts(t *testing.T) {
+	config.Config.EnableStateEndpoint = true
+	ts := runTestServer()
+	defer ts.Close()
+	config.Config.EnableStateEndpoint = false
+
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+	repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{ID: "conflicts-coll"})
+	defer repositories.DeleteCollection(testDatabaseName, "conflicts-coll")
+
+	collectionPath := fmt.Sprintf("dbs/%s/colls/conflicts-coll", testDatabaseName)
+	readConflicts := func(t *testing.T, headers map[string]string) (int, http.Header, []interface{}) {
+		status, responseHeaders, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/conflicts", http.MethodGet, "conflicts", collectionPath, headers, nil)
+		conflicts, _ := body["Conflicts"].([]interface{})
+		return status, responseHeaders, conflicts
+	}
+
+	injectConflictInto := func(t *testing.T, collectionPath string, conflict map[string]interface{}) int {
+		body, _ := json.Marshal(conflict)
+		res, err := http.Post(ts.URL+"/_state/"+collectionPath+"/conflicts", "application/json", bytes.NewReader(body))
+		assert.Nil(t, err)
+		res.Body.Close()
+		return res.StatusCode
+	}
+	injectConflict := func(t *testing.T, conflict map[string]interface{}) int {
+		return injectConflictInto(t, collectionPath, conflict)
+	}
+
+	t.Run("Should return an empty conflicts feed", func(t *testing.T) {
+		status, headers, conflicts := readConflicts(t, nil)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "0", headers.Get("x-ms-item-count"))
+		assert.NotNil(t, conflicts)
+		assert.Len(t, conflicts, 0)
+	})
+
+	t.Run("Should return injected conflicts", func(t *testing.T) {
+		assert.Equal(t, http.StatusCreated, injectConflict(t, map[string]interface{}{
+			"id":            "conflict-1",
+			"operationType": "replace",
+			"resourceId":    "rid-1",
+			"content":       `{"id": "doc-1"}`,
+		}))
+		assert.Equal(t, http.StatusConflict, injectConflict(t, map[string]interface{}{"id": "conflict-1"}))
+
+		status, _, conflicts := readConflicts(t, nil)
+		assert.Equal(t, http.StatusOK, status)
+		if assert.Len(t, conflicts, 1) {
+			conflict := conflicts[0].(map[string]interface{})
+			assert.Equal(t, "conflict-1", conflict["id"])
+			assert.Equal(t, "document", conflict["resourceType"])
+			assert.Equal(t, "replace", conflict["operationType"])
+			assert.Equal(t, "rid-1", conflict["resourceId"])
+			assert.Equal(t, `{"id": "doc-1"}`, conflict["content"])
+			assert.NotEmpty(t, conflict["_rid"])
+			assert.NotEmpty(t, conflict["_etag"])
+		}
+
+		conflictPath := collectionPath + "/conflicts/conflict-1"
+		status, _, body := sendSignedRequestWithHeaders(t, ts.URL, conflictPath, http.MethodGet, "conflicts", conflictPath, nil, nil)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "conflict-1", body["id"])
+	})
+
+	t.Run("Should paginate the conflicts feed", func(t *testing.T) {
+		injectConflict(t, map[string]interface{}{"id": "conflict-2"})
+		injectConflict(t, map[string]interface{}{"id": "conflict-3"})
+
+		status, headers, conflicts := readConflicts(t, map[string]string{"x-ms-max-item-count": "2"})
+		assert.Equal(t, http.StatusOK, status)
+		assert.Len(t, conflicts, 2)
+		assert.NotEmpty(t, headers.Get("x-ms-continuation"))
+
+		status, headers, conflicts = readConflicts(t, map[string]string{
+			"x-ms-max-item-count": "2",
+			"x-ms-continuation":   headers.Get("x-ms-continuation"),
+		})
+		assert.Equal(t, http.StatusOK, status)
+		if assert.Len(t, conflicts, 1) {
+			assert.Equal(t, "conflict-3", conflicts[0].(map[string]interface{})["id"])
+		}
+		assert.Empty(t, headers.Get("x-ms-continuation"))
+	})
+
+	t.Run("Should delete conflicts", func(t *testing.T) {
+		conflictPath := collectionPath + "/conflicts/conflict-2"
+		status, _, _ := sendSignedRequestWithHeaders(t, ts.URL, conflictPath, http.MethodDelete, "conflicts", conflictPath, nil, nil)
+		assert.Equal(t, http.StatusNoContent, status)
+
+		status, _, _ = sendSignedRequestWithHeaders(t, ts.URL, conflictPath, http.MethodGet, "conflicts", conflictPath, nil, nil)
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("Should return not found for a missing collection", func(t *testing.T) {
+		path := fmt.Sprintf("dbs/%s/colls/missing-coll", testDatabaseName)
+		status, _, _ := sendSignedRequestWithHeaders(t, ts.URL, path+"/conflicts", http.MethodGet, "conflicts", path, nil, nil)
+		assert.Equal(t, http.StatusNotFound, status)
+		assert.Equal(t, http.StatusNotFound, injectConflictInto(t, path, map[string]interface{}{"id": "conflict-1"}))
+	})
+}
@@ -56,6 +56,62 @@ func Test_Databases(t *testing.T) {
 		})
 	})
 
+	t.Run("Database Read Feed", func(t *testing.T) {
+		for _, id := range []string{"feed-db-c", "feed-db-a", "feed-db-b"} {
+			repositories.CreateDatabase(repositorymodels.Database{ID: id})
+		}
+
+		t.Run("Should page databases with continuation tokens", func(t *testing.T) {
+			allDatabases, _ := repositories.GetAllDatabases()
+
+			ids := make([]string, 0)
+			continuation := ""
+			for {
+				headers := map[string]string{"x-ms-max-item-count": "2"}
+				if continuation != "" {
+					headers["x-ms-continuation"] = continuation
+				}
+
+				status, responseHeaders, body := sendSignedRequestWithHeaders(t, ts.URL, "dbs", http.MethodGet, "dbs", "", headers, nil)
+				assert.Equal(t, http.StatusOK, status)
+				assert.Contains(t, body, "_rid")
+
+				databases := body["Databases"].([]interface{})
+				assert.LessOrEqual(t, len(databases), 2)
+				assert.Equal(t, float64(len(databases)), body["_count"])
+				assert.Equal(t, fmt.Sprint(len(databases)), responseHeaders.Get("x-ms-item-count"))
+
+				for _, database := range databases {
+					database := database.(map[string]interface{})
+					assert.NotEmpty(t, database["_rid"])
+					assert.Equal(t, fmt.Sprintf("dbs/%s/", database["_rid"]), database["_self"])
+					ids = append(ids, database["id"].(string))
+				}
+
+				if continuation = responseHeaders.Get("x-ms-continuation"); continuation == "" {
+					break
+				}
+			}
+
+			expectedIds := make([]string, 0)
+			for _, database := range allDatabases {
+				expectedIds = append(expectedIds, database.ID)
+			}
+			assert.Equal(t, expectedIds, ids)
+			assert.Subset(t, ids, []string{"feed-db-a", "feed-db-b", "feed-db-c"})
+		})
+
+		t.Run("Should return bad request for invalid continuation token", func(t *testing.T) {
+			status, _, body := sendSignedRequestWithHeaders(t, ts.URL, "dbs", http.MethodGet, "dbs", "", map[string]string{"x-ms-continuation": "invalid"}, nil)
+			assert.Equal(t, http.StatusBadRequest, status)
+			assert.Equal(t, "BadRequest", body["code"])
+		})
+
+		for _, id := range []string{"feed-db-a", "feed-db-b", "feed-db-c"} {
+			repositories.DeleteDatabase(id)
+		}
+	})
+
 	t.Run("Database Read", func(t *testing.T) {
 		t.Run("Should read database", func(t *testing.T) {
 			repositories.CreateDatabase(repositorymodels.Database{
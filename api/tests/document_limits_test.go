@@ -0,0 +1,130 @@
+package tests_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DocumentLimits(t *testing.T) {
+	config.Config.EnableStateEndpoint = true
+	ts := runTestServer()
+	defer ts.Close()
+	config.Config.EnableStateEndpoint = false
+
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+	for _, collectionId := range []string{"limits-coll", "limits-other-coll"} {
+		repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+			ID:           collectionId,
+			PartitionKey: repositorymodels.CollectionPartitionKey{Paths: []string{"/pk"}},
+		})
+		defer repositories.DeleteCollection(testDatabaseName, collectionId)
+	}
+
+	createDocument := func(t *testing.T, collectionId string, documentId string, headers map[string]string) (int, map[string]interface{}) {
+		path := fmt.Sprintf("dbs/%s/colls/%s/docs", testDatabaseName, collectionId)
+		status, _, body := sendSignedRequestWithHeaders(t, ts.URL, path, http.MethodPost, "docs", fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, collectionId), headers, map[string]interface{}{"id": documentId, "pk": "a"})
+		return status, body
+	}
+	partitionKeyHeaders := map[string]string{"x-ms-documentdb-partitionkey": `["a"]`}
+
+	t.Run("Should reject creating documents after the limit was reached", func(t *testing.T) {
+		config.Config.MaxDocumentCount = 2
+		defer func() { config.Config.MaxDocumentCount = 0 }()
+
+		for _, documentId := range []string{"1", "2"} {
+			status, _ := createDocument(t, "limits-coll", documentId, partitionKeyHeaders)
+			assert.Equal(t, http.StatusCreated, status)
+		}
+
+		status, body := createDocument(t, "limits-coll", "3", partitionKeyHeaders)
+		assert.Equal(t, http.StatusForbidden, status)
+		assert.Equal(t, "Forbidden", body["code"])
+		assert.Contains(t, body["message"], "exceeded its quota")
+
+		// Upserts replacing existing documents don't add to the count
+		status, _ = createDocument(t, "limits-coll", "2", map[string]string{
+			"x-ms-documentdb-partitionkey": `["a"]`,
+			"x-ms-documentdb-is-upsert":    "true",
+		})
+		assert.Equal(t, http.StatusOK, status)
+
+		repositories.DeleteDocument(testDatabaseName, "limits-coll", "1", nil)
+		status, _ = createDocument(t, "limits-coll", "3", partitionKeyHeaders)
+		assert.Equal(t, http.StatusCreated, status)
+	})
+
+	t.Run("Should override the limit of a single collection", func(t *testing.T) {
+		limitUrl := fmt.Sprintf("%s/_state/dbs/%s/colls/limits-other-coll/documentlimit", ts.URL, testDatabaseName)
+		sendDocumentLimit := func(t *testing.T, method string, settings map[string]interface{}) int {
+			body, _ := json.Marshal(settings)
+			req, err := http.NewRequest(method, limitUrl, bytes.NewReader(body))
+			assert.Nil(t, err)
+			res, err := http.DefaultClient.Do(req)
+			assert.Nil(t, err)
+			res.Body.Close()
+			return res.StatusCode
+		}
+
+		assert.Equal(t, http.StatusOK, sendDocumentLimit(t, http.MethodPut, map[string]interface{}{"maxDocuments": 1}))
+
+		status, _ := createDocument(t, "limits-other-coll", "1", partitionKeyHeaders)
+		assert.Equal(t, http.StatusCreated, status)
+		status, _ = createDocument(t, "limits-other-coll", "2", partitionKeyHeaders)
+		assert.Equal(t, http.StatusForbidden, status)
+
+		// Other collections are still limited by the flag
+		status, _ = createDocument(t, "limits-coll", "4", partitionKeyHeaders)
+		assert.Equal(t, http.StatusCreated, status)
+
+		assert.Equal(t, http.StatusNoContent, sendDocumentLimit(t, http.MethodDelete, nil))
+		status, _ = createDocument(t, "limits-other-coll", "2", partitionKeyHeaders)
+		assert.Equal(t, http.StatusCreated, status)
+
+		assert.Equal(t, http.StatusBadRequest, sendDocumentLimit(t, http.MethodPut, map[string]interface{}{"maxDocuments": -1}))
+		limitUrl = fmt.Sprintf("%s/_state/dbs/%s/colls/missing-coll/documentlimit", ts.URL, testDatabaseName)
+		assert.Equal(t, http.StatusNotFound, sendDocumentLimit(t, http.MethodPut, map[string]interface{}{"maxDocuments": 1}))
+	})
+
+	t.Run("Should not count expired documents", func(t *testing.T) {
+		defaultTimeToLive := 60
+		repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+			ID:                "limits-ttl-coll",
+			PartitionKey:      repositorymodels.CollectionPartitionKey{Paths: []string{"/pk"}},
+			DefaultTimeToLive: &defaultTimeToLive,
+		})
+		defer repositories.DeleteCollection(testDatabaseName, "limits-ttl-coll")
+
+		config.Config.MaxDocumentCount = 2
+		defer func() { config.Config.MaxDocumentCount = 0 }()
+
+		// Expired, but kept in the state until it is purged
+		expiredDocument, _ := repositories.CreateDocument(testDatabaseName, "limits-ttl-coll", map[string]interface{}{"id": "expired", "pk": "a"})
+		expiredDocument["_ts"] = time.Now().Add(-time.Hour).Unix()
+		repositories.CreateDocument(testDatabaseName, "limits-ttl-coll", map[string]interface{}{"id": "1", "pk": "a"})
+
+		status, _ := createDocument(t, "limits-ttl-coll", "2", partitionKeyHeaders)
+		assert.Equal(t, http.StatusCreated, status)
+		status, _ = createDocument(t, "limits-ttl-coll", "3", partitionKeyHeaders)
+		assert.Equal(t, http.StatusForbidden, status)
+	})
+
+	t.Run("Should fail the creates of a bulk request exceeding the limit", func(t *testing.T) {
+		assert.Equal(t, repositorymodels.StatusOk, int(repositories.SetCollectionDocumentLimit(testDatabaseName, "limits-other-coll", 3)))
+		defer repositories.ResetCollectionDocumentLimit(testDatabaseName, "limits-other-coll")
+
+		_, statuses, _ := repositories.CreateDocuments(testDatabaseName, "limits-other-coll", []map[string]interface{}{
+			{"id": "3", "pk": "a"},
+			{"id": "4", "pk": "a"},
+		})
+		assert.Equal(t, []repositorymodels.RepositoryStatus{repositorymodels.StatusOk, repositorymodels.DocumentLimitReached}, statuses)
+	})
+}
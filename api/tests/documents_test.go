@@ -1,18 +1,26 @@
 package tests_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
 	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/authentication"
 	"github.com/pikami/cosmium/internal/repositories"
 	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
 	"github.com/stretchr/testify/assert"
@@ -36,7 +44,9 @@ func testCosmosQuery(t *testing.T,
 
 	for pager.More() {
 		response, err := pager.NextPage(context)
-		assert.Nil(t, err)
+		if !assert.Nil(t, err) {
+			break
+		}
 
 		for _, bytes := range response.Items {
 			var item interface{}
@@ -60,7 +70,7 @@ func documents_InitializeDb(t *testing.T) (*httptest.Server, *azcosmos.Container
 		PartitionKey: struct {
 			Paths   []string "json:\"paths\""
 			Kind    string   "json:\"kind\""
-			Version int      "json:\"Version\""
+			Version int      "json:\"version\""
 		}{
 			Paths: []string{"/pk"},
 		},
@@ -97,6 +107,40 @@ func Test_Documents(t *testing.T) {
 		)
 	})
 
+	t.Run("Should query documents ordered by a computed expression", func(t *testing.T) {
+		testCosmosQuery(t, collectionClient,
+			"SELECT c.id FROM c WHERE c.pk IN (\"123\", \"456\") ORDER BY ARRAY_LENGTH(c.arr) * -1, c.id DESC",
+			nil,
+			[]interface{}{
+				map[string]interface{}{"id": "67890"},
+				map[string]interface{}{"id": "12345"},
+			},
+		)
+	})
+
+	t.Run("Should query grouped rows of a subquery", func(t *testing.T) {
+		testCosmosQuery(t, collectionClient,
+			`SELECT g.isCool, g.n FROM (
+				SELECT c.isCool AS isCool, COUNT(1) AS n FROM c WHERE c.id IN ("12345", "67890") GROUP BY c.isCool
+			) AS g WHERE g.n > 0 ORDER BY g.isCool`,
+			nil,
+			[]interface{}{
+				map[string]interface{}{"isCool": false, "n": float64(1)},
+				map[string]interface{}{"isCool": true, "n": float64(1)},
+			},
+		)
+	})
+
+	t.Run("Should query a paged subquery", func(t *testing.T) {
+		testCosmosQuery(t, collectionClient,
+			`SELECT VALUE sub.id FROM (
+				SELECT c.id FROM c WHERE c.id IN ("12345", "67890") ORDER BY c.id OFFSET 1 LIMIT 1
+			) AS sub`,
+			nil,
+			[]interface{}{"67890"},
+		)
+	})
+
 	t.Run("Should query VALUE array", func(t *testing.T) {
 		testCosmosQuery(t, collectionClient,
 			"SELECT VALUE [c.id, c[\"pk\"]] FROM c ORDER BY c.id",
@@ -157,10 +201,216 @@ func Test_Documents(t *testing.T) {
 			FROM c ORDER BY c.id`,
 			nil,
 			[]interface{}{
-				map[string]interface{}{"id": "12345", "arr0": 1.0, "arr1": 2.0, "arr2": 3.0, "arr3": nil},
-				map[string]interface{}{"id": "67890", "arr0": 6.0, "arr1": 7.0, "arr2": 8.0, "arr3": nil},
+				map[string]interface{}{"id": "12345", "arr0": 1.0, "arr1": 2.0, "arr2": 3.0},
+				map[string]interface{}{"id": "67890", "arr0": 6.0, "arr1": 7.0, "arr2": 8.0},
+			},
+		)
+	})
+
+	t.Run("Should return system properties with SELECT *", func(t *testing.T) {
+		stored, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+
+		for _, query := range []string{
+			"SELECT * FROM c WHERE c.id = '12345'",
+			"SELECT * FROM root WHERE root.id = '12345'",
+		} {
+			pager := collectionClient.NewQueryItemsPager(query, azcosmos.PartitionKey{}, &azcosmos.QueryOptions{})
+
+			response, err := pager.NextPage(context.TODO())
+			assert.Nil(t, err)
+			if !assert.Len(t, response.Items, 1) {
+				continue
+			}
+
+			var item map[string]interface{}
+			json.Unmarshal(response.Items[0], &item)
+			for _, property := range []string{"_rid", "_etag", "_self", "_attachments"} {
+				assert.Equal(t, stored[property], item[property])
+			}
+			assert.Equal(t, float64(stored["_ts"].(int64)), item["_ts"])
+		}
+	})
+
+	t.Run("Should query system properties as property paths", func(t *testing.T) {
+		stored, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		timestamp := stored["_ts"].(int64)
+
+		testCosmosQuery(t, collectionClient,
+			"SELECT c.id, c._rid, c._ts FROM c WHERE c._ts >= @since AND c._rid = @rid",
+			[]azcosmos.QueryParameter{
+				{Name: "@since", Value: timestamp},
+				{Name: "@rid", Value: stored["_rid"]},
+			},
+			[]interface{}{
+				map[string]interface{}{"id": "12345", "_rid": stored["_rid"], "_ts": float64(timestamp)},
 			},
 		)
+
+		testCosmosQuery(t, collectionClient,
+			"SELECT VALUE c.id FROM c WHERE c._ts > @since ORDER BY c._ts",
+			[]azcosmos.QueryParameter{{Name: "@since", Value: timestamp}},
+			[]interface{}{},
+		)
+	})
+
+	t.Run("Should query with IN and a subquery", func(t *testing.T) {
+		testCosmosQuery(t, collectionClient,
+			"SELECT VALUE c.id FROM c WHERE c.id IN (SELECT VALUE i FROM i IN [@id, \"missing\"])",
+			[]azcosmos.QueryParameter{{Name: "@id", Value: "12345"}},
+			[]interface{}{"12345"},
+		)
+	})
+
+	t.Run("Should read document with resource links", func(t *testing.T) {
+		response, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "12345", nil)
+		assert.Nil(t, err)
+
+		var item map[string]interface{}
+		json.Unmarshal(response.Value, &item)
+
+		database, _ := repositories.GetDatabase(testDatabaseName)
+		collection, _ := repositories.GetCollection(testDatabaseName, testCollectionName)
+		assert.NotEmpty(t, item["_rid"])
+		assert.Equal(t, fmt.Sprintf("dbs/%s/colls/%s/docs/%s/", database.ResourceID, collection.ResourceID, item["_rid"]), item["_self"])
+		assert.Equal(t, "attachments/", item["_attachments"])
+	})
+
+	t.Run("Should scope query to the partition key", func(t *testing.T) {
+		pager := collectionClient.NewQueryItemsPager(
+			"SELECT c.id FROM c",
+			azcosmos.NewPartitionKeyString("456"),
+			&azcosmos.QueryOptions{})
+
+		response, err := pager.NextPage(context.TODO())
+		assert.Nil(t, err)
+		if !assert.Len(t, response.Items, 1) {
+			return
+		}
+
+		var item map[string]interface{}
+		json.Unmarshal(response.Items[0], &item)
+		assert.Equal(t, "67890", item["id"])
+	})
+
+	t.Run("Should page query results with continuation tokens", func(t *testing.T) {
+		for _, query := range []string{
+			"SELECT c.id FROM c",
+			"SELECT c.id FROM c ORDER BY c.id DESC",
+		} {
+			pager := collectionClient.NewQueryItemsPager(query, azcosmos.PartitionKey{}, &azcosmos.QueryOptions{PageSizeHint: 1})
+
+			ids := make([]string, 0)
+			pages := 0
+			for pager.More() {
+				response, err := pager.NextPage(context.TODO())
+				if !assert.Nil(t, err) {
+					break
+				}
+				assert.Len(t, response.Items, 1)
+				pages++
+
+				for _, item := range response.Items {
+					var document map[string]interface{}
+					json.Unmarshal(item, &document)
+					ids = append(ids, document["id"].(string))
+				}
+			}
+
+			assert.Equal(t, 2, pages)
+			assert.ElementsMatch(t, []string{"12345", "67890"}, ids)
+			if strings.Contains(query, "DESC") {
+				assert.Equal(t, []string{"67890", "12345"}, ids)
+			}
+		}
+
+		pager := collectionClient.NewQueryItemsPager("SELECT c.id FROM c", azcosmos.PartitionKey{}, &azcosmos.QueryOptions{
+			ContinuationToken: "not-a-token",
+		})
+		_, err := pager.NextPage(context.TODO())
+		var respErr *azcore.ResponseError
+		if assert.True(t, errors.As(err, &respErr)) {
+			assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+		}
+	})
+
+	t.Run("Should populate query metrics", func(t *testing.T) {
+		pager := collectionClient.NewQueryItemsPager(
+			"SELECT c.id FROM c WHERE c.isCool = true",
+			azcosmos.PartitionKey{},
+			&azcosmos.QueryOptions{})
+
+		response, err := pager.NextPage(context.TODO())
+		assert.Nil(t, err)
+		if !assert.NotNil(t, response.QueryMetrics) {
+			return
+		}
+
+		metrics := map[string]string{}
+		for _, metric := range strings.Split(*response.QueryMetrics, ";") {
+			name, value, _ := strings.Cut(metric, "=")
+			metrics[name] = value
+		}
+
+		assert.Equal(t, "2", metrics["retrievedDocumentCount"])
+		assert.Equal(t, "1", metrics["outputDocumentCount"])
+		assert.Contains(t, metrics, "totalExecutionTimeInMs")
+		assert.Contains(t, metrics, "retrievedDocumentSize")
+	})
+
+	t.Run("Should only count the documents of the queried partition as retrieved", func(t *testing.T) {
+		pager := collectionClient.NewQueryItemsPager(
+			"SELECT c.id FROM c",
+			azcosmos.NewPartitionKeyString("123"),
+			&azcosmos.QueryOptions{})
+
+		response, err := pager.NextPage(context.TODO())
+		assert.Nil(t, err)
+		if !assert.NotNil(t, response.QueryMetrics) {
+			return
+		}
+
+		metrics := map[string]string{}
+		for _, metric := range strings.Split(*response.QueryMetrics, ";") {
+			name, value, _ := strings.Cut(metric, "=")
+			metrics[name] = value
+		}
+
+		document, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		documentJSON, _ := json.Marshal([]interface{}{document})
+		assert.Equal(t, "1", metrics["retrievedDocumentCount"])
+		assert.Equal(t, fmt.Sprint(len(documentJSON)), metrics["retrievedDocumentSize"])
+	})
+
+	t.Run("Should return 400 when query can not be parsed", func(t *testing.T) {
+		pager := collectionClient.NewQueryItemsPager(
+			"SELEC c.id FROM c",
+			azcosmos.PartitionKey{},
+			&azcosmos.QueryOptions{})
+
+		_, err := pager.NextPage(context.TODO())
+		assert.NotNil(t, err)
+
+		var respErr *azcore.ResponseError
+		if errors.As(err, &respErr) {
+			assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+			assert.Equal(t, "BadRequest", respErr.ErrorCode)
+		} else {
+			panic(err)
+		}
+	})
+
+	t.Run("Should return 408 when query exceeds the timeout", func(t *testing.T) {
+		config.Config.QueryTimeout = time.Nanosecond
+		defer func() { config.Config.QueryTimeout = 0 }()
+
+		// The SDK retries timed out requests, so the query is sent directly
+		collectionPath := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, testCollectionName)
+		status, body := sendSignedRequest(t, ts.URL, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, map[string]interface{}{
+			"query": "SELECT * FROM c",
+		})
+
+		assert.Equal(t, http.StatusRequestTimeout, status)
+		assert.Equal(t, "RequestTimeout", body["code"])
 	})
 }
 
@@ -178,7 +428,7 @@ func Test_Documents_Patch(t *testing.T) {
 
 		itemResponse, err := collectionClient.PatchItem(
 			context,
-			azcosmos.PartitionKey{},
+			azcosmos.NewPartitionKeyString("456"),
 			"67890",
 			patch,
 			&azcosmos.ItemOptions{
@@ -196,6 +446,95 @@ func Test_Documents_Patch(t *testing.T) {
 		assert.Equal(t, expectedData["newField"], itemResponseBody["newField"])
 	})
 
+	t.Run("Should PATCH document keeping its resource id", func(t *testing.T) {
+		existingDocument, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "67890")
+
+		patch := azcosmos.PatchOperations{}
+		patch.AppendAdd("/counter", 1)
+		patch.AppendAdd("/_rid", "client-rid")
+
+		response, err := collectionClient.PatchItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("456"),
+			"67890",
+			patch,
+			&azcosmos.ItemOptions{EnableContentResponseOnWrite: true},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, response.RawResponse.StatusCode)
+
+		var patched map[string]interface{}
+		json.Unmarshal(response.Value, &patched)
+		assert.Equal(t, "67890", patched["id"])
+		assert.Equal(t, float64(1), patched["counter"])
+		assert.Equal(t, existingDocument["_rid"], patched["_rid"])
+		assert.Equal(t, existingDocument["_self"], patched["_self"])
+		assert.NotEqual(t, existingDocument["_etag"], patched["_etag"])
+		assert.Equal(t, string(response.ETag), patched["_etag"])
+	})
+
+	t.Run("Should keep document when PATCH fails", func(t *testing.T) {
+		existingDocument, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "67890")
+
+		patch := azcosmos.PatchOperations{}
+		patch.AppendAdd("/counter", 2)
+		patch.AppendReplace("/missing/field", "value")
+
+		_, err := collectionClient.PatchItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("456"),
+			"67890",
+			patch,
+			&azcosmos.ItemOptions{EnableContentResponseOnWrite: false},
+		)
+		assert.NotNil(t, err)
+
+		document, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "67890")
+		assert.Equal(t, existingDocument["_etag"], document["_etag"])
+		assert.Equal(t, existingDocument["counter"], document["counter"])
+	})
+
+	t.Run("Should PATCH document only when the condition is satisfied", func(t *testing.T) {
+		patchWithCondition := func(condition string) (azcosmos.ItemResponse, error) {
+			patch := azcosmos.PatchOperations{}
+			patch.SetCondition(condition)
+			patch.AppendAdd("/conditional", "applied")
+
+			return collectionClient.PatchItem(
+				context.TODO(),
+				azcosmos.NewPartitionKeyString("123"),
+				"12345",
+				patch,
+				&azcosmos.ItemOptions{EnableContentResponseOnWrite: true},
+			)
+		}
+
+		_, err := patchWithCondition("from c where c.isCool = true")
+		var respErr *azcore.ResponseError
+		if errors.As(err, &respErr) {
+			assert.Equal(t, http.StatusPreconditionFailed, respErr.StatusCode)
+		} else {
+			panic(err)
+		}
+
+		document, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		assert.NotContains(t, document, "conditional")
+
+		_, err = patchWithCondition("from c where")
+		if errors.As(err, &respErr) {
+			assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+		} else {
+			panic(err)
+		}
+
+		response, err := patchWithCondition("from c where c.isCool = false")
+		assert.Nil(t, err)
+
+		var patched map[string]interface{}
+		json.Unmarshal(response.Value, &patched)
+		assert.Equal(t, "applied", patched["conditional"])
+	})
+
 	t.Run("Should not allow to PATCH document ID", func(t *testing.T) {
 		context := context.TODO()
 
@@ -204,7 +543,7 @@ func Test_Documents_Patch(t *testing.T) {
 
 		_, err := collectionClient.PatchItem(
 			context,
-			azcosmos.PartitionKey{},
+			azcosmos.NewPartitionKeyString("456"),
 			"67890",
 			patch,
 			&azcosmos.ItemOptions{
@@ -221,11 +560,57 @@ func Test_Documents_Patch(t *testing.T) {
 		}
 	})
 
+	t.Run("Should replace document in place", func(t *testing.T) {
+		existingDocument, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+
+		item, _ := json.Marshal(map[string]interface{}{"id": "12345", "pk": "123", "replaced": true})
+		response, err := collectionClient.ReplaceItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("123"),
+			"12345",
+			item,
+			&azcosmos.ItemOptions{EnableContentResponseOnWrite: true},
+		)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, response.RawResponse.StatusCode)
+
+		var replaced map[string]interface{}
+		json.Unmarshal(response.Value, &replaced)
+		assert.Equal(t, true, replaced["replaced"])
+		assert.NotContains(t, replaced, "isCool")
+		assert.Equal(t, existingDocument["_rid"], replaced["_rid"])
+		assert.Equal(t, existingDocument["_self"], replaced["_self"])
+		assert.NotEqual(t, existingDocument["_etag"], replaced["_etag"])
+	})
+
+	t.Run("Should keep document when replace id does not match", func(t *testing.T) {
+		item, _ := json.Marshal(map[string]interface{}{"id": "other", "pk": "123"})
+		_, err := collectionClient.ReplaceItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("123"),
+			"12345",
+			item,
+			&azcosmos.ItemOptions{EnableContentResponseOnWrite: false},
+		)
+
+		var respErr *azcore.ResponseError
+		if errors.As(err, &respErr) {
+			assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+		} else {
+			panic(err)
+		}
+
+		_, status := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+		_, status = repositories.GetDocument(testDatabaseName, testCollectionName, "other")
+		assert.Equal(t, repositorymodels.StatusNotFound, int(status))
+	})
+
 	t.Run("CreateItem", func(t *testing.T) {
 		context := context.TODO()
 
 		item := map[string]interface{}{
-			"Id":       "6789011",
+			"id":       "6789011",
 			"pk":       "456",
 			"newField": "newValue2",
 		}
@@ -234,7 +619,7 @@ func Test_Documents_Patch(t *testing.T) {
 
 		r, err2 := collectionClient.CreateItem(
 			context,
-			azcosmos.PartitionKey{},
+			azcosmos.NewPartitionKeyString("456"),
 			bytes,
 			&azcosmos.ItemOptions{
 				EnableContentResponseOnWrite: false,
@@ -253,7 +638,7 @@ func Test_Documents_Patch(t *testing.T) {
 
 		r, err := collectionClient.CreateItem(
 			context,
-			azcosmos.PartitionKey{},
+			azcosmos.NewPartitionKeyString("123"),
 			bytes,
 			&azcosmos.ItemOptions{
 				EnableContentResponseOnWrite: false,
@@ -279,7 +664,7 @@ func Test_Documents_Patch(t *testing.T) {
 
 		r, err2 := collectionClient.UpsertItem(
 			context,
-			azcosmos.PartitionKey{},
+			azcosmos.NewPartitionKeyString("1234"),
 			bytes,
 			&azcosmos.ItemOptions{
 				EnableContentResponseOnWrite: false,
@@ -287,18 +672,21 @@ func Test_Documents_Patch(t *testing.T) {
 		)
 		assert.NotNil(t, r)
 		assert.Nil(t, err2)
+		assert.Equal(t, http.StatusCreated, r.RawResponse.StatusCode)
 	})
 
 	t.Run("UpsertItem that already exists", func(t *testing.T) {
 		context := context.TODO()
 
+		existingDocument, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+
 		item := map[string]interface{}{"id": "12345", "pk": "123", "isCool": false, "arr": []int{1, 2, 3, 4}}
 		bytes, err := json.Marshal(item)
 		assert.Nil(t, err)
 
 		r, err2 := collectionClient.UpsertItem(
 			context,
-			azcosmos.PartitionKey{},
+			azcosmos.NewPartitionKeyString("123"),
 			bytes,
 			&azcosmos.ItemOptions{
 				EnableContentResponseOnWrite: false,
@@ -306,6 +694,1275 @@ func Test_Documents_Patch(t *testing.T) {
 		)
 		assert.NotNil(t, r)
 		assert.Nil(t, err2)
+		assert.Equal(t, http.StatusOK, r.RawResponse.StatusCode)
+
+		// The document is replaced in place, keeping its resource id
+		upsertedDocument, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		assert.Equal(t, existingDocument["_rid"], upsertedDocument["_rid"])
+		assert.NotEqual(t, existingDocument["_etag"], upsertedDocument["_etag"])
+		assert.Equal(t, []interface{}{1, 2, 3, 4}, upsertedDocument["arr"])
+	})
+
+	t.Run("CreateItem ignores client supplied system properties", func(t *testing.T) {
+		item := map[string]interface{}{
+			"id":           "system-properties",
+			"pk":           "123",
+			"_rid":         "client-rid",
+			"_self":        "client-self",
+			"_etag":        "client-etag",
+			"_ts":          1,
+			"_attachments": "client-attachments",
+		}
+		bytes, err := json.Marshal(item)
+		assert.Nil(t, err)
+
+		response, err := collectionClient.CreateItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("123"),
+			bytes,
+			&azcosmos.ItemOptions{
+				EnableContentResponseOnWrite: true,
+			},
+		)
+		assert.Nil(t, err)
+
+		var created map[string]interface{}
+		json.Unmarshal(response.Value, &created)
+
+		database, _ := repositories.GetDatabase(testDatabaseName)
+		collection, _ := repositories.GetCollection(testDatabaseName, testCollectionName)
+		assert.NotEqual(t, "client-rid", created["_rid"])
+		assert.NotEqual(t, "client-etag", created["_etag"])
+		assert.Greater(t, created["_ts"], float64(1))
+		assert.Equal(t, fmt.Sprintf("dbs/%s/colls/%s/docs/%s/", database.ResourceID, collection.ResourceID, created["_rid"]), created["_self"])
+		assert.Equal(t, "attachments/", created["_attachments"])
+	})
+}
+
+func Test_Documents_PatchOperations(t *testing.T) {
+	ts, collectionClient := documents_InitializeDb(t)
+	defer ts.Close()
+
+	documentPath := fmt.Sprintf("dbs/%s/colls/%s/docs/patch-ops", testDatabaseName, testCollectionName)
+	resetDocument := func() {
+		repositories.DeleteDocument(testDatabaseName, testCollectionName, "patch-ops", nil)
+		repositories.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{
+			"id":      "patch-ops",
+			"pk":      "123",
+			"counter": 1,
+			"tags":    []interface{}{"a", "b"},
+			"nested":  map[string]interface{}{"name": "nested"},
+		})
+	}
+	patchDocument := func(t *testing.T, operations ...map[string]interface{}) (int, map[string]interface{}) {
+		resetDocument()
+		headers := map[string]string{"x-ms-documentdb-partitionkey": `["123"]`}
+		status, _, body := sendSignedRequestWithHeaders(t, ts.URL, documentPath, http.MethodPatch, "docs", documentPath, headers, map[string]interface{}{
+			"operations": operations,
+		})
+		return status, body
+	}
+
+	t.Run("Should add properties and insert array elements", func(t *testing.T) {
+		status, body := patchDocument(t,
+			map[string]interface{}{"op": "add", "path": "/nested/name", "value": "added"},
+			map[string]interface{}{"op": "add", "path": "/tags/0", "value": "first"},
+			map[string]interface{}{"op": "add", "path": "/tags/3", "value": "last"},
+			map[string]interface{}{"op": "add", "path": "/tags/-", "value": "appended"},
+		)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, map[string]interface{}{"name": "added"}, body["nested"])
+		assert.Equal(t, []interface{}{"first", "a", "b", "last", "appended"}, body["tags"])
+
+		status, _ = patchDocument(t, map[string]interface{}{"op": "add", "path": "/missing/name", "value": "added"})
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("Should set values creating missing parents", func(t *testing.T) {
+		status, body := patchDocument(t,
+			map[string]interface{}{"op": "set", "path": "/tags/0", "value": "updated"},
+			map[string]interface{}{"op": "set", "path": "/tags/2", "value": "appended"},
+			map[string]interface{}{"op": "set", "path": "/parent/child/name", "value": "created"},
+		)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, []interface{}{"updated", "b", "appended"}, body["tags"])
+		assert.Equal(t, map[string]interface{}{"child": map[string]interface{}{"name": "created"}}, body["parent"])
+	})
+
+	t.Run("Should replace existing values only", func(t *testing.T) {
+		status, body := patchDocument(t,
+			map[string]interface{}{"op": "replace", "path": "/nested/name", "value": "replaced"},
+			map[string]interface{}{"op": "replace", "path": "/tags/1", "value": "c"},
+		)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, map[string]interface{}{"name": "replaced"}, body["nested"])
+		assert.Equal(t, []interface{}{"a", "c"}, body["tags"])
+
+		status, _ = patchDocument(t, map[string]interface{}{"op": "replace", "path": "/missing", "value": "value"})
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = patchDocument(t, map[string]interface{}{"op": "replace", "path": "/tags/2", "value": "value"})
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("Should remove existing values only", func(t *testing.T) {
+		status, body := patchDocument(t,
+			map[string]interface{}{"op": "remove", "path": "/nested/name"},
+			map[string]interface{}{"op": "remove", "path": "/tags/0"},
+		)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, map[string]interface{}{}, body["nested"])
+		assert.Equal(t, []interface{}{"b"}, body["tags"])
+
+		status, _ = patchDocument(t, map[string]interface{}{"op": "remove", "path": "/missing"})
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("Should increment numbers", func(t *testing.T) {
+		status, body := patchDocument(t,
+			map[string]interface{}{"op": "incr", "path": "/counter", "value": 5},
+			map[string]interface{}{"op": "incr", "path": "/counter", "value": -2},
+			map[string]interface{}{"op": "incr", "path": "/created", "value": 1.5},
+		)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, float64(4), body["counter"])
+		assert.Equal(t, 1.5, body["created"])
+
+		status, _ = patchDocument(t, map[string]interface{}{"op": "incr", "path": "/nested/name", "value": 1})
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = patchDocument(t, map[string]interface{}{"op": "incr", "path": "/counter", "value": "1"})
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("Should move values", func(t *testing.T) {
+		status, body := patchDocument(t,
+			map[string]interface{}{"op": "move", "from": "/nested/name", "path": "/name"},
+			map[string]interface{}{"op": "move", "from": "/tags/1", "path": "/tags/0"},
+		)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "nested", body["name"])
+		assert.Equal(t, map[string]interface{}{}, body["nested"])
+		assert.Equal(t, []interface{}{"b", "a"}, body["tags"])
+
+		status, _ = patchDocument(t, map[string]interface{}{"op": "move", "from": "/missing", "path": "/name"})
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = patchDocument(t, map[string]interface{}{"op": "move", "from": "/nested", "path": "/nested/child"})
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("Should reject unknown operations and invalid paths", func(t *testing.T) {
+		status, _ := patchDocument(t, map[string]interface{}{"op": "copy", "from": "/counter", "path": "/copied"})
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = patchDocument(t, map[string]interface{}{"op": "add", "path": "counter", "value": 1})
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("Should reject more than 10 operations", func(t *testing.T) {
+		operations := make([]map[string]interface{}, 11)
+		for i := range operations {
+			operations[i] = map[string]interface{}{"op": "incr", "path": "/counter", "value": 1}
+		}
+
+		status, body := patchDocument(t, operations...)
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "BadRequest", body["code"])
+		assert.Contains(t, body["message"], "at most 10 operations")
+
+		status, body = patchDocument(t, operations[:10]...)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, float64(11), body["counter"])
+	})
+
+	t.Run("Should name the index of malformed operations", func(t *testing.T) {
+		valid := map[string]interface{}{"op": "add", "path": "/added", "value": 1}
+		for _, testCase := range []struct {
+			operation map[string]interface{}
+			message   string
+		}{
+			{map[string]interface{}{"op": "copy", "path": "/copied"}, "Operation at index 1 has an invalid op 'copy'"},
+			{map[string]interface{}{"path": "/copied", "value": 1}, "Operation at index 1 has an invalid op"},
+			{map[string]interface{}{"op": "add", "value": 1}, "Operation at index 1 is missing the path"},
+			{map[string]interface{}{"op": "set", "path": "/set"}, "Operation at index 1 is missing the value"},
+			{map[string]interface{}{"op": "move", "path": "/moved"}, "Operation at index 1 is missing the from path"},
+			{map[string]interface{}{"op": "remove", "path": "/missing"}, "Operation at index 1 failed"},
+		} {
+			status, body := patchDocument(t, valid, testCase.operation)
+			assert.Equal(t, http.StatusBadRequest, status)
+			assert.Equal(t, "BadRequest", body["code"])
+			assert.Contains(t, body["message"], testCase.message)
+		}
+
+		document, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "patch-ops")
+		assert.NotContains(t, document, "added")
+	})
+
+	t.Run("Should increment through the SDK", func(t *testing.T) {
+		resetDocument()
+
+		patch := azcosmos.PatchOperations{}
+		patch.AppendIncrement("/counter", 2)
+		patch.AppendSet("/tags/1", "set")
+
+		response, err := collectionClient.PatchItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("123"),
+			"patch-ops",
+			patch,
+			&azcosmos.ItemOptions{EnableContentResponseOnWrite: true},
+		)
+		assert.Nil(t, err)
+
+		var patched map[string]interface{}
+		json.Unmarshal(response.Value, &patched)
+		assert.Equal(t, float64(3), patched["counter"])
+		assert.Equal(t, []interface{}{"a", "set"}, patched["tags"])
+	})
+}
+
+func Test_Documents_BadRequests(t *testing.T) {
+	ts, collectionClient := documents_InitializeDb(t)
+	defer ts.Close()
+
+	collectionPath := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, testCollectionName)
+	documentPath := collectionPath + "/docs/12345"
+
+	for _, request := range []struct {
+		name       string
+		path       string
+		method     string
+		resourceId string
+		body       []byte
+	}{
+		{"create", collectionPath + "/docs", http.MethodPost, collectionPath, []byte(`{"id": "malformed"`)},
+		{"query", collectionPath + "/docs", http.MethodPost, collectionPath, []byte(`{"query": 1}`)},
+		{"replace", documentPath, http.MethodPut, documentPath, []byte(`[]`)},
+		{"patch", documentPath, http.MethodPatch, documentPath, []byte(`{"operations": [`)},
+		{"patch operations", documentPath, http.MethodPatch, documentPath, []byte(`{"operations": {}}`)},
+	} {
+		t.Run(fmt.Sprintf("Should return BadRequest error body for malformed %s request", request.name), func(t *testing.T) {
+			headers := map[string]string{"x-ms-documentdb-partitionkey": `["123"]`}
+			status, _, body := sendSignedRequestWithHeaders(t, ts.URL, request.path, request.method, "docs", request.resourceId, headers, request.body)
+			assert.Equal(t, http.StatusBadRequest, status)
+			assert.Equal(t, "BadRequest", body["code"])
+			assert.NotEmpty(t, body["message"])
+		})
+	}
+
+	t.Run("Should return partition key mismatch sub status", func(t *testing.T) {
+		document, _ := json.Marshal(map[string]interface{}{"id": "mismatch", "pk": "123"})
+		_, err := collectionClient.CreateItem(context.TODO(), azcosmos.NewPartitionKeyString("456"), document, nil)
+
+		var respErr *azcore.ResponseError
+		if errors.As(err, &respErr) {
+			assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+			assert.Equal(t, "BadRequest", respErr.ErrorCode)
+			assert.Equal(t, "1001", respErr.RawResponse.Header.Get("x-ms-substatus"))
+		} else {
+			panic(err)
+		}
+
+		_, status := repositories.GetDocument(testDatabaseName, testCollectionName, "mismatch")
+		assert.Equal(t, repositorymodels.StatusNotFound, int(status))
 	})
 
+	t.Run("Should reject invalid document ids", func(t *testing.T) {
+		headers := map[string]string{"x-ms-documentdb-partitionkey": `["123"]`}
+		for _, id := range []interface{}{nil, 123, "", strings.Repeat("a", 256), "a/b", `a\b`, "a?b", "a#b", "trailing "} {
+			document := map[string]interface{}{"pk": "123"}
+			if id != nil {
+				document["id"] = id
+			}
+
+			status, _, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, headers, document)
+			assert.Equal(t, http.StatusBadRequest, status, "create with id %v", id)
+			assert.Equal(t, "BadRequest", body["code"])
+
+			document["id"] = id
+			status, _, _ = sendSignedRequestWithHeaders(t, ts.URL, documentPath, http.MethodPut, "docs", documentPath, headers, document)
+			assert.Equal(t, http.StatusBadRequest, status, "replace with id %v", id)
+		}
+
+		_, repositoryStatus := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		assert.Equal(t, repositorymodels.StatusOk, int(repositoryStatus))
+	})
+}
+
+func Test_Documents_NestedPartitionKey(t *testing.T) {
+	ts, _ := documents_InitializeDb(t)
+	defer ts.Close()
+
+	repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+		ID:           "nested-coll",
+		PartitionKey: repositorymodels.CollectionPartitionKey{Paths: []string{"/tenant/id"}},
+	})
+	defer repositories.DeleteCollection(testDatabaseName, "nested-coll")
+
+	collectionPath := fmt.Sprintf("dbs/%s/colls/nested-coll", testDatabaseName)
+	createDocument := func(t *testing.T, partitionKey string, document map[string]interface{}) (int, http.Header) {
+		headers := map[string]string{"x-ms-documentdb-partitionkey": partitionKey}
+		status, responseHeaders, _ := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, headers, document)
+		return status, responseHeaders
+	}
+
+	t.Run("Should accept the value at the nested path", func(t *testing.T) {
+		status, _ := createDocument(t, `["a"]`, map[string]interface{}{"id": "nested", "tenant": map[string]interface{}{"id": "a"}})
+		assert.Equal(t, http.StatusCreated, status)
+	})
+
+	t.Run("Should reject a value not matching the nested path", func(t *testing.T) {
+		status, headers := createDocument(t, `["b"]`, map[string]interface{}{"id": "nested-mismatch", "tenant": map[string]interface{}{"id": "a"}})
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "1001", headers.Get("x-ms-substatus"))
+
+		status, _ = createDocument(t, `["a"]`, map[string]interface{}{"id": "nested-missing", "tenant": "a"})
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("Should match undefined values with the undefined representation", func(t *testing.T) {
+		status, _ := createDocument(t, `[{}]`, map[string]interface{}{"id": "undefined-object"})
+		assert.Equal(t, http.StatusCreated, status)
+
+		status, _ = createDocument(t, `[]`, map[string]interface{}{"id": "undefined-empty", "tenant": map[string]interface{}{}})
+		assert.Equal(t, http.StatusCreated, status)
+
+		status, _ = createDocument(t, `[]`, map[string]interface{}{"id": "defined-empty", "tenant": map[string]interface{}{"id": "a"}})
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("Should reject documents without a partition key value when it is required", func(t *testing.T) {
+		config.Config.RequirePartitionKey = true
+		defer func() { config.Config.RequirePartitionKey = false }()
+
+		status, headers := createDocument(t, `[{}]`, map[string]interface{}{"id": "required-missing", "tenant": map[string]interface{}{}})
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "", headers.Get("x-ms-substatus"))
+
+		_, repositoryStatus := repositories.GetDocument(testDatabaseName, "nested-coll", "required-missing")
+		assert.Equal(t, repositorymodels.StatusNotFound, int(repositoryStatus))
+
+		_, repositoryStatus = repositories.CreateDocument(testDatabaseName, "nested-coll", map[string]interface{}{"id": "required-missing"})
+		assert.Equal(t, repositorymodels.MissingPartitionKey, int(repositoryStatus))
+
+		_, repositoryStatus = repositories.CreateDocument(testDatabaseName, "nested-coll", map[string]interface{}{"id": "required-null", "tenant": map[string]interface{}{"id": nil}})
+		assert.Equal(t, repositorymodels.StatusOk, int(repositoryStatus))
+
+		_, repositoryStatus, _ = repositories.ReplaceDocument(testDatabaseName, "nested-coll", "nested", map[string]interface{}{"id": "nested"}, nil)
+		assert.Equal(t, repositorymodels.MissingPartitionKey, int(repositoryStatus))
+	})
+}
+
+func Test_Documents_PartitionKeyScope(t *testing.T) {
+	ts, collectionClient := documents_InitializeDb(t)
+	defer ts.Close()
+
+	wrongPartitionKey := azcosmos.NewPartitionKeyString("456")
+	assertStatusCode := func(t *testing.T, expectedStatus int, err error) {
+		var respErr *azcore.ResponseError
+		if assert.True(t, errors.As(err, &respErr)) {
+			assert.Equal(t, expectedStatus, respErr.StatusCode)
+		}
+	}
+
+	t.Run("Should not find documents of other partitions", func(t *testing.T) {
+		_, err := collectionClient.ReadItem(context.TODO(), wrongPartitionKey, "12345", nil)
+		assertStatusCode(t, http.StatusNotFound, err)
+
+		item, _ := json.Marshal(map[string]interface{}{"id": "12345", "pk": "456"})
+		_, err = collectionClient.ReplaceItem(context.TODO(), wrongPartitionKey, "12345", item, nil)
+		assertStatusCode(t, http.StatusNotFound, err)
+
+		patch := azcosmos.PatchOperations{}
+		patch.AppendSet("/patched", true)
+		_, err = collectionClient.PatchItem(context.TODO(), wrongPartitionKey, "12345", patch, nil)
+		assertStatusCode(t, http.StatusNotFound, err)
+
+		_, err = collectionClient.DeleteItem(context.TODO(), wrongPartitionKey, "12345", nil)
+		assertStatusCode(t, http.StatusNotFound, err)
+
+		document, status := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+		assert.Equal(t, "123", document["pk"])
+		assert.Nil(t, document["patched"])
+	})
+
+	t.Run("Should require the partition key header", func(t *testing.T) {
+		documentPath := fmt.Sprintf("dbs/%s/colls/%s/docs/12345", testDatabaseName, testCollectionName)
+		for _, method := range []string{http.MethodGet, http.MethodDelete} {
+			status, body := sendSignedRequest(t, ts.URL, documentPath, method, "docs", documentPath, nil)
+			assert.Equal(t, http.StatusBadRequest, status)
+			assert.Equal(t, "BadRequest", body["code"])
+		}
+	})
+
+	t.Run("Should not allow patching the partition key", func(t *testing.T) {
+		patch := azcosmos.PatchOperations{}
+		patch.AppendReplace("/pk", "456")
+		_, err := collectionClient.PatchItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "12345", patch, nil)
+		assertStatusCode(t, http.StatusBadRequest, err)
+	})
+
+	t.Run("Should address documents without a partition key value with an empty key", func(t *testing.T) {
+		repositories.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{"id": "no-pk"})
+
+		_, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "no-pk", nil)
+		assertStatusCode(t, http.StatusNotFound, err)
+
+		response, err := collectionClient.ReadItem(context.TODO(), azcosmos.PartitionKey{}, "no-pk", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, response.RawResponse.StatusCode)
+
+		_, err = collectionClient.DeleteItem(context.TODO(), azcosmos.PartitionKey{}, "no-pk", nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should delete documents of the given partition", func(t *testing.T) {
+		response, err := collectionClient.DeleteItem(context.TODO(), azcosmos.NewPartitionKeyString("456"), "67890", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, response.RawResponse.StatusCode)
+	})
+}
+
+func Test_Documents_TransactionalBatch(t *testing.T) {
+	ts, collectionClient := documents_InitializeDb(t)
+	defer ts.Close()
+
+	seedBatchDocuments := func() {
+		for _, id := range []string{"batch-read", "batch-delete", "batch-replace", "batch-upsert", "batch-patch", "batch-new", "batch-upsert-new"} {
+			repositories.DeleteDocument(testDatabaseName, testCollectionName, id, nil)
+		}
+
+		for _, id := range []string{"batch-read", "batch-delete", "batch-replace", "batch-upsert", "batch-patch"} {
+			repositories.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{"id": id, "pk": "batch", "value": "old"})
+		}
+	}
+
+	marshal := func(item map[string]interface{}) []byte {
+		bytes, err := json.Marshal(item)
+		assert.Nil(t, err)
+		return bytes
+	}
+
+	executeBatch := func(batch azcosmos.TransactionalBatch) azcosmos.TransactionalBatchResponse {
+		response, err := collectionClient.ExecuteTransactionalBatch(context.TODO(), batch, nil)
+		assert.Nil(t, err)
+		return response
+	}
+
+	t.Run("Should execute batch operations", func(t *testing.T) {
+		seedBatchDocuments()
+		batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("batch"))
+
+		patch := azcosmos.PatchOperations{}
+		patch.AppendReplace("/value", "patched")
+
+		batch.CreateItem(marshal(map[string]interface{}{"id": "batch-new", "pk": "batch"}), nil)
+		batch.ReadItem("batch-read", nil)
+		batch.DeleteItem("batch-delete", nil)
+		batch.ReplaceItem("batch-replace", marshal(map[string]interface{}{"id": "batch-replace", "pk": "batch", "value": "replaced"}), nil)
+		batch.UpsertItem(marshal(map[string]interface{}{"id": "batch-upsert", "pk": "batch", "value": "upserted"}), nil)
+		batch.UpsertItem(marshal(map[string]interface{}{"id": "batch-upsert-new", "pk": "batch"}), nil)
+		batch.PatchItem("batch-patch", patch, nil)
+
+		response := executeBatch(batch)
+		assert.True(t, response.Success)
+		assert.Len(t, response.OperationResults, 7)
+
+		expectedStatuses := []int{
+			http.StatusCreated,
+			http.StatusOK,
+			http.StatusNoContent,
+			http.StatusOK,
+			http.StatusOK,
+			http.StatusCreated,
+			http.StatusOK,
+		}
+		for i, expectedStatus := range expectedStatuses {
+			assert.Equal(t, int32(expectedStatus), response.OperationResults[i].StatusCode, "operation %d", i)
+		}
+
+		var readItem map[string]interface{}
+		json.Unmarshal(response.OperationResults[1].ResourceBody, &readItem)
+		assert.Equal(t, "batch-read", readItem["id"])
+
+		_, status := repositories.GetDocument(testDatabaseName, testCollectionName, "batch-new")
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+		_, status = repositories.GetDocument(testDatabaseName, testCollectionName, "batch-delete")
+		assert.Equal(t, repositorymodels.StatusNotFound, int(status))
+
+		for id, expectedValue := range map[string]string{"batch-replace": "replaced", "batch-upsert": "upserted", "batch-patch": "patched"} {
+			document, status := repositories.GetDocument(testDatabaseName, testCollectionName, id)
+			assert.Equal(t, repositorymodels.StatusOk, int(status))
+			assert.Equal(t, expectedValue, document["value"])
+		}
+	})
+
+	t.Run("Should upsert like single upserts", func(t *testing.T) {
+		seedBatchDocuments()
+		existingDocument, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "batch-upsert")
+		existingETag := azcore.ETag(existingDocument["_etag"].(string))
+		anyETag := azcore.ETag("*")
+		staleETag := azcore.ETag("\"stale\"")
+
+		batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("batch"))
+		batch.UpsertItem(marshal(map[string]interface{}{"id": "batch-upsert", "pk": "batch", "value": "first"}), &azcosmos.TransactionalBatchItemOptions{IfMatchETag: &existingETag})
+		batch.UpsertItem(marshal(map[string]interface{}{"id": "batch-upsert", "pk": "batch", "value": "second"}), &azcosmos.TransactionalBatchItemOptions{IfMatchETag: &anyETag})
+		// The etag is only checked when the upsert replaces a document
+		batch.UpsertItem(marshal(map[string]interface{}{"id": "batch-upsert-etag", "pk": "batch"}), &azcosmos.TransactionalBatchItemOptions{IfMatchETag: &staleETag})
+
+		response := executeBatch(batch)
+		assert.True(t, response.Success)
+		expectedStatuses := []int{http.StatusOK, http.StatusOK, http.StatusCreated}
+		for i, expectedStatus := range expectedStatuses {
+			assert.Equal(t, int32(expectedStatus), response.OperationResults[i].StatusCode, "operation %d", i)
+		}
+
+		upsertedDocument, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "batch-upsert")
+		assert.Equal(t, "second", upsertedDocument["value"])
+		assert.Equal(t, existingDocument["_rid"], upsertedDocument["_rid"])
+		assert.Equal(t, existingDocument["_self"], upsertedDocument["_self"])
+		assert.NotEqual(t, existingDocument["_etag"], upsertedDocument["_etag"])
+		assert.Equal(t, string(response.OperationResults[1].ETag), upsertedDocument["_etag"])
+
+		batch = collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("batch"))
+		batch.UpsertItem(marshal(map[string]interface{}{"id": "batch-upsert", "pk": "batch", "value": "stale"}), &azcosmos.TransactionalBatchItemOptions{IfMatchETag: &existingETag})
+		response = executeBatch(batch)
+		assert.False(t, response.Success)
+		assert.Equal(t, int32(http.StatusPreconditionFailed), response.OperationResults[0].StatusCode)
+	})
+
+	t.Run("Should reject replace with mismatched id", func(t *testing.T) {
+		seedBatchDocuments()
+		batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("batch"))
+		batch.ReplaceItem("batch-replace", marshal(map[string]interface{}{"id": "other-id", "pk": "batch"}), nil)
+
+		response := executeBatch(batch)
+		assert.False(t, response.Success)
+		assert.Equal(t, int32(http.StatusBadRequest), response.OperationResults[0].StatusCode)
+
+		document, status := repositories.GetDocument(testDatabaseName, testCollectionName, "batch-replace")
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+		assert.Equal(t, "old", document["value"])
+		_, status = repositories.GetDocument(testDatabaseName, testCollectionName, "other-id")
+		assert.Equal(t, repositorymodels.StatusNotFound, int(status))
+	})
+
+	t.Run("Should reject operations outside of the batch partition", func(t *testing.T) {
+		seedBatchDocuments()
+		batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("batch"))
+		batch.CreateItem(marshal(map[string]interface{}{"id": "batch-new", "pk": "other"}), nil)
+
+		response := executeBatch(batch)
+		assert.False(t, response.Success)
+		assert.Equal(t, int32(http.StatusBadRequest), response.OperationResults[0].StatusCode)
+
+		batch = collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("batch"))
+		batch.ReadItem("12345", nil)
+
+		response = executeBatch(batch)
+		assert.False(t, response.Success)
+		assert.Equal(t, int32(http.StatusNotFound), response.OperationResults[0].StatusCode)
+	})
+
+	t.Run("Should fail operation when ifMatch does not match", func(t *testing.T) {
+		seedBatchDocuments()
+		staleEtag := azcore.ETag("\"stale\"")
+		batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("batch"))
+		batch.DeleteItem("batch-delete", &azcosmos.TransactionalBatchItemOptions{IfMatchETag: &staleEtag})
+
+		response := executeBatch(batch)
+		assert.False(t, response.Success)
+		assert.Equal(t, int32(http.StatusPreconditionFailed), response.OperationResults[0].StatusCode)
+
+		_, status := repositories.GetDocument(testDatabaseName, testCollectionName, "batch-delete")
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+	})
+
+	t.Run("Should roll back batch when an operation fails", func(t *testing.T) {
+		seedBatchDocuments()
+		batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("batch"))
+		batch.CreateItem(marshal(map[string]interface{}{"id": "batch-new", "pk": "batch"}), nil)
+		batch.DeleteItem("batch-delete", nil)
+		batch.DeleteItem("does-not-exist", nil)
+
+		response := executeBatch(batch)
+		assert.False(t, response.Success)
+		assert.Len(t, response.OperationResults, 3)
+		assert.Equal(t, int32(http.StatusFailedDependency), response.OperationResults[0].StatusCode)
+		assert.Equal(t, int32(http.StatusFailedDependency), response.OperationResults[1].StatusCode)
+		assert.Equal(t, int32(http.StatusNotFound), response.OperationResults[2].StatusCode)
+
+		_, status := repositories.GetDocument(testDatabaseName, testCollectionName, "batch-new")
+		assert.Equal(t, repositorymodels.StatusNotFound, int(status))
+		_, status = repositories.GetDocument(testDatabaseName, testCollectionName, "batch-delete")
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+	})
+
+	t.Run("Should keep concurrent writes when a batch is rolled back", func(t *testing.T) {
+		seedBatchDocuments()
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < 20; i++ {
+				batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("batch"))
+				batch.UpsertItem(marshal(map[string]interface{}{"id": "batch-rolled-back", "pk": "batch"}), nil)
+				batch.DeleteItem("does-not-exist", nil)
+				executeBatch(batch)
+			}
+		}()
+
+		for i := 0; i < 20; i++ {
+			repositories.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{"id": fmt.Sprintf("batch-concurrent-%d", i), "pk": "batch"})
+		}
+		<-done
+
+		for i := 0; i < 20; i++ {
+			_, status := repositories.GetDocument(testDatabaseName, testCollectionName, fmt.Sprintf("batch-concurrent-%d", i))
+			assert.Equal(t, repositorymodels.StatusOk, int(status))
+		}
+		_, status := repositories.GetDocument(testDatabaseName, testCollectionName, "batch-rolled-back")
+		assert.Equal(t, repositorymodels.StatusNotFound, int(status))
+	})
+}
+
+func Test_Documents_QueryStringLiterals(t *testing.T) {
+	ts, collectionClient := documents_InitializeDb(t)
+	defer ts.Close()
+
+	names := map[string]string{
+		"literal-quote":     "O'Brien",
+		"literal-dquote":    `say "hi"`,
+		"literal-backslash": `C:\temp\file`,
+		"literal-unicode":   "café ☕ 😀",
+		"literal-newline":   "line1\nline2",
+	}
+	for id, name := range names {
+		repositories.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{"id": id, "pk": "literals", "name": name})
+		defer repositories.DeleteDocument(testDatabaseName, testCollectionName, id, nil)
+	}
+
+	for _, testCase := range []struct {
+		literal    string
+		expectedId string
+	}{
+		{`'O''Brien'`, "literal-quote"},
+		{`'O\'Brien'`, "literal-quote"},
+		{`"O'Brien"`, "literal-quote"},
+		{`"say \"hi\""`, "literal-dquote"},
+		{`'say "hi"'`, "literal-dquote"},
+		{`"C:\\temp\\file"`, "literal-backslash"},
+		{`'C:\\temp\\file'`, "literal-backslash"},
+		{`"caf\u00e9 \u2615 \ud83d\ude00"`, "literal-unicode"},
+		{`"café ☕ 😀"`, "literal-unicode"},
+		{`"line1\nline2"`, "literal-newline"},
+	} {
+		t.Run("Should match string literal "+testCase.literal, func(t *testing.T) {
+			testCosmosQuery(t, collectionClient,
+				fmt.Sprintf("SELECT c.id FROM c WHERE c.name = %s", testCase.literal),
+				nil,
+				[]interface{}{
+					map[string]interface{}{"id": testCase.expectedId},
+				},
+			)
+		})
+	}
+}
+
+func Test_Documents_OrderByContinuation(t *testing.T) {
+	ts, collectionClient := documents_InitializeDb(t)
+	defer ts.Close()
+	defer repositories.DeleteDatabase(testDatabaseName)
+
+	createDocument := func(id string, value int) {
+		repositories.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{"id": id, "pk": "paging", "value": float64(value)})
+	}
+	for id, value := range map[string]int{"a": 1, "b": 2, "c": 2, "d": 3, "e": 4} {
+		createDocument(id, value)
+	}
+
+	readPage := func(pager *runtime.Pager[azcosmos.QueryItemsResponse]) []string {
+		response, err := pager.NextPage(context.TODO())
+		if !assert.Nil(t, err) {
+			t.FailNow()
+		}
+
+		ids := make([]string, 0)
+		for _, item := range response.Items {
+			var document map[string]interface{}
+			json.Unmarshal(item, &document)
+			ids = append(ids, document["id"].(string))
+		}
+		return ids
+	}
+
+	t.Run("Should not skip or duplicate rows when documents change between pages", func(t *testing.T) {
+		pager := collectionClient.NewQueryItemsPager(
+			"SELECT c.id FROM c WHERE c.pk = 'paging' ORDER BY c.value",
+			azcosmos.PartitionKey{},
+			&azcosmos.QueryOptions{PageSizeHint: 2})
+
+		assert.Equal(t, []string{"a", "b"}, readPage(pager))
+
+		// Sorts before the last returned row, after it on the id tie-break and a row not yet returned
+		createDocument("0", 0)
+		createDocument("bb", 2)
+		repositories.DeleteDocument(testDatabaseName, testCollectionName, "c", nil)
+
+		assert.Equal(t, []string{"bb", "d"}, readPage(pager))
+		assert.True(t, pager.More())
+		assert.Equal(t, []string{"e"}, readPage(pager))
+		assert.False(t, pager.More())
+	})
+
+	t.Run("Should resume descending queries after the last sort key", func(t *testing.T) {
+		pager := collectionClient.NewQueryItemsPager(
+			"SELECT VALUE c.id FROM c WHERE c.pk = 'paging' ORDER BY c.value DESC",
+			azcosmos.PartitionKey{},
+			&azcosmos.QueryOptions{PageSizeHint: 2})
+
+		response, err := pager.NextPage(context.TODO())
+		assert.Nil(t, err)
+		assert.Len(t, response.Items, 2)
+		assert.Equal(t, "\"e\"", string(response.Items[0]))
+		assert.Equal(t, "\"d\"", string(response.Items[1]))
+
+		repositories.DeleteDocument(testDatabaseName, testCollectionName, "d", nil)
+		createDocument("f", 5)
+
+		response, err = pager.NextPage(context.TODO())
+		assert.Nil(t, err)
+		assert.Len(t, response.Items, 2)
+		assert.Equal(t, "\"b\"", string(response.Items[0]))
+		assert.Equal(t, "\"bb\"", string(response.Items[1]))
+	})
+}
+
+func Test_Documents_OptimisticConcurrency(t *testing.T) {
+	ts, collectionClient := documents_InitializeDb(t)
+	defer ts.Close()
+
+	staleETag := azcore.ETag("\"stale\"")
+	assertPreconditionFailed := func(t *testing.T, err error) {
+		var respErr *azcore.ResponseError
+		if errors.As(err, &respErr) {
+			assert.Equal(t, http.StatusPreconditionFailed, respErr.StatusCode)
+			assert.Equal(t, "PreconditionFailed", respErr.ErrorCode)
+		} else {
+			panic(err)
+		}
+	}
+
+	t.Run("Should return the etag header on read", func(t *testing.T) {
+		response, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "12345", nil)
+		assert.Nil(t, err)
+
+		document, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		assert.Equal(t, document["_etag"], string(response.ETag))
+	})
+
+	t.Run("Should return the quoted etag header on writes", func(t *testing.T) {
+		assertETagHeader := func(t *testing.T, response azcosmos.ItemResponse, err error) {
+			if assert.Nil(t, err) {
+				var document map[string]interface{}
+				json.Unmarshal(response.Value, &document)
+				assert.Equal(t, document["_etag"], string(response.ETag))
+				assert.Regexp(t, `^"[0-9a-f-]{36}"$`, string(response.ETag))
+			}
+		}
+
+		pk := azcosmos.NewPartitionKeyString("123")
+		contentResponse := &azcosmos.ItemOptions{EnableContentResponseOnWrite: true}
+
+		response, err := collectionClient.CreateItem(context.TODO(), pk, []byte(`{"id": "etag-header", "pk": "123"}`), contentResponse)
+		assertETagHeader(t, response, err)
+
+		response, err = collectionClient.ReplaceItem(context.TODO(), pk, "etag-header", []byte(`{"id": "etag-header", "pk": "123", "replaced": true}`), contentResponse)
+		assertETagHeader(t, response, err)
+
+		response, err = collectionClient.UpsertItem(context.TODO(), pk, []byte(`{"id": "etag-header", "pk": "123", "upserted": true}`), contentResponse)
+		assertETagHeader(t, response, err)
+
+		response, err = collectionClient.UpsertItem(context.TODO(), pk, []byte(`{"id": "etag-header-new", "pk": "123"}`), contentResponse)
+		assertETagHeader(t, response, err)
+
+		patch := azcosmos.PatchOperations{}
+		patch.AppendSet("/patched", true)
+		response, err = collectionClient.PatchItem(context.TODO(), pk, "etag-header", patch, contentResponse)
+		assertETagHeader(t, response, err)
+	})
+
+	t.Run("Should return 304 on read when etag matches If-None-Match", func(t *testing.T) {
+		document, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		documentPath := fmt.Sprintf("dbs/%s/colls/%s/docs/12345", testDatabaseName, testCollectionName)
+
+		// The SDK has no If-None-Match option for point reads, so the requests are signed manually
+		readWithIfNoneMatch := func(etag string) (*http.Response, []byte) {
+			date := time.Now().Format(time.RFC1123)
+			signature := authentication.GenerateSignature(http.MethodGet, "docs", documentPath, date, config.Config.AccountKey)
+			req, _ := http.NewRequest(http.MethodGet, ts.URL+"/"+documentPath, nil)
+			req.Header.Add("x-ms-date", date)
+			req.Header.Add("authorization", "sig="+url.QueryEscape(signature))
+			req.Header.Add("If-None-Match", etag)
+			req.Header.Add("x-ms-documentdb-partitionkey", `["123"]`)
+
+			res, err := http.DefaultClient.Do(req)
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			return res, body
+		}
+
+		res, body := readWithIfNoneMatch(document["_etag"].(string))
+		assert.Equal(t, http.StatusNotModified, res.StatusCode)
+		assert.Equal(t, document["_etag"], res.Header.Get("etag"))
+		assert.Empty(t, body)
+
+		res, body = readWithIfNoneMatch(string(staleETag))
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Contains(t, string(body), "12345")
+	})
+
+	t.Run("Should replace document when etag matches", func(t *testing.T) {
+		readResponse, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "12345", nil)
+		assert.Nil(t, err)
+
+		item, _ := json.Marshal(map[string]interface{}{"id": "12345", "pk": "123", "isCool": true})
+		response, err := collectionClient.ReplaceItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("123"),
+			"12345",
+			item,
+			&azcosmos.ItemOptions{IfMatchEtag: &readResponse.ETag},
+		)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, response.ETag)
+		assert.NotEqual(t, readResponse.ETag, response.ETag)
+
+		// The etag read before the replace is stale now
+		_, err = collectionClient.ReplaceItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("123"),
+			"12345",
+			item,
+			&azcosmos.ItemOptions{IfMatchEtag: &readResponse.ETag},
+		)
+		assertPreconditionFailed(t, err)
+	})
+
+	t.Run("Should reject patch with stale etag", func(t *testing.T) {
+		patch := azcosmos.PatchOperations{}
+		patch.AppendAdd("/newField", "newValue")
+
+		_, err := collectionClient.PatchItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("456"),
+			"67890",
+			patch,
+			&azcosmos.ItemOptions{IfMatchEtag: &staleETag},
+		)
+		assertPreconditionFailed(t, err)
+
+		document, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "67890")
+		assert.NotContains(t, document, "newField")
+	})
+
+	t.Run("Should accept only one of concurrent replaces with the same etag", func(t *testing.T) {
+		document, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		currentETag := azcore.ETag(document["_etag"].(string))
+
+		errs := make([]error, 8)
+		var wg sync.WaitGroup
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				item, _ := json.Marshal(map[string]interface{}{"id": "12345", "pk": "123", "writer": i})
+				_, errs[i] = collectionClient.ReplaceItem(
+					context.TODO(),
+					azcosmos.NewPartitionKeyString("123"),
+					"12345",
+					item,
+					&azcosmos.ItemOptions{IfMatchEtag: &currentETag},
+				)
+			}(i)
+		}
+		wg.Wait()
+
+		succeeded := 0
+		for _, err := range errs {
+			if err == nil {
+				succeeded++
+			} else {
+				assertPreconditionFailed(t, err)
+			}
+		}
+		assert.Equal(t, 1, succeeded)
+	})
+
+	t.Run("Should reject upsert of existing document with stale etag", func(t *testing.T) {
+		existingDocument, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "67890")
+
+		item, _ := json.Marshal(map[string]interface{}{"id": "67890", "pk": "456", "upserted": true})
+		_, err := collectionClient.UpsertItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("456"),
+			item,
+			&azcosmos.ItemOptions{IfMatchEtag: &staleETag},
+		)
+		assertPreconditionFailed(t, err)
+
+		document, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "67890")
+		assert.Equal(t, existingDocument["_etag"], document["_etag"])
+		assert.NotContains(t, document, "upserted")
+	})
+
+	t.Run("Should delete document only when etag matches", func(t *testing.T) {
+		_, err := collectionClient.DeleteItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("456"),
+			"67890",
+			&azcosmos.ItemOptions{IfMatchEtag: &staleETag},
+		)
+		assertPreconditionFailed(t, err)
+
+		document, status := repositories.GetDocument(testDatabaseName, testCollectionName, "67890")
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+
+		currentETag := azcore.ETag(document["_etag"].(string))
+		_, err = collectionClient.DeleteItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("456"),
+			"67890",
+			&azcosmos.ItemOptions{IfMatchEtag: &currentETag},
+		)
+		assert.Nil(t, err)
+
+		_, status = repositories.GetDocument(testDatabaseName, testCollectionName, "67890")
+		assert.Equal(t, repositorymodels.StatusNotFound, int(status))
+	})
+
+	t.Run("Should not delete a document replaced concurrently with the same etag", func(t *testing.T) {
+		document, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		currentETag := azcore.ETag(document["_etag"].(string))
+		pk := azcosmos.NewPartitionKeyString("123")
+
+		var replaceErr, deleteErr error
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			item, _ := json.Marshal(map[string]interface{}{"id": "12345", "pk": "123", "racing": true})
+			_, replaceErr = collectionClient.ReplaceItem(context.TODO(), pk, "12345", item, &azcosmos.ItemOptions{IfMatchEtag: &currentETag})
+		}()
+		go func() {
+			defer wg.Done()
+			_, deleteErr = collectionClient.DeleteItem(context.TODO(), pk, "12345", &azcosmos.ItemOptions{IfMatchEtag: &currentETag})
+		}()
+		wg.Wait()
+
+		// Exactly one of the writes sees the etag it was sent with
+		_, status := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		if replaceErr == nil {
+			assertPreconditionFailed(t, deleteErr)
+			assert.Equal(t, repositorymodels.StatusOk, int(status))
+		} else {
+			// The replace doesn't find the deleted document
+			assert.Nil(t, deleteErr)
+			var respErr *azcore.ResponseError
+			if assert.True(t, errors.As(replaceErr, &respErr)) {
+				assert.Equal(t, http.StatusNotFound, respErr.StatusCode)
+			}
+			assert.Equal(t, repositorymodels.StatusNotFound, int(status))
+		}
+	})
+}
+
+func Test_Documents_MaxDocumentSize(t *testing.T) {
+	ts, _ := documents_InitializeDb(t)
+	defer ts.Close()
+
+	config.Config.MaxDocumentSize = 1024
+	defer func() { config.Config.MaxDocumentSize = 0 }()
+
+	collectionPath := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, testCollectionName)
+	documentPath := collectionPath + "/docs/12345"
+	headers := map[string]string{"x-ms-documentdb-partitionkey": `["123"]`}
+	largeValue := strings.Repeat("a", 1024)
+
+	t.Run("Should reject oversized documents on create", func(t *testing.T) {
+		document := map[string]interface{}{"id": "large", "pk": "123", "value": largeValue}
+		status, _, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, headers, document)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, status)
+		assert.Equal(t, "RequestEntityTooLarge", body["code"])
+
+		_, repositoryStatus := repositories.GetDocument(testDatabaseName, testCollectionName, "large")
+		assert.Equal(t, repositorymodels.StatusNotFound, int(repositoryStatus))
+	})
+
+	t.Run("Should reject oversized documents on upsert", func(t *testing.T) {
+		upsertHeaders := map[string]string{"x-ms-documentdb-partitionkey": `["123"]`, "x-ms-documentdb-is-upsert": "true"}
+		document := map[string]interface{}{"id": "12345", "pk": "123", "value": largeValue}
+		status, _, _ := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, upsertHeaders, document)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, status)
+	})
+
+	t.Run("Should reject oversized documents on replace and patch", func(t *testing.T) {
+		document := map[string]interface{}{"id": "12345", "pk": "123", "value": largeValue}
+		status, _, _ := sendSignedRequestWithHeaders(t, ts.URL, documentPath, http.MethodPut, "docs", documentPath, headers, document)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, status)
+
+		operations := map[string]interface{}{"operations": []map[string]interface{}{{"op": "add", "path": "/value", "value": largeValue}}}
+		status, _, body := sendSignedRequestWithHeaders(t, ts.URL, documentPath, http.MethodPatch, "docs", documentPath, headers, operations)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, status)
+		assert.Equal(t, "RequestEntityTooLarge", body["code"])
+
+		storedDocument, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		assert.Nil(t, storedDocument["value"])
+	})
+
+	t.Run("Should accept documents within the limit", func(t *testing.T) {
+		document := map[string]interface{}{"id": "small", "pk": "123", "value": "a"}
+		status, _, _ := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, headers, document)
+		assert.Equal(t, http.StatusCreated, status)
+	})
+}
+
+func Test_Documents_ReadFeed(t *testing.T) {
+	ts, _ := documents_InitializeDb(t)
+	defer ts.Close()
+
+	for _, id := range []string{"feed-c", "feed-a", "feed-b"} {
+		repositories.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{"id": id, "pk": "123"})
+	}
+
+	collectionPath := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, testCollectionName)
+
+	allDocuments, _ := repositories.GetAllDocuments(testDatabaseName, testCollectionName)
+	expectedIds := make([]string, 0)
+	for _, document := range allDocuments {
+		expectedIds = append(expectedIds, document["id"].(string))
+	}
+
+	t.Run("Should page documents with continuation tokens", func(t *testing.T) {
+		ids := make([]string, 0)
+		continuation := ""
+		for {
+			headers := map[string]string{"x-ms-max-item-count": "2"}
+			if continuation != "" {
+				headers["x-ms-continuation"] = continuation
+			}
+
+			status, responseHeaders, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodGet, "docs", collectionPath, headers, nil)
+			assert.Equal(t, http.StatusOK, status)
+
+			documents := body["Documents"].([]interface{})
+			assert.LessOrEqual(t, len(documents), 2)
+			assert.Equal(t, float64(len(documents)), body["_count"])
+			assert.Equal(t, fmt.Sprint(len(documents)), responseHeaders.Get("x-ms-item-count"))
+
+			for _, document := range documents {
+				ids = append(ids, document.(map[string]interface{})["id"].(string))
+			}
+
+			if continuation = responseHeaders.Get("x-ms-continuation"); continuation == "" {
+				break
+			}
+		}
+
+		assert.Subset(t, ids, []string{"feed-a", "feed-b", "feed-c"})
+		assert.Equal(t, expectedIds, ids)
+	})
+
+	t.Run("Should return all documents without a max item count", func(t *testing.T) {
+		status, responseHeaders, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodGet, "docs", collectionPath, nil, nil)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Len(t, body["Documents"], len(expectedIds))
+		assert.Empty(t, responseHeaders.Get("x-ms-continuation"))
+	})
+
+	t.Run("Should reject invalid continuation tokens", func(t *testing.T) {
+		headers := map[string]string{"x-ms-continuation": "invalid"}
+		status, _, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodGet, "docs", collectionPath, headers, nil)
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "BadRequest", body["code"])
+	})
+
+	t.Run("Should page the documents of a partition key", func(t *testing.T) {
+		for _, id := range []string{"feed-pk-e", "feed-pk-d", "feed-pk-f"} {
+			repositories.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{"id": id, "pk": "feed-pk"})
+		}
+
+		ids := make([]string, 0)
+		continuation := ""
+		for {
+			headers := map[string]string{"x-ms-max-item-count": "2", "x-ms-documentdb-partitionkey": `["feed-pk"]`}
+			if continuation != "" {
+				headers["x-ms-continuation"] = continuation
+			}
+
+			status, responseHeaders, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodGet, "docs", collectionPath, headers, nil)
+			assert.Equal(t, http.StatusOK, status)
+			assert.LessOrEqual(t, len(body["Documents"].([]interface{})), 2)
+
+			for _, document := range body["Documents"].([]interface{}) {
+				ids = append(ids, document.(map[string]interface{})["id"].(string))
+			}
+
+			if continuation = responseHeaders.Get("x-ms-continuation"); continuation == "" {
+				break
+			}
+		}
+
+		assert.Equal(t, []string{"feed-pk-d", "feed-pk-e", "feed-pk-f"}, ids)
+	})
+}
+
+func Test_Documents_DeletePartitionKey(t *testing.T) {
+	ts, _ := documents_InitializeDb(t)
+	defer ts.Close()
+
+	repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+		ID:           "pk-delete-coll",
+		PartitionKey: repositorymodels.CollectionPartitionKey{Paths: []string{"/pk"}},
+	})
+	defer repositories.DeleteCollection(testDatabaseName, "pk-delete-coll")
+
+	for _, document := range []map[string]interface{}{
+		{"id": "a-1", "pk": "a"},
+		{"id": "a-2", "pk": "a"},
+		{"id": "b-1", "pk": "b"},
+	} {
+		repositories.CreateDocument(testDatabaseName, "pk-delete-coll", document)
+	}
+
+	collectionPath := fmt.Sprintf("dbs/%s/colls/pk-delete-coll", testDatabaseName)
+	deletePath := collectionPath + "/operations/partitionkeydelete"
+
+	t.Run("Should delete all documents of the partition", func(t *testing.T) {
+		status, responseHeaders, _ := sendSignedRequestWithHeaders(t, ts.URL, deletePath, http.MethodPost, "partitionkey", collectionPath, map[string]string{
+			"x-ms-documentdb-partitionkey": `["a"]`,
+		}, nil)
+		assert.Equal(t, http.StatusOK, status)
+		assert.NotEmpty(t, responseHeaders.Get("x-ms-session-token"))
+
+		documents, _ := repositories.GetAllDocuments(testDatabaseName, "pk-delete-coll")
+		assert.Len(t, documents, 1)
+		assert.Equal(t, "b-1", documents[0]["id"])
+	})
+
+	t.Run("Should require the partition key header", func(t *testing.T) {
+		status, _, body := sendSignedRequestWithHeaders(t, ts.URL, deletePath, http.MethodPost, "partitionkey", collectionPath, nil, nil)
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "BadRequest", body["code"])
+	})
+
+	t.Run("Should return not found for missing collections", func(t *testing.T) {
+		missingPath := fmt.Sprintf("dbs/%s/colls/missing", testDatabaseName)
+		status, _, _ := sendSignedRequestWithHeaders(t, ts.URL, missingPath+"/operations/partitionkeydelete", http.MethodPost, "partitionkey", missingPath, map[string]string{
+			"x-ms-documentdb-partitionkey": `["a"]`,
+		}, nil)
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+}
+
+func Test_Documents_Serialization(t *testing.T) {
+	ts, collectionClient := documents_InitializeDb(t)
+	defer ts.Close()
+
+	// The SDK decodes query results into floats, so the response is read as is
+	queryRaw := func(t *testing.T, query string) string {
+		collectionPath := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, testCollectionName)
+		date := time.Now().Format(time.RFC1123)
+		signature := authentication.GenerateSignature(http.MethodPost, "docs", collectionPath, date, config.Config.AccountKey)
+		queryBody, _ := json.Marshal(map[string]interface{}{"query": query})
+		req, _ := http.NewRequest(http.MethodPost, ts.URL+"/"+collectionPath+"/docs", bytes.NewReader(queryBody))
+		req.Header.Add("x-ms-date", date)
+		req.Header.Add("authorization", "sig="+url.QueryEscape(signature))
+		req.Header.Add("Content-Type", "application/query+json")
+
+		res, err := http.DefaultClient.Do(req)
+		if !assert.Nil(t, err) {
+			return ""
+		}
+		defer res.Body.Close()
+		body, _ := io.ReadAll(res.Body)
+		return string(body)
+	}
+
+	t.Run("Should keep the precision of large integers", func(t *testing.T) {
+		_, err := collectionClient.CreateItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("123"),
+			[]byte(`{"id": "large-integer", "pk": "123", "big": 9007199254740993, "small": 1, "float": 1.5}`),
+			nil,
+		)
+		assert.Nil(t, err)
+
+		readResponse, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "large-integer", nil)
+		assert.Nil(t, err)
+		assert.Contains(t, string(readResponse.Value), `"big":9007199254740993`)
+		assert.Contains(t, string(readResponse.Value), `"small":1`)
+		assert.Contains(t, string(readResponse.Value), `"float":1.5`)
+
+		body := queryRaw(t, "SELECT VALUE c.big FROM c WHERE c.big = 9007199254740993")
+		assert.Contains(t, body, `"Documents":[9007199254740993]`)
+	})
+
+	t.Run("Should keep every digit of high precision numbers", func(t *testing.T) {
+		_, err := collectionClient.CreateItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("123"),
+			[]byte(`{"id": "precise-1", "pk": "123", "int64Max": 9223372036854775807, "beyondInt64": 123456789012345678901234,
+				"decimal": 0.12345678901234567890123, "scientific": 1.5e300, "smallScientific": 1.2345678901234567890e-30}`),
+			nil,
+		)
+		assert.Nil(t, err)
+		_, err = collectionClient.CreateItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("123"),
+			[]byte(`{"id": "precise-2", "pk": "123", "decimal": 0.12345678901234567890124}`),
+			nil,
+		)
+		assert.Nil(t, err)
+
+		readResponse, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "precise-1", nil)
+		assert.Nil(t, err)
+		assert.Contains(t, string(readResponse.Value), `"int64Max":9223372036854775807`)
+		assert.Contains(t, string(readResponse.Value), `"beyondInt64":123456789012345678901234`)
+		assert.Contains(t, string(readResponse.Value), `"decimal":0.12345678901234567890123`)
+		assert.Contains(t, string(readResponse.Value), `"scientific":1.5e+300`)
+		assert.Contains(t, string(readResponse.Value), `"smallScientific":1.2345678901234567890e-30`)
+
+		body := queryRaw(t, "SELECT VALUE c.int64Max FROM c WHERE c.int64Max = 9223372036854775807")
+		assert.Contains(t, body, `"Documents":[9223372036854775807]`)
+
+		// Both decimals round to the same float, they are still ordered by their exact value
+		body = queryRaw(t, "SELECT VALUE c.decimal FROM c WHERE IS_NUMBER(c.decimal) ORDER BY c.decimal DESC")
+		assert.Contains(t, body, `"Documents":[0.12345678901234567890124,0.12345678901234567890123]`)
+
+		body = queryRaw(t, "SELECT VALUE c.id FROM c WHERE c.decimal = 0.12345678901234567890123")
+		assert.Contains(t, body, `"Documents":["precise-1"]`)
+
+		body = queryRaw(t, "SELECT VALUE c.id FROM c WHERE c.beyondInt64 > 123456789012345678901233")
+		assert.Contains(t, body, `"Documents":["precise-1"]`)
+	})
+
+	t.Run("Should write compact JSON unless pretty printing is enabled", func(t *testing.T) {
+		readResponse, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "12345", nil)
+		assert.Nil(t, err)
+		assert.NotContains(t, string(readResponse.Value), "\n")
+
+		config.Config.PrettyJSON = true
+		defer func() { config.Config.PrettyJSON = false }()
+
+		readResponse, err = collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "12345", nil)
+		assert.Nil(t, err)
+		assert.Contains(t, string(readResponse.Value), "\n    \"id\": \"12345\"")
+	})
 }
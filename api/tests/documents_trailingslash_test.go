@@ -27,6 +27,7 @@ func Test_Documents_Read_Trailing_Slash(t *testing.T) {
 		req, _ := http.NewRequest("GET", testUrl, nil)
 		req.Header.Add("x-ms-date", date)
 		req.Header.Add("authorization", "sig="+url.QueryEscape(signature))
+		req.Header.Add("x-ms-documentdb-partitionkey", `["123"]`)
 		res, err := httpClient.Do(req)
 
 		assert.Nil(t, err)
@@ -0,0 +1,91 @@
+package tests_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ErrorResponses(t *testing.T) {
+	ts := runTestServer()
+	defer ts.Close()
+
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+	repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+		ID:           "errors-coll",
+		PartitionKey: repositorymodels.CollectionPartitionKey{Paths: []string{"/pk"}},
+	})
+	defer repositories.DeleteCollection(testDatabaseName, "errors-coll")
+	repositories.CreateDocument(testDatabaseName, "errors-coll", map[string]interface{}{"id": "12345", "pk": "a"})
+
+	collectionPath := fmt.Sprintf("dbs/%s/colls/errors-coll", testDatabaseName)
+
+	t.Run("Should echo the activity id of the request", func(t *testing.T) {
+		documentPath := collectionPath + "/docs/missing"
+		status, headers, body := sendSignedRequestWithHeaders(t, ts.URL, documentPath, http.MethodGet, "docs", documentPath, map[string]string{
+			"x-ms-documentdb-partitionkey": `["a"]`,
+			"x-ms-activity-id":             "5a7e1f2b-0000-0000-0000-000000000001",
+		}, nil)
+
+		assert.Equal(t, http.StatusNotFound, status)
+		assert.Equal(t, "5a7e1f2b-0000-0000-0000-000000000001", headers.Get("x-ms-activity-id"))
+		assert.Equal(t, "NotFound", body["code"])
+		assert.Equal(t,
+			"Message: {\"Errors\":[\"Resource Not Found. Learn more: https://aka.ms/cosmosdb-tsg-not-found\"]}\r\n"+
+				"ActivityId: 5a7e1f2b-0000-0000-0000-000000000001, Request URI: /"+documentPath,
+			body["message"])
+	})
+
+	t.Run("Should generate an activity id when the request has none", func(t *testing.T) {
+		path := fmt.Sprintf("dbs/%s/colls/missing-coll", testDatabaseName)
+		status, headers, body := sendSignedRequestWithHeaders(t, ts.URL, path, http.MethodGet, "colls", path, nil, nil)
+
+		assert.Equal(t, http.StatusNotFound, status)
+		assert.Regexp(t, `^[0-9a-f-]{36}$`, headers.Get("x-ms-activity-id"))
+		assert.Contains(t, body["message"], "ActivityId: "+headers.Get("x-ms-activity-id"))
+	})
+
+	t.Run("Should set the sub status of the error", func(t *testing.T) {
+		status, headers, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, map[string]string{
+			"x-ms-documentdb-partitionkey": `["b"]`,
+		}, map[string]interface{}{"id": "mismatch", "pk": "a"})
+
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "1001", headers.Get("x-ms-substatus"))
+		assert.Equal(t, "BadRequest", body["code"])
+		assert.Contains(t, body["message"], "PartitionKey extracted from document doesn't match the one specified in the header")
+	})
+
+	t.Run("Should return errors the SDK can read", func(t *testing.T) {
+		client, err := azcosmos.NewClientFromConnectionString(
+			fmt.Sprintf("AccountEndpoint=%s;AccountKey=%s", ts.URL, config.Config.AccountKey),
+			&azcosmos.ClientOptions{},
+		)
+		assert.Nil(t, err)
+
+		collectionClient, err := client.NewContainer(testDatabaseName, "errors-coll")
+		assert.Nil(t, err)
+
+		_, err = collectionClient.CreateItem(context.TODO(), azcosmos.NewPartitionKeyString("a"), []byte(`{"id": "12345", "pk": "a"}`), nil)
+		var respErr *azcore.ResponseError
+		if assert.True(t, errors.As(err, &respErr)) {
+			assert.Equal(t, http.StatusConflict, respErr.StatusCode)
+			assert.Equal(t, "Conflict", respErr.ErrorCode)
+		}
+
+		_, err = client.CreateDatabase(context.TODO(), azcosmos.DatabaseProperties{ID: testDatabaseName}, nil)
+		if assert.True(t, errors.As(err, &respErr)) {
+			assert.Equal(t, http.StatusConflict, respErr.StatusCode)
+			assert.Equal(t, "Conflict", respErr.ErrorCode)
+		}
+	})
+}
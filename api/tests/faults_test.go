@@ -0,0 +1,168 @@
+package tests_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FaultInjection(t *testing.T) {
+	config.Config.EnableStateEndpoint = true
+	ts := runTestServer()
+	defer ts.Close()
+	config.Config.EnableStateEndpoint = false
+
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+	for _, collectionId := range []string{"faults-coll", "faults-other-coll"} {
+		repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+			ID:           collectionId,
+			PartitionKey: repositorymodels.CollectionPartitionKey{Paths: []string{"/pk"}},
+		})
+		defer repositories.DeleteCollection(testDatabaseName, collectionId)
+		repositories.CreateDocument(testDatabaseName, collectionId, map[string]interface{}{"id": "12345", "pk": "a"})
+	}
+
+	sendAdminRequest := func(t *testing.T, method string, path string, body interface{}) (int, map[string]interface{}) {
+		var requestBody []byte
+		if body != nil {
+			requestBody, _ = json.Marshal(body)
+		}
+
+		req, err := http.NewRequest(method, ts.URL+path, bytes.NewReader(requestBody))
+		assert.Nil(t, err)
+		res, err := http.DefaultClient.Do(req)
+		assert.Nil(t, err)
+		defer res.Body.Close()
+
+		var responseBody map[string]interface{}
+		json.NewDecoder(res.Body).Decode(&responseBody)
+		return res.StatusCode, responseBody
+	}
+	addFault := func(t *testing.T, rule map[string]interface{}) (int, map[string]interface{}) {
+		return sendAdminRequest(t, http.MethodPost, "/_admin/faults", rule)
+	}
+	defer sendAdminRequest(t, http.MethodDelete, "/_admin/faults", nil)
+
+	documentRequest := func(t *testing.T, method string, collectionId string, documentId string, body map[string]interface{}) (int, http.Header) {
+		collectionPath := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, collectionId)
+		path, resourceId := collectionPath+"/docs", collectionPath
+		if documentId != "" {
+			path = collectionPath + "/docs/" + documentId
+			resourceId = path
+		}
+
+		status, headers, _ := sendSignedRequestWithHeaders(t, ts.URL, path, method, "docs", resourceId, map[string]string{
+			"x-ms-documentdb-partitionkey": `["a"]`,
+		}, body)
+		return status, headers
+	}
+
+	t.Run("Should fail the given count of matching requests", func(t *testing.T) {
+		status, rule := addFault(t, map[string]interface{}{
+			"method":        "delete",
+			"resourceType":  "docs",
+			"databaseId":    testDatabaseName,
+			"collectionId":  "faults-coll",
+			"count":         2,
+			"statusCode":    http.StatusServiceUnavailable,
+			"subStatusCode": 21008,
+		})
+		assert.Equal(t, http.StatusCreated, status)
+		assert.NotEmpty(t, rule["id"])
+
+		// Reads and requests to other collections don't match
+		status, _ = documentRequest(t, http.MethodGet, "faults-coll", "12345", nil)
+		assert.Equal(t, http.StatusOK, status)
+		status, _ = documentRequest(t, http.MethodDelete, "faults-other-coll", "12345", nil)
+		assert.Equal(t, http.StatusNoContent, status)
+
+		for i := 0; i < 2; i++ {
+			status, headers := documentRequest(t, http.MethodDelete, "faults-coll", "12345", nil)
+			assert.Equal(t, http.StatusServiceUnavailable, status)
+			assert.Equal(t, "21008", headers.Get("x-ms-substatus"))
+		}
+
+		status, _ = documentRequest(t, http.MethodDelete, "faults-coll", "12345", nil)
+		assert.Equal(t, http.StatusNoContent, status)
+
+		_, body := sendAdminRequest(t, http.MethodGet, "/_admin/faults", nil)
+		assert.Len(t, body["Faults"], 0)
+	})
+
+	t.Run("Should fail once per document id", func(t *testing.T) {
+		status, _ := addFault(t, map[string]interface{}{
+			"id":              "retry-with",
+			"operation":       "write",
+			"oncePerDocument": true,
+			"statusCode":      449,
+			"retryAfter":      "10ms",
+		})
+		assert.Equal(t, http.StatusCreated, status)
+		defer sendAdminRequest(t, http.MethodDelete, "/_admin/faults/retry-with", nil)
+
+		for _, documentId := range []string{"first", "second"} {
+			document := map[string]interface{}{"id": documentId, "pk": "a"}
+			status, headers := documentRequest(t, http.MethodPost, "faults-coll", "", document)
+			assert.Equal(t, 449, status)
+			assert.Equal(t, "10", headers.Get("x-ms-retry-after-ms"))
+
+			status, _ = documentRequest(t, http.MethodPost, "faults-coll", "", document)
+			assert.Equal(t, http.StatusCreated, status)
+		}
+
+		status, _ = documentRequest(t, http.MethodPut, "faults-coll", "first", map[string]interface{}{"id": "first", "pk": "a"})
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("Should delay matching requests", func(t *testing.T) {
+		status, rule := addFault(t, map[string]interface{}{"operation": "read", "collectionId": "faults-other-coll", "latency": "200ms"})
+		assert.Equal(t, http.StatusCreated, status)
+		defer sendAdminRequest(t, http.MethodDelete, fmt.Sprintf("/_admin/faults/%s", rule["id"]), nil)
+
+		start := time.Now()
+		status, _ = documentRequest(t, http.MethodGet, "faults-other-coll", "missing", nil)
+		assert.Equal(t, http.StatusNotFound, status)
+		assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+	})
+
+	t.Run("Should expire rules", func(t *testing.T) {
+		status, _ := addFault(t, map[string]interface{}{"statusCode": http.StatusServiceUnavailable, "expiresAfter": "100ms"})
+		assert.Equal(t, http.StatusCreated, status)
+
+		status, _ = documentRequest(t, http.MethodGet, "faults-coll", "first", nil)
+		assert.Equal(t, http.StatusServiceUnavailable, status)
+
+		time.Sleep(100 * time.Millisecond)
+		status, _ = documentRequest(t, http.MethodGet, "faults-coll", "first", nil)
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("Should reject invalid rules", func(t *testing.T) {
+		status, _ := addFault(t, map[string]interface{}{"method": "GET"})
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = addFault(t, map[string]interface{}{"statusCode": http.StatusOK})
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = addFault(t, map[string]interface{}{"latency": "soon"})
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = addFault(t, map[string]interface{}{"id": "duplicate", "latency": "1ms", "count": 1})
+		assert.Equal(t, http.StatusCreated, status)
+		status, _ = addFault(t, map[string]interface{}{"id": "duplicate", "latency": "1ms"})
+		assert.Equal(t, http.StatusConflict, status)
+
+		status, _ = sendAdminRequest(t, http.MethodDelete, "/_admin/faults/duplicate", nil)
+		assert.Equal(t, http.StatusNoContent, status)
+		status, _ = sendAdminRequest(t, http.MethodDelete, "/_admin/faults/duplicate", nil)
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+}
@@ -0,0 +1,142 @@
+package tests_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pikami/cosmium/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+// The azcosmos SDK can not send hierarchical partition keys, so requests are signed manually
+func Test_HierarchicalPartitionKeys(t *testing.T) {
+	ts, _ := documents_InitializeDb(t)
+	defer ts.Close()
+
+	databasePath := fmt.Sprintf("dbs/%s", testDatabaseName)
+	collectionPath := databasePath + "/colls/hierarchical-coll"
+	defer repositories.DeleteCollection(testDatabaseName, "hierarchical-coll")
+
+	withPartitionKey := func(partitionKey string) map[string]string {
+		return map[string]string{"x-ms-documentdb-partitionkey": partitionKey}
+	}
+
+	t.Run("Should create collection with MultiHash partition key", func(t *testing.T) {
+		status, body := sendSignedRequest(t, ts.URL, databasePath+"/colls", http.MethodPost, "colls", databasePath, map[string]interface{}{
+			"id": "hierarchical-coll",
+			"partitionKey": map[string]interface{}{
+				"paths":   []string{"/tenantId", "/userId"},
+				"kind":    "MultiHash",
+				"version": 2,
+			},
+		})
+		assert.Equal(t, http.StatusCreated, status)
+		assert.Equal(t, map[string]interface{}{
+			"paths":   []interface{}{"/tenantId", "/userId"},
+			"kind":    "MultiHash",
+			"version": float64(2),
+		}, body["partitionKey"])
+	})
+
+	t.Run("Should reject invalid partition key definitions", func(t *testing.T) {
+		for _, partitionKey := range []map[string]interface{}{
+			{"paths": []string{"/tenantId", "/userId"}, "kind": "Hash"},
+			{"paths": []string{"/a", "/b", "/c", "/d"}, "kind": "MultiHash"},
+			{"paths": []string{"/a", "/a"}, "kind": "MultiHash"},
+			{"paths": []string{"tenantId"}, "kind": "Hash"},
+			{"paths": []string{"/tenantId"}, "kind": "Unknown"},
+		} {
+			status, body := sendSignedRequest(t, ts.URL, databasePath+"/colls", http.MethodPost, "colls", databasePath, map[string]interface{}{
+				"id":           "invalid-coll",
+				"partitionKey": partitionKey,
+			})
+			assert.Equal(t, http.StatusBadRequest, status, partitionKey)
+			assert.Equal(t, "BadRequest", body["code"])
+		}
+	})
+
+	t.Run("Should create documents with the full partition key", func(t *testing.T) {
+		for _, document := range []map[string]interface{}{
+			{"id": "1", "tenantId": "t1", "userId": "u1"},
+			{"id": "2", "tenantId": "t1", "userId": "u2"},
+			{"id": "3", "tenantId": "t2", "userId": "u1"},
+		} {
+			partitionKey := fmt.Sprintf(`["%s","%s"]`, document["tenantId"], document["userId"])
+			status, _, _ := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, withPartitionKey(partitionKey), document)
+			assert.Equal(t, http.StatusCreated, status)
+		}
+
+		status, _, _ := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, withPartitionKey(`["t1","u2"]`), map[string]interface{}{
+			"id": "mismatch", "tenantId": "t1", "userId": "u1",
+		})
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		status, _, _ = sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, withPartitionKey(`["t1"]`), map[string]interface{}{
+			"id": "prefix", "tenantId": "t1", "userId": "u1",
+		})
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("Should read documents with the full partition key or a prefix", func(t *testing.T) {
+		documentPath := collectionPath + "/docs/2"
+
+		status, _, body := sendSignedRequestWithHeaders(t, ts.URL, documentPath, http.MethodGet, "docs", documentPath, withPartitionKey(`["t1","u2"]`), nil)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "2", body["id"])
+
+		status, _, _ = sendSignedRequestWithHeaders(t, ts.URL, documentPath, http.MethodGet, "docs", documentPath, withPartitionKey(`["t1","u1"]`), nil)
+		assert.Equal(t, http.StatusNotFound, status)
+
+		status, _, body = sendSignedRequestWithHeaders(t, ts.URL, documentPath, http.MethodGet, "docs", documentPath, withPartitionKey(`["t1"]`), nil)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "2", body["id"])
+
+		status, _, _ = sendSignedRequestWithHeaders(t, ts.URL, documentPath, http.MethodGet, "docs", documentPath, withPartitionKey(`["t2"]`), nil)
+		assert.Equal(t, http.StatusNotFound, status)
+
+		status, _, _ = sendSignedRequestWithHeaders(t, ts.URL, documentPath, http.MethodGet, "docs", documentPath, withPartitionKey(`["t1","u2","x"]`), nil)
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		status, _, _ = sendSignedRequestWithHeaders(t, ts.URL, documentPath, http.MethodDelete, "docs", documentPath, withPartitionKey(`["t1"]`), nil)
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("Should scope the read feed to a partition key prefix", func(t *testing.T) {
+		readFeed := func(partitionKey string) []string {
+			status, _, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodGet, "docs", collectionPath, withPartitionKey(partitionKey), nil)
+			assert.Equal(t, http.StatusOK, status)
+
+			ids := make([]string, 0)
+			for _, document := range body["Documents"].([]interface{}) {
+				ids = append(ids, document.(map[string]interface{})["id"].(string))
+			}
+			return ids
+		}
+
+		assert.Equal(t, []string{"1", "2"}, readFeed(`["t1"]`))
+		assert.Equal(t, []string{"2"}, readFeed(`["t1","u2"]`))
+
+		status, _, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodGet, "docs", collectionPath, nil, nil)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Len(t, body["Documents"], 3)
+	})
+
+	t.Run("Should scope queries to a partition key prefix", func(t *testing.T) {
+		query := func(partitionKey string) []interface{} {
+			headers := withPartitionKey(partitionKey)
+			headers["x-ms-documentdb-isquery"] = "True"
+			status, _, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, headers, map[string]interface{}{
+				"query": "SELECT VALUE c.id FROM c ORDER BY c.id",
+			})
+			assert.Equal(t, http.StatusOK, status)
+			documents, _ := body["Documents"].([]interface{})
+			return documents
+		}
+
+		assert.Equal(t, []interface{}{"1", "2"}, query(`["t1"]`))
+		assert.Equal(t, []interface{}{"1"}, query(`["t1","u1"]`))
+		assert.Equal(t, []interface{}{"3"}, query(`["t2"]`))
+		assert.Equal(t, []interface{}{"1", "2", "3"}, query(`[]`))
+	})
+}
@@ -0,0 +1,95 @@
+package tests_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ArtificialLatency(t *testing.T) {
+	ts := runTestServer()
+	defer ts.Close()
+
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+	repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+		ID:           "latency-coll",
+		PartitionKey: repositorymodels.CollectionPartitionKey{Paths: []string{"/pk"}},
+	})
+	defer repositories.DeleteCollection(testDatabaseName, "latency-coll")
+	repositories.CreateDocument(testDatabaseName, "latency-coll", map[string]interface{}{"id": "12345", "pk": "a"})
+
+	defer func() {
+		config.Config.ReadLatency = config.LatencyRange{}
+		config.Config.WriteLatency = config.LatencyRange{}
+		config.Config.QueryLatency = config.LatencyRange{}
+	}()
+
+	collectionPath := fmt.Sprintf("dbs/%s/colls/latency-coll", testDatabaseName)
+	documentPath := collectionPath + "/docs/12345"
+	timeRequest := func(t *testing.T, method string, path string, resourceId string, headers map[string]string, body interface{}) (int, time.Duration) {
+		requestHeaders := map[string]string{"x-ms-documentdb-partitionkey": `["a"]`}
+		for key, value := range headers {
+			requestHeaders[key] = value
+		}
+
+		start := time.Now()
+		status, _, _ := sendSignedRequestWithHeaders(t, ts.URL, path, method, "docs", resourceId, requestHeaders, body)
+		return status, time.Since(start)
+	}
+
+	t.Run("Should delay operations by their configured latency", func(t *testing.T) {
+		config.Config.ReadLatency = config.LatencyRange{Min: 100 * time.Millisecond, Max: 100 * time.Millisecond}
+		defer func() { config.Config.ReadLatency = config.LatencyRange{} }()
+
+		status, elapsed := timeRequest(t, http.MethodGet, documentPath, documentPath, nil, nil)
+		assert.Equal(t, http.StatusOK, status)
+		assert.GreaterOrEqual(t, elapsed, 100*time.Millisecond)
+
+		// Queries and writes have their own latency
+		status, elapsed = timeRequest(t, http.MethodPost, collectionPath+"/docs", collectionPath, map[string]string{
+			"x-ms-documentdb-isquery": "True",
+			"Content-Type":            "application/query+json",
+		}, map[string]interface{}{"query": "SELECT * FROM c"})
+		assert.Equal(t, http.StatusOK, status)
+		assert.Less(t, elapsed, 100*time.Millisecond)
+	})
+
+	t.Run("Should pick a delay within the configured range", func(t *testing.T) {
+		config.Config.WriteLatency = config.LatencyRange{Min: 50 * time.Millisecond, Max: 150 * time.Millisecond}
+		defer func() { config.Config.WriteLatency = config.LatencyRange{} }()
+
+		status, elapsed := timeRequest(t, http.MethodPost, collectionPath+"/docs", collectionPath, nil, map[string]interface{}{"id": "delayed", "pk": "a"})
+		assert.Equal(t, http.StatusCreated, status)
+		assert.GreaterOrEqual(t, elapsed, 50*time.Millisecond)
+	})
+
+	t.Run("Should override the latency with the request header", func(t *testing.T) {
+		config.Config.QueryLatency = config.LatencyRange{Min: time.Minute, Max: time.Minute}
+		defer func() { config.Config.QueryLatency = config.LatencyRange{} }()
+
+		status, elapsed := timeRequest(t, http.MethodPost, collectionPath+"/docs", collectionPath, map[string]string{
+			"x-ms-documentdb-isquery": "True",
+			"Content-Type":            "application/query+json",
+			"x-cosmium-latency":       "0s",
+		}, map[string]interface{}{"query": "SELECT * FROM c"})
+		assert.Equal(t, http.StatusOK, status)
+		assert.Less(t, elapsed, time.Second)
+
+		status, elapsed = timeRequest(t, http.MethodGet, documentPath, documentPath, map[string]string{"x-cosmium-latency": "50ms-100ms"}, nil)
+		assert.Equal(t, http.StatusOK, status)
+		assert.GreaterOrEqual(t, elapsed, 50*time.Millisecond)
+	})
+
+	t.Run("Should reject malformed latency headers", func(t *testing.T) {
+		for _, header := range []string{"slow", "-5ms", "200ms-100ms"} {
+			status, _ := timeRequest(t, http.MethodGet, documentPath, documentPath, map[string]string{"x-cosmium-latency": header}, nil)
+			assert.Equal(t, http.StatusBadRequest, status, header)
+		}
+	})
+}
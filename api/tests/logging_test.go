@@ -0,0 +1,101 @@
+package tests_test
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/logger"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RequestLogging(t *testing.T) {
+	ts := runTestServer()
+	defer ts.Close()
+
+	var output bytes.Buffer
+	defaultInfoLogger := logger.InfoLogger
+	logger.InfoLogger = log.New(&output, "", 0)
+	defer func() { logger.InfoLogger = defaultInfoLogger }()
+
+	t.Run("Should log requests with their method, path, status and activity id", func(t *testing.T) {
+		output.Reset()
+		status, _, _ := sendSignedRequestWithHeaders(t, ts.URL, "dbs/missing-db", http.MethodGet, "dbs", "dbs/missing-db", map[string]string{"x-ms-activity-id": "activity-1"}, nil)
+		assert.Equal(t, http.StatusNotFound, status)
+
+		assert.Contains(t, output.String(), "Request method=GET path=/dbs/missing-db status=404 durationMs=")
+		assert.Contains(t, output.String(), "activityId=activity-1")
+	})
+
+	t.Run("Should not log requests below the log level", func(t *testing.T) {
+		config.Config.LogLevel = config.LogLevelWarn
+		defer func() { config.Config.LogLevel = "" }()
+
+		output.Reset()
+		sendSignedRequestWithHeaders(t, ts.URL, "dbs", http.MethodGet, "dbs", "", nil, nil)
+		assert.Empty(t, output.String())
+	})
+}
+
+func Test_QueryTracing(t *testing.T) {
+	ts := runTestServer()
+	defer ts.Close()
+
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+	repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+		ID:           "tracing-coll",
+		PartitionKey: repositorymodels.CollectionPartitionKey{Paths: []string{"/pk"}},
+	})
+	defer repositories.DeleteCollection(testDatabaseName, "tracing-coll")
+	repositories.CreateDocument(testDatabaseName, "tracing-coll", map[string]interface{}{"id": "1", "pk": "a", "isCool": true})
+	repositories.CreateDocument(testDatabaseName, "tracing-coll", map[string]interface{}{"id": "2", "pk": "b", "isCool": false})
+
+	var output bytes.Buffer
+	defaultDebugLogger := logger.DebugLogger
+	logger.DebugLogger = log.New(&output, "", 0)
+	defer func() { logger.DebugLogger = defaultDebugLogger }()
+
+	collectionPath := fmt.Sprintf("dbs/%s/colls/tracing-coll", testDatabaseName)
+	query := func(t *testing.T) {
+		status, _, _ := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, map[string]string{
+			"x-ms-documentdb-isquery":                    "true",
+			"x-ms-documentdb-query-enablecrosspartition": "true",
+		}, map[string]interface{}{
+			"query":      "SELECT c.id FROM c WHERE c.isCool = @isCool",
+			"parameters": []interface{}{map[string]interface{}{"name": "@isCool", "value": true}},
+		})
+		assert.Equal(t, http.StatusOK, status)
+	}
+
+	t.Run("Should log the executed queries", func(t *testing.T) {
+		config.Config.TraceQueries = true
+		config.Config.LogLevel = config.LogLevelDebug
+		defer func() {
+			config.Config.TraceQueries = false
+			config.Config.LogLevel = ""
+		}()
+
+		output.Reset()
+		query(t)
+
+		assert.Contains(t, output.String(), "Query executed query=SELECT c.id FROM c WHERE c.isCool = @isCool")
+		assert.Contains(t, output.String(), `parameters={"@isCool":true}`)
+		assert.Contains(t, output.String(), `"Operation":"="`)
+		assert.Contains(t, output.String(), "scannedDocuments=2 matchedDocuments=1 returnedDocuments=1")
+	})
+
+	t.Run("Should not log queries without the flag", func(t *testing.T) {
+		config.Config.LogLevel = config.LogLevelDebug
+		defer func() { config.Config.LogLevel = "" }()
+
+		output.Reset()
+		query(t)
+
+		assert.NotContains(t, output.String(), "Query executed")
+	})
+}
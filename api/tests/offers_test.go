@@ -0,0 +1,262 @@
+package tests_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Offers(t *testing.T) {
+	ts := runTestServer()
+	defer ts.Close()
+
+	client, err := azcosmos.NewClientFromConnectionString(
+		fmt.Sprintf("AccountEndpoint=%s;AccountKey=%s", ts.URL, config.Config.AccountKey),
+		&azcosmos.ClientOptions{},
+	)
+	assert.Nil(t, err)
+
+	repositories.DeleteDatabase(testDatabaseName)
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+	defer repositories.DeleteDatabase(testDatabaseName)
+	databaseClient, err := client.NewDatabase(testDatabaseName)
+	assert.Nil(t, err)
+
+	createContainer := func(t *testing.T, containerId string, throughput *azcosmos.ThroughputProperties) *azcosmos.ContainerClient {
+		_, err := databaseClient.CreateContainer(context.TODO(), azcosmos.ContainerProperties{
+			ID: containerId,
+			PartitionKeyDefinition: azcosmos.PartitionKeyDefinition{
+				Paths: []string{"/pk"},
+			},
+		}, &azcosmos.CreateContainerOptions{ThroughputProperties: throughput})
+		assert.Nil(t, err)
+
+		containerClient, err := databaseClient.NewContainer(containerId)
+		assert.Nil(t, err)
+		return containerClient
+	}
+
+	t.Run("Should read the throughput the container was created with", func(t *testing.T) {
+		throughput := azcosmos.NewManualThroughputProperties(400)
+		containerClient := createContainer(t, "offers-manual", &throughput)
+
+		response, err := containerClient.ReadThroughput(context.TODO(), nil)
+		assert.Nil(t, err)
+
+		manualThroughput, ok := response.ThroughputProperties.ManualThroughput()
+		assert.True(t, ok)
+		assert.Equal(t, int32(400), manualThroughput)
+	})
+
+	t.Run("Should read the autoscale settings the container was created with", func(t *testing.T) {
+		throughput := azcosmos.NewAutoscaleThroughputProperties(4000)
+		containerClient := createContainer(t, "offers-autoscale", &throughput)
+
+		response, err := containerClient.ReadThroughput(context.TODO(), nil)
+		assert.Nil(t, err)
+
+		maxThroughput, ok := response.ThroughputProperties.AutoscaleMaxThroughput()
+		assert.True(t, ok)
+		assert.Equal(t, int32(4000), maxThroughput)
+
+		// Autoscaled offers report the throughput they scale down to
+		manualThroughput, ok := response.ThroughputProperties.ManualThroughput()
+		assert.True(t, ok)
+		assert.Equal(t, int32(400), manualThroughput)
+	})
+
+	t.Run("Should return not found for containers without throughput", func(t *testing.T) {
+		containerClient := createContainer(t, "offers-none", nil)
+
+		_, err := containerClient.ReadThroughput(context.TODO(), nil)
+		var respErr *azcore.ResponseError
+		if assert.True(t, errors.As(err, &respErr)) {
+			assert.Equal(t, http.StatusNotFound, respErr.StatusCode)
+		}
+	})
+
+	t.Run("Should reject invalid throughput", func(t *testing.T) {
+		throughput := azcosmos.NewManualThroughputProperties(150)
+		_, err := databaseClient.CreateContainer(context.TODO(), azcosmos.ContainerProperties{
+			ID:                     "offers-invalid",
+			PartitionKeyDefinition: azcosmos.PartitionKeyDefinition{Paths: []string{"/pk"}},
+		}, &azcosmos.CreateContainerOptions{ThroughputProperties: &throughput})
+
+		var respErr *azcore.ResponseError
+		if assert.True(t, errors.As(err, &respErr)) {
+			assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+		}
+
+		_, status := repositories.GetCollection(testDatabaseName, "offers-invalid")
+		assert.Equal(t, repositorymodels.StatusNotFound, int(status))
+	})
+
+	t.Run("Should replace the offer", func(t *testing.T) {
+		collection, _ := repositories.GetCollection(testDatabaseName, "offers-manual")
+		status, _, body := sendSignedRequestWithHeaders(t, ts.URL, "offers", http.MethodPost, "offers", "", map[string]string{
+			"x-ms-documentdb-isquery": "true",
+			"Content-Type":            "application/query+json",
+		}, map[string]interface{}{
+			"query":      "SELECT * FROM c WHERE c.offerResourceId = @rid",
+			"parameters": []map[string]interface{}{{"name": "@rid", "value": collection.ResourceID}},
+		})
+		assert.Equal(t, http.StatusOK, status)
+		if !assert.Len(t, body["Offers"], 1) {
+			return
+		}
+
+		offer := body["Offers"].([]interface{})[0].(map[string]interface{})
+		assert.Equal(t, "V2", offer["offerVersion"])
+		assert.Equal(t, collection.Self, offer["resource"])
+
+		offerId := offer["id"].(string)
+		offer["content"] = map[string]interface{}{"offerThroughput": 1000}
+		status, _, body = sendSignedRequestWithHeaders(t, ts.URL, "offers/"+offerId, http.MethodPut, "offers", offerId, nil, offer)
+		assert.Equal(t, http.StatusOK, status)
+		assert.NotEqual(t, offer["_etag"], body["_etag"])
+
+		containerClient, _ := databaseClient.NewContainer("offers-manual")
+		response, err := containerClient.ReadThroughput(context.TODO(), nil)
+		assert.Nil(t, err)
+		manualThroughput, _ := response.ThroughputProperties.ManualThroughput()
+		assert.Equal(t, int32(1000), manualThroughput)
+
+		offer["content"] = map[string]interface{}{"offerThroughput": 50}
+		status, _, _ = sendSignedRequestWithHeaders(t, ts.URL, "offers/"+offerId, http.MethodPut, "offers", offerId, nil, offer)
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		offer["content"] = map[string]interface{}{"offerThroughput": 1000}
+		offer["offerResourceId"] = "other"
+		status, _, _ = sendSignedRequestWithHeaders(t, ts.URL, "offers/"+offerId, http.MethodPut, "offers", offerId, nil, offer)
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		status, _, _ = sendSignedRequestWithHeaders(t, ts.URL, "offers/missing", http.MethodGet, "offers", "missing", nil, nil)
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("Should migrate the offer between manual throughput and autoscale", func(t *testing.T) {
+		collection, _ := repositories.GetCollection(testDatabaseName, "offers-manual")
+		var offer repositorymodels.Offer
+		for _, storedOffer := range repositories.GetAllOffers() {
+			if storedOffer.OfferResourceID == collection.ResourceID {
+				offer = storedOffer
+			}
+		}
+
+		replaceOffer := func(t *testing.T, headers map[string]string, content map[string]interface{}) (int, map[string]interface{}) {
+			status, _, body := sendSignedRequestWithHeaders(t, ts.URL, "offers/"+offer.ID, http.MethodPut, "offers", offer.ID, headers, map[string]interface{}{
+				"id":              offer.ID,
+				"offerResourceId": offer.OfferResourceID,
+				"offerVersion":    "V2",
+				"content":         content,
+			})
+			return status, body
+		}
+		autoscaleContent := map[string]interface{}{"offerAutopilotSettings": map[string]interface{}{"maxThroughput": 6000}}
+
+		status, _ := replaceOffer(t, nil, autoscaleContent)
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		status, body := replaceOffer(t, map[string]string{"x-ms-cosmos-migrate-offer-to-autopilot": "true"}, nil)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, map[string]interface{}{
+			"offerThroughput":        float64(400),
+			"offerAutopilotSettings": map[string]interface{}{"maxThroughput": float64(4000)},
+		}, body["content"])
+
+		status, _ = replaceOffer(t, map[string]string{"x-ms-cosmos-migrate-offer-to-autopilot": "true"}, nil)
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = replaceOffer(t, nil, autoscaleContent)
+		assert.Equal(t, http.StatusOK, status)
+
+		containerClient, _ := databaseClient.NewContainer("offers-manual")
+		response, err := containerClient.ReadThroughput(context.TODO(), nil)
+		assert.Nil(t, err)
+		maxThroughput, _ := response.ThroughputProperties.AutoscaleMaxThroughput()
+		assert.Equal(t, int32(6000), maxThroughput)
+
+		status, body = replaceOffer(t, map[string]string{"x-ms-cosmos-migrate-offer-to-manual-throughput": "true"}, nil)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, map[string]interface{}{"offerThroughput": float64(6000)}, body["content"])
+	})
+
+	t.Run("Should delete the offer with the container", func(t *testing.T) {
+		collection, _ := repositories.GetCollection(testDatabaseName, "offers-autoscale")
+		repositories.DeleteCollection(testDatabaseName, "offers-autoscale")
+
+		for _, offer := range repositories.GetAllOffers() {
+			assert.NotEqual(t, collection.ResourceID, offer.OfferResourceID)
+		}
+	})
+
+	t.Run("Should read the throughput the database was created with", func(t *testing.T) {
+		throughput := azcosmos.NewManualThroughputProperties(800)
+		_, err := client.CreateDatabase(context.TODO(), azcosmos.DatabaseProperties{ID: "offers-db"}, &azcosmos.CreateDatabaseOptions{ThroughputProperties: &throughput})
+		assert.Nil(t, err)
+		defer repositories.DeleteDatabase("offers-db")
+
+		sharedDatabaseClient, _ := client.NewDatabase("offers-db")
+		response, err := sharedDatabaseClient.ReadThroughput(context.TODO(), nil)
+		assert.Nil(t, err)
+		manualThroughput, _ := response.ThroughputProperties.ManualThroughput()
+		assert.Equal(t, int32(800), manualThroughput)
+	})
+
+	t.Run("Should report the shared throughput of the database for its containers", func(t *testing.T) {
+		throughput := azcosmos.NewManualThroughputProperties(1000)
+		_, err := client.CreateDatabase(context.TODO(), azcosmos.DatabaseProperties{ID: "offers-shared-db"}, &azcosmos.CreateDatabaseOptions{ThroughputProperties: &throughput})
+		assert.Nil(t, err)
+		defer repositories.DeleteDatabase("offers-shared-db")
+
+		sharedDatabaseClient, _ := client.NewDatabase("offers-shared-db")
+		dedicatedThroughput := azcosmos.NewManualThroughputProperties(500)
+		for containerId, containerThroughput := range map[string]*azcosmos.ThroughputProperties{"shared": nil, "dedicated": &dedicatedThroughput} {
+			_, err := sharedDatabaseClient.CreateContainer(context.TODO(), azcosmos.ContainerProperties{
+				ID:                     containerId,
+				PartitionKeyDefinition: azcosmos.PartitionKeyDefinition{Paths: []string{"/pk"}},
+			}, &azcosmos.CreateContainerOptions{ThroughputProperties: containerThroughput})
+			assert.Nil(t, err)
+		}
+
+		readThroughput := func(t *testing.T, containerId string) int32 {
+			containerClient, _ := sharedDatabaseClient.NewContainer(containerId)
+			response, err := containerClient.ReadThroughput(context.TODO(), nil)
+			assert.Nil(t, err)
+			manualThroughput, _ := response.ThroughputProperties.ManualThroughput()
+			return manualThroughput
+		}
+		assert.Equal(t, int32(1000), readThroughput(t, "shared"))
+		assert.Equal(t, int32(500), readThroughput(t, "dedicated"))
+
+		// The database offer is found by the database resource id
+		database, _ := repositories.GetDatabase("offers-shared-db")
+		status, _, body := sendSignedRequestWithHeaders(t, ts.URL, "offers", http.MethodPost, "offers", "", map[string]string{
+			"x-ms-documentdb-isquery": "true",
+		}, map[string]interface{}{
+			"query": fmt.Sprintf("SELECT * FROM c WHERE c.offerResourceId = '%s'", database.ResourceID),
+		})
+		assert.Equal(t, http.StatusOK, status)
+		if assert.Len(t, body["Offers"], 1) {
+			offer := body["Offers"].([]interface{})[0].(map[string]interface{})
+			assert.Equal(t, database.Self, offer["resource"])
+
+			offer["content"] = map[string]interface{}{"offerThroughput": 2000}
+			offerId := offer["id"].(string)
+			status, _, _ = sendSignedRequestWithHeaders(t, ts.URL, "offers/"+offerId, http.MethodPut, "offers", offerId, nil, offer)
+			assert.Equal(t, http.StatusOK, status)
+		}
+
+		assert.Equal(t, int32(2000), readThroughput(t, "shared"))
+		assert.Equal(t, int32(500), readThroughput(t, "dedicated"))
+	})
+}
@@ -0,0 +1,325 @@
+package tests_test
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PartitionKeyRanges(t *testing.T) {
+	ts := runTestServer()
+	defer ts.Close()
+
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+	repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{ID: "pkranges-coll"})
+	defer repositories.DeleteCollection(testDatabaseName, "pkranges-coll")
+
+	collectionPath := fmt.Sprintf("dbs/%s/colls/pkranges-coll", testDatabaseName)
+	getPartitionKeyRanges := func(t *testing.T, collectionPath string, headers map[string]string) (int, http.Header, map[string]interface{}) {
+		return sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/pkranges", http.MethodGet, "pkranges", collectionPath, headers, nil)
+	}
+
+	t.Run("Should return a range covering the whole collection", func(t *testing.T) {
+		status, headers, body := getPartitionKeyRanges(t, collectionPath, nil)
+		assert.Equal(t, http.StatusOK, status)
+
+		collection, _ := repositories.GetCollection(testDatabaseName, "pkranges-coll")
+		assert.Equal(t, collection.ResourceID, body["_rid"])
+		assert.Equal(t, float64(1), body["_count"])
+		assert.Equal(t, "1", headers.Get("x-ms-item-count"))
+
+		partitionKeyRanges, _ := body["PartitionKeyRanges"].([]interface{})
+		if assert.Len(t, partitionKeyRanges, 1) {
+			partitionKeyRange := partitionKeyRanges[0].(map[string]interface{})
+			assert.Equal(t, "0", partitionKeyRange["id"])
+			assert.Equal(t, "", partitionKeyRange["minInclusive"])
+			assert.Equal(t, "FF", partitionKeyRange["maxExclusive"])
+			assert.Equal(t, headers.Get("etag"), partitionKeyRange["_etag"])
+		}
+	})
+
+	t.Run("Should return the same etag on every request", func(t *testing.T) {
+		_, firstHeaders, firstBody := getPartitionKeyRanges(t, collectionPath, nil)
+		_, secondHeaders, secondBody := getPartitionKeyRanges(t, collectionPath, nil)
+
+		assert.NotEmpty(t, firstHeaders.Get("etag"))
+		assert.Equal(t, firstHeaders.Get("etag"), secondHeaders.Get("etag"))
+		assert.Equal(t, firstBody, secondBody)
+	})
+
+	t.Run("Should return not modified for the cached etag", func(t *testing.T) {
+		_, headers, _ := getPartitionKeyRanges(t, collectionPath, nil)
+
+		status, _, _ := getPartitionKeyRanges(t, collectionPath, map[string]string{"if-none-match": headers.Get("etag")})
+		assert.Equal(t, http.StatusNotModified, status)
+
+		status, _, _ = getPartitionKeyRanges(t, collectionPath, map[string]string{"if-none-match": `"stale"`})
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("Should return not found when collection does not exist", func(t *testing.T) {
+		status, _, _ := getPartitionKeyRanges(t, fmt.Sprintf("dbs/%s/colls/missing-coll", testDatabaseName), nil)
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+}
+
+func Test_PartitionKeyRanges_Split(t *testing.T) {
+	config.Config.EnableStateEndpoint = true
+	ts := runTestServer()
+	defer ts.Close()
+	config.Config.EnableStateEndpoint = false
+
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+	repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+		ID:           "pkranges-split-coll",
+		PartitionKey: repositorymodels.CollectionPartitionKey{Paths: []string{"/pk"}},
+	})
+	defer repositories.DeleteCollection(testDatabaseName, "pkranges-split-coll")
+
+	documentIds := make([]string, 0)
+	for i := 0; i < 20; i++ {
+		documentId := fmt.Sprintf("doc-%02d", i)
+		documentIds = append(documentIds, documentId)
+		repositories.CreateDocument(testDatabaseName, "pkranges-split-coll", map[string]interface{}{"id": documentId, "pk": fmt.Sprintf("pk-%d", i)})
+	}
+
+	collectionPath := fmt.Sprintf("dbs/%s/colls/pkranges-split-coll", testDatabaseName)
+	splitRange := func(t *testing.T, partitionKeyRangeId string) (int, []interface{}) {
+		status, _, body := sendSignedRequestWithHeaders(t, ts.URL, "_state/"+collectionPath+"/pkranges/"+partitionKeyRangeId+"/split", http.MethodPost, "", "", nil, nil)
+		children, _ := body["PartitionKeyRanges"].([]interface{})
+		return status, children
+	}
+	getPartitionKeyRanges := func(t *testing.T, headers map[string]string) (int, http.Header, []interface{}) {
+		status, responseHeaders, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/pkranges", http.MethodGet, "pkranges", collectionPath, headers, nil)
+		partitionKeyRanges, _ := body["PartitionKeyRanges"].([]interface{})
+		return status, responseHeaders, partitionKeyRanges
+	}
+	queryRange := func(t *testing.T, partitionKeyRangeId string) (int, http.Header, []string) {
+		status, headers, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, map[string]string{
+			"x-ms-documentdb-isquery":             "true",
+			"x-ms-documentdb-partitionkeyrangeid": partitionKeyRangeId,
+		}, map[string]interface{}{"query": "SELECT * FROM c"})
+
+		ids := make([]string, 0)
+		documents, _ := body["Documents"].([]interface{})
+		for _, document := range documents {
+			ids = append(ids, document.(map[string]interface{})["id"].(string))
+		}
+		return status, headers, ids
+	}
+	readFeedRange := func(t *testing.T, partitionKeyRangeId string) (int, []string) {
+		status, _, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodGet, "docs", collectionPath, map[string]string{
+			"x-ms-documentdb-partitionkeyrangeid": partitionKeyRangeId,
+		}, nil)
+
+		ids := make([]string, 0)
+		documents, _ := body["Documents"].([]interface{})
+		for _, document := range documents {
+			ids = append(ids, document.(map[string]interface{})["id"].(string))
+		}
+		return status, ids
+	}
+
+	_, initialHeaders, _ := getPartitionKeyRanges(t, nil)
+
+	t.Run("Should split a range into two children", func(t *testing.T) {
+		status, children := splitRange(t, "0")
+		assert.Equal(t, http.StatusOK, status)
+
+		status, headers, partitionKeyRanges := getPartitionKeyRanges(t, nil)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "2", headers.Get("x-ms-item-count"))
+		assert.Equal(t, children, partitionKeyRanges)
+
+		if assert.Len(t, partitionKeyRanges, 2) {
+			first := partitionKeyRanges[0].(map[string]interface{})
+			second := partitionKeyRanges[1].(map[string]interface{})
+			assert.Equal(t, "1", first["id"])
+			assert.Equal(t, "2", second["id"])
+			assert.Equal(t, "", first["minInclusive"])
+			assert.Equal(t, "8000000000000000", first["maxExclusive"])
+			assert.Equal(t, "8000000000000000", second["minInclusive"])
+			assert.Equal(t, "FF", second["maxExclusive"])
+			assert.Equal(t, []interface{}{"0"}, first["parents"])
+			assert.Equal(t, []interface{}{"0"}, second["parents"])
+		}
+	})
+
+	t.Run("Should change the etag of the ranges", func(t *testing.T) {
+		status, headers, _ := getPartitionKeyRanges(t, map[string]string{"if-none-match": initialHeaders.Get("etag")})
+		assert.Equal(t, http.StatusOK, status)
+		assert.NotEqual(t, initialHeaders.Get("etag"), headers.Get("etag"))
+
+		status, _, _ = getPartitionKeyRanges(t, map[string]string{"if-none-match": headers.Get("etag")})
+		assert.Equal(t, http.StatusNotModified, status)
+	})
+
+	t.Run("Should return gone for requests pinned to the split range", func(t *testing.T) {
+		status, headers, _ := queryRange(t, "0")
+		assert.Equal(t, http.StatusGone, status)
+		assert.Equal(t, "1002", headers.Get("x-ms-substatus"))
+
+		status, _ = readFeedRange(t, "0")
+		assert.Equal(t, http.StatusGone, status)
+
+		status, _, _ = queryRange(t, "99")
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("Should spread the documents over the children", func(t *testing.T) {
+		status, _, firstIds := queryRange(t, "1")
+		assert.Equal(t, http.StatusOK, status)
+		status, _, secondIds := queryRange(t, "collection-rid,2")
+		assert.Equal(t, http.StatusOK, status)
+
+		assert.NotEmpty(t, firstIds)
+		assert.NotEmpty(t, secondIds)
+		allIds := append(firstIds, secondIds...)
+		sort.Strings(allIds)
+		assert.Equal(t, documentIds, allIds)
+
+		status, feedIds := readFeedRange(t, "1")
+		assert.Equal(t, http.StatusOK, status)
+		assert.ElementsMatch(t, firstIds, feedIds)
+	})
+
+	t.Run("Should link the parents of nested splits", func(t *testing.T) {
+		status, children := splitRange(t, "1")
+		assert.Equal(t, http.StatusOK, status)
+		if assert.Len(t, children, 2) {
+			assert.Equal(t, "3", children[0].(map[string]interface{})["id"])
+			assert.Equal(t, "4000000000000000", children[0].(map[string]interface{})["maxExclusive"])
+			assert.Equal(t, []interface{}{"0", "1"}, children[1].(map[string]interface{})["parents"])
+		}
+
+		_, _, partitionKeyRanges := getPartitionKeyRanges(t, nil)
+		assert.Len(t, partitionKeyRanges, 3)
+
+		status, _, _ = queryRange(t, "1")
+		assert.Equal(t, http.StatusGone, status)
+
+		status, _ = splitRange(t, "1")
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+}
+
+func Test_PartitionKeyRanges_Count(t *testing.T) {
+	config.Config.EnableStateEndpoint = true
+	ts := runTestServer()
+	defer ts.Close()
+	config.Config.EnableStateEndpoint = false
+
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+	repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+		ID:           "pkranges-count-coll",
+		PartitionKey: repositorymodels.CollectionPartitionKey{Paths: []string{"/pk"}},
+	})
+	defer repositories.DeleteCollection(testDatabaseName, "pkranges-count-coll")
+
+	documentIds := make([]string, 0)
+	for i := 0; i < 20; i++ {
+		documentId := fmt.Sprintf("doc-%02d", i)
+		documentIds = append(documentIds, documentId)
+		repositories.CreateDocument(testDatabaseName, "pkranges-count-coll", map[string]interface{}{"id": documentId, "pk": fmt.Sprintf("pk-%d", i)})
+	}
+
+	collectionPath := fmt.Sprintf("dbs/%s/colls/pkranges-count-coll", testDatabaseName)
+	setRangeCount := func(t *testing.T, method string, body interface{}) int {
+		status, _, _ := sendSignedRequestWithHeaders(t, ts.URL, "_state/"+collectionPath+"/pkranges", method, "", "", nil, body)
+		return status
+	}
+	getPartitionKeyRanges := func(t *testing.T) []interface{} {
+		_, _, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/pkranges", http.MethodGet, "pkranges", collectionPath, nil, nil)
+		partitionKeyRanges, _ := body["PartitionKeyRanges"].([]interface{})
+		return partitionKeyRanges
+	}
+	readDocument := func(t *testing.T, sessionToken string) (int, http.Header) {
+		status, headers, _ := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs/doc-00", http.MethodGet, "docs", collectionPath+"/docs/doc-00", map[string]string{
+			"x-ms-documentdb-partitionkey": `["pk-0"]`,
+			"x-ms-session-token":           sessionToken,
+		}, nil)
+		return status, headers
+	}
+
+	t.Run("Should return the configured number of ranges", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, setRangeCount(t, http.MethodPut, map[string]interface{}{"count": 4}))
+
+		partitionKeyRanges := getPartitionKeyRanges(t)
+		if assert.Len(t, partitionKeyRanges, 4) {
+			expectedBounds := []string{"", "4000000000000000", "8000000000000000", "C000000000000000", "FF"}
+			for i, item := range partitionKeyRanges {
+				partitionKeyRange := item.(map[string]interface{})
+				assert.Equal(t, fmt.Sprint(i), partitionKeyRange["id"])
+				assert.Equal(t, expectedBounds[i], partitionKeyRange["minInclusive"])
+				assert.Equal(t, expectedBounds[i+1], partitionKeyRange["maxExclusive"])
+				assert.Equal(t, float64(i), partitionKeyRange["ridPrefix"])
+				assert.Equal(t, 0.25, partitionKeyRange["throughputFraction"])
+				assert.Equal(t, "online", partitionKeyRange["status"])
+				assert.NotEmpty(t, partitionKeyRange["_rid"])
+				assert.NotEmpty(t, partitionKeyRange["_etag"])
+			}
+		}
+	})
+
+	t.Run("Should spread the documents over the ranges", func(t *testing.T) {
+		allIds := make([]string, 0)
+		for _, partitionKeyRangeId := range []string{"0", "1", "2", "3"} {
+			status, _, body := sendSignedRequestWithHeaders(t, ts.URL, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, map[string]string{
+				"x-ms-documentdb-isquery":             "true",
+				"x-ms-documentdb-partitionkeyrangeid": partitionKeyRangeId,
+			}, map[string]interface{}{"query": "SELECT * FROM c"})
+			assert.Equal(t, http.StatusOK, status)
+
+			documents, _ := body["Documents"].([]interface{})
+			for _, document := range documents {
+				allIds = append(allIds, document.(map[string]interface{})["id"].(string))
+			}
+		}
+
+		sort.Strings(allIds)
+		assert.Equal(t, documentIds, allIds)
+	})
+
+	t.Run("Should return session tokens for every range", func(t *testing.T) {
+		lsn, _ := repositories.GetCollectionLSN(testDatabaseName, "pkranges-count-coll")
+
+		status, headers := readDocument(t, fmt.Sprintf("3:%d", lsn))
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, fmt.Sprintf("0:%d,1:%d,2:%d,3:%d", lsn, lsn, lsn, lsn), headers.Get("x-ms-session-token"))
+
+		status, headers = readDocument(t, fmt.Sprintf("3:%d", lsn+1))
+		assert.Equal(t, http.StatusNotFound, status)
+		assert.Equal(t, "1002", headers.Get("x-ms-substatus"))
+
+		// Tokens of ranges the collection doesn't have are ignored
+		status, _ = readDocument(t, fmt.Sprintf("7:%d", lsn+1))
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("Should reject invalid range counts", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, setRangeCount(t, http.MethodPut, map[string]interface{}{"count": 0}))
+		assert.Equal(t, http.StatusBadRequest, setRangeCount(t, http.MethodPut, map[string]interface{}{"count": 101}))
+		assert.Len(t, getPartitionKeyRanges(t), 4)
+	})
+
+	t.Run("Should restore a single range", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, setRangeCount(t, http.MethodDelete, nil))
+
+		partitionKeyRanges := getPartitionKeyRanges(t)
+		if assert.Len(t, partitionKeyRanges, 1) {
+			assert.Equal(t, "0", partitionKeyRanges[0].(map[string]interface{})["id"])
+			assert.Equal(t, float64(1), partitionKeyRanges[0].(map[string]interface{})["throughputFraction"])
+		}
+	})
+
+	t.Run("Should return not found when collection does not exist", func(t *testing.T) {
+		status, _, _ := sendSignedRequestWithHeaders(t, ts.URL, fmt.Sprintf("_state/dbs/%s/colls/missing-coll/pkranges", testDatabaseName), http.MethodPut, "", "", nil, map[string]interface{}{"count": 2})
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+}
@@ -0,0 +1,74 @@
+package tests_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/authentication"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+)
+
+// Compares the throughput of a query returning 10k documents with compact
+// and indented responses:
+// go test ./api/tests -run ^$ -bench BenchmarkQuery_Serialization
+func BenchmarkQuery_Serialization(b *testing.B) {
+	ts := runTestServer()
+	defer ts.Close()
+
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+	repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{ID: "benchmark-coll"})
+	defer repositories.DeleteCollection(testDatabaseName, "benchmark-coll")
+
+	for i := 0; i < 10000; i++ {
+		repositories.CreateDocument(testDatabaseName, "benchmark-coll", map[string]interface{}{
+			"id":     fmt.Sprintf("doc-%d", i),
+			"number": i,
+			"name":   fmt.Sprintf("Document number %d", i),
+			"tags":   []interface{}{"benchmark", "query", "serialization"},
+			"nested": map[string]interface{}{"isEven": i%2 == 0, "score": float64(i) / 3},
+		})
+	}
+
+	collectionPath := fmt.Sprintf("dbs/%s/colls/benchmark-coll", testDatabaseName)
+	executeQuery := func(b *testing.B) int64 {
+		date := time.Now().Format(time.RFC1123)
+		signature := authentication.GenerateSignature(http.MethodPost, "docs", collectionPath, date, config.Config.AccountKey)
+		req, _ := http.NewRequest(http.MethodPost, ts.URL+"/"+collectionPath+"/docs", strings.NewReader(`{"query": "SELECT * FROM c"}`))
+		req.Header.Add("x-ms-date", date)
+		req.Header.Add("authorization", "sig="+url.QueryEscape(signature))
+		req.Header.Add("Content-Type", "application/query+json")
+
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			b.Fatal(err)
+		}
+		defer res.Body.Close()
+
+		// The body is discarded, so the benchmark measures the server side
+		size, _ := io.Copy(io.Discard, res.Body)
+		if res.StatusCode != http.StatusOK {
+			b.Fatalf("unexpected status %d", res.StatusCode)
+		}
+		return size
+	}
+
+	for _, prettyJSON := range []bool{false, true} {
+		b.Run(fmt.Sprintf("PrettyJSON=%t", prettyJSON), func(b *testing.B) {
+			config.Config.PrettyJSON = prettyJSON
+			defer func() { config.Config.PrettyJSON = false }()
+
+			var size int64
+			for i := 0; i < b.N; i++ {
+				size = executeQuery(b)
+			}
+			b.ReportMetric(float64(size), "bytes/response")
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package tests_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/authentication"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_QueryPlan(t *testing.T) {
+	ts := runTestServer()
+	defer ts.Close()
+
+	repositories.DeleteDatabase(testDatabaseName)
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+	repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{ID: testCollectionName})
+	repositories.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{"id": "1", "pk": "a", "value": 1})
+	repositories.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{"id": "2", "pk": "a", "value": 2})
+	repositories.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{"id": "3", "pk": "b", "value": 3})
+
+	collectionPath := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, testCollectionName)
+	getQueryPlan := func(t *testing.T, query string) (int, map[string]interface{}) {
+		requestBody, _ := json.Marshal(map[string]interface{}{"query": query})
+
+		date := time.Now().Format(time.RFC1123)
+		signature := authentication.GenerateSignature(http.MethodPost, "docs", collectionPath, date, config.Config.AccountKey)
+		req, _ := http.NewRequest(http.MethodPost, ts.URL+"/"+collectionPath+"/docs", bytes.NewReader(requestBody))
+		req.Header.Add("x-ms-date", date)
+		req.Header.Add("authorization", "sig="+url.QueryEscape(signature))
+		req.Header.Add("x-ms-cosmos-is-query-plan-request", "True")
+		req.Header.Add("Content-Type", "application/query+json")
+
+		res, err := http.DefaultClient.Do(req)
+		if !assert.Nil(t, err) {
+			t.FailNow()
+		}
+		defer res.Body.Close()
+
+		var responseBody map[string]interface{}
+		json.NewDecoder(res.Body).Decode(&responseBody)
+		queryInfo, _ := responseBody["queryInfo"].(map[string]interface{})
+		return res.StatusCode, queryInfo
+	}
+
+	t.Run("Should describe plain queries", func(t *testing.T) {
+		status, queryInfo := getQueryPlan(t, "SELECT * FROM c")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "None", queryInfo["distinctType"])
+		assert.Equal(t, false, queryInfo["hasSelectValue"])
+		assert.Empty(t, queryInfo["aggregates"])
+		assert.Empty(t, queryInfo["orderBy"])
+		assert.Empty(t, queryInfo["rewrittenQuery"])
+		assert.Nil(t, queryInfo["top"])
+	})
+
+	t.Run("Should describe value aggregates", func(t *testing.T) {
+		_, queryInfo := getQueryPlan(t, "SELECT VALUE COUNT(1) FROM c")
+		assert.Equal(t, true, queryInfo["hasSelectValue"])
+		assert.Equal(t, []interface{}{"Count"}, queryInfo["aggregates"])
+	})
+
+	t.Run("Should describe aliased aggregates and group by", func(t *testing.T) {
+		_, queryInfo := getQueryPlan(t, "SELECT c.pk, SUM(c.value) AS total FROM c GROUP BY c.pk")
+		assert.Equal(t, []interface{}{"c.pk"}, queryInfo["groupByExpressions"])
+		assert.Equal(t, []interface{}{"pk", "total"}, queryInfo["groupByAliases"])
+		assert.Equal(t, map[string]interface{}{"pk": nil, "total": "Sum"}, queryInfo["groupByAliasToAggregateType"])
+		assert.Empty(t, queryInfo["aggregates"])
+	})
+
+	t.Run("Should describe order by, distinct and paging", func(t *testing.T) {
+		_, queryInfo := getQueryPlan(t, "SELECT DISTINCT c.id FROM c ORDER BY c.id DESC, c.name OFFSET 5 LIMIT 10")
+		assert.Equal(t, "Ordered", queryInfo["distinctType"])
+		assert.Equal(t, []interface{}{"Descending", "Ascending"}, queryInfo["orderBy"])
+		assert.Equal(t, []interface{}{"c.id", "c.name"}, queryInfo["orderByExpressions"])
+		assert.Equal(t, float64(5), queryInfo["offset"])
+		assert.Equal(t, float64(10), queryInfo["limit"])
+
+		_, queryInfo = getQueryPlan(t, "SELECT TOP 3 c.id FROM c")
+		assert.Equal(t, float64(3), queryInfo["top"])
+	})
+
+	t.Run("Should return 400 when query can not be parsed", func(t *testing.T) {
+		status, _ := getQueryPlan(t, "SELEC c.id FROM c")
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	executeRewrittenQuery := func(t *testing.T, query string) []interface{} {
+		_, queryInfo := getQueryPlan(t, query)
+		rewrittenQuery, _ := queryInfo["rewrittenQuery"].(string)
+		if !assert.NotEmpty(t, rewrittenQuery) {
+			t.FailNow()
+		}
+
+		status, body := sendSignedRequest(t, ts.URL, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, map[string]interface{}{"query": rewrittenQuery})
+		assert.Equal(t, http.StatusOK, status)
+		documents, _ := body["Documents"].([]interface{})
+		return documents
+	}
+
+	t.Run("Should return partial aggregates for rewritten queries", func(t *testing.T) {
+		assert.Equal(t,
+			[]interface{}{[]interface{}{map[string]interface{}{"item": float64(3)}}},
+			executeRewrittenQuery(t, "SELECT VALUE COUNT(1) FROM c"))
+
+		assert.Equal(t,
+			[]interface{}{[]interface{}{map[string]interface{}{"item": map[string]interface{}{"sum": float64(2), "count": float64(1)}}}},
+			executeRewrittenQuery(t, "SELECT VALUE AVG(c.value) FROM c"))
+
+		assert.Equal(t,
+			[]interface{}{map[string]interface{}{
+				"groupByItems": []interface{}{map[string]interface{}{"item": float64(0)}},
+				"payload":      map[string]interface{}{"cnt": map[string]interface{}{"item": float64(2)}},
+			}},
+			executeRewrittenQuery(t, "SELECT COUNT(1) AS cnt FROM c WHERE c.pk = 'a'"))
+	})
+
+	t.Run("Should return order by items for rewritten order by queries", func(t *testing.T) {
+		documents := executeRewrittenQuery(t, "SELECT c.id FROM c ORDER BY c.value DESC")
+		if assert.Len(t, documents, 3) {
+			for i, value := range []float64{3, 2, 1} {
+				document := documents[i].(map[string]interface{})
+				assert.Equal(t, []interface{}{map[string]interface{}{"item": value}}, document["orderByItems"])
+				assert.Equal(t, map[string]interface{}{"id": fmt.Sprint(value)}, document["payload"])
+				assert.NotEmpty(t, document["_rid"])
+			}
+		}
+	})
+
+	t.Run("Should leave the offset of rewritten queries to the client", func(t *testing.T) {
+		documents := executeRewrittenQuery(t, "SELECT VALUE c.id FROM c ORDER BY c.id OFFSET 1 LIMIT 1")
+		if assert.Len(t, documents, 2) {
+			assert.Equal(t, "1", documents[0].(map[string]interface{})["payload"])
+			assert.Equal(t, "2", documents[1].(map[string]interface{})["payload"])
+		}
+
+		_, queryInfo := getQueryPlan(t, "SELECT VALUE c.id FROM c OFFSET 1 LIMIT 1")
+		rewrittenQuery, _ := queryInfo["rewrittenQuery"].(string)
+		assert.NotContains(t, rewrittenQuery, "order-by")
+		assert.Len(t, executeRewrittenQuery(t, "SELECT VALUE c.id FROM c OFFSET 1 LIMIT 1"), 2)
+	})
+}
@@ -0,0 +1,120 @@
+package tests_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RequestCharge(t *testing.T) {
+	ts, _ := documents_InitializeDb(t)
+	defer ts.Close()
+
+	client, err := azcosmos.NewClientFromConnectionString(
+		fmt.Sprintf("AccountEndpoint=%s;AccountKey=%s", ts.URL, config.Config.AccountKey),
+		&azcosmos.ClientOptions{},
+	)
+	assert.Nil(t, err)
+
+	databaseClient, err := client.NewDatabase(testDatabaseName)
+	assert.Nil(t, err)
+
+	_, err = databaseClient.CreateContainer(context.TODO(), azcosmos.ContainerProperties{
+		ID:                     "charge-coll",
+		PartitionKeyDefinition: azcosmos.PartitionKeyDefinition{Paths: []string{"/pk"}},
+	}, nil)
+	assert.Nil(t, err)
+	defer repositories.DeleteCollection(testDatabaseName, "charge-coll")
+
+	collectionClient, err := databaseClient.NewContainer("charge-coll")
+	assert.Nil(t, err)
+
+	repositories.CreateDocument(testDatabaseName, "charge-coll", map[string]interface{}{"id": "12345", "pk": "123"})
+
+	t.Run("Should charge point reads one unit", func(t *testing.T) {
+		response, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "12345", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, float32(1), response.RequestCharge)
+	})
+
+	t.Run("Should charge writes more than reads", func(t *testing.T) {
+		response, err := collectionClient.UpsertItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("123"),
+			[]byte(`{"id": "charged", "pk": "123"}`),
+			nil,
+		)
+		assert.Nil(t, err)
+		assert.Greater(t, response.RequestCharge, float32(5))
+
+		response, err = collectionClient.DeleteItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "charged", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, float32(5), response.RequestCharge)
+	})
+
+	t.Run("Should charge queries", func(t *testing.T) {
+		pager := collectionClient.NewQueryItemsPager("SELECT * FROM c", azcosmos.NewPartitionKeyString("123"), nil)
+		response, err := pager.NextPage(context.TODO())
+		assert.Nil(t, err)
+		assert.Greater(t, response.RequestCharge, float32(2))
+	})
+
+	t.Run("Should charge the documents of the queried partition key range", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			repositories.CreateDocument(testDatabaseName, "charge-coll", map[string]interface{}{"id": fmt.Sprintf("range-%d", i), "pk": fmt.Sprintf("range-pk-%d", i)})
+		}
+		partitionKeyRanges, _ := repositories.SetPartitionKeyRangeCount(testDatabaseName, "charge-coll", 2)
+		defer repositories.ResetPartitionKeyRanges(testDatabaseName, "charge-coll")
+
+		collection, _ := repositories.GetCollection(testDatabaseName, "charge-coll")
+		documents, _ := repositories.GetAllDocuments(testDatabaseName, "charge-coll")
+		rangeDocuments := 0
+		for _, document := range documents {
+			if repositories.IsInPartitionKeyRange(collection, document, partitionKeyRanges[0]) {
+				rangeDocuments++
+			}
+		}
+
+		path := fmt.Sprintf("dbs/%s/colls/charge-coll", testDatabaseName)
+		status, headers, _ := sendSignedRequestWithHeaders(t, ts.URL, path+"/docs", http.MethodPost, "docs", path, map[string]string{
+			"x-ms-documentdb-isquery":             "true",
+			"Content-Type":                        "application/query+json",
+			"x-ms-documentdb-partitionkeyrangeid": partitionKeyRanges[0].ID,
+		}, map[string]interface{}{"query": "SELECT * FROM c"})
+		assert.Equal(t, http.StatusOK, status)
+
+		requestCharge, err := strconv.ParseFloat(headers.Get("x-ms-request-charge"), 64)
+		assert.Nil(t, err)
+		assert.InDelta(t, 2+0.1*float64(rangeDocuments), requestCharge, 0.001)
+		assert.Less(t, rangeDocuments, len(documents))
+	})
+
+	t.Run("Should charge database and collection reads", func(t *testing.T) {
+		databaseResponse, err := databaseClient.Read(context.TODO(), nil)
+		assert.Nil(t, err)
+		assert.Equal(t, float32(1), databaseResponse.RequestCharge)
+
+		collectionResponse, err := collectionClient.Read(context.TODO(), nil)
+		assert.Nil(t, err)
+		assert.Equal(t, float32(1), collectionResponse.RequestCharge)
+	})
+
+	t.Run("Should charge failed requests", func(t *testing.T) {
+		path := fmt.Sprintf("dbs/%s/colls/charge-coll/docs/missing", testDatabaseName)
+		status, headers, _ := sendSignedRequestWithHeaders(t, ts.URL, path, http.MethodGet, "docs", path, map[string]string{
+			"x-ms-documentdb-partitionkey": `["123"]`,
+		}, nil)
+		assert.Equal(t, http.StatusNotFound, status)
+
+		requestCharge, err := strconv.ParseFloat(headers.Get("x-ms-request-charge"), 64)
+		assert.Nil(t, err)
+		assert.Equal(t, 1.0, requestCharge)
+	})
+}
@@ -0,0 +1,155 @@
+package tests_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/authentication"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/stretchr/testify/assert"
+)
+
+// The azcosmos SDK has no client for server side scripts, so requests are signed manually.
+// A []byte body is sent as it is, so malformed bodies can be sent as well
+func sendSignedRequest(t *testing.T, serverUrl string, path string, method string, resourceType string, resourceId string, body interface{}) (int, map[string]interface{}) {
+	status, _, responseBody := sendSignedRequestWithHeaders(t, serverUrl, path, method, resourceType, resourceId, nil, body)
+	return status, responseBody
+}
+
+func sendSignedRequestWithHeaders(t testing.TB, serverUrl string, path string, method string, resourceType string, resourceId string, headers map[string]string, body interface{}) (int, http.Header, map[string]interface{}) {
+	var requestBody bytes.Buffer
+	if rawBody, ok := body.([]byte); ok {
+		requestBody.Write(rawBody)
+	} else if body != nil {
+		err := json.NewEncoder(&requestBody).Encode(body)
+		assert.Nil(t, err)
+	}
+
+	date := time.Now().Format(time.RFC1123)
+	signature := authentication.GenerateSignature(method, resourceType, resourceId, date, config.Config.AccountKey)
+	req, _ := http.NewRequest(method, serverUrl+"/"+path, &requestBody)
+	req.Header.Add("x-ms-date", date)
+	req.Header.Add("authorization", "sig="+url.QueryEscape(signature))
+	for key, value := range headers {
+		req.Header.Add(key, value)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	defer res.Body.Close()
+
+	var responseBody map[string]interface{}
+	json.NewDecoder(res.Body).Decode(&responseBody)
+
+	return res.StatusCode, res.Header, responseBody
+}
+
+func Test_ServerSideScripts(t *testing.T) {
+	ts := runTestServer()
+	defer ts.Close()
+
+	repositories.DeleteDatabase(testDatabaseName)
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+	repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{ID: testCollectionName})
+
+	collectionPath := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, testCollectionName)
+	feedPath := func(resourceType string) string {
+		return collectionPath + "/" + resourceType
+	}
+
+	for _, scriptType := range []struct {
+		resourceType string
+		feedName     string
+		resource     map[string]interface{}
+	}{
+		{"sprocs", "StoredProcedures", map[string]interface{}{"id": "sp1", "body": "function () {}"}},
+		{"triggers", "Triggers", map[string]interface{}{"id": "trigger1", "body": "function () {}", "triggerOperation": "All", "triggerType": "Pre"}},
+		{"udfs", "UserDefinedFunctions", map[string]interface{}{"id": "udf1", "body": "function () {}"}},
+	} {
+		resourceId := feedPath(scriptType.resourceType) + "/" + scriptType.resource["id"].(string)
+
+		t.Run(fmt.Sprintf("Should create %s", scriptType.resourceType), func(t *testing.T) {
+			status, body := sendSignedRequest(t, ts.URL, feedPath(scriptType.resourceType), http.MethodPost, scriptType.resourceType, collectionPath, scriptType.resource)
+			assert.Equal(t, http.StatusCreated, status)
+			assert.Equal(t, scriptType.resource["id"], body["id"])
+			assert.NotEmpty(t, body["_rid"])
+			assert.NotEmpty(t, body["_etag"])
+
+			status, _ = sendSignedRequest(t, ts.URL, feedPath(scriptType.resourceType), http.MethodPost, scriptType.resourceType, collectionPath, scriptType.resource)
+			assert.Equal(t, http.StatusConflict, status)
+		})
+
+		t.Run(fmt.Sprintf("Should list %s", scriptType.resourceType), func(t *testing.T) {
+			status, body := sendSignedRequest(t, ts.URL, feedPath(scriptType.resourceType), http.MethodGet, scriptType.resourceType, collectionPath, nil)
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, float64(1), body["_count"])
+			assert.Len(t, body[scriptType.feedName], 1)
+			assert.NotEmpty(t, body["_rid"])
+		})
+
+		t.Run(fmt.Sprintf("Should read and replace %s", scriptType.resourceType), func(t *testing.T) {
+			status, body := sendSignedRequest(t, ts.URL, resourceId, http.MethodGet, scriptType.resourceType, resourceId, nil)
+			assert.Equal(t, http.StatusOK, status)
+			createdRid := body["_rid"]
+
+			replacement := map[string]interface{}{}
+			for key, value := range scriptType.resource {
+				replacement[key] = value
+			}
+			replacement["body"] = "function () { return 1; }"
+
+			status, body = sendSignedRequest(t, ts.URL, resourceId, http.MethodPut, scriptType.resourceType, resourceId, replacement)
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, "function () { return 1; }", body["body"])
+			assert.Equal(t, createdRid, body["_rid"])
+		})
+
+		t.Run(fmt.Sprintf("Should query %s", scriptType.resourceType), func(t *testing.T) {
+			queryHeaders := map[string]string{
+				"x-ms-documentdb-isquery": "true",
+				"Content-Type":            "application/query+json",
+			}
+
+			status, _, body := sendSignedRequestWithHeaders(t, ts.URL, feedPath(scriptType.resourceType), http.MethodPost, scriptType.resourceType, collectionPath, queryHeaders, map[string]interface{}{
+				"query":      "SELECT * FROM s WHERE s.id = @id",
+				"parameters": []map[string]interface{}{{"name": "@id", "value": scriptType.resource["id"]}},
+			})
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, float64(1), body["_count"])
+			if assert.Len(t, body[scriptType.feedName], 1) {
+				queriedResource := body[scriptType.feedName].([]interface{})[0].(map[string]interface{})
+				assert.Equal(t, scriptType.resource["id"], queriedResource["id"])
+				assert.NotEmpty(t, queriedResource["_rid"])
+			}
+
+			status, _, body = sendSignedRequestWithHeaders(t, ts.URL, feedPath(scriptType.resourceType), http.MethodPost, scriptType.resourceType, collectionPath, queryHeaders, map[string]interface{}{
+				"query": `SELECT VALUE s.id FROM s WHERE s.id = "missing"`,
+			})
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, float64(0), body["_count"])
+
+			missingFeedPath := fmt.Sprintf("dbs/%s/colls/missing-coll/%s", testDatabaseName, scriptType.resourceType)
+			status, _, _ = sendSignedRequestWithHeaders(t, ts.URL, missingFeedPath, http.MethodPost, scriptType.resourceType, fmt.Sprintf("dbs/%s/colls/missing-coll", testDatabaseName), queryHeaders, map[string]interface{}{
+				"query": "SELECT * FROM s",
+			})
+			assert.Equal(t, http.StatusNotFound, status)
+		})
+
+		t.Run(fmt.Sprintf("Should delete %s", scriptType.resourceType), func(t *testing.T) {
+			status, _ := sendSignedRequest(t, ts.URL, resourceId, http.MethodDelete, scriptType.resourceType, resourceId, nil)
+			assert.Equal(t, http.StatusNoContent, status)
+
+			status, _ = sendSignedRequest(t, ts.URL, resourceId, http.MethodGet, scriptType.resourceType, resourceId, nil)
+			assert.Equal(t, http.StatusNotFound, status)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package tests_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api"
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	"github.com/pikami/cosmium/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StartAPI(t *testing.T) {
+	config.Config.AccountKey = config.DefaultAccountKey
+	config.Config.Host = "localhost"
+	config.Config.BindAddress = "127.0.0.1"
+	config.Config.DisableTls = true
+	defer func() {
+		config.Config.BindAddress = ""
+		config.Config.DisableTls = false
+	}()
+
+	t.Run("Should listen on a free port when port is 0", func(t *testing.T) {
+		config.SetPort(0)
+		server, err := api.StartAPI()
+		if !assert.Nil(t, err) {
+			t.FailNow()
+		}
+		defer server.Close()
+
+		assert.NotEqual(t, 0, config.Config.Port)
+		assert.Equal(t, fmt.Sprintf("http://localhost:%d/", config.Config.Port), config.Config.DatabaseEndpoint)
+
+		res, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d/", config.Config.Port))
+		assert.Nil(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+
+	t.Run("Should fail when the port is in use", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.Nil(t, err)
+		defer listener.Close()
+
+		config.SetPort(listener.Addr().(*net.TCPAddr).Port)
+		server, err := api.StartAPI()
+		assert.Nil(t, server)
+		assert.ErrorContains(t, err, "failed to listen on")
+	})
+}
+
+func Test_EmbeddedServer(t *testing.T) {
+	previousConfig := config.Config
+	previousState := repositories.GetState()
+	defer func() {
+		config.Config = previousConfig
+		repositories.ReplaceState(previousState)
+	}()
+
+	emulator := server.New(config.ServerConfig{BindAddress: "127.0.0.1", DisableTls: true}).WithState(server.State{
+		Databases: map[string]server.Database{"embedded-db": {ID: "embedded-db"}},
+		Collections: map[string]map[string]server.Collection{
+			"embedded-db": {"embedded-coll": {ID: "embedded-coll", PartitionKey: server.CollectionPartitionKey{Paths: []string{"/pk"}}}},
+		},
+		Documents: map[string]map[string]map[string]server.Document{
+			"embedded-db": {"embedded-coll": {"seeded": {"id": "seeded", "pk": "a"}}},
+		},
+	})
+	if !assert.Nil(t, emulator.Start()) {
+		t.FailNow()
+	}
+
+	t.Run("Should serve the seeded store", func(t *testing.T) {
+		assert.NotEqual(t, 0, emulator.Port())
+		assert.Equal(t, fmt.Sprintf("http://localhost:%d/", emulator.Port()), emulator.URL())
+
+		client, err := azcosmos.NewClientFromConnectionString(
+			fmt.Sprintf("AccountEndpoint=%s;AccountKey=%s", emulator.URL(), emulator.AccountKey()),
+			&azcosmos.ClientOptions{},
+		)
+		assert.Nil(t, err)
+		collectionClient, err := client.NewContainer("embedded-db", "embedded-coll")
+		assert.Nil(t, err)
+
+		response, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("a"), "seeded", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, response.RawResponse.StatusCode)
+
+		item, _ := json.Marshal(map[string]interface{}{"id": "created", "pk": "b"})
+		_, err = collectionClient.CreateItem(context.TODO(), azcosmos.NewPartitionKeyString("b"), item, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should read back the store", func(t *testing.T) {
+		state := emulator.State()
+		assert.Equal(t, "b", state.Documents["embedded-db"]["embedded-coll"]["created"]["pk"])
+		assert.Contains(t, state.Documents["embedded-db"]["embedded-coll"], "seeded")
+	})
+
+	t.Run("Should allow only one running server", func(t *testing.T) {
+		assert.ErrorContains(t, server.New(config.ServerConfig{}).Start(), "already running")
+	})
+
+	t.Run("Should stop serving", func(t *testing.T) {
+		assert.Nil(t, emulator.Stop())
+
+		_, err := http.Get(emulator.URL())
+		assert.NotNil(t, err)
+		assert.NotNil(t, emulator.Stop())
+	})
+}
@@ -0,0 +1,128 @@
+package tests_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SessionTokens(t *testing.T) {
+	ts, _ := documents_InitializeDb(t)
+	defer ts.Close()
+
+	client, err := azcosmos.NewClientFromConnectionString(
+		fmt.Sprintf("AccountEndpoint=%s;AccountKey=%s", ts.URL, config.Config.AccountKey),
+		&azcosmos.ClientOptions{},
+	)
+	assert.Nil(t, err)
+
+	databaseClient, err := client.NewDatabase(testDatabaseName)
+	assert.Nil(t, err)
+
+	_, err = databaseClient.CreateContainer(context.TODO(), azcosmos.ContainerProperties{
+		ID:                     "session-coll",
+		PartitionKeyDefinition: azcosmos.PartitionKeyDefinition{Paths: []string{"/pk"}},
+	}, nil)
+	assert.Nil(t, err)
+	defer repositories.DeleteCollection(testDatabaseName, "session-coll")
+
+	collectionClient, err := databaseClient.NewContainer("session-coll")
+	assert.Nil(t, err)
+
+	repositories.CreateDocument(testDatabaseName, "session-coll", map[string]interface{}{"id": "12345", "pk": "123"})
+
+	parseLSN := func(t *testing.T, sessionToken string) int64 {
+		rangeId, lsn, found := strings.Cut(sessionToken, ":")
+		assert.True(t, found)
+		assert.Equal(t, "0", rangeId)
+
+		parsedLSN, err := strconv.ParseInt(lsn, 10, 64)
+		assert.Nil(t, err)
+		return parsedLSN
+	}
+
+	upsertItem := func(t *testing.T, id string) azcosmos.ItemResponse {
+		response, err := collectionClient.UpsertItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("123"),
+			[]byte(fmt.Sprintf(`{"id": "%s", "pk": "123"}`, id)),
+			nil,
+		)
+		assert.Nil(t, err)
+		return response
+	}
+
+	t.Run("Should advance the session token on writes", func(t *testing.T) {
+		first := parseLSN(t, upsertItem(t, "session-1").SessionToken)
+		second := parseLSN(t, upsertItem(t, "session-2").SessionToken)
+		assert.Greater(t, second, first)
+
+		response, err := collectionClient.DeleteItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "session-1", nil)
+		assert.Nil(t, err)
+		assert.Greater(t, parseLSN(t, response.SessionToken), second)
+	})
+
+	t.Run("Should accept session tokens of previous writes", func(t *testing.T) {
+		sessionToken := upsertItem(t, "session-3").SessionToken
+
+		for _, token := range []string{sessionToken, "0:-1#1", "1:5," + sessionToken} {
+			response, err := collectionClient.ReadItem(
+				context.TODO(),
+				azcosmos.NewPartitionKeyString("123"),
+				"session-3",
+				&azcosmos.ItemOptions{SessionToken: token},
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, sessionToken, response.SessionToken)
+		}
+
+		pager := collectionClient.NewQueryItemsPager(
+			"SELECT * FROM c",
+			azcosmos.NewPartitionKeyString("123"),
+			&azcosmos.QueryOptions{SessionToken: sessionToken},
+		)
+		_, err := pager.NextPage(context.TODO())
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should reject session tokens ahead of the collection", func(t *testing.T) {
+		_, err := collectionClient.ReadItem(
+			context.TODO(),
+			azcosmos.NewPartitionKeyString("123"),
+			"12345",
+			&azcosmos.ItemOptions{SessionToken: "0:999999999"},
+		)
+
+		var respErr *azcore.ResponseError
+		if assert.True(t, errors.As(err, &respErr)) {
+			assert.Equal(t, http.StatusNotFound, respErr.StatusCode)
+			assert.Equal(t, "1002", respErr.RawResponse.Header.Get("x-ms-substatus"))
+		}
+	})
+
+	t.Run("Should reject malformed session tokens", func(t *testing.T) {
+		for _, token := range []string{"invalid", "0:abc", ":5", "0:x#5"} {
+			_, err := collectionClient.ReadItem(
+				context.TODO(),
+				azcosmos.NewPartitionKeyString("123"),
+				"12345",
+				&azcosmos.ItemOptions{SessionToken: token},
+			)
+
+			var respErr *azcore.ResponseError
+			if assert.True(t, errors.As(err, &respErr), token) {
+				assert.Equal(t, http.StatusBadRequest, respErr.StatusCode, token)
+			}
+		}
+	})
+}
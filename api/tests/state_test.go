@@ -0,0 +1,199 @@
+package tests_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StateEndpoint(t *testing.T) {
+	repositories.DeleteDatabase(testDatabaseName)
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+	repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+		ID:           testCollectionName,
+		PartitionKey: repositorymodels.CollectionPartitionKey{Paths: []string{"/pk"}},
+	})
+	repositories.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{"id": "12345", "pk": "123"})
+
+	t.Run("Should not expose state by default", func(t *testing.T) {
+		config.Config.EnableStateEndpoint = false
+		ts := runTestServer()
+		defer ts.Close()
+
+		res, err := http.Get(ts.URL + "/_state")
+		assert.Nil(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("Should export state when enabled", func(t *testing.T) {
+		config.Config.EnableStateEndpoint = true
+		defer func() { config.Config.EnableStateEndpoint = false }()
+		ts := runTestServer()
+		defer ts.Close()
+
+		res, err := http.Get(ts.URL + "/_state")
+		assert.Nil(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		var state repositorymodels.State
+		assert.Nil(t, json.NewDecoder(res.Body).Decode(&state))
+		assert.Contains(t, state.Databases, testDatabaseName)
+		assert.Equal(t, []string{"/pk"}, state.Collections[testDatabaseName][testCollectionName].PartitionKey.Paths)
+		assert.Equal(t, "123", state.Documents[testDatabaseName][testCollectionName]["12345"]["pk"])
+	})
+	t.Run("Should import a previous export", func(t *testing.T) {
+		config.Config.EnableStateEndpoint = true
+		defer func() { config.Config.EnableStateEndpoint = false }()
+		ts := runTestServer()
+		defer ts.Close()
+
+		exported, _ := json.Marshal(repositories.GetState())
+		repositories.DeleteDatabase(testDatabaseName)
+
+		res, err := http.Post(ts.URL+"/_state", "application/json", bytes.NewReader(exported))
+		assert.Nil(t, err)
+		res.Body.Close()
+		assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+		document, status := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+		assert.Equal(t, "123", document["pk"])
+	})
+
+	t.Run("Should reject a malformed snapshot and keep the current state", func(t *testing.T) {
+		config.Config.EnableStateEndpoint = true
+		defer func() { config.Config.EnableStateEndpoint = false }()
+		ts := runTestServer()
+		defer ts.Close()
+
+		for _, snapshot := range []string{
+			`{"databases": []}`,
+			`{"unknownField": {}}`,
+			`{"databases": {"db1": {"id": "db2"}}, "collections": {"db3": {"coll1": {"id": "coll1"}}}}`,
+		} {
+			res, err := http.Post(ts.URL+"/_state", "application/json", bytes.NewReader([]byte(snapshot)))
+			assert.Nil(t, err)
+
+			var body map[string]interface{}
+			json.NewDecoder(res.Body).Decode(&body)
+			res.Body.Close()
+
+			assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+			assert.NotEmpty(t, body["errors"])
+		}
+
+		_, status := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+	})
+
+	t.Run("Should generate system properties for imported documents without them", func(t *testing.T) {
+		var state map[string]interface{}
+		exported, _ := json.Marshal(repositories.GetState())
+		json.Unmarshal(exported, &state)
+
+		documents := state["documents"].(map[string]interface{})[testDatabaseName].(map[string]interface{})[testCollectionName].(map[string]interface{})
+		documents["12345"] = map[string]interface{}{"id": "12345", "pk": "123"}
+		snapshot, _ := json.Marshal(state)
+
+		status, err := repositories.ImportState(snapshot)
+		assert.Nil(t, err)
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+
+		document, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		assert.NotEmpty(t, document["_rid"])
+		assert.NotEmpty(t, document["_etag"])
+		assert.NotEmpty(t, document["_ts"])
+		assert.NotEmpty(t, document["_self"])
+		assert.Equal(t, "attachments/", document["_attachments"])
+	})
+
+	t.Run("Should quote the etags of imported documents", func(t *testing.T) {
+		var state map[string]interface{}
+		exported, _ := json.Marshal(repositories.GetState())
+		json.Unmarshal(exported, &state)
+
+		documents := state["documents"].(map[string]interface{})[testDatabaseName].(map[string]interface{})[testCollectionName].(map[string]interface{})
+		document := documents["12345"].(map[string]interface{})
+		document["_etag"] = "hand-written"
+		documents["67890"] = map[string]interface{}{"id": "67890", "pk": "123", "_rid": document["_rid"], "_attachments": "attachments/"}
+		snapshot, _ := json.Marshal(state)
+
+		status, err := repositories.ImportState(snapshot)
+		assert.Nil(t, err)
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+
+		quoted, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "12345")
+		assert.Equal(t, `"hand-written"`, quoted["_etag"])
+
+		generated, _ := repositories.GetDocument(testDatabaseName, testCollectionName, "67890")
+		assert.Regexp(t, `^"[0-9a-f-]{36}"$`, generated["_etag"])
+	})
+
+	t.Run("Should generate system properties for imported conflicts", func(t *testing.T) {
+		state := repositories.CopyState()
+		state.Conflicts = map[string]map[string]map[string]repositorymodels.ConflictResource{
+			testDatabaseName: {testCollectionName: {"conflict-1": {ID: "conflict-1"}}},
+		}
+		snapshot, _ := json.Marshal(state)
+
+		status, err := repositories.ImportState(snapshot)
+		assert.Nil(t, err)
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+
+		conflict, status := repositories.GetConflict(testDatabaseName, testCollectionName, "conflict-1")
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+		assert.NotEmpty(t, conflict.ResourceID)
+		assert.NotEmpty(t, conflict.Etag)
+		assert.Equal(t, "document", conflict.ResourceType)
+	})
+
+	t.Run("Should generate system properties for imported databases without them", func(t *testing.T) {
+		var state map[string]interface{}
+		exported, _ := json.Marshal(repositories.GetState())
+		json.Unmarshal(exported, &state)
+
+		state["databases"].(map[string]interface{})["imported-db"] = map[string]interface{}{"id": "imported-db"}
+		snapshot, _ := json.Marshal(state)
+
+		status, err := repositories.ImportState(snapshot)
+		assert.Nil(t, err)
+		assert.Equal(t, repositorymodels.StatusOk, int(status))
+		defer repositories.DeleteDatabase("imported-db")
+
+		database, _ := repositories.GetDatabase("imported-db")
+		assert.NotEmpty(t, database.ResourceID)
+		assert.NotEmpty(t, database.ETag)
+		assert.Equal(t, fmt.Sprintf("dbs/%s/", database.ResourceID), database.Self)
+	})
+
+	t.Run("Should save state to file", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "state.json")
+		assert.Nil(t, repositories.SaveStateFS(filePath))
+
+		data, err := os.ReadFile(filePath)
+		assert.Nil(t, err)
+
+		var state repositorymodels.State
+		assert.Nil(t, json.Unmarshal(data, &state))
+		assert.Contains(t, state.Documents[testDatabaseName][testCollectionName], "12345")
+
+		_, err = os.Stat(filePath + ".tmp")
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("Should fail to save state to a missing directory", func(t *testing.T) {
+		err := repositories.SaveStateFS(filepath.Join(t.TempDir(), "missing", "state.json"))
+		assert.NotNil(t, err)
+	})
+}
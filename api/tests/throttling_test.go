@@ -0,0 +1,184 @@
+package tests_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Throttling(t *testing.T) {
+	repositories.CreateDatabase(repositorymodels.Database{ID: testDatabaseName})
+	repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+		ID:           "throttling-coll",
+		PartitionKey: repositorymodels.CollectionPartitionKey{Paths: []string{"/pk"}},
+	})
+	defer repositories.DeleteCollection(testDatabaseName, "throttling-coll")
+	repositories.CreateCollection(testDatabaseName, repositorymodels.Collection{
+		ID:           "throttling-other-coll",
+		PartitionKey: repositorymodels.CollectionPartitionKey{Paths: []string{"/pk"}},
+	})
+	defer repositories.DeleteCollection(testDatabaseName, "throttling-other-coll")
+	repositories.CreateDocument(testDatabaseName, "throttling-coll", map[string]interface{}{"id": "12345", "pk": "a"})
+
+	defer func() {
+		config.Config.ThrottleRate = 0
+		config.Config.ThrottleRUBudget = 0
+		config.Config.ThrottleRetryAfter = 0
+		config.Config.ThrottleSeed = 0
+		config.Config.ThrottleEveryNthWrite = 0
+	}()
+
+	documentPath := fmt.Sprintf("dbs/%s/colls/throttling-coll/docs/12345", testDatabaseName)
+	readDocument := func(t *testing.T, serverUrl string) (int, http.Header) {
+		status, headers, _ := sendSignedRequestWithHeaders(t, serverUrl, documentPath, http.MethodGet, "docs", documentPath, map[string]string{
+			"x-ms-documentdb-partitionkey": `["a"]`,
+		}, nil)
+		return status, headers
+	}
+
+	readDocuments := func(t *testing.T, count int) []int {
+		ts := runTestServer()
+		defer ts.Close()
+
+		statuses := make([]int, count)
+		for i := range statuses {
+			statuses[i], _ = readDocument(t, ts.URL)
+		}
+		return statuses
+	}
+
+	t.Run("Should throttle the same requests for the same seed", func(t *testing.T) {
+		config.Config.ThrottleRate = 0.5
+		config.Config.ThrottleSeed = 42
+		defer func() { config.Config.ThrottleRate = 0 }()
+
+		statuses := readDocuments(t, 50)
+		assert.Contains(t, statuses, http.StatusOK)
+		assert.Contains(t, statuses, http.StatusTooManyRequests)
+		assert.Equal(t, statuses, readDocuments(t, 50))
+
+		config.Config.ThrottleSeed = 7
+		assert.NotEqual(t, statuses, readDocuments(t, 50))
+	})
+
+	t.Run("Should advertise the retry delay of throttled requests", func(t *testing.T) {
+		config.Config.ThrottleRate = 1
+		config.Config.ThrottleRetryAfter = 250 * time.Millisecond
+		defer func() { config.Config.ThrottleRate = 0 }()
+
+		ts := runTestServer()
+		defer ts.Close()
+
+		status, headers := readDocument(t, ts.URL)
+		assert.Equal(t, http.StatusTooManyRequests, status)
+		assert.Equal(t, "250", headers.Get("x-ms-retry-after-ms"))
+		assert.Equal(t, "3200", headers.Get("x-ms-substatus"))
+	})
+
+	t.Run("Should throttle requests exceeding the request units budget", func(t *testing.T) {
+		config.Config.ThrottleRUBudget = 2
+		defer func() { config.Config.ThrottleRUBudget = 0 }()
+
+		ts := runTestServer()
+		defer ts.Close()
+
+		// Point reads cost one request unit
+		status, _ := readDocument(t, ts.URL)
+		assert.Equal(t, http.StatusOK, status)
+		status, _ = readDocument(t, ts.URL)
+		assert.Equal(t, http.StatusOK, status)
+
+		status, headers := readDocument(t, ts.URL)
+		assert.Equal(t, http.StatusTooManyRequests, status)
+		retryAfter, err := strconv.Atoi(headers.Get("x-ms-retry-after-ms"))
+		assert.Nil(t, err)
+		assert.Greater(t, retryAfter, 0)
+		assert.LessOrEqual(t, retryAfter, 1000)
+
+		time.Sleep(time.Duration(retryAfter) * time.Millisecond)
+		status, _ = readDocument(t, ts.URL)
+		assert.Equal(t, http.StatusOK, status)
+	})
+	writeDocuments := func(t *testing.T, serverUrl string, collectionId string, count int) []int {
+		collectionPath := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, collectionId)
+		statuses := make([]int, count)
+		for i := range statuses {
+			statuses[i], _, _ = sendSignedRequestWithHeaders(t, serverUrl, collectionPath+"/docs", http.MethodPost, "docs", collectionPath, map[string]string{
+				"x-ms-documentdb-partitionkey": `["a"]`,
+				"x-ms-documentdb-is-upsert":    "true",
+			}, map[string]interface{}{"id": "written", "pk": "a"})
+		}
+		return statuses
+	}
+
+	t.Run("Should throttle every nth write", func(t *testing.T) {
+		config.Config.ThrottleEveryNthWrite = 3
+		defer func() { config.Config.ThrottleEveryNthWrite = 0 }()
+
+		ts := runTestServer()
+		defer ts.Close()
+
+		// Reads don't count towards the writes
+		status, _ := readDocument(t, ts.URL)
+		assert.Equal(t, http.StatusOK, status)
+
+		assert.Equal(t, []int{
+			http.StatusCreated, http.StatusOK, http.StatusTooManyRequests,
+			http.StatusOK, http.StatusOK, http.StatusTooManyRequests,
+		}, writeDocuments(t, ts.URL, "throttling-coll", 6))
+
+		// Every collection counts its own writes
+		assert.Equal(t, []int{http.StatusCreated, http.StatusOK, http.StatusTooManyRequests}, writeDocuments(t, ts.URL, "throttling-other-coll", 3))
+	})
+
+	t.Run("Should budget the request units of each collection", func(t *testing.T) {
+		config.Config.ThrottleRUBudget = 1
+		defer func() { config.Config.ThrottleRUBudget = 0 }()
+
+		ts := runTestServer()
+		defer ts.Close()
+
+		assert.Equal(t, []int{http.StatusOK, http.StatusTooManyRequests}, writeDocuments(t, ts.URL, "throttling-other-coll", 2))
+
+		status, _ := readDocument(t, ts.URL)
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("Should override the throttling of a collection", func(t *testing.T) {
+		config.Config.EnableStateEndpoint = true
+		ts := runTestServer()
+		defer ts.Close()
+		config.Config.EnableStateEndpoint = false
+
+		throttlingUrl := fmt.Sprintf("%s/_state/dbs/%s/colls/throttling-coll/throttling", ts.URL, testDatabaseName)
+		sendThrottling := func(t *testing.T, method string, settings map[string]interface{}) int {
+			body, _ := json.Marshal(settings)
+			req, err := http.NewRequest(method, throttlingUrl, bytes.NewReader(body))
+			assert.Nil(t, err)
+			res, err := http.DefaultClient.Do(req)
+			assert.Nil(t, err)
+			res.Body.Close()
+			return res.StatusCode
+		}
+
+		assert.Equal(t, http.StatusOK, sendThrottling(t, http.MethodPut, map[string]interface{}{"everyNthWrite": 2}))
+		assert.Equal(t, []int{http.StatusOK, http.StatusTooManyRequests}, writeDocuments(t, ts.URL, "throttling-coll", 2))
+		assert.Equal(t, []int{http.StatusOK, http.StatusOK}, writeDocuments(t, ts.URL, "throttling-other-coll", 2))
+
+		assert.Equal(t, http.StatusNoContent, sendThrottling(t, http.MethodDelete, nil))
+		assert.Equal(t, []int{http.StatusOK, http.StatusOK}, writeDocuments(t, ts.URL, "throttling-coll", 2))
+
+		assert.Equal(t, http.StatusBadRequest, sendThrottling(t, http.MethodPut, map[string]interface{}{"ruBudget": -1}))
+		throttlingUrl = fmt.Sprintf("%s/_state/dbs/%s/colls/missing-coll/throttling", ts.URL, testDatabaseName)
+		assert.Equal(t, http.StatusNotFound, sendThrottling(t, http.MethodPut, map[string]interface{}{"everyNthWrite": 2}))
+	})
+}
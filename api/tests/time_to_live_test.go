@@ -0,0 +1,123 @@
+package tests_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Documents_TimeToLive(t *testing.T) {
+	ts, collectionClient := documents_InitializeDb(t)
+	defer ts.Close()
+
+	client, err := azcosmos.NewClientFromConnectionString(
+		fmt.Sprintf("AccountEndpoint=%s;AccountKey=%s", ts.URL, config.Config.AccountKey),
+		&azcosmos.ClientOptions{},
+	)
+	assert.Nil(t, err)
+
+	databaseClient, err := client.NewDatabase(testDatabaseName)
+	assert.Nil(t, err)
+
+	defaultTimeToLive := int32(3600)
+	_, err = databaseClient.CreateContainer(context.TODO(), azcosmos.ContainerProperties{
+		ID:                     "ttl-coll",
+		DefaultTimeToLive:      &defaultTimeToLive,
+		PartitionKeyDefinition: azcosmos.PartitionKeyDefinition{Paths: []string{"/pk"}},
+	}, nil)
+	assert.Nil(t, err)
+	defer repositories.DeleteCollection(testDatabaseName, "ttl-coll")
+
+	ttlCollectionClient, err := databaseClient.NewContainer("ttl-coll")
+	assert.Nil(t, err)
+
+	// Documents are written through the repository to backdate their timestamps
+	createDocument := func(collectionId string, document map[string]interface{}, age time.Duration) {
+		createdDocument, _ := repositories.CreateDocument(testDatabaseName, collectionId, document)
+		createdDocument["_ts"] = time.Now().Add(-age).Unix()
+	}
+	createDocument("ttl-coll", map[string]interface{}{"id": "default-expired", "pk": "a"}, 2*time.Hour)
+	createDocument("ttl-coll", map[string]interface{}{"id": "default-live", "pk": "a"}, time.Minute)
+	createDocument("ttl-coll", map[string]interface{}{"id": "never", "pk": "a", "ttl": -1}, 2*time.Hour)
+	createDocument("ttl-coll", map[string]interface{}{"id": "override-expired", "pk": "a", "ttl": 10}, time.Minute)
+	createDocument("ttl-coll", map[string]interface{}{"id": "override-live", "pk": "a", "ttl": 7200}, 90*time.Minute)
+	createDocument(testCollectionName, map[string]interface{}{"id": "ttl-disabled", "pk": "a", "ttl": 10}, time.Hour)
+
+	t.Run("Should not query expired documents", func(t *testing.T) {
+		testCosmosQuery(t, ttlCollectionClient,
+			"SELECT c.id FROM c ORDER BY c.id",
+			nil,
+			[]interface{}{
+				map[string]interface{}{"id": "default-live"},
+				map[string]interface{}{"id": "never"},
+				map[string]interface{}{"id": "override-live"},
+			},
+		)
+	})
+
+	t.Run("Should not read expired documents", func(t *testing.T) {
+		for _, id := range []string{"default-expired", "override-expired"} {
+			_, err := ttlCollectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("a"), id, nil)
+
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) {
+				assert.Equal(t, http.StatusNotFound, respErr.StatusCode)
+			} else {
+				panic(err)
+			}
+		}
+
+		_, err := ttlCollectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("a"), "never", nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should ignore document ttl when the collection has no default", func(t *testing.T) {
+		_, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("a"), "ttl-disabled", nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should purge expired documents", func(t *testing.T) {
+		assert.Equal(t, 2, repositories.PurgeExpiredDocuments())
+		assert.Equal(t, 0, repositories.PurgeExpiredDocuments())
+
+		documents := repositories.GetState().Documents[testDatabaseName]["ttl-coll"]
+		assert.Len(t, documents, 3)
+		assert.NotContains(t, documents, "default-expired")
+		assert.NotContains(t, documents, "override-expired")
+	})
+
+	t.Run("Should create documents in place of expired ones", func(t *testing.T) {
+		createDocument("ttl-coll", map[string]interface{}{"id": "recreated", "pk": "a", "ttl": 10}, time.Minute)
+
+		_, err := ttlCollectionClient.CreateItem(context.TODO(), azcosmos.NewPartitionKeyString("a"), []byte(`{"id": "recreated", "pk": "a"}`), nil)
+		assert.Nil(t, err)
+
+		_, err = ttlCollectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("a"), "recreated", nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Should reject invalid default time to live", func(t *testing.T) {
+		invalidTimeToLive := int32(0)
+		_, err := databaseClient.CreateContainer(context.TODO(), azcosmos.ContainerProperties{
+			ID:                     "ttl-invalid",
+			DefaultTimeToLive:      &invalidTimeToLive,
+			PartitionKeyDefinition: azcosmos.PartitionKeyDefinition{Paths: []string{"/pk"}},
+		}, nil)
+
+		var respErr *azcore.ResponseError
+		if errors.As(err, &respErr) {
+			assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+		} else {
+			panic(err)
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package tests_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/stretchr/testify/assert"
+)
+
+func sendResourceTokenRequest(t *testing.T, serverUrl string, method string, path string, token string, body string) int {
+	req, _ := http.NewRequest(method, serverUrl+"/"+path, strings.NewReader(body))
+	req.Header.Add("authorization", url.QueryEscape(token))
+	// The documents requested with resource tokens are all in the "123" partition
+	req.Header.Add("x-ms-documentdb-partitionkey", `["123"]`)
+	if body != "" {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	defer res.Body.Close()
+
+	return res.StatusCode
+}
+
+func Test_UsersAndPermissions(t *testing.T) {
+	ts, _ := documents_InitializeDb(t)
+	defer ts.Close()
+
+	databasePath := fmt.Sprintf("dbs/%s", testDatabaseName)
+	usersPath := databasePath + "/users"
+	userPath := usersPath + "/test-user"
+	permissionsPath := userPath + "/permissions"
+	collectionPath := fmt.Sprintf("%s/colls/%s", databasePath, testCollectionName)
+
+	t.Run("Should create and read user", func(t *testing.T) {
+		status, body := sendSignedRequest(t, ts.URL, usersPath, http.MethodPost, "users", databasePath, map[string]interface{}{"id": "test-user"})
+		assert.Equal(t, http.StatusCreated, status)
+		assert.Equal(t, "test-user", body["id"])
+		assert.Equal(t, "permissions/", body["_permissions"])
+
+		status, _ = sendSignedRequest(t, ts.URL, usersPath, http.MethodPost, "users", databasePath, map[string]interface{}{"id": "test-user"})
+		assert.Equal(t, http.StatusConflict, status)
+
+		status, body = sendSignedRequest(t, ts.URL, userPath, http.MethodGet, "users", userPath, nil)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "test-user", body["id"])
+
+		status, body = sendSignedRequest(t, ts.URL, usersPath, http.MethodGet, "users", databasePath, nil)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, float64(1), body["_count"])
+	})
+
+	var readToken, allToken string
+	t.Run("Should create permissions with tokens", func(t *testing.T) {
+		status, body := sendSignedRequest(t, ts.URL, permissionsPath, http.MethodPost, "permissions", userPath, map[string]interface{}{
+			"id":             "read-permission",
+			"permissionMode": "Read",
+			"resource":       collectionPath,
+		})
+		assert.Equal(t, http.StatusCreated, status)
+		assert.NotEmpty(t, body["_token"])
+		readToken, _ = body["_token"].(string)
+
+		collection, _ := repositories.GetCollection(testDatabaseName, testCollectionName)
+		status, body = sendSignedRequest(t, ts.URL, permissionsPath, http.MethodPost, "permissions", userPath, map[string]interface{}{
+			"id":             "all-permission",
+			"permissionMode": "All",
+			"resource":       collection.Self,
+		})
+		assert.Equal(t, http.StatusCreated, status)
+		allToken, _ = body["_token"].(string)
+
+		status, _ = sendSignedRequest(t, ts.URL, permissionsPath, http.MethodPost, "permissions", userPath, map[string]interface{}{
+			"id":             "invalid-permission",
+			"permissionMode": "Write",
+			"resource":       collectionPath,
+		})
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		status, body = sendSignedRequest(t, ts.URL, permissionsPath+"/read-permission", http.MethodGet, "permissions", permissionsPath+"/read-permission", nil)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, readToken, body["_token"])
+	})
+
+	t.Run("Should scope read resource token", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, sendResourceTokenRequest(t, ts.URL, http.MethodGet, collectionPath+"/docs/12345", readToken, ""))
+		assert.Equal(t, http.StatusForbidden, sendResourceTokenRequest(t, ts.URL, http.MethodDelete, collectionPath+"/docs/12345", readToken, ""))
+		assert.Equal(t, http.StatusForbidden, sendResourceTokenRequest(t, ts.URL, http.MethodGet, databasePath+"/colls", readToken, ""))
+		assert.Equal(t, http.StatusForbidden, sendResourceTokenRequest(t, ts.URL, http.MethodGet, databasePath+"/colls/other-coll", readToken, ""))
+	})
+
+	t.Run("Should allow writes with all resource token", func(t *testing.T) {
+		assert.Equal(t, http.StatusCreated, sendResourceTokenRequest(t, ts.URL, http.MethodPost, collectionPath+"/docs", allToken, `{"id":"token-doc","pk":"123"}`))
+		assert.Equal(t, http.StatusNoContent, sendResourceTokenRequest(t, ts.URL, http.MethodDelete, collectionPath+"/docs/token-doc", allToken, ""))
+	})
+
+	t.Run("Should reject unknown resource token", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, sendResourceTokenRequest(t, ts.URL, http.MethodGet, collectionPath+"/docs/12345", "type=resource&ver=1.0&sig=unknown", ""))
+	})
+
+	t.Run("Should delete permission and user", func(t *testing.T) {
+		status, _ := sendSignedRequest(t, ts.URL, permissionsPath+"/read-permission", http.MethodDelete, "permissions", permissionsPath+"/read-permission", nil)
+		assert.Equal(t, http.StatusNoContent, status)
+		assert.Equal(t, http.StatusUnauthorized, sendResourceTokenRequest(t, ts.URL, http.MethodGet, collectionPath+"/docs/12345", readToken, ""))
+
+		status, _ = sendSignedRequest(t, ts.URL, userPath, http.MethodDelete, "users", userPath, nil)
+		assert.Equal(t, http.StatusNoContent, status)
+
+		_, repositoryStatus := repositories.GetUser(testDatabaseName, "test-user")
+		assert.Equal(t, repositorymodels.StatusNotFound, int(repositoryStatus))
+	})
+}
@@ -4,10 +4,84 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"net/url"
 	"strings"
+	"time"
 )
 
+const (
+	TokenTypeMaster   = "master"
+	TokenTypeResource = "resource"
+
+	// Requests signed longer ago than this are rejected, same as the service does
+	MaxRequestDateSkew = 15 * time.Minute
+)
+
+type AuthorizationToken struct {
+	Type      string
+	Version   string
+	Signature string
+}
+
+// Parses the url encoded "type={type}&ver={version}&sig={signature}" authorization header
+func ParseAuthorizationHeader(header string) (AuthorizationToken, error) {
+	if header == "" {
+		return AuthorizationToken{}, errors.New("required header 'authorization' is missing")
+	}
+
+	decoded, err := url.QueryUnescape(header)
+	if err != nil {
+		return AuthorizationToken{}, fmt.Errorf("authorization header is not url encoded: %w", err)
+	}
+
+	params, err := url.ParseQuery(decoded)
+	if err != nil {
+		return AuthorizationToken{}, fmt.Errorf("authorization header is malformed: %w", err)
+	}
+
+	token := AuthorizationToken{
+		Type:    strings.ToLower(params.Get("type")),
+		Version: params.Get("ver"),
+		// ParseQuery decodes '+' as a space, signatures are base64 so put them back
+		Signature: strings.Replace(params.Get("sig"), " ", "+", -1),
+	}
+
+	if token.Signature == "" {
+		return AuthorizationToken{}, errors.New("authorization header is missing the signature")
+	}
+
+	// Clients of the emulator are allowed to omit the type, it defaults to a master key signature
+	if token.Type == "" {
+		token.Type = TokenTypeMaster
+	}
+
+	if token.Type != TokenTypeMaster && token.Type != TokenTypeResource {
+		return AuthorizationToken{}, fmt.Errorf("authorization token type '%s' is not supported", token.Type)
+	}
+
+	return token, nil
+}
+
+// Ensures the signed date is present and close enough to the current time
+func ValidateRequestDate(date string, now time.Time) error {
+	if date == "" {
+		return errors.New("required header 'x-ms-date' is missing")
+	}
+
+	requestTime, err := time.Parse(time.RFC1123, date)
+	if err != nil {
+		return fmt.Errorf("the 'x-ms-date' header '%s' is not a valid RFC1123 date", date)
+	}
+
+	if skew := now.Sub(requestTime); skew > MaxRequestDateSkew || skew < -MaxRequestDateSkew {
+		return fmt.Errorf("the request date '%s' is outside of the allowed %s window", date, MaxRequestDateSkew)
+	}
+
+	return nil
+}
+
 // https://learn.microsoft.com/en-us/rest/api/cosmos-db/access-control-on-cosmosdb-resources
 func GenerateSignature(verb string, resourceType string, resourceId string, date string, masterKey string) string {
 	isNameBased := resourceId != "" && ((len(resourceId) > 4 && resourceId[3] == '/') || strings.HasPrefix(strings.ToLower(resourceId), "interopusers"))
@@ -29,3 +103,15 @@ func GenerateSignature(verb string, resourceType string, resourceId string, date
 	signature := base64.StdEncoding.EncodeToString(hash.Sum(nil))
 	return signature
 }
+
+// Generates a resource token for a permission, the signature is derived
+// from the permission so tokens stay stable when the state is persisted
+func GenerateResourceToken(permissionResourceId string, resource string, permissionMode string, masterKey string) string {
+	payload := fmt.Sprintf("%s\n%s\n%s\n", permissionResourceId, strings.ToLower(resource), strings.ToLower(permissionMode))
+
+	masterKeyBytes, _ := base64.StdEncoding.DecodeString(masterKey)
+	hash := hmac.New(sha256.New, masterKeyBytes)
+	hash.Write([]byte(payload))
+	signature := base64.StdEncoding.EncodeToString(hash.Sum(nil))
+	return fmt.Sprintf("type=resource&ver=1.0&sig=%s", signature)
+}
@@ -1,7 +1,9 @@
 package authentication_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/pikami/cosmium/api/config"
 	"github.com/pikami/cosmium/internal/authentication"
@@ -38,3 +40,64 @@ func Test_GenerateSignature(t *testing.T) {
 		assert.Equal(t, "VR1ddfxKBXnoaT+b3WkhyYVc9JmGNpTnaRmyDM44398=", signature)
 	})
 }
+
+func Test_GenerateResourceToken(t *testing.T) {
+	t.Run("Should generate resource token", func(t *testing.T) {
+		token := authentication.GenerateResourceToken("m4d+xG08uVMBAAAAAAAAAA==", "dbs/test-db/colls/test-coll", "Read", config.DefaultAccountKey)
+		assert.True(t, strings.HasPrefix(token, "type=resource&ver=1.0&sig="))
+	})
+
+	t.Run("Should generate different tokens per permission mode", func(t *testing.T) {
+		readToken := authentication.GenerateResourceToken("m4d+xG08uVMBAAAAAAAAAA==", "dbs/test-db/colls/test-coll", "Read", config.DefaultAccountKey)
+		allToken := authentication.GenerateResourceToken("m4d+xG08uVMBAAAAAAAAAA==", "dbs/test-db/colls/test-coll", "All", config.DefaultAccountKey)
+		assert.NotEqual(t, readToken, allToken)
+	})
+}
+
+func Test_ParseAuthorizationHeader(t *testing.T) {
+	t.Run("Should parse master key token", func(t *testing.T) {
+		token, err := authentication.ParseAuthorizationHeader("type%3Dmaster%26ver%3D1.0%26sig%3DVR1ddfxKBXnoaT%2Bb3WkhyYVc9JmGNpTnaRmyDM44398%3D")
+		assert.Nil(t, err)
+		assert.Equal(t, authentication.TokenTypeMaster, token.Type)
+		assert.Equal(t, "1.0", token.Version)
+		assert.Equal(t, "VR1ddfxKBXnoaT+b3WkhyYVc9JmGNpTnaRmyDM44398=", token.Signature)
+	})
+
+	t.Run("Should default to master key token when type is omitted", func(t *testing.T) {
+		token, err := authentication.ParseAuthorizationHeader("sig=VR1ddfxKBXnoaT+b3WkhyYVc9JmGNpTnaRmyDM44398=")
+		assert.Nil(t, err)
+		assert.Equal(t, authentication.TokenTypeMaster, token.Type)
+		assert.Equal(t, "VR1ddfxKBXnoaT+b3WkhyYVc9JmGNpTnaRmyDM44398=", token.Signature)
+	})
+
+	t.Run("Should parse resource token", func(t *testing.T) {
+		token, err := authentication.ParseAuthorizationHeader("type%3Dresource%26ver%3D1.0%26sig%3Dabc")
+		assert.Nil(t, err)
+		assert.Equal(t, authentication.TokenTypeResource, token.Type)
+	})
+
+	t.Run("Should reject missing header, missing signature and unsupported types", func(t *testing.T) {
+		for _, header := range []string{"", "type%3Dmaster%26ver%3D1.0", "type%3Daad%26ver%3D1.0%26sig%3Dabc"} {
+			_, err := authentication.ParseAuthorizationHeader(header)
+			assert.NotNil(t, err, header)
+		}
+	})
+}
+
+func Test_ValidateRequestDate(t *testing.T) {
+	now, _ := time.Parse(time.RFC1123, testDate)
+
+	t.Run("Should accept recent date", func(t *testing.T) {
+		assert.Nil(t, authentication.ValidateRequestDate(testDate, now.Add(time.Minute)))
+	})
+
+	t.Run("Should reject stale and future dates", func(t *testing.T) {
+		assert.NotNil(t, authentication.ValidateRequestDate(testDate, now.Add(time.Hour)))
+		assert.NotNil(t, authentication.ValidateRequestDate(testDate, now.Add(-time.Hour)))
+	})
+
+	t.Run("Should reject missing or malformed date", func(t *testing.T) {
+		assert.NotNil(t, authentication.ValidateRequestDate("", now))
+		assert.NotNil(t, authentication.ValidateRequestDate("yesterday", now))
+	})
+}
@@ -0,0 +1,106 @@
+// Package jsonnumbers decodes JSON without rounding numbers through float64. Integers
+// are decoded as int, so ids and counters above 2^53 keep their exact value, other
+// numbers are decoded as float64 when it holds them exactly and are kept as
+// json.Number otherwise, which is written back with every digit
+package jsonnumbers
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+func Unmarshal(data []byte, v interface{}) error {
+	return Decode(json.NewDecoder(bytes.NewReader(data)), v)
+}
+
+// Decodes the next value of the decoder into v, options like
+// DisallowUnknownFields set on the decoder are kept
+func Decode(decoder *json.Decoder, v interface{}) error {
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+
+	convertNumbers(reflect.ValueOf(v))
+	return nil
+}
+
+// Replaces the json.Number values held by interfaces, numbers decoded
+// into typed fields are converted by encoding/json already
+func convertNumbers(value reflect.Value) {
+	switch value.Kind() {
+	case reflect.Pointer:
+		if !value.IsNil() {
+			convertNumbers(value.Elem())
+		}
+	case reflect.Interface:
+		if value.IsNil() {
+			return
+		}
+
+		if number, ok := value.Interface().(json.Number); ok {
+			if value.CanSet() {
+				value.Set(reflect.ValueOf(toNumber(number)))
+			}
+			return
+		}
+
+		// Maps are changed in place and slice elements can be set, so the copy is enough
+		convertNumbers(value.Elem())
+	case reflect.Map:
+		for _, key := range value.MapKeys() {
+			element := reflect.New(value.Type().Elem()).Elem()
+			element.Set(value.MapIndex(key))
+			convertNumbers(element)
+			value.SetMapIndex(key, element)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			convertNumbers(value.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).IsExported() {
+				convertNumbers(value.Field(i))
+			}
+		}
+	}
+}
+
+// Parses a number literal the way decoded numbers are converted
+func Parse(number string) interface{} {
+	return toNumber(json.Number(number))
+}
+
+func toNumber(number json.Number) interface{} {
+	if integer, err := strconv.ParseInt(string(number), 10, 0); err == nil {
+		return int(integer)
+	}
+
+	// Integers beyond the int range would lose digits as a float
+	if !strings.ContainsAny(string(number), ".eE") {
+		return number
+	}
+
+	float, err := number.Float64()
+	if err != nil || significantDigits(strconv.FormatFloat(float, 'e', -1, 64)) != significantDigits(string(number)) {
+		return number
+	}
+
+	return float
+}
+
+// Strips the sign, decimal point, exponent and the surrounding zeros,
+// so "0.0150" and "1.5e-2" both give "15"
+func significantDigits(number string) string {
+	if index := strings.IndexAny(number, "eE"); index >= 0 {
+		number = number[:index]
+	}
+
+	number = strings.TrimLeft(number, "-+")
+	number = strings.Replace(number, ".", "", 1)
+	return strings.Trim(number, "0")
+}
@@ -1,40 +1,113 @@
 package logger
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"log/slog"
 	"os"
+	"strings"
 
 	"github.com/pikami/cosmium/api/config"
 )
 
 var DebugLogger = log.New(os.Stdout, "", log.Ldate|log.Ltime|log.Lshortfile)
 var InfoLogger = log.New(os.Stdout, "", log.Ldate|log.Ltime)
+var WarnLogger = log.New(os.Stdout, "", log.Ldate|log.Ltime|log.Lshortfile)
 var ErrorLogger = log.New(os.Stderr, "", log.Ldate|log.Ltime|log.Lshortfile)
 
+// Used by the json log format, errors are written to stderr like in the text format
+var jsonLogger = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+var jsonErrorLogger = slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+var logLevels = map[string]slog.Level{
+	config.LogLevelDebug: slog.LevelDebug,
+	config.LogLevelInfo:  slog.LevelInfo,
+	config.LogLevelWarn:  slog.LevelWarn,
+	config.LogLevelError: slog.LevelError,
+}
+
 func Debug(v ...any) {
-	if config.Config.Debug {
-		DebugLogger.Println(v...)
-	}
+	write(slog.LevelDebug, DebugLogger, fmt.Sprintln(v...))
 }
 
 func Debugf(format string, v ...any) {
-	if config.Config.Debug {
-		DebugLogger.Printf(format, v...)
-	}
+	write(slog.LevelDebug, DebugLogger, fmt.Sprintf(format, v...))
+}
+
+// Logs the message with key-value pairs, which become fields of the json log format
+func Debugw(msg string, keysAndValues ...any) {
+	write(slog.LevelDebug, DebugLogger, msg, keysAndValues...)
 }
 
 func Info(v ...any) {
-	InfoLogger.Println(v...)
+	write(slog.LevelInfo, InfoLogger, fmt.Sprintln(v...))
 }
 
 func Infof(format string, v ...any) {
-	InfoLogger.Printf(format, v...)
+	write(slog.LevelInfo, InfoLogger, fmt.Sprintf(format, v...))
+}
+
+// Logs the message with key-value pairs, which become fields of the json log format
+func Infow(msg string, keysAndValues ...any) {
+	write(slog.LevelInfo, InfoLogger, msg, keysAndValues...)
+}
+
+func Warn(v ...any) {
+	write(slog.LevelWarn, WarnLogger, fmt.Sprintln(v...))
+}
+
+func Warnf(format string, v ...any) {
+	write(slog.LevelWarn, WarnLogger, fmt.Sprintf(format, v...))
 }
 
 func Error(v ...any) {
-	ErrorLogger.Println(v...)
+	write(slog.LevelError, ErrorLogger, fmt.Sprintln(v...))
 }
 
 func Errorf(format string, v ...any) {
-	ErrorLogger.Printf(format, v...)
+	write(slog.LevelError, ErrorLogger, fmt.Sprintf(format, v...))
+}
+
+// Whether messages of the level are logged, the Debug flag enables every level
+func IsEnabled(level slog.Level) bool {
+	minLevel, ok := logLevels[config.Config.LogLevel]
+	if !ok {
+		minLevel = slog.LevelInfo
+	}
+
+	if config.Config.Debug {
+		minLevel = slog.LevelDebug
+	}
+
+	return level >= minLevel
+}
+
+func write(level slog.Level, textLogger *log.Logger, msg string, keysAndValues ...any) {
+	if !IsEnabled(level) {
+		return
+	}
+
+	if config.Config.LogFormat == config.LogFormatJSON {
+		logger := jsonLogger
+		if level >= slog.LevelError {
+			logger = jsonErrorLogger
+		}
+		logger.Log(context.Background(), level, strings.TrimSpace(msg), keysAndValues...)
+		return
+	}
+
+	var builder strings.Builder
+	builder.WriteString(strings.TrimRight(msg, "\n"))
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		value := keysAndValues[i+1]
+		if rawJSON, ok := value.(json.RawMessage); ok {
+			value = string(rawJSON)
+		}
+		fmt.Fprintf(&builder, " %v=%v", keysAndValues[i], value)
+	}
+
+	// Skips this function and the exported one calling it, so the file of the caller is logged
+	textLogger.Output(3, builder.String())
 }
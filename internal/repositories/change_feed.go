@@ -0,0 +1,93 @@
+package repositories
+
+import (
+	"maps"
+	"sort"
+
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+)
+
+// Map collection resource id -> document id -> LSN of the latest write to the document.
+// Like the LSNs of the collections they are not persisted, documents loaded from a
+// state file have the LSN 0 and are listed at the start of the change feed
+var documentLSNs = make(map[string]map[string]int64)
+
+// Advances the LSN of the collection and records it as the LSN of the written document.
+// Expects the store lock to be held by the caller
+func recordDocumentWrite(collection repositorymodels.Collection, documentId string) {
+	advanceSessionLSN(collection)
+
+	if _, ok := documentLSNs[collection.ResourceID]; !ok {
+		documentLSNs[collection.ResourceID] = make(map[string]int64)
+	}
+	documentLSNs[collection.ResourceID][documentId] = getSessionLSN(collection)
+}
+
+// The LSNs of a collection before a transaction, restored when it is rolled back
+// so the discarded writes don't show up in the change feed
+type documentWritesSnapshot struct {
+	collection   repositorymodels.Collection
+	sessionLSN   int64
+	documentLSNs map[string]int64
+}
+
+// Expects the store lock to be held by the caller
+func snapshotDocumentWrites(collection repositorymodels.Collection) documentWritesSnapshot {
+	return documentWritesSnapshot{
+		collection:   collection,
+		sessionLSN:   getSessionLSN(collection),
+		documentLSNs: maps.Clone(documentLSNs[collection.ResourceID]),
+	}
+}
+
+// Expects the store lock to be held by the caller
+func (s documentWritesSnapshot) restore() {
+	setSessionLSN(s.collection, s.sessionLSN)
+	if s.documentLSNs == nil {
+		delete(documentLSNs, s.collection.ResourceID)
+		return
+	}
+	documentLSNs[s.collection.ResourceID] = s.documentLSNs
+}
+
+// Returns the latest version of the documents written after the LSN in the order they were
+// written, with their LSN in the "_lsn" property, and the LSN of the collection. A start LSN
+// of -1 lists every document, deleted documents are not listed. When a partition key range
+// is given only its documents are listed
+func GetChangeFeed(
+	databaseId string,
+	collectionId string,
+	partitionKeyRange *repositorymodels.PartitionKeyRange,
+	startLSN int64,
+) ([]repositorymodels.Document, int64, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	documents, status := getAllDocuments(databaseId, collectionId)
+	if status != repositorymodels.StatusOk {
+		return nil, 0, status
+	}
+
+	collection := storeState.Collections[databaseId][collectionId]
+	changedDocuments := make([]repositorymodels.Document, 0)
+	for _, document := range documents {
+		if partitionKeyRange != nil && !IsInPartitionKeyRange(collection, document, *partitionKeyRange) {
+			continue
+		}
+
+		documentId, _ := document["id"].(string)
+		lsn := documentLSNs[collection.ResourceID][documentId]
+		if lsn > startLSN {
+			changedDocument := maps.Clone(document)
+			changedDocument["_lsn"] = lsn
+			changedDocuments = append(changedDocuments, changedDocument)
+		}
+	}
+
+	// Documents are sorted by id, so documents written at the same LSN keep that order
+	sort.SliceStable(changedDocuments, func(i, j int) bool {
+		return changedDocuments[i]["_lsn"].(int64) < changedDocuments[j]["_lsn"].(int64)
+	})
+
+	return changedDocuments, getSessionLSN(collection), repositorymodels.StatusOk
+}
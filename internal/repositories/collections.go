@@ -1,7 +1,10 @@
 package repositories
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,6 +15,9 @@ import (
 )
 
 func GetAllCollections(databaseId string) ([]repositorymodels.Collection, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
 	if _, ok := storeState.Databases[databaseId]; !ok {
 		return make([]repositorymodels.Collection, 0), repositorymodels.StatusNotFound
 	}
@@ -20,6 +26,9 @@ func GetAllCollections(databaseId string) ([]repositorymodels.Collection, reposi
 }
 
 func GetCollection(databaseId string, collectionId string) (repositorymodels.Collection, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
 	if _, ok := storeState.Databases[databaseId]; !ok {
 		return repositorymodels.Collection{}, repositorymodels.StatusNotFound
 	}
@@ -32,20 +41,33 @@ func GetCollection(databaseId string, collectionId string) (repositorymodels.Col
 }
 
 func DeleteCollection(databaseId string, collectionId string) repositorymodels.RepositoryStatus {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
 	if _, ok := storeState.Databases[databaseId]; !ok {
 		return repositorymodels.StatusNotFound
 	}
 
-	if _, ok := storeState.Collections[databaseId][collectionId]; !ok {
+	collection, ok := storeState.Collections[databaseId][collectionId]
+	if !ok {
 		return repositorymodels.StatusNotFound
 	}
 
+	deleteResourceOffers(collection.ResourceID)
 	delete(storeState.Collections[databaseId], collectionId)
+	delete(storeState.Documents[databaseId], collectionId)
+	delete(storeState.StoredProcedures[databaseId], collectionId)
+	delete(storeState.Triggers[databaseId], collectionId)
+	delete(storeState.UserDefinedFunctions[databaseId], collectionId)
+	delete(storeState.Conflicts[databaseId], collectionId)
 
 	return repositorymodels.StatusOk
 }
 
 func CreateCollection(databaseId string, newCollection repositorymodels.Collection) (repositorymodels.Collection, repositorymodels.RepositoryStatus) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
 	var ok bool
 	var database repositorymodels.Database
 	if database, ok = storeState.Databases[databaseId]; !ok {
@@ -56,8 +78,23 @@ func CreateCollection(databaseId string, newCollection repositorymodels.Collecti
 		return repositorymodels.Collection{}, repositorymodels.Conflict
 	}
 
-	newCollection = structhidrators.Hidrate(newCollection).(repositorymodels.Collection)
+	if err := ValidateIndexingPolicy(newCollection.IndexingPolicy); err != nil {
+		return repositorymodels.Collection{}, repositorymodels.BadRequest
+	}
+
+	if err := ValidatePartitionKeyDefinition(newCollection.PartitionKey); err != nil {
+		return repositorymodels.Collection{}, repositorymodels.BadRequest
+	}
 
+	if err := ValidateDefaultTimeToLive(newCollection.DefaultTimeToLive); err != nil {
+		return repositorymodels.Collection{}, repositorymodels.BadRequest
+	}
+
+	if err := ValidateGeospatialConfig(newCollection.GeospatialConfig); err != nil {
+		return repositorymodels.Collection{}, repositorymodels.BadRequest
+	}
+
+	newCollection = hidrateCollection(newCollection)
 	newCollection.TimeStamp = time.Now().Unix()
 	newCollection.ResourceID = resourceid.NewCombined(database.ResourceID, resourceid.New())
 	newCollection.ETag = fmt.Sprintf("\"%s\"", uuid.New())
@@ -65,6 +102,137 @@ func CreateCollection(databaseId string, newCollection repositorymodels.Collecti
 
 	storeState.Collections[databaseId][newCollection.ID] = newCollection
 	storeState.Documents[databaseId][newCollection.ID] = make(map[string]repositorymodels.Document)
+	storeState.StoredProcedures[databaseId][newCollection.ID] = make(map[string]repositorymodels.StoredProcedure)
+	storeState.Triggers[databaseId][newCollection.ID] = make(map[string]repositorymodels.Trigger)
+	storeState.UserDefinedFunctions[databaseId][newCollection.ID] = make(map[string]repositorymodels.UserDefinedFunction)
+	storeState.Conflicts[databaseId][newCollection.ID] = make(map[string]repositorymodels.ConflictResource)
 
 	return newCollection, repositorymodels.StatusOk
 }
+
+// Replaces the indexing policy and default time to live of the collection, its id and
+// partition key can't be changed. Left out properties are reset to their defaults
+func ReplaceCollection(databaseId string, collectionId string, collection repositorymodels.Collection) (repositorymodels.Collection, repositorymodels.RepositoryStatus) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	if _, ok := storeState.Databases[databaseId]; !ok {
+		return repositorymodels.Collection{}, repositorymodels.StatusNotFound
+	}
+
+	existingCollection, ok := storeState.Collections[databaseId][collectionId]
+	if !ok {
+		return repositorymodels.Collection{}, repositorymodels.StatusNotFound
+	}
+
+	if err := ValidateCollectionReplace(existingCollection, collection); err != nil {
+		return repositorymodels.Collection{}, repositorymodels.BadRequest
+	}
+
+	if err := ValidateIndexingPolicy(collection.IndexingPolicy); err != nil {
+		return repositorymodels.Collection{}, repositorymodels.BadRequest
+	}
+
+	if err := ValidateDefaultTimeToLive(collection.DefaultTimeToLive); err != nil {
+		return repositorymodels.Collection{}, repositorymodels.BadRequest
+	}
+
+	if err := ValidateGeospatialConfig(collection.GeospatialConfig); err != nil {
+		return repositorymodels.Collection{}, repositorymodels.BadRequest
+	}
+
+	existingCollection.IndexingPolicy = hidrateCollection(repositorymodels.Collection{IndexingPolicy: collection.IndexingPolicy}).IndexingPolicy
+	// SDKs without the geospatial config leave it out of the replacement
+	if collection.GeospatialConfig.Type != "" {
+		existingCollection.GeospatialConfig = collection.GeospatialConfig
+	}
+	existingCollection.DefaultTimeToLive = collection.DefaultTimeToLive
+	existingCollection.AnalyticalStorageTimeToLive = collection.AnalyticalStorageTimeToLive
+	existingCollection.TimeStamp = time.Now().Unix()
+	existingCollection.ETag = fmt.Sprintf("\"%s\"", uuid.New())
+
+	storeState.Collections[databaseId][collectionId] = existingCollection
+
+	return existingCollection, repositorymodels.StatusOk
+}
+
+// Spatial data is indexed as Geography unless the collection is created with Geometry
+func ValidateGeospatialConfig(geospatialConfig repositorymodels.CollectionGeospatialConfig) error {
+	switch geospatialConfig.Type {
+	case "", "Geography", "Geometry":
+		return nil
+	}
+
+	return fmt.Errorf("The geospatial type '%s' is not valid, it must be Geography or Geometry", geospatialConfig.Type)
+}
+
+// Checks that the replacement keeps the properties which are fixed when the
+// collection is created, properties left out of the replacement are unchanged
+func ValidateCollectionReplace(existing repositorymodels.Collection, replacement repositorymodels.Collection) error {
+	if replacement.ID != "" && replacement.ID != existing.ID {
+		return fmt.Errorf("The id of the collection '%s' cannot be changed to '%s'.", existing.ID, replacement.ID)
+	}
+
+	if !isSamePartitionKeyDefinition(existing.PartitionKey, replacement.PartitionKey) {
+		return errors.New("Document collection partition key cannot be changed.")
+	}
+
+	if !isSameUniqueKeyPolicy(existing.UniqueKeyPolicy, replacement.UniqueKeyPolicy) {
+		return errors.New("Document collection unique key policy cannot be changed.")
+	}
+
+	if !isSameConflictResolutionPolicy(existing.ConflictResolutionPolicy, replacement.ConflictResolutionPolicy) {
+		return errors.New("Document collection conflict resolution policy cannot be changed.")
+	}
+
+	return nil
+}
+
+func hidrateCollection(collection repositorymodels.Collection) repositorymodels.Collection {
+	submittedIndexingPolicy := collection.IndexingPolicy
+	collection = structhidrators.Hidrate(collection).(repositorymodels.Collection)
+	collection.IndexingPolicy = applyIndexingPolicyDefaults(submittedIndexingPolicy)
+	collection.ConflictResolutionPolicy = applyConflictResolutionPolicyDefaults(collection.ConflictResolutionPolicy)
+
+	return collection
+}
+
+const conflictResolutionModeLastWriterWins = "LastWriterWins"
+
+// Conflicts are resolved by the last writer unless a custom policy is set, the writes
+// are ordered by their timestamp when the policy has no resolution path
+func applyConflictResolutionPolicyDefaults(policy repositorymodels.CollectionConflictResolutionPolicy) repositorymodels.CollectionConflictResolutionPolicy {
+	if policy.Mode == "" {
+		policy.Mode = conflictResolutionModeLastWriterWins
+	}
+
+	if strings.EqualFold(policy.Mode, conflictResolutionModeLastWriterWins) && policy.ConflictResolutionPath == "" {
+		policy.ConflictResolutionPath = "/_ts"
+	}
+
+	return policy
+}
+
+// The SDKs send the partition key definition they read, some of them without its kind,
+// so only the submitted properties are compared and a left out definition is unchanged
+func isSamePartitionKeyDefinition(existing repositorymodels.CollectionPartitionKey, submitted repositorymodels.CollectionPartitionKey) bool {
+	if len(submitted.Paths) > 0 && !slices.Equal(existing.Paths, submitted.Paths) {
+		return false
+	}
+
+	if submitted.Kind != "" && !strings.EqualFold(existing.Kind, submitted.Kind) {
+		return false
+	}
+
+	return submitted.Version == 0 || existing.Version == submitted.Version
+}
+
+func isSameUniqueKeyPolicy(existing repositorymodels.CollectionUniqueKeyPolicy, submitted repositorymodels.CollectionUniqueKeyPolicy) bool {
+	return submitted.UniqueKeys == nil || slices.EqualFunc(existing.UniqueKeys, submitted.UniqueKeys, func(a repositorymodels.CollectionUniqueKey, b repositorymodels.CollectionUniqueKey) bool {
+		return slices.Equal(a.Paths, b.Paths)
+	})
+}
+
+func isSameConflictResolutionPolicy(existing repositorymodels.CollectionConflictResolutionPolicy, submitted repositorymodels.CollectionConflictResolutionPolicy) bool {
+	return submitted == repositorymodels.CollectionConflictResolutionPolicy{} || applyConflictResolutionPolicyDefaults(submitted) == existing
+}
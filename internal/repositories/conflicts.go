@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/pikami/cosmium/internal/resourceid"
+	"golang.org/x/exp/maps"
+)
+
+// Returns the conflicts of the collection sorted by id, so the feed can be paginated
+func GetAllConflicts(databaseId string, collectionId string) ([]repositorymodels.ConflictResource, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	if _, ok := storeState.Databases[databaseId]; !ok {
+		return make([]repositorymodels.ConflictResource, 0), repositorymodels.StatusNotFound
+	}
+
+	if _, ok := storeState.Collections[databaseId][collectionId]; !ok {
+		return make([]repositorymodels.ConflictResource, 0), repositorymodels.StatusNotFound
+	}
+
+	conflicts := maps.Values(storeState.Conflicts[databaseId][collectionId])
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i].ID < conflicts[j].ID })
+
+	return conflicts, repositorymodels.StatusOk
+}
+
+func GetConflict(databaseId string, collectionId string, conflictId string) (repositorymodels.ConflictResource, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	if _, ok := storeState.Databases[databaseId]; !ok {
+		return repositorymodels.ConflictResource{}, repositorymodels.StatusNotFound
+	}
+
+	if _, ok := storeState.Collections[databaseId][collectionId]; !ok {
+		return repositorymodels.ConflictResource{}, repositorymodels.StatusNotFound
+	}
+
+	if conflict, ok := storeState.Conflicts[databaseId][collectionId][conflictId]; ok {
+		return conflict, repositorymodels.StatusOk
+	}
+
+	return repositorymodels.ConflictResource{}, repositorymodels.StatusNotFound
+}
+
+func DeleteConflict(databaseId string, collectionId string, conflictId string) repositorymodels.RepositoryStatus {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	if _, ok := storeState.Databases[databaseId]; !ok {
+		return repositorymodels.StatusNotFound
+	}
+
+	if _, ok := storeState.Collections[databaseId][collectionId]; !ok {
+		return repositorymodels.StatusNotFound
+	}
+
+	if _, ok := storeState.Conflicts[databaseId][collectionId][conflictId]; !ok {
+		return repositorymodels.StatusNotFound
+	}
+
+	delete(storeState.Conflicts[databaseId][collectionId], conflictId)
+
+	return repositorymodels.StatusOk
+}
+
+// Conflicts only occur on multi-region accounts, they are added
+// through the state endpoint to test conflict resolution code
+func CreateConflict(databaseId string, collectionId string, conflict repositorymodels.ConflictResource) (repositorymodels.ConflictResource, repositorymodels.RepositoryStatus) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	var ok bool
+	var database repositorymodels.Database
+	var collection repositorymodels.Collection
+	if database, ok = storeState.Databases[databaseId]; !ok {
+		return repositorymodels.ConflictResource{}, repositorymodels.StatusNotFound
+	}
+
+	if collection, ok = storeState.Collections[databaseId][collectionId]; !ok {
+		return repositorymodels.ConflictResource{}, repositorymodels.StatusNotFound
+	}
+
+	if conflict.ID == "" {
+		conflict.ID = uuid.New().String()
+	}
+
+	if _, ok = storeState.Conflicts[databaseId][collectionId][conflict.ID]; ok {
+		return repositorymodels.ConflictResource{}, repositorymodels.Conflict
+	}
+
+	setConflictSystemProperties(database, collection, &conflict)
+	storeState.Conflicts[databaseId][collectionId][conflict.ID] = conflict
+
+	return conflict, repositorymodels.StatusOk
+}
+
+func setConflictSystemProperties(database repositorymodels.Database, collection repositorymodels.Collection, conflict *repositorymodels.ConflictResource) {
+	if conflict.ResourceType == "" {
+		conflict.ResourceType = "document"
+	}
+
+	if conflict.OperationType == "" {
+		conflict.OperationType = "create"
+	}
+
+	conflict.TimeStamp = time.Now().Unix()
+	conflict.ResourceID = resourceid.NewCombined(database.ResourceID, collection.ResourceID, resourceid.New())
+	conflict.Etag = fmt.Sprintf("\"%s\"", uuid.New())
+	conflict.Self = fmt.Sprintf("dbs/%s/colls/%s/conflicts/%s/", database.ResourceID, collection.ResourceID, conflict.ResourceID)
+}
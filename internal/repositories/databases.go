@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,11 +11,21 @@ import (
 	"golang.org/x/exp/maps"
 )
 
+// Databases are sorted by id, so read feeds can be paged in a stable order
 func GetAllDatabases() ([]repositorymodels.Database, repositorymodels.RepositoryStatus) {
-	return maps.Values(storeState.Databases), repositorymodels.StatusOk
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	databases := maps.Values(storeState.Databases)
+	sort.Slice(databases, func(i, j int) bool { return databases[i].ID < databases[j].ID })
+
+	return databases, repositorymodels.StatusOk
 }
 
 func GetDatabase(id string) (repositorymodels.Database, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
 	if database, ok := storeState.Databases[id]; ok {
 		return database, repositorymodels.StatusOk
 	}
@@ -23,28 +34,59 @@ func GetDatabase(id string) (repositorymodels.Database, repositorymodels.Reposit
 }
 
 func DeleteDatabase(id string) repositorymodels.RepositoryStatus {
-	if _, ok := storeState.Databases[id]; !ok {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	database, ok := storeState.Databases[id]
+	if !ok {
 		return repositorymodels.StatusNotFound
 	}
 
+	offerResourceIds := []string{database.ResourceID}
+	for _, collection := range storeState.Collections[id] {
+		offerResourceIds = append(offerResourceIds, collection.ResourceID)
+	}
+	deleteResourceOffers(offerResourceIds...)
+
 	delete(storeState.Databases, id)
+	delete(storeState.Collections, id)
+	delete(storeState.Documents, id)
+	delete(storeState.StoredProcedures, id)
+	delete(storeState.Triggers, id)
+	delete(storeState.UserDefinedFunctions, id)
+	delete(storeState.Conflicts, id)
+	delete(storeState.Users, id)
+	delete(storeState.Permissions, id)
 
 	return repositorymodels.StatusOk
 }
 
 func CreateDatabase(newDatabase repositorymodels.Database) (repositorymodels.Database, repositorymodels.RepositoryStatus) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
 	if _, ok := storeState.Databases[newDatabase.ID]; ok {
 		return repositorymodels.Database{}, repositorymodels.Conflict
 	}
 
-	newDatabase.TimeStamp = time.Now().Unix()
-	newDatabase.ResourceID = resourceid.New()
-	newDatabase.ETag = fmt.Sprintf("\"%s\"", uuid.New())
-	newDatabase.Self = fmt.Sprintf("dbs/%s/", newDatabase.ResourceID)
+	setDatabaseSystemProperties(&newDatabase)
 
 	storeState.Databases[newDatabase.ID] = newDatabase
 	storeState.Collections[newDatabase.ID] = make(map[string]repositorymodels.Collection)
 	storeState.Documents[newDatabase.ID] = make(map[string]map[string]repositorymodels.Document)
+	storeState.StoredProcedures[newDatabase.ID] = make(map[string]map[string]repositorymodels.StoredProcedure)
+	storeState.Triggers[newDatabase.ID] = make(map[string]map[string]repositorymodels.Trigger)
+	storeState.UserDefinedFunctions[newDatabase.ID] = make(map[string]map[string]repositorymodels.UserDefinedFunction)
+	storeState.Conflicts[newDatabase.ID] = make(map[string]map[string]repositorymodels.ConflictResource)
+	storeState.Users[newDatabase.ID] = make(map[string]repositorymodels.User)
+	storeState.Permissions[newDatabase.ID] = make(map[string]map[string]repositorymodels.Permission)
 
 	return newDatabase, repositorymodels.StatusOk
 }
+
+func setDatabaseSystemProperties(database *repositorymodels.Database) {
+	database.TimeStamp = time.Now().Unix()
+	database.ResourceID = resourceid.New()
+	database.ETag = fmt.Sprintf("\"%s\"", uuid.New())
+	database.Self = fmt.Sprintf("dbs/%s/", database.ResourceID)
+}
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/pikami/cosmium/api/config"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+)
+
+// Map collection resource id -> documents the collection can hold, overriding the
+// MaxDocumentCount flag. Recreated collections get a new resource id, so
+// they are limited by the flag again
+var collectionDocumentLimits = make(map[string]int)
+
+// Overrides the MaxDocumentCount flag for the collection, 0 removes its limit
+func SetCollectionDocumentLimit(databaseId string, collectionId string, limit int) repositorymodels.RepositoryStatus {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	collection, ok := storeState.Collections[databaseId][collectionId]
+	if !ok {
+		return repositorymodels.StatusNotFound
+	}
+
+	collectionDocumentLimits[collection.ResourceID] = limit
+	return repositorymodels.StatusOk
+}
+
+// Limits the collection by the MaxDocumentCount flag again
+func ResetCollectionDocumentLimit(databaseId string, collectionId string) repositorymodels.RepositoryStatus {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	collection, ok := storeState.Collections[databaseId][collectionId]
+	if !ok {
+		return repositorymodels.StatusNotFound
+	}
+
+	delete(collectionDocumentLimits, collection.ResourceID)
+	return repositorymodels.StatusOk
+}
+
+// Returns 0 when the collection can hold any number of documents
+func GetCollectionDocumentLimit(databaseId string, collectionId string) (int, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	collection, ok := storeState.Collections[databaseId][collectionId]
+	if !ok {
+		return 0, repositorymodels.StatusNotFound
+	}
+
+	return getDocumentLimit(collection), repositorymodels.StatusOk
+}
+
+// Expects the store lock to be held by the caller
+func getDocumentLimit(collection repositorymodels.Collection) int {
+	if limit, ok := collectionDocumentLimits[collection.ResourceID]; ok {
+		return limit
+	}
+
+	return max(config.Config.MaxDocumentCount, 0)
+}
+
+// Expired documents which are not purged yet don't count, as they can't be read anymore.
+// Expects the store lock to be held by the caller
+func isDocumentLimitReached(databaseId string, collection repositorymodels.Collection) bool {
+	limit := getDocumentLimit(collection)
+	documents := storeState.Documents[databaseId][collection.ID]
+	if limit <= 0 || len(documents) < limit {
+		return false
+	}
+
+	now := time.Now()
+	documentCount := 0
+	for _, document := range documents {
+		if !isDocumentExpired(collection, document, now) {
+			documentCount++
+		}
+	}
+
+	return documentCount >= limit
+}
@@ -1,8 +1,13 @@
 package repositories
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,10 +16,19 @@ import (
 	"github.com/pikami/cosmium/parsers"
 	"github.com/pikami/cosmium/parsers/nosql"
 	memoryexecutor "github.com/pikami/cosmium/query_executors/memory_executor"
-	"golang.org/x/exp/maps"
 )
 
+// Returns the documents that have not expired sorted by id, so feeds
+// and queries return them in the same order on every request
 func GetAllDocuments(databaseId string, collectionId string) ([]repositorymodels.Document, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	return getAllDocuments(databaseId, collectionId)
+}
+
+// Expects the store lock to be held by the caller
+func getAllDocuments(databaseId string, collectionId string) ([]repositorymodels.Document, repositorymodels.RepositoryStatus) {
 	if _, ok := storeState.Databases[databaseId]; !ok {
 		return make([]repositorymodels.Document, 0), repositorymodels.StatusNotFound
 	}
@@ -23,10 +37,99 @@ func GetAllDocuments(databaseId string, collectionId string) ([]repositorymodels
 		return make([]repositorymodels.Document, 0), repositorymodels.StatusNotFound
 	}
 
-	return maps.Values(storeState.Documents[databaseId][collectionId]), repositorymodels.StatusOk
+	collection := storeState.Collections[databaseId][collectionId]
+	documents := make([]repositorymodels.Document, 0, len(storeState.Documents[databaseId][collectionId]))
+	now := time.Now()
+	for _, document := range storeState.Documents[databaseId][collectionId] {
+		if !isDocumentExpired(collection, document, now) {
+			documents = append(documents, document)
+		}
+	}
+
+	sort.Slice(documents, func(i, j int) bool {
+		iId, _ := documents[i]["id"].(string)
+		jId, _ := documents[j]["id"].(string)
+		return iId < jId
+	})
+
+	return documents, repositorymodels.StatusOk
+}
+
+// Returns a page of the documents that have not expired and pass the filter, sorted by id.
+// Only the documents after afterId are considered and only the smallest maxCount ids are
+// kept while scanning, so reading a page doesn't sort the whole collection. A maxCount
+// of 0 or less returns all of them. The bool reports whether more documents follow
+func GetDocumentsPage(
+	databaseId string,
+	collectionId string,
+	afterId string,
+	maxCount int,
+	filter func(document repositorymodels.Document) bool,
+) ([]repositorymodels.Document, bool, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	if _, ok := storeState.Databases[databaseId]; !ok {
+		return make([]repositorymodels.Document, 0), false, repositorymodels.StatusNotFound
+	}
+
+	if _, ok := storeState.Collections[databaseId][collectionId]; !ok {
+		return make([]repositorymodels.Document, 0), false, repositorymodels.StatusNotFound
+	}
+
+	compareIds := func(document repositorymodels.Document, id string) int {
+		documentId, _ := document["id"].(string)
+		return strings.Compare(documentId, id)
+	}
+
+	collection := storeState.Collections[databaseId][collectionId]
+	documents := make([]repositorymodels.Document, 0)
+	now := time.Now()
+	for id, document := range storeState.Documents[databaseId][collectionId] {
+		if afterId != "" && id <= afterId {
+			continue
+		}
+
+		if maxCount > 0 && len(documents) > maxCount && compareIds(documents[maxCount], id) < 0 {
+			continue
+		}
+
+		if isDocumentExpired(collection, document, now) || (filter != nil && !filter(document)) {
+			continue
+		}
+
+		if maxCount <= 0 {
+			documents = append(documents, document)
+			continue
+		}
+
+		// One extra document is kept to tell whether another page follows
+		index, _ := slices.BinarySearchFunc(documents, id, compareIds)
+		documents = slices.Insert(documents, index, document)
+		if len(documents) > maxCount+1 {
+			documents = documents[:maxCount+1]
+		}
+	}
+
+	if maxCount <= 0 {
+		slices.SortFunc(documents, func(a, b repositorymodels.Document) int {
+			bId, _ := b["id"].(string)
+			return compareIds(a, bId)
+		})
+		return documents, false, repositorymodels.StatusOk
+	}
+
+	if len(documents) > maxCount {
+		return documents[:maxCount], true, repositorymodels.StatusOk
+	}
+
+	return documents, false, repositorymodels.StatusOk
 }
 
 func GetDocument(databaseId string, collectionId string, documentId string) (repositorymodels.Document, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
 	if _, ok := storeState.Databases[databaseId]; !ok {
 		return repositorymodels.Document{}, repositorymodels.StatusNotFound
 	}
@@ -35,14 +138,37 @@ func GetDocument(databaseId string, collectionId string, documentId string) (rep
 		return repositorymodels.Document{}, repositorymodels.StatusNotFound
 	}
 
-	if _, ok := storeState.Documents[databaseId][collectionId][documentId]; !ok {
+	document, ok := lookupDocument(databaseId, collectionId, documentId)
+	if !ok {
 		return repositorymodels.Document{}, repositorymodels.StatusNotFound
 	}
 
-	return storeState.Documents[databaseId][collectionId][documentId], repositorymodels.StatusOk
+	return document, repositorymodels.StatusOk
+}
+
+// Deletes the document while holding the lock, the check is run on the stored document
+// first like for ReplaceDocument. The document is kept when the check returns an error,
+// which is passed on with a BadRequest status
+func DeleteDocument(
+	databaseId string,
+	collectionId string,
+	documentId string,
+	check func(existingDocument repositorymodels.Document) error,
+) (repositorymodels.RepositoryStatus, error) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	if existingDocument, ok := lookupDocument(databaseId, collectionId, documentId); ok && check != nil {
+		if err := check(existingDocument); err != nil {
+			return repositorymodels.BadRequest, err
+		}
+	}
+
+	return deleteDocument(databaseId, collectionId, documentId), nil
 }
 
-func DeleteDocument(databaseId string, collectionId string, documentId string) repositorymodels.RepositoryStatus {
+// Expects the store lock to be held by the caller
+func deleteDocument(databaseId string, collectionId string, documentId string) repositorymodels.RepositoryStatus {
 	if _, ok := storeState.Databases[databaseId]; !ok {
 		return repositorymodels.StatusNotFound
 	}
@@ -51,16 +177,116 @@ func DeleteDocument(databaseId string, collectionId string, documentId string) r
 		return repositorymodels.StatusNotFound
 	}
 
-	if _, ok := storeState.Documents[databaseId][collectionId][documentId]; !ok {
+	if _, ok := lookupDocument(databaseId, collectionId, documentId); !ok {
 		return repositorymodels.StatusNotFound
 	}
 
 	delete(storeState.Documents[databaseId][collectionId], documentId)
+	advanceSessionLSN(storeState.Collections[databaseId][collectionId])
 
 	return repositorymodels.StatusOk
 }
 
+// Removes every document of the logical partition while holding the lock,
+// so no document of the partition can be written while it is deleted.
+// Returns the number of removed documents
+func DeleteDocumentsByPartitionKey(databaseId string, collectionId string, partitionKey []interface{}) (int, repositorymodels.RepositoryStatus) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	if _, ok := storeState.Databases[databaseId]; !ok {
+		return 0, repositorymodels.StatusNotFound
+	}
+
+	collection, ok := storeState.Collections[databaseId][collectionId]
+	if !ok {
+		return 0, repositorymodels.StatusNotFound
+	}
+
+	deletedDocuments := 0
+	for documentId, document := range storeState.Documents[databaseId][collectionId] {
+		if IsInPartition(collection, document, partitionKey) {
+			delete(storeState.Documents[databaseId][collectionId], documentId)
+			deletedDocuments++
+		}
+	}
+
+	if deletedDocuments > 0 {
+		advanceSessionLSN(collection)
+	}
+
+	return deletedDocuments, repositorymodels.StatusOk
+}
+
 func CreateDocument(databaseId string, collectionId string, document map[string]interface{}) (repositorymodels.Document, repositorymodels.RepositoryStatus) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	return createDocument(databaseId, collectionId, document)
+}
+
+// Creates the documents of a bulk request while holding the lock once, instead of once
+// per document. Every document succeeds or fails on its own, the status of each is
+// returned at its index. Fails with NotFound when the collection doesn't exist
+func CreateDocuments(databaseId string, collectionId string, documents []map[string]interface{}) ([]repositorymodels.Document, []repositorymodels.RepositoryStatus, repositorymodels.RepositoryStatus) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	if _, ok := storeState.Collections[databaseId][collectionId]; !ok {
+		return nil, nil, repositorymodels.StatusNotFound
+	}
+
+	createdDocuments := make([]repositorymodels.Document, len(documents))
+	statuses := make([]repositorymodels.RepositoryStatus, len(documents))
+	for i, document := range documents {
+		createdDocuments[i], statuses[i] = createDocument(databaseId, collectionId, document)
+	}
+
+	return createdDocuments, statuses, repositorymodels.StatusOk
+}
+
+// Creates the document, or replaces the stored document with the same id in place while
+// holding the lock. The check is applied to the stored document before it is replaced,
+// the document is left untouched when it returns an error, which is passed on with a
+// BadRequest status. Returns whether an existing document was replaced
+func UpsertDocument(
+	databaseId string,
+	collectionId string,
+	document map[string]interface{},
+	check func(existingDocument repositorymodels.Document) error,
+) (repositorymodels.Document, bool, repositorymodels.RepositoryStatus, error) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	return upsertDocument(databaseId, collectionId, document, check)
+}
+
+// Expects the store lock to be held by the caller
+func upsertDocument(
+	databaseId string,
+	collectionId string,
+	document map[string]interface{},
+	check func(existingDocument repositorymodels.Document) error,
+) (repositorymodels.Document, bool, repositorymodels.RepositoryStatus, error) {
+	documentId, _ := document["id"].(string)
+	existingDocument, ok := lookupDocument(databaseId, collectionId, documentId)
+	if !ok {
+		createdDocument, status := createDocument(databaseId, collectionId, document)
+		return createdDocument, false, status, nil
+	}
+
+	if check != nil {
+		if err := check(existingDocument); err != nil {
+			return repositorymodels.Document{}, true, repositorymodels.BadRequest, err
+		}
+	}
+
+	replacedDocument, status := replaceDocument(databaseId, collectionId, documentId, existingDocument, document)
+	return replacedDocument, true, status, nil
+}
+
+// Expects the store lock to be held by the caller
+func createDocument(databaseId string, collectionId string, document map[string]interface{}) (repositorymodels.Document, repositorymodels.RepositoryStatus) {
 	var ok bool
 	var documentId string
 	var database repositorymodels.Database
@@ -70,6 +296,10 @@ func CreateDocument(databaseId string, collectionId string, document map[string]
 		document["id"] = documentId
 	}
 
+	for _, property := range documentSystemProperties {
+		delete(document, property)
+	}
+
 	if database, ok = storeState.Databases[databaseId]; !ok {
 		return repositorymodels.Document{}, repositorymodels.StatusNotFound
 	}
@@ -78,41 +308,195 @@ func CreateDocument(databaseId string, collectionId string, document map[string]
 		return repositorymodels.Document{}, repositorymodels.StatusNotFound
 	}
 
-	if _, ok := storeState.Documents[databaseId][collectionId][documentId]; ok {
+	// Expired documents that weren't purged yet are overwritten
+	if _, ok := lookupDocument(databaseId, collectionId, documentId); ok {
 		return repositorymodels.Document{}, repositorymodels.Conflict
 	}
 
-	document["_ts"] = time.Now().Unix()
-	document["_rid"] = resourceid.NewCombined(database.ResourceID, collection.ResourceID, resourceid.New())
-	document["_etag"] = fmt.Sprintf("\"%s\"", uuid.New())
-	document["_self"] = fmt.Sprintf("dbs/%s/colls/%s/docs/%s/", database.ResourceID, collection.ResourceID, document["_rid"])
+	if !hasPartitionKeyValues(collection, document) {
+		return repositorymodels.Document{}, repositorymodels.MissingPartitionKey
+	}
+
+	if isDocumentLimitReached(databaseId, collection) {
+		return repositorymodels.Document{}, repositorymodels.DocumentLimitReached
+	}
+
+	setDocumentSystemProperties(database, collection, document)
 
 	storeState.Documents[databaseId][collectionId][documentId] = document
+	recordDocumentWrite(storeState.Collections[databaseId][collectionId], documentId)
 
 	return document, repositorymodels.StatusOk
 }
 
-func ExecuteQueryDocuments(databaseId string, collectionId string, query string, queryParameters map[string]interface{}) ([]memoryexecutor.RowType, repositorymodels.RepositoryStatus) {
-	parsedQuery, err := nosql.Parse("", []byte(query))
+// Replaces the stored document in place, so readers never observe it missing.
+// The resource id and links are kept while the timestamp and etag are renewed
+// Replaces the document while holding the lock, the check is run on the stored document
+// first, so its etag can't change in between. The document is left untouched when the
+// check returns an error, which is passed on with a BadRequest status
+func ReplaceDocument(
+	databaseId string,
+	collectionId string,
+	documentId string,
+	document map[string]interface{},
+	check func(existingDocument repositorymodels.Document) error,
+) (repositorymodels.Document, repositorymodels.RepositoryStatus, error) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	existingDocument, ok := lookupDocument(databaseId, collectionId, documentId)
+	if !ok {
+		return repositorymodels.Document{}, repositorymodels.StatusNotFound, nil
+	}
+
+	if check != nil {
+		if err := check(existingDocument); err != nil {
+			return repositorymodels.Document{}, repositorymodels.BadRequest, err
+		}
+	}
+
+	replacedDocument, status := replaceDocument(databaseId, collectionId, documentId, existingDocument, document)
+	return replacedDocument, status, nil
+}
+
+// Applies the patch function to the stored document and replaces it with the
+// result while holding the lock. The document is left untouched when the
+// function returns an error, which is passed on with a BadRequest status
+func PatchDocument(
+	databaseId string,
+	collectionId string,
+	documentId string,
+	patch func(document repositorymodels.Document) (map[string]interface{}, error),
+) (repositorymodels.Document, repositorymodels.RepositoryStatus, error) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	return patchDocument(databaseId, collectionId, documentId, patch)
+}
+
+// Expects the store lock to be held by the caller
+func patchDocument(
+	databaseId string,
+	collectionId string,
+	documentId string,
+	patch func(document repositorymodels.Document) (map[string]interface{}, error),
+) (repositorymodels.Document, repositorymodels.RepositoryStatus, error) {
+	existingDocument, ok := lookupDocument(databaseId, collectionId, documentId)
+	if !ok {
+		return repositorymodels.Document{}, repositorymodels.StatusNotFound, nil
+	}
+
+	patchedDocument, err := patch(existingDocument)
 	if err != nil {
-		log.Printf("Failed to parse query: %s\nerr: %v", query, err)
-		return nil, repositorymodels.BadRequest
+		return repositorymodels.Document{}, repositorymodels.BadRequest, err
+	}
+
+	replacedDocument, status := replaceDocument(databaseId, collectionId, documentId, existingDocument, patchedDocument)
+	return replacedDocument, status, nil
+}
+
+// Expects the store lock to be held by the caller
+func replaceDocument(databaseId string, collectionId string, documentId string, existingDocument repositorymodels.Document, document map[string]interface{}) (repositorymodels.Document, repositorymodels.RepositoryStatus) {
+	if id, hasId := document["id"]; !hasId {
+		document["id"] = documentId
+	} else if id != documentId {
+		return repositorymodels.Document{}, repositorymodels.BadRequest
+	}
+
+	if !hasPartitionKeyValues(storeState.Collections[databaseId][collectionId], document) {
+		return repositorymodels.Document{}, repositorymodels.MissingPartitionKey
+	}
+
+	for _, property := range documentSystemProperties {
+		delete(document, property)
 	}
 
-	collectionDocuments, status := GetAllDocuments(databaseId, collectionId)
+	document["_rid"] = existingDocument["_rid"]
+	document["_self"] = existingDocument["_self"]
+	document["_attachments"] = existingDocument["_attachments"]
+	document["_ts"] = time.Now().Unix()
+	document["_etag"] = fmt.Sprintf("\"%s\"", uuid.New())
+
+	storeState.Documents[databaseId][collectionId][documentId] = document
+	recordDocumentWrite(storeState.Collections[databaseId][collectionId], documentId)
+
+	return document, repositorymodels.StatusOk
+}
+
+// Properties generated by the server on every write, values sent by clients are discarded.
+// The LSN is only listed by the change feed, clients may write back documents read from it
+var documentSystemProperties = []string{"_rid", "_self", "_etag", "_ts", "_attachments", "_lsn"}
+
+func setDocumentSystemProperties(database repositorymodels.Database, collection repositorymodels.Collection, document map[string]interface{}) {
+	document["_ts"] = time.Now().Unix()
+	document["_rid"] = resourceid.NewCombined(database.ResourceID, collection.ResourceID, resourceid.New())
+	document["_etag"] = fmt.Sprintf("\"%s\"", uuid.New())
+	setDocumentLinks(database, collection, document)
+}
+
+// Sets the self link and attachments link computed from the resource ids
+func setDocumentLinks(database repositorymodels.Database, collection repositorymodels.Collection, document map[string]interface{}) {
+	document["_self"] = fmt.Sprintf("dbs/%s/colls/%s/docs/%s/", database.ResourceID, collection.ResourceID, document["_rid"])
+	document["_attachments"] = "attachments/"
+}
+
+// Executes the query against the collection documents, when a partition key or
+// a partition key range is given only the documents within them are queried.
+// Execution stops with QueryCancelled once the context is done, the stats count
+// the queried documents and the ones matching the query
+// Counts the rows of a document query, along with the documents of the partition
+// the query was scoped to, which are the ones it was executed on
+type DocumentQueryStats struct {
+	memoryexecutor.QueryStats
+	ScannedDocuments []memoryexecutor.RowType
+}
+
+func ExecuteQueryDocuments(
+	ctx context.Context,
+	databaseId string,
+	collectionId string,
+	query parsers.SelectStmt,
+	partitionKey []interface{},
+	partitionKeyRange *repositorymodels.PartitionKeyRange,
+) ([]memoryexecutor.RowType, DocumentQueryStats, repositorymodels.RepositoryStatus) {
+	// Writes store new document maps instead of modifying the stored ones,
+	// so the query runs on the collected documents after the lock is released
+	storeStateLock.RLock()
+	collectionDocuments, status := getAllDocuments(databaseId, collectionId)
+	collection := storeState.Collections[databaseId][collectionId]
+	storeStateLock.RUnlock()
 	if status != repositorymodels.StatusOk {
-		return nil, status
+		return nil, DocumentQueryStats{}, status
 	}
 
 	covDocs := make([]memoryexecutor.RowType, 0)
 	for _, doc := range collectionDocuments {
-		covDocs = append(covDocs, map[string]interface{}(doc))
+		if IsInPartitionPrefix(collection, doc, partitionKey) &&
+			(partitionKeyRange == nil || IsInPartitionKeyRange(collection, doc, *partitionKeyRange)) {
+			covDocs = append(covDocs, map[string]interface{}(doc))
+		}
+	}
+
+	result, stats, err := memoryexecutor.ExecuteContextWithStats(ctx, query, covDocs)
+	if err != nil {
+		return nil, DocumentQueryStats{}, repositorymodels.QueryCancelled
+	}
+
+	return result, DocumentQueryStats{QueryStats: stats, ScannedDocuments: covDocs}, repositorymodels.StatusOk
+}
+
+// Parses the query, the returned error describes why
+// parsing failed when status is QueryParseError or BadRequest
+func ParseQuery(query string) (parsers.SelectStmt, repositorymodels.RepositoryStatus, error) {
+	parsedQuery, err := nosql.Parse("", []byte(query))
+	if err != nil {
+		log.Printf("Failed to parse query: %s\nerr: %v", query, err)
+		return parsers.SelectStmt{}, repositorymodels.QueryParseError, err
 	}
 
 	if typedQuery, ok := parsedQuery.(parsers.SelectStmt); ok {
-		typedQuery.Parameters = queryParameters
-		return memoryexecutor.Execute(typedQuery, covDocs), repositorymodels.StatusOk
+		return typedQuery, repositorymodels.StatusOk, nil
 	}
 
-	return nil, repositorymodels.BadRequest
+	return parsers.SelectStmt{}, repositorymodels.BadRequest, errors.New("unsupported query type")
 }
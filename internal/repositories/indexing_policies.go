@@ -0,0 +1,141 @@
+package repositories
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+)
+
+var indexingModes = []string{"consistent", "lazy", "none"}
+
+var spatialTypes = []string{"point", "polygon", "linestring", "multipolygon"}
+
+var compositeIndexOrders = []string{"ascending", "descending"}
+
+var indexKindDataTypes = map[string][]string{
+	"hash":    {"string", "number"},
+	"range":   {"string", "number"},
+	"spatial": spatialTypes,
+}
+
+// Checks the shape of an indexing policy, modes, kinds and data types are
+// matched case insensitively. Paths must end with "/?" or "/*" and may only
+// be listed once across the included and excluded paths
+func ValidateIndexingPolicy(policy repositorymodels.CollectionIndexingPolicy) error {
+	if policy.IndexingMode != "" && !containsFold(indexingModes, policy.IndexingMode) {
+		return fmt.Errorf("Invalid indexing mode '%s', must be one of: %s", policy.IndexingMode, strings.Join(indexingModes, ", "))
+	}
+
+	if strings.EqualFold(policy.IndexingMode, "none") {
+		if len(policy.IncludedPaths) > 0 || len(policy.ExcludedPaths) > 0 {
+			return fmt.Errorf("Included and excluded paths can not be specified when the indexing mode is 'none'")
+		}
+		if policy.Automatic != nil && *policy.Automatic {
+			return fmt.Errorf("Automatic indexing can not be enabled when the indexing mode is 'none'")
+		}
+	}
+
+	seenPaths := make(map[string]bool)
+	for _, paths := range [][]repositorymodels.CollectionIndexingPolicyPath{policy.IncludedPaths, policy.ExcludedPaths} {
+		for _, path := range paths {
+			if err := validateIndexingPolicyPath(path); err != nil {
+				return err
+			}
+
+			if seenPaths[path.Path] {
+				return fmt.Errorf("The indexing path '%s' is specified more than once", path.Path)
+			}
+			seenPaths[path.Path] = true
+		}
+	}
+
+	for _, spatialIndex := range policy.SpatialIndexes {
+		if !strings.HasPrefix(spatialIndex.Path, "/") {
+			return fmt.Errorf("The spatial index path '%s' could not be accepted, paths must start with '/'", spatialIndex.Path)
+		}
+		for _, spatialType := range spatialIndex.Types {
+			if !containsFold(spatialTypes, spatialType) {
+				return fmt.Errorf("Invalid spatial type '%s' at path '%s'", spatialType, spatialIndex.Path)
+			}
+		}
+	}
+
+	for _, compositeIndex := range policy.CompositeIndexes {
+		if len(compositeIndex) < 2 {
+			return fmt.Errorf("Composite indexes must contain at least two paths")
+		}
+		for _, compositePath := range compositeIndex {
+			if !strings.HasPrefix(compositePath.Path, "/") {
+				return fmt.Errorf("The composite index path '%s' could not be accepted, paths must start with '/'", compositePath.Path)
+			}
+			if compositePath.Order != "" && !containsFold(compositeIndexOrders, compositePath.Order) {
+				return fmt.Errorf("Invalid composite index order '%s' at path '%s'", compositePath.Order, compositePath.Path)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Fills in the properties Cosmos DB adds to a submitted policy, a policy without paths
+// indexes every path and the "_etag" system property is always excluded
+func applyIndexingPolicyDefaults(policy repositorymodels.CollectionIndexingPolicy) repositorymodels.CollectionIndexingPolicy {
+	if policy.IndexingMode == "" {
+		policy.IndexingMode = "consistent"
+	}
+
+	if policy.Automatic == nil {
+		automatic := !strings.EqualFold(policy.IndexingMode, "none")
+		policy.Automatic = &automatic
+	}
+
+	if strings.EqualFold(policy.IndexingMode, "none") {
+		return policy
+	}
+
+	if len(policy.IncludedPaths) == 0 {
+		policy.IncludedPaths = []repositorymodels.CollectionIndexingPolicyPath{{Path: "/*"}}
+	}
+
+	isEtagPath := func(path repositorymodels.CollectionIndexingPolicyPath) bool {
+		return path.Path == etagIndexingPolicyPath
+	}
+	if !slices.ContainsFunc(policy.IncludedPaths, isEtagPath) && !slices.ContainsFunc(policy.ExcludedPaths, isEtagPath) {
+		policy.ExcludedPaths = append(slices.Clone(policy.ExcludedPaths), repositorymodels.CollectionIndexingPolicyPath{Path: etagIndexingPolicyPath})
+	}
+
+	return policy
+}
+
+const etagIndexingPolicyPath = "/\"_etag\"/?"
+
+func validateIndexingPolicyPath(path repositorymodels.CollectionIndexingPolicyPath) error {
+	if !strings.HasPrefix(path.Path, "/") || !(strings.HasSuffix(path.Path, "/?") || strings.HasSuffix(path.Path, "/*")) {
+		return fmt.Errorf("The indexing path '%s' could not be accepted, paths must start with '/' and end with '/?' or '/*'", path.Path)
+	}
+
+	for _, index := range path.Indexes {
+		dataTypes, ok := indexKindDataTypes[strings.ToLower(index.Kind)]
+		if !ok {
+			return fmt.Errorf("Invalid index kind '%s' at path '%s'", index.Kind, path.Path)
+		}
+
+		if index.DataType != "" && !containsFold(dataTypes, index.DataType) {
+			return fmt.Errorf("Invalid data type '%s' for index kind '%s' at path '%s'", index.DataType, index.Kind, path.Path)
+		}
+	}
+
+	return nil
+}
+
+func containsFold(values []string, value string) bool {
+	for _, candidate := range values {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,266 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/pikami/cosmium/parsers"
+	memoryexecutor "github.com/pikami/cosmium/query_executors/memory_executor"
+	"golang.org/x/exp/maps"
+)
+
+// Offers of the second version are the ones with throughput in their content,
+// their offer type is only used by the first version
+const (
+	offerVersion = "V2"
+	offerType    = "Invalid"
+)
+
+// Bounds Cosmos DB enforces for provisioned throughput
+const (
+	minOfferThroughput          = 400
+	offerThroughputStep         = 100
+	minAutoscaleMaxThroughput   = 1000
+	autoscaleMaxThroughputStep  = 1000
+	autoscaleMinThroughputRatio = 10
+	// Offers migrated to autoscale scale up to at least this throughput
+	minMigratedAutoscaleMaxThroughput = 4000
+)
+
+const offerIdCharacters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// Manual offers are provisioned in steps of 100 RU/s, autoscaled ones
+// scale between a tenth of their max throughput and the max throughput
+func ValidateOfferContent(content repositorymodels.OfferContent) error {
+	if autopilotSettings := content.OfferAutopilotSettings; autopilotSettings != nil {
+		if autopilotSettings.MaxThroughput < minAutoscaleMaxThroughput || autopilotSettings.MaxThroughput%autoscaleMaxThroughputStep != 0 {
+			return fmt.Errorf("The autoscale max throughput must be a multiple of %d of at least %d RU/s", autoscaleMaxThroughputStep, minAutoscaleMaxThroughput)
+		}
+
+		return nil
+	}
+
+	if content.OfferThroughput < minOfferThroughput || content.OfferThroughput%offerThroughputStep != 0 {
+		return fmt.Errorf("The offer throughput must be a multiple of %d of at least %d RU/s", offerThroughputStep, minOfferThroughput)
+	}
+
+	return nil
+}
+
+func GetAllOffers() []repositorymodels.Offer {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	return maps.Values(storeState.Offers)
+}
+
+func GetOffer(offerId string) (repositorymodels.Offer, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	if offer, ok := storeState.Offers[offerId]; ok {
+		return offer, repositorymodels.StatusOk
+	}
+
+	return repositorymodels.Offer{}, repositorymodels.StatusNotFound
+}
+
+// Provisions throughput for the resource with the self link and resource id,
+// resources have a single offer so a second one is a conflict
+func CreateOffer(resource string, offerResourceId string, content repositorymodels.OfferContent) (repositorymodels.Offer, repositorymodels.RepositoryStatus) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	if err := ValidateOfferContent(content); err != nil {
+		return repositorymodels.Offer{}, repositorymodels.BadRequest
+	}
+
+	for _, offer := range storeState.Offers {
+		if offer.OfferResourceID == offerResourceId {
+			return repositorymodels.Offer{}, repositorymodels.Conflict
+		}
+	}
+
+	offerId := newOfferId()
+	offer := repositorymodels.Offer{
+		ID:              offerId,
+		Resource:        resource,
+		OfferType:       offerType,
+		OfferResourceID: offerResourceId,
+		OfferVersion:    offerVersion,
+		Content:         hidrateOfferContent(content),
+		ResourceID:      offerId,
+		TimeStamp:       time.Now().Unix(),
+		Self:            fmt.Sprintf("offers/%s/", offerId),
+		ETag:            fmt.Sprintf("\"%s\"", uuid.New()),
+	}
+
+	storeState.Offers[offerId] = offer
+
+	return offer, repositorymodels.StatusOk
+}
+
+// Replaces the content of the offer, the offer can't be moved to another resource
+func ReplaceOffer(offerId string, offer repositorymodels.Offer) (repositorymodels.Offer, repositorymodels.RepositoryStatus) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	existingOffer, ok := storeState.Offers[offerId]
+	if !ok {
+		return repositorymodels.Offer{}, repositorymodels.StatusNotFound
+	}
+
+	if (offer.ID != "" && offer.ID != offerId) ||
+		(offer.OfferResourceID != "" && offer.OfferResourceID != existingOffer.OfferResourceID) {
+		return repositorymodels.Offer{}, repositorymodels.BadRequest
+	}
+
+	if err := ValidateOfferContent(offer.Content); err != nil {
+		return repositorymodels.Offer{}, repositorymodels.BadRequest
+	}
+
+	// Switching between manual throughput and autoscale requires a migration
+	if IsAutoscaleOffer(offer) != IsAutoscaleOffer(existingOffer) {
+		return repositorymodels.Offer{}, repositorymodels.BadRequest
+	}
+
+	return updateOfferContent(existingOffer, offer.Content), repositorymodels.StatusOk
+}
+
+func IsAutoscaleOffer(offer repositorymodels.Offer) bool {
+	return offer.Content.OfferAutopilotSettings != nil
+}
+
+// Migrates a manual offer to autoscale like Cosmos DB does, the max throughput is the
+// current throughput rounded up to a multiple of 1000, or 4000 RU/s when that is more
+func MigrateOfferToAutoscale(offerId string) (repositorymodels.Offer, repositorymodels.RepositoryStatus) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	offer, ok := storeState.Offers[offerId]
+	if !ok {
+		return repositorymodels.Offer{}, repositorymodels.StatusNotFound
+	}
+
+	if IsAutoscaleOffer(offer) {
+		return repositorymodels.Offer{}, repositorymodels.BadRequest
+	}
+
+	roundedThroughput := (offer.Content.OfferThroughput + autoscaleMaxThroughputStep - 1) / autoscaleMaxThroughputStep * autoscaleMaxThroughputStep
+	offer.Content.OfferAutopilotSettings = &repositorymodels.OfferAutopilotSettings{
+		MaxThroughput: max(roundedThroughput, minMigratedAutoscaleMaxThroughput),
+	}
+
+	return updateOfferContent(offer, offer.Content), repositorymodels.StatusOk
+}
+
+// Migrates an autoscaled offer to manual throughput, which is set to its max throughput
+func MigrateOfferToManualThroughput(offerId string) (repositorymodels.Offer, repositorymodels.RepositoryStatus) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	offer, ok := storeState.Offers[offerId]
+	if !ok {
+		return repositorymodels.Offer{}, repositorymodels.StatusNotFound
+	}
+
+	if !IsAutoscaleOffer(offer) {
+		return repositorymodels.Offer{}, repositorymodels.BadRequest
+	}
+
+	return updateOfferContent(offer, repositorymodels.OfferContent{
+		OfferThroughput: offer.Content.OfferAutopilotSettings.MaxThroughput,
+	}), repositorymodels.StatusOk
+}
+
+// Runs the query on the offers of every resource, the SDKs find the offer
+// of a resource by querying for its offerResourceId
+func ExecuteQueryOffers(ctx context.Context, query parsers.SelectStmt) ([]memoryexecutor.RowType, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	rows := getOfferRows()
+	storeStateLock.RUnlock()
+
+	result, err := memoryexecutor.ExecuteContext(ctx, query, rows)
+	if err != nil {
+		return nil, repositorymodels.QueryCancelled
+	}
+
+	return result, repositorymodels.StatusOk
+}
+
+// Collections without an offer of their own share the throughput of their database,
+// they are queried as if they had a copy of the database offer for their resource id.
+// Expects the store lock to be held by the caller
+func getOfferRows() []memoryexecutor.RowType {
+	rows := make([]memoryexecutor.RowType, 0, len(storeState.Offers))
+	offersByResourceId := make(map[string]repositorymodels.Offer)
+	for _, offer := range storeState.Offers {
+		rows = append(rows, resourceToRow(offer))
+		offersByResourceId[offer.OfferResourceID] = offer
+	}
+
+	for databaseId, database := range storeState.Databases {
+		databaseOffer, ok := offersByResourceId[database.ResourceID]
+		if !ok {
+			continue
+		}
+
+		for _, collection := range storeState.Collections[databaseId] {
+			if _, ok := offersByResourceId[collection.ResourceID]; !ok {
+				row := resourceToRow(databaseOffer)
+				row["offerResourceId"] = collection.ResourceID
+				rows = append(rows, row)
+			}
+		}
+	}
+
+	return rows
+}
+
+// Expects the store lock to be held by the caller
+func updateOfferContent(offer repositorymodels.Offer, content repositorymodels.OfferContent) repositorymodels.Offer {
+	offer.Content = hidrateOfferContent(content)
+	offer.TimeStamp = time.Now().Unix()
+	offer.ETag = fmt.Sprintf("\"%s\"", uuid.New())
+
+	storeState.Offers[offer.ID] = offer
+
+	return offer
+}
+
+// Expects the store lock to be held by the caller
+func deleteResourceOffers(offerResourceIds ...string) {
+	for offerId, offer := range storeState.Offers {
+		for _, offerResourceId := range offerResourceIds {
+			if offer.OfferResourceID == offerResourceId {
+				delete(storeState.Offers, offerId)
+			}
+		}
+	}
+}
+
+// Expects the store lock to be held by the caller
+func newOfferId() string {
+	for {
+		id := make([]byte, 4)
+		for i := range id {
+			id[i] = offerIdCharacters[rand.Intn(len(offerIdCharacters))]
+		}
+
+		if _, ok := storeState.Offers[string(id)]; !ok {
+			return string(id)
+		}
+	}
+}
+
+func hidrateOfferContent(content repositorymodels.OfferContent) repositorymodels.OfferContent {
+	if content.OfferAutopilotSettings != nil {
+		content.OfferThroughput = content.OfferAutopilotSettings.MaxThroughput / autoscaleMinThroughputRatio
+	}
+
+	return content
+}
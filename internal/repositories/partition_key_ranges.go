@@ -1,46 +1,288 @@
 package repositories
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"math/big"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/pikami/cosmium/api/config"
 	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
 	"github.com/pikami/cosmium/internal/resourceid"
 )
 
-// I have no idea what this is tbh
+// Resource id suffix of the partition key range, appended to the collection resource
+// id like the ones Cosmos DB assigns to the first range of a collection. The ranges a
+// collection is created with carry their number in the fifth byte of the suffix
+var partitionKeyRangeResourceIdSuffix = []byte{0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x50}
+
+// Bounds of the hash space covered by the ranges of a collection
+const (
+	minEffectivePartitionKey = ""
+	maxEffectivePartitionKey = "FF"
+)
+
+// Ranges a collection can be served by, enough to exercise the code paths
+// of clients fanning out over the ranges without flooding them with requests
+const maxPartitionKeyRangeCount = 100
+
+// Map collection resource id -> ranges of the collection after it was split or given a
+// number of ranges. Recreated collections get a new resource id, so they start with
+// the ranges given by the PartitionKeyRangeCount flag again
+var collectionPartitionKeyRanges = make(map[string][]repositorymodels.PartitionKeyRange)
+
+// Collections are served by the number of ranges given by the PartitionKeyRangeCount flag,
+// a single range covering the whole hash space by default, until they are split. The ranges
+// are derived from the collection, so their etag stays the same until the collection is
+// replaced or recreated and clients caching the ranges by etag get a new one then
 func GetPartitionKeyRanges(databaseId string, collectionId string) ([]repositorymodels.PartitionKeyRange, repositorymodels.RepositoryStatus) {
-	databaseRid := databaseId
-	collectionRid := collectionId
-	var timestamp int64 = 0
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	database, ok := storeState.Databases[databaseId]
+	if !ok {
+		return nil, repositorymodels.StatusNotFound
+	}
+
+	collection, ok := storeState.Collections[databaseId][collectionId]
+	if !ok {
+		return nil, repositorymodels.StatusNotFound
+	}
+
+	return slices.Clone(getPartitionKeyRanges(database, collection)), repositorymodels.StatusOk
+}
+
+// Returns the current range with the id, or PartitionKeyRangeGone when the range was split
+func GetPartitionKeyRange(databaseId string, collectionId string, partitionKeyRangeId string) (repositorymodels.PartitionKeyRange, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	database, ok := storeState.Databases[databaseId]
+	if !ok {
+		return repositorymodels.PartitionKeyRange{}, repositorymodels.StatusNotFound
+	}
+
+	collection, ok := storeState.Collections[databaseId][collectionId]
+	if !ok {
+		return repositorymodels.PartitionKeyRange{}, repositorymodels.StatusNotFound
+	}
+
+	partitionKeyRanges := getPartitionKeyRanges(database, collection)
+	for _, partitionKeyRange := range partitionKeyRanges {
+		if partitionKeyRange.ID == partitionKeyRangeId {
+			return partitionKeyRange, repositorymodels.StatusOk
+		}
+	}
 
-	if database, ok := storeState.Databases[databaseId]; !ok {
-		databaseRid = database.ResourceID
+	for _, partitionKeyRange := range partitionKeyRanges {
+		if slices.Contains(partitionKeyRange.Parents, any(partitionKeyRangeId)) {
+			return repositorymodels.PartitionKeyRange{}, repositorymodels.PartitionKeyRangeGone
+		}
 	}
 
-	if collection, ok := storeState.Collections[databaseId][collectionId]; !ok {
-		collectionRid = collection.ResourceID
-		timestamp = collection.TimeStamp
+	return repositorymodels.PartitionKeyRange{}, repositorymodels.StatusNotFound
+}
+
+// Splits the range into two children covering one half of its hash space each, like
+// Cosmos DB does when a partition grows too large. The children list the split range
+// and its parents as their parents, requests to the split range fail from then on
+func SplitPartitionKeyRange(databaseId string, collectionId string, partitionKeyRangeId string) ([]repositorymodels.PartitionKeyRange, repositorymodels.RepositoryStatus) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	database, ok := storeState.Databases[databaseId]
+	if !ok {
+		return nil, repositorymodels.StatusNotFound
+	}
+
+	collection, ok := storeState.Collections[databaseId][collectionId]
+	if !ok {
+		return nil, repositorymodels.StatusNotFound
+	}
+
+	partitionKeyRanges := getPartitionKeyRanges(database, collection)
+	index := slices.IndexFunc(partitionKeyRanges, func(partitionKeyRange repositorymodels.PartitionKeyRange) bool {
+		return partitionKeyRange.ID == partitionKeyRangeId
+	})
+	if index < 0 {
+		return nil, repositorymodels.StatusNotFound
+	}
+
+	parent := partitionKeyRanges[index]
+	minInclusive := effectivePartitionKeyToInt(parent.MinInclusive)
+	maxExclusive := effectivePartitionKeyToInt(parent.MaxExclusive)
+	if new(big.Int).Sub(maxExclusive, minInclusive).Cmp(big.NewInt(1)) <= 0 {
+		return nil, repositorymodels.BadRequest
 	}
+	middle := intToEffectivePartitionKey(new(big.Int).Rsh(new(big.Int).Add(minInclusive, maxExclusive), 1))
 
-	pkrResourceId := resourceid.NewCombined(databaseRid, collectionRid, resourceid.New())
-	pkrSelf := fmt.Sprintf("dbs/%s/colls/%s/pkranges/%s/", databaseRid, collectionRid, pkrResourceId)
+	nextId := 0
+	for _, partitionKeyRange := range partitionKeyRanges {
+		for _, id := range append([]interface{}{partitionKeyRange.ID}, partitionKeyRange.Parents...) {
+			if number, err := strconv.Atoi(fmt.Sprint(id)); err == nil {
+				nextId = max(nextId, number+1)
+			}
+		}
+	}
+
+	// Clients cache the ranges by etag, every range gets a new one
 	etag := fmt.Sprintf("\"%s\"", uuid.New())
+	parents := append(slices.Clone(parent.Parents), parent.ID)
+	children := make([]repositorymodels.PartitionKeyRange, 0, 2)
+	for i, bounds := range [][2]string{{parent.MinInclusive, middle}, {middle, parent.MaxExclusive}} {
+		childResourceId := resourceid.NewCombined(collection.ResourceID, resourceid.New(), resourceid.New())
+		children = append(children, repositorymodels.PartitionKeyRange{
+			ResourceID:         childResourceId,
+			ID:                 strconv.Itoa(nextId + i),
+			Etag:               etag,
+			MinInclusive:       bounds[0],
+			MaxExclusive:       bounds[1],
+			RidPrefix:          nextId + i,
+			Self:               fmt.Sprintf("dbs/%s/colls/%s/pkranges/%s/", database.ResourceID, collection.ResourceID, childResourceId),
+			ThroughputFraction: parent.ThroughputFraction / 2,
+			Status:             "online",
+			Parents:            parents,
+			TimeStamp:          time.Now().Unix(),
+			Lsn:                parent.Lsn,
+		})
+	}
+
+	splitRanges := slices.Replace(slices.Clone(partitionKeyRanges), index, index+1, children...)
+	for i := range splitRanges {
+		splitRanges[i].Etag = etag
+	}
+	collectionPartitionKeyRanges[collection.ResourceID] = splitRanges
+
+	return children, repositorymodels.StatusOk
+}
 
-	return []repositorymodels.PartitionKeyRange{
-		{
+// Replaces the ranges of the collection with the number of ranges evenly covering the
+// hash space, so clients fan out over several ranges like they do for large collections.
+// The ranges are numbered from 0 and list no parents, requests pinned to ranges that no
+// longer exist fail with not found
+func SetPartitionKeyRangeCount(databaseId string, collectionId string, count int) ([]repositorymodels.PartitionKeyRange, repositorymodels.RepositoryStatus) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	database, ok := storeState.Databases[databaseId]
+	if !ok {
+		return nil, repositorymodels.StatusNotFound
+	}
+
+	collection, ok := storeState.Collections[databaseId][collectionId]
+	if !ok {
+		return nil, repositorymodels.StatusNotFound
+	}
+
+	if count < 1 || count > maxPartitionKeyRangeCount {
+		return nil, repositorymodels.BadRequest
+	}
+
+	partitionKeyRanges := newPartitionKeyRanges(database, collection, count, fmt.Sprintf("\"%s\"", uuid.New()), time.Now().Unix())
+	collectionPartitionKeyRanges[collection.ResourceID] = partitionKeyRanges
+
+	return slices.Clone(partitionKeyRanges), repositorymodels.StatusOk
+}
+
+// Serves the collection by the ranges given by the PartitionKeyRangeCount flag again
+func ResetPartitionKeyRanges(databaseId string, collectionId string) repositorymodels.RepositoryStatus {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	collection, ok := storeState.Collections[databaseId][collectionId]
+	if !ok {
+		return repositorymodels.StatusNotFound
+	}
+
+	delete(collectionPartitionKeyRanges, collection.ResourceID)
+	return repositorymodels.StatusOk
+}
+
+// Checks whether the effective partition key of the document falls within the range.
+// The effective partition key is a hash of the partition key value, which is not the
+// same one Cosmos DB computes, documents are only spread over the ranges consistently
+func IsInPartitionKeyRange(collection repositorymodels.Collection, document map[string]interface{}, partitionKeyRange repositorymodels.PartitionKeyRange) bool {
+	effectivePartitionKey := getEffectivePartitionKey(collection, document)
+	return effectivePartitionKey >= partitionKeyRange.MinInclusive &&
+		(partitionKeyRange.MaxExclusive == maxEffectivePartitionKey || effectivePartitionKey < partitionKeyRange.MaxExclusive)
+}
+
+// Expects the store lock to be held by the caller
+func getPartitionKeyRanges(database repositorymodels.Database, collection repositorymodels.Collection) []repositorymodels.PartitionKeyRange {
+	if partitionKeyRanges, ok := collectionPartitionKeyRanges[collection.ResourceID]; ok {
+		return partitionKeyRanges
+	}
+
+	count := min(max(config.Config.PartitionKeyRangeCount, 1), maxPartitionKeyRangeCount)
+	return newPartitionKeyRanges(database, collection, count, collection.ETag, collection.TimeStamp)
+}
+
+// Creates the number of ranges evenly covering the hash space, numbered from 0
+func newPartitionKeyRanges(database repositorymodels.Database, collection repositorymodels.Collection, count int, etag string, timestamp int64) []repositorymodels.PartitionKeyRange {
+	hashSpace := effectivePartitionKeyToInt(maxEffectivePartitionKey)
+	bounds := make([]string, 0, count+1)
+	bounds = append(bounds, minEffectivePartitionKey)
+	for i := 1; i < count; i++ {
+		bound := new(big.Int).Div(new(big.Int).Mul(hashSpace, big.NewInt(int64(i))), big.NewInt(int64(count)))
+		bounds = append(bounds, intToEffectivePartitionKey(bound))
+	}
+	bounds = append(bounds, maxEffectivePartitionKey)
+
+	partitionKeyRanges := make([]repositorymodels.PartitionKeyRange, 0, count)
+	for i := 0; i < count; i++ {
+		suffix := slices.Clone(partitionKeyRangeResourceIdSuffix)
+		suffix[4] = byte(i)
+		pkrResourceId := resourceid.NewCombined(collection.ResourceID, base64.StdEncoding.EncodeToString(suffix))
+
+		partitionKeyRanges = append(partitionKeyRanges, repositorymodels.PartitionKeyRange{
 			ResourceID:         pkrResourceId,
-			ID:                 "0",
+			ID:                 strconv.Itoa(i),
 			Etag:               etag,
-			MinInclusive:       "",
-			MaxExclusive:       "FF",
-			RidPrefix:          0,
-			Self:               pkrSelf,
-			ThroughputFraction: 1,
+			MinInclusive:       bounds[i],
+			MaxExclusive:       bounds[i+1],
+			RidPrefix:          i,
+			Self:               fmt.Sprintf("dbs/%s/colls/%s/pkranges/%s/", database.ResourceID, collection.ResourceID, pkrResourceId),
+			ThroughputFraction: 1 / float64(count),
 			Status:             "online",
 			Parents:            []interface{}{},
 			TimeStamp:          timestamp,
 			Lsn:                17,
-		},
-	}, repositorymodels.StatusOk
+		})
+	}
+
+	return partitionKeyRanges
+}
+
+// Effective partition keys are 16 hex digits, the bounds of split
+// ranges have the same length so they can be compared as strings
+const effectivePartitionKeyLength = 16
+
+func getEffectivePartitionKey(collection repositorymodels.Collection, document map[string]interface{}) string {
+	serializedValue, _ := json.Marshal(GetPartitionKeyValue(collection, document))
+	hash := fnv.New64a()
+	hash.Write(serializedValue)
+
+	return fmt.Sprintf("%0*X", effectivePartitionKeyLength, hash.Sum64())
+}
+
+// The maximum bound of the hash space is one past the largest effective partition key
+func effectivePartitionKeyToInt(effectivePartitionKey string) *big.Int {
+	switch effectivePartitionKey {
+	case minEffectivePartitionKey:
+		return big.NewInt(0)
+	case maxEffectivePartitionKey:
+		return new(big.Int).Lsh(big.NewInt(1), effectivePartitionKeyLength*4)
+	}
+
+	value, _ := new(big.Int).SetString(effectivePartitionKey, 16)
+	return value
+}
+
+func intToEffectivePartitionKey(value *big.Int) string {
+	return fmt.Sprintf("%0*X", effectivePartitionKeyLength, value)
 }
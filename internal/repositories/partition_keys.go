@@ -0,0 +1,159 @@
+package repositories
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pikami/cosmium/api/config"
+	jsonnumbers "github.com/pikami/cosmium/internal/json_numbers"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+)
+
+const maxHierarchicalPartitionKeyPaths = 3
+
+// Extracts the values found at the collection partition key paths,
+// undefined values are represented as an empty object like the service does
+func GetPartitionKeyValue(collection repositorymodels.Collection, document map[string]interface{}) []interface{} {
+	values := make([]interface{}, 0, len(collection.PartitionKey.Paths))
+
+	for _, path := range collection.PartitionKey.Paths {
+		values = append(values, getValueAtPath(document, path))
+	}
+
+	return values
+}
+
+// Checks whether the document belongs to the given partition, every
+// document matches when no partition key is given or the collection
+// does not define partition key paths
+func IsInPartition(collection repositorymodels.Collection, document map[string]interface{}, partitionKey []interface{}) bool {
+	if len(partitionKey) == 0 || len(collection.PartitionKey.Paths) == 0 {
+		return true
+	}
+
+	return arePartitionKeysEqual(GetPartitionKeyValue(collection, document), partitionKey)
+}
+
+// Checks whether the document belongs to a partition starting with the given key,
+// queries on hierarchical partition keys may be scoped by a prefix of the key
+func IsInPartitionPrefix(collection repositorymodels.Collection, document map[string]interface{}, partitionKey []interface{}) bool {
+	if len(partitionKey) == 0 || len(collection.PartitionKey.Paths) == 0 {
+		return true
+	}
+
+	if len(partitionKey) > len(collection.PartitionKey.Paths) {
+		return false
+	}
+
+	return arePartitionKeysEqual(GetPartitionKeyValue(collection, document)[:len(partitionKey)], partitionKey)
+}
+
+// Integers and floats are compared by value, documents given as Go values
+// may hold floats where the decoded header holds integers
+func arePartitionKeysEqual(values []interface{}, partitionKey []interface{}) bool {
+	if len(values) != len(partitionKey) {
+		return false
+	}
+
+	for i := range values {
+		if value, ok := values[i].(int); ok {
+			if keyValue, ok := partitionKey[i].(float64); ok && float64(value) == keyValue {
+				continue
+			}
+		}
+		if value, ok := values[i].(float64); ok {
+			if keyValue, ok := partitionKey[i].(int); ok && value == float64(keyValue) {
+				continue
+			}
+		}
+
+		if !reflect.DeepEqual(values[i], partitionKey[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Hash partition keys have a single path, hierarchical
+// MultiHash partition keys have up to three paths
+func ValidatePartitionKeyDefinition(partitionKey repositorymodels.CollectionPartitionKey) error {
+	maxPaths := 1
+	switch {
+	case partitionKey.Kind == "" || strings.EqualFold(partitionKey.Kind, "Hash") || strings.EqualFold(partitionKey.Kind, "Range"):
+	case strings.EqualFold(partitionKey.Kind, "MultiHash"):
+		maxPaths = maxHierarchicalPartitionKeyPaths
+	default:
+		return fmt.Errorf("Invalid partition key kind '%s', must be one of: Hash, Range, MultiHash", partitionKey.Kind)
+	}
+
+	if len(partitionKey.Paths) > maxPaths {
+		return fmt.Errorf("The partition key of kind '%s' can have at most %d paths, got %d", partitionKey.Kind, maxPaths, len(partitionKey.Paths))
+	}
+
+	seenPaths := make(map[string]bool)
+	for _, path := range partitionKey.Paths {
+		if len(path) < 2 || !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("The partition key path '%s' could not be accepted, paths must start with '/'", path)
+		}
+
+		if seenPaths[path] {
+			return fmt.Errorf("The partition key path '%s' is specified more than once", path)
+		}
+		seenPaths[path] = true
+	}
+
+	return nil
+}
+
+// Parses the JSON array sent in the x-ms-documentdb-partitionkey header
+func ParsePartitionKeyHeader(header string) ([]interface{}, error) {
+	var values []interface{}
+	if err := jsonnumbers.Unmarshal([]byte(header), &values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// Documents lacking a value at any partition key path are stored in the partition of
+// undefined values, unless partition key values are required. Null is a value
+func hasPartitionKeyValues(collection repositorymodels.Collection, document map[string]interface{}) bool {
+	if !config.Config.RequirePartitionKey {
+		return true
+	}
+
+	for _, path := range collection.PartitionKey.Paths {
+		if _, found := lookupValueAtPath(document, path); !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func getValueAtPath(document map[string]interface{}, path string) interface{} {
+	if value, found := lookupValueAtPath(document, path); found {
+		return value
+	}
+
+	return map[string]interface{}{}
+}
+
+func lookupValueAtPath(document map[string]interface{}, path string) (interface{}, bool) {
+	var value interface{} = document
+	for _, segment := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		object, isObject := value.(map[string]interface{})
+		if !isObject {
+			return nil, false
+		}
+
+		var found bool
+		if value, found = object[segment]; !found {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
@@ -0,0 +1,129 @@
+package repositories
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/authentication"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/pikami/cosmium/internal/resourceid"
+	"golang.org/x/exp/maps"
+)
+
+func GetAllPermissions(databaseId string, userId string) ([]repositorymodels.Permission, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	if _, ok := storeState.Databases[databaseId]; !ok {
+		return make([]repositorymodels.Permission, 0), repositorymodels.StatusNotFound
+	}
+
+	if _, ok := storeState.Users[databaseId][userId]; !ok {
+		return make([]repositorymodels.Permission, 0), repositorymodels.StatusNotFound
+	}
+
+	return maps.Values(storeState.Permissions[databaseId][userId]), repositorymodels.StatusOk
+}
+
+func GetPermission(databaseId string, userId string, permissionId string) (repositorymodels.Permission, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	if _, ok := storeState.Databases[databaseId]; !ok {
+		return repositorymodels.Permission{}, repositorymodels.StatusNotFound
+	}
+
+	if _, ok := storeState.Users[databaseId][userId]; !ok {
+		return repositorymodels.Permission{}, repositorymodels.StatusNotFound
+	}
+
+	if permission, ok := storeState.Permissions[databaseId][userId][permissionId]; ok {
+		return permission, repositorymodels.StatusOk
+	}
+
+	return repositorymodels.Permission{}, repositorymodels.StatusNotFound
+}
+
+// Looks up the permission a resource token was issued for
+func GetPermissionByToken(token string) (repositorymodels.Permission, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	for _, users := range storeState.Permissions {
+		for _, permissions := range users {
+			for _, permission := range permissions {
+				if permission.Token == token {
+					return permission, repositorymodels.StatusOk
+				}
+			}
+		}
+	}
+
+	return repositorymodels.Permission{}, repositorymodels.StatusNotFound
+}
+
+func DeletePermission(databaseId string, userId string, permissionId string) repositorymodels.RepositoryStatus {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	if _, ok := storeState.Databases[databaseId]; !ok {
+		return repositorymodels.StatusNotFound
+	}
+
+	if _, ok := storeState.Users[databaseId][userId]; !ok {
+		return repositorymodels.StatusNotFound
+	}
+
+	if _, ok := storeState.Permissions[databaseId][userId][permissionId]; !ok {
+		return repositorymodels.StatusNotFound
+	}
+
+	delete(storeState.Permissions[databaseId][userId], permissionId)
+
+	return repositorymodels.StatusOk
+}
+
+func CreatePermission(databaseId string, userId string, newPermission repositorymodels.Permission) (repositorymodels.Permission, repositorymodels.RepositoryStatus) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	var ok bool
+	var database repositorymodels.Database
+	var user repositorymodels.User
+	if database, ok = storeState.Databases[databaseId]; !ok {
+		return repositorymodels.Permission{}, repositorymodels.StatusNotFound
+	}
+
+	if user, ok = storeState.Users[databaseId][userId]; !ok {
+		return repositorymodels.Permission{}, repositorymodels.StatusNotFound
+	}
+
+	if newPermission.PermissionMode != repositorymodels.PermissionModeRead &&
+		newPermission.PermissionMode != repositorymodels.PermissionModeAll {
+		return repositorymodels.Permission{}, repositorymodels.BadRequest
+	}
+
+	if newPermission.ID == "" || newPermission.Resource == "" {
+		return repositorymodels.Permission{}, repositorymodels.BadRequest
+	}
+
+	if _, ok = storeState.Permissions[databaseId][userId][newPermission.ID]; ok {
+		return repositorymodels.Permission{}, repositorymodels.Conflict
+	}
+
+	newPermission.TimeStamp = time.Now().Unix()
+	newPermission.ResourceID = resourceid.NewCombined(user.ResourceID, resourceid.New())
+	newPermission.ETag = fmt.Sprintf("\"%s\"", uuid.New())
+	newPermission.Self = fmt.Sprintf("dbs/%s/users/%s/permissions/%s/", database.ResourceID, user.ResourceID, newPermission.ResourceID)
+	newPermission.Token = authentication.GenerateResourceToken(
+		newPermission.ResourceID,
+		newPermission.Resource,
+		string(newPermission.PermissionMode),
+		config.Config.AccountKey)
+
+	storeState.Permissions[databaseId][userId][newPermission.ID] = newPermission
+
+	return newPermission, repositorymodels.StatusOk
+}
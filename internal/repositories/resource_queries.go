@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+
+	jsonnumbers "github.com/pikami/cosmium/internal/json_numbers"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/pikami/cosmium/parsers"
+	memoryexecutor "github.com/pikami/cosmium/query_executors/memory_executor"
+)
+
+func ExecuteQueryStoredProcedures(ctx context.Context, databaseId string, collectionId string, query parsers.SelectStmt) ([]memoryexecutor.RowType, repositorymodels.RepositoryStatus) {
+	storedProcedures, status := GetAllStoredProcedures(databaseId, collectionId)
+	if status != repositorymodels.StatusOk {
+		return nil, status
+	}
+
+	return executeQueryResources(ctx, query, storedProcedures)
+}
+
+func ExecuteQueryTriggers(ctx context.Context, databaseId string, collectionId string, query parsers.SelectStmt) ([]memoryexecutor.RowType, repositorymodels.RepositoryStatus) {
+	triggers, status := GetAllTriggers(databaseId, collectionId)
+	if status != repositorymodels.StatusOk {
+		return nil, status
+	}
+
+	return executeQueryResources(ctx, query, triggers)
+}
+
+func ExecuteQueryUserDefinedFunctions(ctx context.Context, databaseId string, collectionId string, query parsers.SelectStmt) ([]memoryexecutor.RowType, repositorymodels.RepositoryStatus) {
+	userDefinedFunctions, status := GetAllUserDefinedFunctions(databaseId, collectionId)
+	if status != repositorymodels.StatusOk {
+		return nil, status
+	}
+
+	return executeQueryResources(ctx, query, userDefinedFunctions)
+}
+
+// Resources other than documents are queried with the properties they are written with
+func executeQueryResources[T any](ctx context.Context, query parsers.SelectStmt, resources []T) ([]memoryexecutor.RowType, repositorymodels.RepositoryStatus) {
+	rows := make([]memoryexecutor.RowType, len(resources))
+	for i, resource := range resources {
+		rows[i] = resourceToRow(resource)
+	}
+
+	result, err := memoryexecutor.ExecuteContext(ctx, query, rows)
+	if err != nil {
+		return nil, repositorymodels.QueryCancelled
+	}
+
+	return result, repositorymodels.StatusOk
+}
+
+func resourceToRow(resource interface{}) map[string]interface{} {
+	data, _ := json.Marshal(resource)
+
+	var row map[string]interface{}
+	jsonnumbers.Unmarshal(data, &row)
+
+	return row
+}
@@ -0,0 +1,147 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+)
+
+var errInvalidSessionToken = errors.New("The session token provided is malformed")
+
+var sessionLSNsLock sync.Mutex
+
+// Keyed by the collection resource id, so a recreated collection starts over. The
+// numbers are not persisted, tokens issued before a restart are ahead of the new ones
+var sessionLSNs = make(map[string]int64)
+
+// Advances the log sequence number of the collection, called on every document write
+func advanceSessionLSN(collection repositorymodels.Collection) {
+	sessionLSNsLock.Lock()
+	defer sessionLSNsLock.Unlock()
+
+	sessionLSNs[collection.ResourceID]++
+}
+
+// Restores the log sequence number of the collection when writes are rolled back
+func setSessionLSN(collection repositorymodels.Collection, lsn int64) {
+	sessionLSNsLock.Lock()
+	defer sessionLSNsLock.Unlock()
+
+	sessionLSNs[collection.ResourceID] = lsn
+}
+
+func getSessionLSN(collection repositorymodels.Collection) int64 {
+	sessionLSNsLock.Lock()
+	defer sessionLSNsLock.Unlock()
+
+	return sessionLSNs[collection.ResourceID]
+}
+
+// Returns the log sequence number of the latest write to the collection
+func GetCollectionLSN(databaseId string, collectionId string) (int64, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	collection, ok := storeState.Collections[databaseId][collectionId]
+	if !ok {
+		return 0, repositorymodels.StatusNotFound
+	}
+
+	return getSessionLSN(collection), repositorymodels.StatusOk
+}
+
+// Returns the session token reflecting the latest write to the collection. The LSN is
+// kept per collection, so the token lists it for every partition key range of the
+// collection, like "1:<lsn>,2:<lsn>" once a range was split
+func GetSessionToken(databaseId string, collectionId string) (string, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	database, ok := storeState.Databases[databaseId]
+	if !ok {
+		return "", repositorymodels.StatusNotFound
+	}
+
+	collection, ok := storeState.Collections[databaseId][collectionId]
+	if !ok {
+		return "", repositorymodels.StatusNotFound
+	}
+
+	lsn := getSessionLSN(collection)
+	rangeTokens := make([]string, 0)
+	for _, partitionKeyRange := range getPartitionKeyRanges(database, collection) {
+		rangeTokens = append(rangeTokens, fmt.Sprintf("%s:%d", partitionKeyRange.ID, lsn))
+	}
+
+	return strings.Join(rangeTokens, ","), repositorymodels.StatusOk
+}
+
+// Checks a session token sent by a client, tokens may list several partition key ranges
+// separated by commas and use either the "<range>:<lsn>" or the "<range>:<version>#<lsn>"
+// format. Returns SessionNotAvailable when the token is ahead of the collection and
+// BadRequest with the reason when it is malformed. Tokens of split ranges are still
+// checked, tokens of ranges the collection never had are ignored
+func ValidateSessionToken(databaseId string, collectionId string, sessionToken string) (repositorymodels.RepositoryStatus, error) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	database, ok := storeState.Databases[databaseId]
+	if !ok {
+		return repositorymodels.StatusNotFound, nil
+	}
+
+	collection, ok := storeState.Collections[databaseId][collectionId]
+	if !ok {
+		return repositorymodels.StatusNotFound, nil
+	}
+
+	rangeIds := make([]string, 0)
+	for _, partitionKeyRange := range getPartitionKeyRanges(database, collection) {
+		rangeIds = append(rangeIds, partitionKeyRange.ID)
+		for _, parent := range partitionKeyRange.Parents {
+			rangeIds = append(rangeIds, fmt.Sprint(parent))
+		}
+	}
+
+	for _, rangeToken := range strings.Split(sessionToken, ",") {
+		rangeId, lsn, err := parseSessionToken(rangeToken)
+		if err != nil {
+			return repositorymodels.BadRequest, err
+		}
+
+		if slices.Contains(rangeIds, rangeId) && lsn > getSessionLSN(collection) {
+			return repositorymodels.SessionNotAvailable, nil
+		}
+	}
+
+	return repositorymodels.StatusOk, nil
+}
+
+func parseSessionToken(rangeToken string) (string, int64, error) {
+	rangeId, value, found := strings.Cut(strings.TrimSpace(rangeToken), ":")
+	if !found || rangeId == "" {
+		return "", 0, errInvalidSessionToken
+	}
+
+	// The second segment holds the global LSN in the versioned format,
+	// the following ones hold the LSNs of the regions
+	segments := strings.Split(value, "#")
+	if len(segments) > 1 {
+		if _, err := strconv.ParseInt(segments[0], 10, 64); err != nil {
+			return "", 0, errInvalidSessionToken
+		}
+		value = segments[1]
+	}
+
+	lsn, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return "", 0, errInvalidSessionToken
+	}
+
+	return rangeId, lsn, nil
+}
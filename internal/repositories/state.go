@@ -1,23 +1,38 @@
 package repositories
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"os"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 
+	"github.com/google/uuid"
 	"github.com/pikami/cosmium/api/config"
+	jsonnumbers "github.com/pikami/cosmium/internal/json_numbers"
 	"github.com/pikami/cosmium/internal/logger"
 	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
 )
 
-var storedProcedures = []repositorymodels.StoredProcedure{}
-var triggers = []repositorymodels.Trigger{}
-var userDefinedFunctions = []repositorymodels.UserDefinedFunction{}
+// Guards swapping the whole store, held exclusively while a snapshot is imported
+var storeStateLock sync.RWMutex
+
 var storeState = repositorymodels.State{
-	Databases:   make(map[string]repositorymodels.Database),
-	Collections: make(map[string]map[string]repositorymodels.Collection),
-	Documents:   make(map[string]map[string]map[string]repositorymodels.Document),
+	Databases:            make(map[string]repositorymodels.Database),
+	Collections:          make(map[string]map[string]repositorymodels.Collection),
+	Documents:            make(map[string]map[string]map[string]repositorymodels.Document),
+	StoredProcedures:     make(map[string]map[string]map[string]repositorymodels.StoredProcedure),
+	Triggers:             make(map[string]map[string]map[string]repositorymodels.Trigger),
+	UserDefinedFunctions: make(map[string]map[string]map[string]repositorymodels.UserDefinedFunction),
+	Conflicts:            make(map[string]map[string]map[string]repositorymodels.ConflictResource),
+	Users:                make(map[string]map[string]repositorymodels.User),
+	Permissions:          make(map[string]map[string]map[string]repositorymodels.Permission),
+	Offers:               make(map[string]repositorymodels.Offer),
 }
 
 func InitializeRepository() {
@@ -50,7 +65,7 @@ func LoadStateFS(filePath string) {
 	}
 
 	var state repositorymodels.State
-	if err := json.Unmarshal(data, &state); err != nil {
+	if err := jsonnumbers.Unmarshal(data, &state); err != nil {
 		log.Fatalf("Error unmarshalling state JSON: %v", err)
 		return
 	}
@@ -60,30 +75,198 @@ func LoadStateFS(filePath string) {
 	logger.Infof("Collections: %d\n", getLength(state.Collections))
 	logger.Infof("Documents: %d\n", getLength(state.Documents))
 
-	storeState = state
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
 
+	storeState = state
 	ensureStoreStateNoNullReferences()
 }
 
-func SaveStateFS(filePath string) {
+// Writes the state to a temporary file next to the given path and renames it,
+// so an interrupted save doesn't leave a truncated state behind
+func SaveStateFS(filePath string) error {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
 	data, err := json.MarshalIndent(storeState, "", "\t")
 	if err != nil {
-		logger.Errorf("Failed to save state: %v\n", err)
-		return
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+
+	temporaryFilePath := filePath + ".tmp"
+	if err := os.WriteFile(temporaryFilePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to save state: %w", err)
 	}
 
-	os.WriteFile(filePath, data, os.ModePerm)
+	if err := os.Rename(temporaryFilePath, filePath); err != nil {
+		os.Remove(temporaryFilePath)
+		return fmt.Errorf("failed to save state: %w", err)
+	}
 
 	logger.Info("Saved state:")
 	logger.Infof("Databases: %d\n", getLength(storeState.Databases))
 	logger.Infof("Collections: %d\n", getLength(storeState.Collections))
 	logger.Infof("Documents: %d\n", getLength(storeState.Documents))
+
+	return nil
 }
 
 func GetState() repositorymodels.State {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
 	return storeState
 }
 
+// Returns a deep copy of the store, which stays unchanged by later requests
+func CopyState() repositorymodels.State {
+	storeStateLock.RLock()
+	data, _ := json.Marshal(storeState)
+	storeStateLock.RUnlock()
+
+	var state repositorymodels.State
+	jsonnumbers.Unmarshal(data, &state)
+	return state
+}
+
+// Replaces the whole store with the given JSON snapshot
+func ImportState(data []byte) (repositorymodels.RepositoryStatus, error) {
+	var state repositorymodels.State
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := jsonnumbers.Decode(decoder, &state); err != nil {
+		return repositorymodels.BadRequest, fmt.Errorf("malformed state: %w", err)
+	}
+
+	return ReplaceState(state)
+}
+
+// Replaces the whole store with the given state, the current
+// state is left untouched when the state fails validation
+func ReplaceState(state repositorymodels.State) (repositorymodels.RepositoryStatus, error) {
+	if err := validateState(state); err != nil {
+		return repositorymodels.BadRequest, err
+	}
+
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	storeState = state
+	ensureStoreStateNoNullReferences()
+
+	logger.Info("Imported state:")
+	logger.Infof("Databases: %d\n", getLength(storeState.Databases))
+	logger.Infof("Collections: %d\n", getLength(storeState.Collections))
+	logger.Infof("Documents: %d\n", getLength(storeState.Documents))
+
+	return repositorymodels.StatusOk, nil
+}
+
+// Checks that every resource is stored under its own id and
+// belongs to a parent resource that is present in the snapshot
+func validateState(state repositorymodels.State) error {
+	var errs []error
+	addError := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	for databaseId, database := range state.Databases {
+		if database.ID != databaseId {
+			addError("database '%s' is stored under id '%s'", database.ID, databaseId)
+		}
+	}
+
+	for databaseId, collections := range state.Collections {
+		if _, ok := state.Databases[databaseId]; !ok {
+			addError("collection references missing database '%s'", databaseId)
+		}
+
+		for collectionId, collection := range collections {
+			if collection.ID != collectionId {
+				addError("collection '%s/%s' is stored under id '%s'", databaseId, collection.ID, collectionId)
+			}
+		}
+	}
+
+	errs = append(errs, validateCollectionResources(state, "document", state.Documents, func(document repositorymodels.Document) string {
+		id, _ := document["id"].(string)
+		return id
+	})...)
+	errs = append(errs, validateCollectionResources(state, "stored procedure", state.StoredProcedures, func(sp repositorymodels.StoredProcedure) string {
+		return sp.ID
+	})...)
+	errs = append(errs, validateCollectionResources(state, "trigger", state.Triggers, func(trigger repositorymodels.Trigger) string {
+		return trigger.ID
+	})...)
+	errs = append(errs, validateCollectionResources(state, "user defined function", state.UserDefinedFunctions, func(udf repositorymodels.UserDefinedFunction) string {
+		return udf.ID
+	})...)
+	errs = append(errs, validateCollectionResources(state, "conflict", state.Conflicts, func(conflict repositorymodels.ConflictResource) string {
+		return conflict.ID
+	})...)
+
+	for databaseId, users := range state.Users {
+		if _, ok := state.Databases[databaseId]; !ok {
+			addError("user references missing database '%s'", databaseId)
+		}
+
+		for userId, user := range users {
+			if user.ID != userId {
+				addError("user '%s/%s' is stored under id '%s'", databaseId, user.ID, userId)
+			}
+		}
+	}
+
+	for databaseId, users := range state.Permissions {
+		for userId, permissions := range users {
+			if _, ok := state.Users[databaseId][userId]; !ok {
+				addError("permission references missing user '%s/%s'", databaseId, userId)
+			}
+
+			for permissionId, permission := range permissions {
+				if permission.ID != permissionId {
+					addError("permission '%s/%s/%s' is stored under id '%s'", databaseId, userId, permission.ID, permissionId)
+				}
+			}
+		}
+	}
+
+	for offerId, offer := range state.Offers {
+		if offer.ID != offerId {
+			addError("offer '%s' is stored under id '%s'", offer.ID, offerId)
+		}
+	}
+
+	// Maps are iterated in random order, keep the report stable
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+
+	return errors.Join(errs...)
+}
+
+func validateCollectionResources[T any](
+	state repositorymodels.State,
+	resourceType string,
+	resources map[string]map[string]map[string]T,
+	getId func(T) string,
+) []error {
+	var errs []error
+	for databaseId, collections := range resources {
+		for collectionId, collectionResources := range collections {
+			if _, ok := state.Collections[databaseId][collectionId]; !ok {
+				errs = append(errs, fmt.Errorf("%s references missing collection '%s/%s'", resourceType, databaseId, collectionId))
+			}
+
+			for key, resource := range collectionResources {
+				if id := getId(resource); id != key {
+					errs = append(errs, fmt.Errorf("%s '%s/%s/%s' is stored under id '%s'", resourceType, databaseId, collectionId, id, key))
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
 func getLength(v interface{}) int {
 	switch v.(type) {
 	case repositorymodels.Database,
@@ -122,7 +305,42 @@ func ensureStoreStateNoNullReferences() {
 		storeState.Documents = make(map[string]map[string]map[string]repositorymodels.Document)
 	}
 
+	if storeState.StoredProcedures == nil {
+		storeState.StoredProcedures = make(map[string]map[string]map[string]repositorymodels.StoredProcedure)
+	}
+
+	if storeState.Triggers == nil {
+		storeState.Triggers = make(map[string]map[string]map[string]repositorymodels.Trigger)
+	}
+
+	if storeState.UserDefinedFunctions == nil {
+		storeState.UserDefinedFunctions = make(map[string]map[string]map[string]repositorymodels.UserDefinedFunction)
+	}
+
+	if storeState.Conflicts == nil {
+		storeState.Conflicts = make(map[string]map[string]map[string]repositorymodels.ConflictResource)
+	}
+
+	if storeState.Users == nil {
+		storeState.Users = make(map[string]map[string]repositorymodels.User)
+	}
+
+	if storeState.Permissions == nil {
+		storeState.Permissions = make(map[string]map[string]map[string]repositorymodels.Permission)
+	}
+
+	if storeState.Offers == nil {
+		storeState.Offers = make(map[string]repositorymodels.Offer)
+	}
+
 	for database := range storeState.Databases {
+		// Hand written initial data may lack the system properties of databases
+		if storedDatabase := storeState.Databases[database]; storedDatabase.ResourceID == "" {
+			storedDatabase.ID = database
+			setDatabaseSystemProperties(&storedDatabase)
+			storeState.Databases[database] = storedDatabase
+		}
+
 		if storeState.Collections[database] == nil {
 			storeState.Collections[database] = make(map[string]repositorymodels.Collection)
 		}
@@ -131,14 +349,105 @@ func ensureStoreStateNoNullReferences() {
 			storeState.Documents[database] = make(map[string]map[string]repositorymodels.Document)
 		}
 
+		if storeState.StoredProcedures[database] == nil {
+			storeState.StoredProcedures[database] = make(map[string]map[string]repositorymodels.StoredProcedure)
+		}
+
+		if storeState.Triggers[database] == nil {
+			storeState.Triggers[database] = make(map[string]map[string]repositorymodels.Trigger)
+		}
+
+		if storeState.UserDefinedFunctions[database] == nil {
+			storeState.UserDefinedFunctions[database] = make(map[string]map[string]repositorymodels.UserDefinedFunction)
+		}
+
+		if storeState.Conflicts[database] == nil {
+			storeState.Conflicts[database] = make(map[string]map[string]repositorymodels.ConflictResource)
+		}
+
+		if storeState.Users[database] == nil {
+			storeState.Users[database] = make(map[string]repositorymodels.User)
+		}
+
+		if storeState.Permissions[database] == nil {
+			storeState.Permissions[database] = make(map[string]map[string]repositorymodels.Permission)
+		}
+
+		for user := range storeState.Users[database] {
+			if storeState.Permissions[database][user] == nil {
+				storeState.Permissions[database][user] = make(map[string]repositorymodels.Permission)
+			}
+		}
+
 		for collection := range storeState.Collections[database] {
+			// States saved by older versions lack the unique key and conflict resolution policies
+			// and the geospatial config
+			storedCollection := storeState.Collections[database][collection]
+			if storedCollection.UniqueKeyPolicy.UniqueKeys == nil {
+				storedCollection.UniqueKeyPolicy.UniqueKeys = make([]repositorymodels.CollectionUniqueKey, 0)
+			}
+			storedCollection.ConflictResolutionPolicy = applyConflictResolutionPolicyDefaults(storedCollection.ConflictResolutionPolicy)
+			if storedCollection.GeospatialConfig.Type == "" {
+				storedCollection.GeospatialConfig.Type = "Geography"
+			}
+			storeState.Collections[database][collection] = storedCollection
+
 			if storeState.Documents[database][collection] == nil {
 				storeState.Documents[database][collection] = make(map[string]repositorymodels.Document)
 			}
 
+			if storeState.StoredProcedures[database][collection] == nil {
+				storeState.StoredProcedures[database][collection] = make(map[string]repositorymodels.StoredProcedure)
+			}
+
+			if storeState.Triggers[database][collection] == nil {
+				storeState.Triggers[database][collection] = make(map[string]repositorymodels.Trigger)
+			}
+
+			if storeState.UserDefinedFunctions[database][collection] == nil {
+				storeState.UserDefinedFunctions[database][collection] = make(map[string]repositorymodels.UserDefinedFunction)
+			}
+
+			if storeState.Conflicts[database][collection] == nil {
+				storeState.Conflicts[database][collection] = make(map[string]repositorymodels.ConflictResource)
+			}
+
+			// Synthetic conflicts are usually hand written without system properties
+			for conflictId, conflict := range storeState.Conflicts[database][collection] {
+				if conflict.ResourceID == "" {
+					setConflictSystemProperties(storeState.Databases[database], storeState.Collections[database][collection], &conflict)
+					storeState.Conflicts[database][collection][conflictId] = conflict
+				}
+			}
+
 			for document := range storeState.Documents[database][collection] {
 				if storeState.Documents[database][collection][document] == nil {
 					delete(storeState.Documents[database][collection], document)
+					continue
+				}
+
+				// Hand written initial data may lack the system properties,
+				// and states saved by older versions lack the document links
+				storedDocument := storeState.Documents[database][collection][document]
+				_, hasRid := storedDocument["_rid"]
+				_, hasAttachments := storedDocument["_attachments"]
+				if !hasRid {
+					setDocumentSystemProperties(
+						storeState.Databases[database],
+						storeState.Collections[database][collection],
+						storedDocument)
+				} else if !hasAttachments {
+					setDocumentLinks(
+						storeState.Databases[database],
+						storeState.Collections[database][collection],
+						storedDocument)
+				}
+
+				// The etag is sent back in the header, it has to be quoted like the ones Cosmos DB generates
+				if etag, _ := storedDocument["_etag"].(string); etag == "" {
+					storedDocument["_etag"] = fmt.Sprintf("\"%s\"", uuid.New())
+				} else if len(etag) < 2 || !strings.HasPrefix(etag, "\"") || !strings.HasSuffix(etag, "\"") {
+					storedDocument["_etag"] = fmt.Sprintf("\"%s\"", etag)
 				}
 			}
 		}
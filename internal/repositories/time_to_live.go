@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+)
+
+// Documents never expire when the collection has no default time to live, with -1
+// they only expire when their "ttl" property is set, which overrides the default
+func ValidateDefaultTimeToLive(defaultTimeToLive *int) error {
+	if defaultTimeToLive != nil && *defaultTimeToLive != -1 && *defaultTimeToLive <= 0 {
+		return errors.New("The value of the 'defaultTtl' property must be -1 or a positive number of seconds")
+	}
+
+	return nil
+}
+
+// Documents expire "ttl" seconds after they were last written, a "ttl"
+// of -1 keeps the document and values that aren't numbers are ignored
+func isDocumentExpired(collection repositorymodels.Collection, document repositorymodels.Document, now time.Time) bool {
+	if collection.DefaultTimeToLive == nil {
+		return false
+	}
+
+	timeToLive := int64(*collection.DefaultTimeToLive)
+	if documentTimeToLive, ok := toInt64(document["ttl"]); ok {
+		timeToLive = documentTimeToLive
+	}
+
+	if timeToLive <= 0 {
+		return false
+	}
+
+	timestamp, ok := toInt64(document["_ts"])
+	return ok && now.Unix() >= timestamp+timeToLive
+}
+
+// Looks up a document that has not expired, expects the store lock to be held by the caller
+func lookupDocument(databaseId string, collectionId string, documentId string) (repositorymodels.Document, bool) {
+	document, ok := storeState.Documents[databaseId][collectionId][documentId]
+	if !ok || isDocumentExpired(storeState.Collections[databaseId][collectionId], document, time.Now()) {
+		return repositorymodels.Document{}, false
+	}
+
+	return document, true
+}
+
+// Removes the expired documents of all collections, they are already hidden from
+// reads and queries before. Returns the number of removed documents
+func PurgeExpiredDocuments() int {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	now := time.Now()
+	purgedDocuments := 0
+	for databaseId, collections := range storeState.Collections {
+		for collectionId, collection := range collections {
+			for documentId, document := range storeState.Documents[databaseId][collectionId] {
+				if isDocumentExpired(collection, document, now) {
+					delete(storeState.Documents[databaseId][collectionId], documentId)
+					purgedDocuments++
+				}
+			}
+		}
+	}
+
+	return purgedDocuments
+}
+
+// Purges the expired documents at every interval until the context is done
+func RunExpiredDocumentsPurge(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			PurgeExpiredDocuments()
+		}
+	}
+}
+
+// Timestamps are integers when the document was written and
+// floats when it was loaded from JSON, fractions are truncated
+func toInt64(value interface{}) (int64, bool) {
+	switch typedValue := value.(type) {
+	case int:
+		return int64(typedValue), true
+	case int64:
+		return typedValue, true
+	case float64:
+		return int64(typedValue), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"golang.org/x/exp/maps"
+)
+
+// Document operations on a single collection applied as a unit, the store lock is
+// held while the transaction runs, so other requests never observe a part of it
+type DocumentTransaction struct {
+	databaseId   string
+	collectionId string
+}
+
+// Runs the function as a transaction on the collection. Its changes are kept when it
+// returns true and discarded when it returns false, as if none of them were applied
+func RunDocumentTransaction(databaseId string, collectionId string, run func(tx DocumentTransaction) bool) repositorymodels.RepositoryStatus {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	collection, ok := storeState.Collections[databaseId][collectionId]
+	if !ok {
+		return repositorymodels.StatusNotFound
+	}
+
+	// Writes store new document maps instead of modifying the stored ones,
+	// so a shallow copy is enough to restore the documents
+	snapshot := maps.Clone(storeState.Documents[databaseId][collectionId])
+	writesSnapshot := snapshotDocumentWrites(collection)
+	if !run(DocumentTransaction{databaseId: databaseId, collectionId: collectionId}) {
+		storeState.Documents[databaseId][collectionId] = snapshot
+		writesSnapshot.restore()
+	}
+
+	return repositorymodels.StatusOk
+}
+
+func (tx DocumentTransaction) GetDocument(documentId string) (repositorymodels.Document, repositorymodels.RepositoryStatus) {
+	document, ok := lookupDocument(tx.databaseId, tx.collectionId, documentId)
+	if !ok {
+		return repositorymodels.Document{}, repositorymodels.StatusNotFound
+	}
+
+	return document, repositorymodels.StatusOk
+}
+
+func (tx DocumentTransaction) CreateDocument(document map[string]interface{}) (repositorymodels.Document, repositorymodels.RepositoryStatus) {
+	return createDocument(tx.databaseId, tx.collectionId, document)
+}
+
+// Behaves like UpsertDocument
+func (tx DocumentTransaction) UpsertDocument(
+	document map[string]interface{},
+	check func(existingDocument repositorymodels.Document) error,
+) (repositorymodels.Document, bool, repositorymodels.RepositoryStatus, error) {
+	return upsertDocument(tx.databaseId, tx.collectionId, document, check)
+}
+
+func (tx DocumentTransaction) ReplaceDocument(documentId string, document map[string]interface{}) (repositorymodels.Document, repositorymodels.RepositoryStatus) {
+	existingDocument, ok := lookupDocument(tx.databaseId, tx.collectionId, documentId)
+	if !ok {
+		return repositorymodels.Document{}, repositorymodels.StatusNotFound
+	}
+
+	return replaceDocument(tx.databaseId, tx.collectionId, documentId, existingDocument, document)
+}
+
+// Behaves like PatchDocument
+func (tx DocumentTransaction) PatchDocument(
+	documentId string,
+	patch func(document repositorymodels.Document) (map[string]interface{}, error),
+) (repositorymodels.Document, repositorymodels.RepositoryStatus, error) {
+	return patchDocument(tx.databaseId, tx.collectionId, documentId, patch)
+}
+
+func (tx DocumentTransaction) DeleteDocument(documentId string) repositorymodels.RepositoryStatus {
+	return deleteDocument(tx.databaseId, tx.collectionId, documentId)
+}
@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+	"github.com/pikami/cosmium/internal/resourceid"
+	"golang.org/x/exp/maps"
+)
+
+func GetAllUsers(databaseId string) ([]repositorymodels.User, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	if _, ok := storeState.Databases[databaseId]; !ok {
+		return make([]repositorymodels.User, 0), repositorymodels.StatusNotFound
+	}
+
+	return maps.Values(storeState.Users[databaseId]), repositorymodels.StatusOk
+}
+
+func GetUser(databaseId string, userId string) (repositorymodels.User, repositorymodels.RepositoryStatus) {
+	storeStateLock.RLock()
+	defer storeStateLock.RUnlock()
+
+	if _, ok := storeState.Databases[databaseId]; !ok {
+		return repositorymodels.User{}, repositorymodels.StatusNotFound
+	}
+
+	if user, ok := storeState.Users[databaseId][userId]; ok {
+		return user, repositorymodels.StatusOk
+	}
+
+	return repositorymodels.User{}, repositorymodels.StatusNotFound
+}
+
+func DeleteUser(databaseId string, userId string) repositorymodels.RepositoryStatus {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	if _, ok := storeState.Databases[databaseId]; !ok {
+		return repositorymodels.StatusNotFound
+	}
+
+	if _, ok := storeState.Users[databaseId][userId]; !ok {
+		return repositorymodels.StatusNotFound
+	}
+
+	delete(storeState.Users[databaseId], userId)
+	delete(storeState.Permissions[databaseId], userId)
+
+	return repositorymodels.StatusOk
+}
+
+func CreateUser(databaseId string, newUser repositorymodels.User) (repositorymodels.User, repositorymodels.RepositoryStatus) {
+	storeStateLock.Lock()
+	defer storeStateLock.Unlock()
+
+	var ok bool
+	var database repositorymodels.Database
+	if database, ok = storeState.Databases[databaseId]; !ok {
+		return repositorymodels.User{}, repositorymodels.StatusNotFound
+	}
+
+	if _, ok = storeState.Users[databaseId][newUser.ID]; ok {
+		return repositorymodels.User{}, repositorymodels.Conflict
+	}
+
+	newUser.TimeStamp = time.Now().Unix()
+	newUser.ResourceID = resourceid.NewCombined(database.ResourceID, resourceid.New())
+	newUser.ETag = fmt.Sprintf("\"%s\"", uuid.New())
+	newUser.Self = fmt.Sprintf("dbs/%s/users/%s/", database.ResourceID, newUser.ResourceID)
+	newUser.Permissions = "permissions/"
+
+	storeState.Users[databaseId][newUser.ID] = newUser
+	storeState.Permissions[databaseId][newUser.ID] = make(map[string]repositorymodels.Permission)
+
+	return newUser, repositorymodels.StatusOk
+}
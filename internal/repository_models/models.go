@@ -15,43 +15,97 @@ const (
 	StatusNotFound = 2
 	Conflict       = 3
 	BadRequest     = 4
+	// Returned when the query text could not be parsed,
+	// as opposed to BadRequest for queries that parse but can't be executed
+	QueryParseError = 5
+	// Returned when the query execution was stopped by a cancelled or expired context
+	QueryCancelled = 6
+	// Returned when a session token is ahead of the writes the collection has seen
+	SessionNotAvailable = 7
+	// Returned when partition key values are required and the document lacks one
+	MissingPartitionKey = 8
+	// Returned for partition key ranges that were split into new ranges
+	PartitionKeyRangeGone = 9
+	// Returned when a collection already holds as many documents as its limit allows
+	DocumentLimitReached = 10
 )
 
 type Collection struct {
 	ID             string                   `json:"id"`
 	IndexingPolicy CollectionIndexingPolicy `json:"indexingPolicy"`
 	PartitionKey   CollectionPartitionKey   `json:"partitionKey"`
-	ResourceID     string                   `json:"_rid"`
-	TimeStamp      int64                    `json:"_ts"`
-	Self           string                   `json:"_self"`
-	ETag           string                   `json:"_etag"`
-	Docs           string                   `json:"_docs"`
-	Sprocs         string                   `json:"_sprocs"`
-	Triggers       string                   `json:"_triggers"`
-	Udfs           string                   `json:"_udfs"`
-	Conflicts      string                   `json:"_conflicts"`
+	// Seconds after the last write at which documents expire, nil disables expiration
+	DefaultTimeToLive *int `json:"defaultTtl,omitempty"`
+	// Stored as submitted, there is no analytical store to expire documents from
+	AnalyticalStorageTimeToLive *int `json:"analyticalStorageTtl,omitempty"`
+	// Unique keys and the conflict resolution policy are set when the collection is created
+	UniqueKeyPolicy          CollectionUniqueKeyPolicy          `json:"uniqueKeyPolicy"`
+	ConflictResolutionPolicy CollectionConflictResolutionPolicy `json:"conflictResolutionPolicy"`
+	GeospatialConfig         CollectionGeospatialConfig         `json:"geospatialConfig"`
+	ResourceID               string                             `json:"_rid"`
+	TimeStamp                int64                              `json:"_ts"`
+	Self                     string                             `json:"_self"`
+	ETag                     string                             `json:"_etag"`
+	Docs                     string                             `json:"_docs"`
+	Sprocs                   string                             `json:"_sprocs"`
+	Triggers                 string                             `json:"_triggers"`
+	Udfs                     string                             `json:"_udfs"`
+	Conflicts                string                             `json:"_conflicts"`
 }
 
+// Whether spatial data of the collection is indexed as Geography or Geometry
+type CollectionGeospatialConfig struct {
+	Type string `json:"type"`
+}
+
+type CollectionUniqueKeyPolicy struct {
+	UniqueKeys []CollectionUniqueKey `json:"uniqueKeys"`
+}
+
+type CollectionUniqueKey struct {
+	Paths []string `json:"paths"`
+}
+
+type CollectionConflictResolutionPolicy struct {
+	Mode                        string `json:"mode"`
+	ConflictResolutionPath      string `json:"conflictResolutionPath"`
+	ConflictResolutionProcedure string `json:"conflictResolutionProcedure"`
+}
+
+// Submitted policies are stored with the defaults Cosmos DB fills in, other
+// properties that were left out are also left out when the collection is read
 type CollectionIndexingPolicy struct {
-	IndexingMode  string                         `json:"indexingMode"`
-	Automatic     bool                           `json:"automatic"`
-	IncludedPaths []CollectionIndexingPolicyPath `json:"includedPaths"`
-	ExcludedPaths []CollectionIndexingPolicyPath `json:"excludedPaths"`
+	IndexingMode     string                                     `json:"indexingMode,omitempty"`
+	Automatic        *bool                                      `json:"automatic,omitempty"`
+	IncludedPaths    []CollectionIndexingPolicyPath             `json:"includedPaths,omitempty"`
+	ExcludedPaths    []CollectionIndexingPolicyPath             `json:"excludedPaths,omitempty"`
+	SpatialIndexes   []CollectionIndexingPolicySpatialIndex     `json:"spatialIndexes,omitempty"`
+	CompositeIndexes [][]CollectionIndexingPolicyCompositeIndex `json:"compositeIndexes,omitempty"`
+}
+
+type CollectionIndexingPolicySpatialIndex struct {
+	Path  string   `json:"path"`
+	Types []string `json:"types"`
+}
+
+type CollectionIndexingPolicyCompositeIndex struct {
+	Path  string `json:"path"`
+	Order string `json:"order,omitempty"`
 }
 
 type CollectionIndexingPolicyPath struct {
 	Path    string `json:"path"`
 	Indexes []struct {
 		Kind      string `json:"kind"`
-		DataType  string `json:"dataType"`
-		Precision int    `json:"precision"`
-	} `json:"indexes"`
+		DataType  string `json:"dataType,omitempty"`
+		Precision int    `json:"precision,omitempty"`
+	} `json:"indexes,omitempty"`
 }
 
 type CollectionPartitionKey struct {
 	Paths   []string `json:"paths"`
 	Kind    string   `json:"kind"`
-	Version int      `json:"Version"`
+	Version int      `json:"version"`
 }
 
 type UserDefinedFunction struct {
@@ -83,21 +137,88 @@ type Trigger struct {
 	Etag             string `json:"_etag"`
 }
 
+// Conflicting write of a multi-region account, resourceId is the resource id
+// of the conflicting resource and content holds its JSON
+type ConflictResource struct {
+	ID                    string `json:"id"`
+	ResourceType          string `json:"resourceType"`
+	OperationType         string `json:"operationType"`
+	ConflictingResourceID string `json:"resourceId"`
+	Content               string `json:"content"`
+	ResourceID            string `json:"_rid"`
+	TimeStamp             int64  `json:"_ts"`
+	Self                  string `json:"_self"`
+	Etag                  string `json:"_etag"`
+}
+
 type Document map[string]interface{}
 
+type User struct {
+	ID          string `json:"id"`
+	ResourceID  string `json:"_rid"`
+	TimeStamp   int64  `json:"_ts"`
+	Self        string `json:"_self"`
+	ETag        string `json:"_etag"`
+	Permissions string `json:"_permissions"`
+}
+
+type PermissionMode string
+
+const (
+	PermissionModeRead PermissionMode = "Read"
+	PermissionModeAll  PermissionMode = "All"
+)
+
+type Permission struct {
+	ID             string         `json:"id"`
+	PermissionMode PermissionMode `json:"permissionMode"`
+	Resource       string         `json:"resource"`
+	ResourceID     string         `json:"_rid"`
+	TimeStamp      int64          `json:"_ts"`
+	Self           string         `json:"_self"`
+	ETag           string         `json:"_etag"`
+	Token          string         `json:"_token"`
+}
+
 type PartitionKeyRange struct {
-	ResourceID         string `json:"_rid"`
-	ID                 string `json:"id"`
-	Etag               string `json:"_etag"`
-	MinInclusive       string `json:"minInclusive"`
-	MaxExclusive       string `json:"maxExclusive"`
-	RidPrefix          int    `json:"ridPrefix"`
-	Self               string `json:"_self"`
-	ThroughputFraction int    `json:"throughputFraction"`
-	Status             string `json:"status"`
-	Parents            []any  `json:"parents"`
-	TimeStamp          int64  `json:"_ts"`
-	Lsn                int    `json:"lsn"`
+	ResourceID         string  `json:"_rid"`
+	ID                 string  `json:"id"`
+	Etag               string  `json:"_etag"`
+	MinInclusive       string  `json:"minInclusive"`
+	MaxExclusive       string  `json:"maxExclusive"`
+	RidPrefix          int     `json:"ridPrefix"`
+	Self               string  `json:"_self"`
+	ThroughputFraction float64 `json:"throughputFraction"`
+	Status             string  `json:"status"`
+	Parents            []any   `json:"parents"`
+	TimeStamp          int64   `json:"_ts"`
+	Lsn                int     `json:"lsn"`
+}
+
+// Throughput provisioned for a collection or a database, offerResourceId is the
+// resource id of the resource and resource its self link
+type Offer struct {
+	ID              string       `json:"id"`
+	Resource        string       `json:"resource"`
+	OfferType       string       `json:"offerType"`
+	OfferResourceID string       `json:"offerResourceId"`
+	OfferVersion    string       `json:"offerVersion"`
+	Content         OfferContent `json:"content"`
+	ResourceID      string       `json:"_rid"`
+	TimeStamp       int64        `json:"_ts"`
+	Self            string       `json:"_self"`
+	ETag            string       `json:"_etag"`
+}
+
+// Autoscaled offers report the throughput they scale down to as their offer throughput
+type OfferContent struct {
+	OfferThroughput        int                     `json:"offerThroughput"`
+	OfferAutopilotSettings *OfferAutopilotSettings `json:"offerAutopilotSettings,omitempty"`
+}
+
+type OfferAutopilotSettings struct {
+	MaxThroughput     int                    `json:"maxThroughput"`
+	AutoUpgradePolicy map[string]interface{} `json:"autoUpgradePolicy,omitempty"`
 }
 
 type State struct {
@@ -109,4 +230,25 @@ type State struct {
 
 	// Map databaseId -> collectionId -> documentId -> Documents
 	Documents map[string]map[string]map[string]Document `json:"documents"`
+
+	// Map databaseId -> collectionId -> storedProcedureId -> StoredProcedure
+	StoredProcedures map[string]map[string]map[string]StoredProcedure `json:"sprocs"`
+
+	// Map databaseId -> collectionId -> triggerId -> Trigger
+	Triggers map[string]map[string]map[string]Trigger `json:"triggers"`
+
+	// Map databaseId -> collectionId -> udfId -> UserDefinedFunction
+	UserDefinedFunctions map[string]map[string]map[string]UserDefinedFunction `json:"udfs"`
+
+	// Map databaseId -> collectionId -> conflictId -> Conflict
+	Conflicts map[string]map[string]map[string]ConflictResource `json:"conflicts"`
+
+	// Map databaseId -> userId -> User
+	Users map[string]map[string]User `json:"users"`
+
+	// Map databaseId -> userId -> permissionId -> Permission
+	Permissions map[string]map[string]map[string]Permission `json:"permissions"`
+
+	// Map offerId -> Offer
+	Offers map[string]Offer `json:"offers"`
 }
@@ -4,10 +4,12 @@ import (
 	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
 )
 
+var defaultAutomaticIndexing = true
+
 var defaultCollection repositorymodels.Collection = repositorymodels.Collection{
 	IndexingPolicy: repositorymodels.CollectionIndexingPolicy{
 		IndexingMode: "consistent",
-		Automatic:    true,
+		Automatic:    &defaultAutomaticIndexing,
 		IncludedPaths: []repositorymodels.CollectionIndexingPolicyPath{
 			{Path: "/*"},
 		},
@@ -20,6 +22,12 @@ var defaultCollection repositorymodels.Collection = repositorymodels.Collection{
 		Kind:    "Hash",
 		Version: 2,
 	},
+	UniqueKeyPolicy: repositorymodels.CollectionUniqueKeyPolicy{
+		UniqueKeys: []repositorymodels.CollectionUniqueKey{},
+	},
+	GeospatialConfig: repositorymodels.CollectionGeospatialConfig{
+		Type: "Geography",
+	},
 	ResourceID: "nFFFFFFFFFF=",
 	TimeStamp:  0,
 	Self:       "",
@@ -0,0 +1,30 @@
+package tlsprovider_test
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	tlsprovider "github.com/pikami/cosmium/internal/tls_provider"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GenerateSelfSignedTlsConfig(t *testing.T) {
+	t.Run("Should generate certificate for given hosts", func(t *testing.T) {
+		tlsConfig, certificatePem, err := tlsprovider.GenerateSelfSignedTlsConfig([]string{"localhost", "127.0.0.1", "cosmium.local"})
+		assert.Nil(t, err)
+		assert.Len(t, tlsConfig.Certificates, 1)
+
+		block, _ := pem.Decode(certificatePem)
+		if !assert.NotNil(t, block) {
+			return
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		assert.Nil(t, err)
+		assert.Nil(t, certificate.VerifyHostname("localhost"))
+		assert.Nil(t, certificate.VerifyHostname("127.0.0.1"))
+		assert.Nil(t, certificate.VerifyHostname("cosmium.local"))
+		assert.NotNil(t, certificate.VerifyHostname("example.com"))
+	})
+}
@@ -1,33 +1,52 @@
 package main
 
 import (
+	"context"
 	"os"
 	"os/signal"
 	"syscall"
 
-	"github.com/pikami/cosmium/api"
 	"github.com/pikami/cosmium/api/config"
-	"github.com/pikami/cosmium/internal/repositories"
+	"github.com/pikami/cosmium/internal/logger"
+	"github.com/pikami/cosmium/server"
 )
 
 func main() {
 	config.ParseFlags()
 
-	repositories.InitializeRepository()
-
-	go api.StartAPI()
+	emulator := server.New(config.Config)
+	if err := emulator.Start(); err != nil {
+		logger.Error(err)
+		os.Exit(1)
+	}
 
-	waitForExit()
+	os.Exit(waitForExit(emulator))
 }
 
-func waitForExit() {
+// Blocks until an exit signal is received, then stops accepting requests,
+// waits for in-flight requests to finish and persists the state.
+// Returns a non-zero exit code when the shutdown had to be forced
+func waitForExit(emulator *server.Server) int {
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 
 	// Block until a exit signal is received
 	<-sigs
+	logger.Info("Shutting down")
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.Config.ShutdownTimeout)
+	defer cancel()
 
-	if config.Config.PersistDataFilePath != "" {
-		repositories.SaveStateFS(config.Config.PersistDataFilePath)
+	// A second signal forces the shutdown
+	go func() {
+		<-sigs
+		cancel()
+	}()
+
+	if err := emulator.Shutdown(ctx); err != nil {
+		logger.Error(err)
+		return 1
 	}
+
+	return 0
 }
@@ -13,8 +13,13 @@ type SelectStmt struct {
 	GroupBy          []SelectItem
 }
 
+// Value is the name the rows are bound to, for subqueries in the FROM
+// clause it is their alias and the rows are the results of the subquery.
+// For "FROM p IN c.parents" the rows are the items of the array Source yields
 type Table struct {
-	Value string
+	Value    string
+	SubQuery *SelectStmt
+	Source   *SelectItem
 }
 
 type JoinItem struct {
@@ -30,6 +35,9 @@ const (
 	SelectItemTypeArray
 	SelectItemTypeConstant
 	SelectItemTypeFunctionCall
+	SelectItemTypeBinaryExpression
+	// Value is a SelectStmt evaluated against the current row, like the subquery of "c.id IN (SELECT ...)"
+	SelectItemTypeSubQuery
 )
 
 type SelectItem struct {
@@ -41,13 +49,22 @@ type SelectItem struct {
 	IsTopLevel  bool
 }
 
+// Arithmetic on two values, Operation is one of "+", "-", "*", "/" and "%"
+type BinaryExpression struct {
+	Left      SelectItem
+	Right     SelectItem
+	Operation string
+}
+
 type LogicalExpressionType int
 
 const (
 	LogicalExpressionTypeOr LogicalExpressionType = iota
 	LogicalExpressionTypeAnd
+	LogicalExpressionTypeNot
 )
 
+// Expressions of a NOT hold the single negated expression
 type LogicalExpression struct {
 	Expressions []interface{}
 	Operation   LogicalExpressionType
@@ -59,6 +76,15 @@ type ComparisonExpression struct {
 	Operation string
 }
 
+// Matches Left against a LIKE pattern with the "%" and "_" wildcards,
+// Escape is the optional expression of the ESCAPE clause
+type LikeExpression struct {
+	Left    interface{}
+	Pattern interface{}
+	Escape  interface{}
+	Not     bool
+}
+
 type ConstantType int
 
 const (
@@ -108,6 +134,7 @@ const (
 	FunctionCallRTrim        FunctionCallType = "RTrim"
 	FunctionCallSubstring    FunctionCallType = "Substring"
 	FunctionCallTrim         FunctionCallType = "Trim"
+	FunctionCallRegexMatch   FunctionCallType = "RegexMatch"
 
 	FunctionCallIsDefined      FunctionCallType = "IsDefined"
 	FunctionCallIsArray        FunctionCallType = "IsArray"
@@ -120,11 +147,14 @@ const (
 	FunctionCallIsPrimitive    FunctionCallType = "IsPrimitive"
 	FunctionCallIsString       FunctionCallType = "IsString"
 
-	FunctionCallArrayConcat  FunctionCallType = "ArrayConcat"
-	FunctionCallArrayLength  FunctionCallType = "ArrayLength"
-	FunctionCallArraySlice   FunctionCallType = "ArraySlice"
-	FunctionCallSetIntersect FunctionCallType = "SetIntersect"
-	FunctionCallSetUnion     FunctionCallType = "SetUnion"
+	FunctionCallArrayConcat      FunctionCallType = "ArrayConcat"
+	FunctionCallArrayContains    FunctionCallType = "ArrayContains"
+	FunctionCallArrayContainsAny FunctionCallType = "ArrayContainsAny"
+	FunctionCallArrayContainsAll FunctionCallType = "ArrayContainsAll"
+	FunctionCallArrayLength      FunctionCallType = "ArrayLength"
+	FunctionCallArraySlice       FunctionCallType = "ArraySlice"
+	FunctionCallSetIntersect     FunctionCallType = "SetIntersect"
+	FunctionCallSetUnion         FunctionCallType = "SetUnion"
 
 	FunctionCallMathAbs              FunctionCallType = "MathAbs"
 	FunctionCallMathAcos             FunctionCallType = "MathAcos"
@@ -163,13 +193,25 @@ const (
 	FunctionCallMathTan              FunctionCallType = "MathTan"
 	FunctionCallMathTrunc            FunctionCallType = "MathTrunc"
 
+	FunctionCallSpatialDistance   FunctionCallType = "SpatialDistance"
+	FunctionCallSpatialWithin     FunctionCallType = "SpatialWithin"
+	FunctionCallSpatialIntersects FunctionCallType = "SpatialIntersects"
+
+	FunctionCallDateTimeToTicks     FunctionCallType = "DateTimeToTicks"
+	FunctionCallDateTimeToTimestamp FunctionCallType = "DateTimeToTimestamp"
+	FunctionCallGetCurrentTicks     FunctionCallType = "GetCurrentTicks"
+	FunctionCallTicksToDateTime     FunctionCallType = "TicksToDateTime"
+	FunctionCallTimestampToDateTime FunctionCallType = "TimestampToDateTime"
+
 	FunctionCallAggregateAvg   FunctionCallType = "AggregateAvg"
 	FunctionCallAggregateCount FunctionCallType = "AggregateCount"
 	FunctionCallAggregateMax   FunctionCallType = "AggregateMax"
 	FunctionCallAggregateMin   FunctionCallType = "AggregateMin"
 	FunctionCallAggregateSum   FunctionCallType = "AggregateSum"
 
-	FunctionCallIn FunctionCallType = "In"
+	FunctionCallIn        FunctionCallType = "In"
+	FunctionCallExists    FunctionCallType = "Exists"
+	FunctionCallNotExists FunctionCallType = "NotExists"
 )
 
 var AggregateFunctions = []FunctionCallType{
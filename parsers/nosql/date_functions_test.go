@@ -0,0 +1,63 @@
+package nosql_test
+
+import (
+	"testing"
+
+	"github.com/pikami/cosmium/parsers"
+)
+
+func Test_Parse_DateTimeFunctions(t *testing.T) {
+
+	t.Run("Should parse function GETCURRENTTICKS()", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT GetCurrentTicks() FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Type: parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type:      parsers.FunctionCallGetCurrentTicks,
+							Arguments: []interface{}{},
+						},
+					},
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+		)
+	})
+
+	for _, function := range []struct {
+		name         string
+		functionType parsers.FunctionCallType
+	}{
+		{"DateTimeToTicks", parsers.FunctionCallDateTimeToTicks},
+		{"DateTimeToTimestamp", parsers.FunctionCallDateTimeToTimestamp},
+		{"TicksToDateTime", parsers.FunctionCallTicksToDateTime},
+		{"TimestampToDateTime", parsers.FunctionCallTimestampToDateTime},
+	} {
+		t.Run("Should parse function "+function.name+"()", func(t *testing.T) {
+			testQueryParse(
+				t,
+				`SELECT `+function.name+`(c.value) FROM c`,
+				parsers.SelectStmt{
+					SelectItems: []parsers.SelectItem{
+						{
+							Type: parsers.SelectItemTypeFunctionCall,
+							Value: parsers.FunctionCall{
+								Type: function.functionType,
+								Arguments: []interface{}{
+									parsers.SelectItem{
+										Path: []string{"c", "value"},
+										Type: parsers.SelectItemTypeField,
+									},
+								},
+							},
+						},
+					},
+					Table: parsers.Table{Value: "c"},
+				},
+			)
+		})
+	}
+}
@@ -1,9 +1,11 @@
 package nosql_test
 
 import (
-	"log"
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"unicode"
 
 	"github.com/pikami/cosmium/parsers"
 	"github.com/pikami/cosmium/parsers/nosql"
@@ -29,7 +31,7 @@ import (
 func testQueryParse(t *testing.T, query string, expectedQuery parsers.SelectStmt) {
 	parsedQuery, err := nosql.Parse("", []byte(query))
 	if err != nil {
-		log.Fatal(err)
+		t.Fatal(err)
 	}
 
 	if !reflect.DeepEqual(parsedQuery, expectedQuery) {
@@ -63,6 +65,177 @@ func Test_Parse(t *testing.T) {
 		)
 	})
 
+	t.Run("Should parse SELECT with computed ORDER BY expressions", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.id FROM c ORDER BY c.price * c.quantity DESC, LOWER(c.name)`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{Value: "c"},
+				OrderExpressions: []parsers.OrderExpression{
+					{
+						SelectItem: parsers.SelectItem{
+							Type: parsers.SelectItemTypeBinaryExpression,
+							Value: parsers.BinaryExpression{
+								Left:      parsers.SelectItem{Path: []string{"c", "price"}},
+								Right:     parsers.SelectItem{Path: []string{"c", "quantity"}},
+								Operation: "*",
+							},
+						},
+						Direction: parsers.OrderDirectionDesc,
+					},
+					{
+						SelectItem: parsers.SelectItem{
+							Type: parsers.SelectItemTypeFunctionCall,
+							Value: parsers.FunctionCall{
+								Type: parsers.FunctionCallLower,
+								Arguments: []interface{}{
+									parsers.SelectItem{Path: []string{"c", "name"}, Type: parsers.SelectItemTypeField},
+								},
+							},
+						},
+						Direction: parsers.OrderDirectionAsc,
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("Should parse SELECT with ORDER BY a subquery", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.id FROM c ORDER BY (SELECT VALUE COUNT(1) FROM t IN c.items) DESC`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{Value: "c"},
+				OrderExpressions: []parsers.OrderExpression{
+					{
+						SelectItem: parsers.SelectItem{
+							Type: parsers.SelectItemTypeSubQuery,
+							Value: parsers.SelectStmt{
+								SelectItems: []parsers.SelectItem{
+									{
+										Type: parsers.SelectItemTypeFunctionCall,
+										Value: parsers.FunctionCall{
+											Type: parsers.FunctionCallAggregateCount,
+											Arguments: []interface{}{
+												parsers.SelectItem{
+													Type:  parsers.SelectItemTypeConstant,
+													Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 1},
+												},
+											},
+										},
+										IsTopLevel: true,
+									},
+								},
+								Table: parsers.Table{
+									Value:  "t",
+									Source: &parsers.SelectItem{Path: []string{"c", "items"}},
+								},
+							},
+						},
+						Direction: parsers.OrderDirectionDesc,
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("Should parse arithmetic operators with precedence", func(t *testing.T) {
+		integerConstant := func(value int) parsers.SelectItem {
+			return parsers.SelectItem{
+				Type:  parsers.SelectItemTypeConstant,
+				Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: value},
+			}
+		}
+		binaryExpression := func(left parsers.SelectItem, operation string, right parsers.SelectItem) parsers.SelectItem {
+			return parsers.SelectItem{
+				Type:  parsers.SelectItemTypeBinaryExpression,
+				Value: parsers.BinaryExpression{Left: left, Right: right, Operation: operation},
+			}
+		}
+
+		total := binaryExpression(
+			binaryExpression(
+				parsers.SelectItem{Path: []string{"c", "a"}},
+				"+",
+				binaryExpression(parsers.SelectItem{Path: []string{"c", "b"}}, "*", integerConstant(2)),
+			),
+			"-",
+			integerConstant(1),
+		)
+		total.Alias = "total"
+
+		testQueryParse(
+			t,
+			`SELECT c.a + c.b * 2 - 1 AS total FROM c WHERE (c.a + 1) % 2 > 0`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{total},
+				Table:       parsers.Table{Value: "c"},
+				Filters: parsers.ComparisonExpression{
+					Operation: ">",
+					Left: binaryExpression(
+						binaryExpression(parsers.SelectItem{Path: []string{"c", "a"}}, "+", integerConstant(1)),
+						"%",
+						integerConstant(2),
+					),
+					Right: integerConstant(0),
+				},
+			},
+		)
+	})
+
+	t.Run("Should parse subqueries in the FROM clause", func(t *testing.T) {
+		subQuery := parsers.SelectStmt{
+			SelectItems: []parsers.SelectItem{
+				{Path: []string{"c", "cat"}, Alias: "cat"},
+				{
+					Alias: "n",
+					Type:  parsers.SelectItemTypeFunctionCall,
+					Value: parsers.FunctionCall{
+						Type: parsers.FunctionCallAggregateCount,
+						Arguments: []interface{}{
+							parsers.SelectItem{
+								Type:  parsers.SelectItemTypeConstant,
+								Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 1},
+							},
+						},
+					},
+				},
+			},
+			Table:   parsers.Table{Value: "c"},
+			GroupBy: []parsers.SelectItem{{Path: []string{"c", "cat"}}},
+		}
+
+		for _, query := range []string{
+			`SELECT * FROM (SELECT c.cat AS cat, COUNT(1) AS n FROM c GROUP BY c.cat) AS g WHERE g.n > 5`,
+			`select * from ( select c.cat as cat, count(1) as n from c group by c.cat ) g where g.n > 5`,
+		} {
+			testQueryParse(
+				t,
+				query,
+				parsers.SelectStmt{
+					SelectItems: []parsers.SelectItem{
+						{Path: []string{"g"}, IsTopLevel: true},
+					},
+					Table: parsers.Table{Value: "g", SubQuery: &subQuery},
+					Filters: parsers.ComparisonExpression{
+						Operation: ">",
+						Left:      parsers.SelectItem{Path: []string{"g", "n"}},
+						Right: parsers.SelectItem{
+							Type:  parsers.SelectItemTypeConstant,
+							Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 5},
+						},
+					},
+				},
+			)
+		}
+	})
+
 	t.Run("Should parse SELECT with GROUP BY", func(t *testing.T) {
 		testQueryParse(
 			t,
@@ -122,4 +295,216 @@ func Test_Parse(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("Should parse IN function with a subquery", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.id FROM c WHERE c.id IN (SELECT VALUE p.refId FROM p IN c.parents)`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Path: []string{"c", "id"},
+						Type: parsers.SelectItemTypeField,
+					},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.SelectItem{
+					Type: parsers.SelectItemTypeFunctionCall,
+					Value: parsers.FunctionCall{
+						Type: parsers.FunctionCallIn,
+						Arguments: []interface{}{
+							parsers.SelectItem{
+								Path: []string{"c", "id"},
+								Type: parsers.SelectItemTypeField,
+							},
+							parsers.SelectItem{
+								Type: parsers.SelectItemTypeSubQuery,
+								Value: parsers.SelectStmt{
+									SelectItems: []parsers.SelectItem{
+										{Path: []string{"p", "refId"}, IsTopLevel: true},
+									},
+									Table: parsers.Table{
+										Value:  "p",
+										Source: &parsers.SelectItem{Path: []string{"c", "parents"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("Should parse EXISTS and NOT EXISTS", func(t *testing.T) {
+		existsCall := func(functionType parsers.FunctionCallType) parsers.SelectItem {
+			return parsers.SelectItem{
+				Type: parsers.SelectItemTypeFunctionCall,
+				Value: parsers.FunctionCall{
+					Type: functionType,
+					Arguments: []interface{}{
+						parsers.SelectItem{
+							Type: parsers.SelectItemTypeSubQuery,
+							Value: parsers.SelectStmt{
+								SelectItems: []parsers.SelectItem{
+									{Path: []string{"t"}, IsTopLevel: true},
+								},
+								Table: parsers.Table{
+									Value:  "t",
+									Source: &parsers.SelectItem{Path: []string{"c", "tags"}},
+								},
+								Filters: parsers.SelectItem{Path: []string{"t", "active"}},
+							},
+						},
+					},
+				},
+			}
+		}
+		hasActive := existsCall(parsers.FunctionCallExists)
+		hasActive.Alias = "hasActive"
+		lacksActive := existsCall(parsers.FunctionCallNotExists)
+		lacksActive.Alias = "lacksActive"
+
+		testQueryParse(
+			t,
+			`SELECT c.id, EXISTS(SELECT VALUE t FROM t IN c.tags WHERE t.active) AS hasActive,
+				NOT EXISTS (SELECT VALUE t FROM t IN c.tags WHERE t.active) AS lacksActive
+			FROM c WHERE NOT EXISTS(SELECT VALUE t FROM t IN c.tags WHERE t.active)`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+					hasActive,
+					lacksActive,
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.LogicalExpression{
+					Operation:   parsers.LogicalExpressionTypeNot,
+					Expressions: []interface{}{existsCall(parsers.FunctionCallExists)},
+				},
+			},
+		)
+	})
+
+	t.Run("Should parse FROM with an array source", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT * FROM p IN c.parents`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"p"}, IsTopLevel: true},
+				},
+				Table: parsers.Table{
+					Value:  "p",
+					Source: &parsers.SelectItem{Path: []string{"c", "parents"}},
+				},
+			},
+		)
+	})
+}
+
+func Test_Parse_Formatting(t *testing.T) {
+	expectedQuery := parsers.SelectStmt{
+		SelectItems: []parsers.SelectItem{
+			{Path: []string{"c", "id"}},
+			{
+				Alias: "upperName",
+				Type:  parsers.SelectItemTypeFunctionCall,
+				Value: parsers.FunctionCall{
+					Type: parsers.FunctionCallUpper,
+					Arguments: []interface{}{
+						parsers.SelectItem{Path: []string{"c", "Name"}, Type: parsers.SelectItemTypeField},
+					},
+				},
+			},
+		},
+		Table: parsers.Table{Value: "c"},
+		Filters: parsers.LogicalExpression{
+			Operation: parsers.LogicalExpressionTypeAnd,
+			Expressions: []interface{}{
+				parsers.ComparisonExpression{
+					Operation: "=",
+					Left:      parsers.SelectItem{Path: []string{"c", "Name"}},
+					Right:     testutils_stringConstant("Alice"),
+				},
+				parsers.ComparisonExpression{
+					Operation: "=",
+					Left:      parsers.SelectItem{Path: []string{"c", "isCool"}},
+					Right: parsers.SelectItem{
+						Type:  parsers.SelectItemTypeConstant,
+						Value: parsers.Constant{Type: parsers.ConstantTypeBoolean, Value: true},
+					},
+				},
+			},
+		},
+		OrderExpressions: []parsers.OrderExpression{
+			{
+				SelectItem: parsers.SelectItem{Path: []string{"c", "id"}},
+				Direction:  parsers.OrderDirectionDesc,
+			},
+		},
+	}
+
+	keywordCases := []func(string) string{
+		strings.ToUpper,
+		strings.ToLower,
+		func(s string) string {
+			result := []rune(s)
+			for i := range result {
+				if i%2 == 0 {
+					result[i] = unicode.ToUpper(result[i])
+				} else {
+					result[i] = unicode.ToLower(result[i])
+				}
+			}
+			return string(result)
+		},
+	}
+	separators := []string{" ", "  ", "\t", "\n", "\r\n", " \n\t ", " -- comment\n", "\n-- SELECT * FROM c\n\t"}
+
+	for caseIndex, keywordCase := range keywordCases {
+		for separatorIndex, separator := range separators {
+			tokens := []string{
+				keywordCase("SELECT"), "c.id,", keywordCase("UPPER") + "(", "c.Name", ")", keywordCase("AS"), "upperName",
+				keywordCase("FROM"), "c",
+				keywordCase("WHERE"), "c.Name", "=", "\"Alice\"", keywordCase("AND"), "c.isCool", "=", keywordCase("true"),
+				keywordCase("ORDER"), keywordCase("BY"), "c.id", keywordCase("DESC"),
+			}
+			query := strings.Join(tokens, separator) + separator
+
+			t.Run(fmt.Sprintf("Should parse formatting variant %d-%d", caseIndex, separatorIndex), func(t *testing.T) {
+				testQueryParse(t, query, expectedQuery)
+			})
+		}
+	}
+
+	t.Run("Should keep identifiers and string literals case-sensitive", func(t *testing.T) {
+		parsedQuery, err := nosql.Parse("", []byte(`select c.ID from c where c.Name = "alice"`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		selectStmt := parsedQuery.(parsers.SelectStmt)
+		if !reflect.DeepEqual(selectStmt.SelectItems[0].Path, []string{"c", "ID"}) {
+			t.Errorf("identifier case was not preserved: %v", selectStmt.SelectItems[0].Path)
+		}
+
+		filter := selectStmt.Filters.(parsers.ComparisonExpression)
+		if !reflect.DeepEqual(filter.Right, testutils_stringConstant("alice")) {
+			t.Errorf("string literal case was not preserved: %v", filter.Right)
+		}
+	})
+
+	t.Run("Should reject trailing garbage", func(t *testing.T) {
+		_, err := nosql.Parse("", []byte(`SELECT c.id FROMM c`))
+		if err == nil {
+			t.Errorf("expected query with trailing tokens to fail parsing")
+		}
+	})
+}
+
+func testutils_stringConstant(value string) parsers.SelectItem {
+	return parsers.SelectItem{
+		Type:  parsers.SelectItemTypeConstant,
+		Value: parsers.Constant{Type: parsers.ConstantTypeString, Value: value},
+	}
 }
@@ -14,8 +14,10 @@ import (
 	"strings"
 	"sync"
 	"unicode"
+	"unicode/utf16"
 	"unicode/utf8"
 
+	jsonnumbers "github.com/pikami/cosmium/internal/json_numbers"
 	"github.com/pikami/cosmium/parsers"
 )
 
@@ -30,6 +32,13 @@ func makeSelectStmt(
 		Table:       table.(parsers.Table),
 	}
 
+	// "SELECT *" selects the whole document, whatever alias the FROM clause uses
+	for i, selectItem := range selectStmt.SelectItems {
+		if selectItem.IsTopLevel && len(selectItem.Path) == 1 && selectItem.Path[0] == "*" {
+			selectStmt.SelectItems[i].Path = []string{selectStmt.Table.Value}
+		}
+	}
+
 	if joinItemsArray, ok := joinItems.([]interface{}); ok && len(joinItemsArray) > 0 {
 		selectStmt.JoinItems = make([]parsers.JoinItem, len(joinItemsArray))
 		for i, joinItem := range joinItemsArray {
@@ -38,7 +47,7 @@ func makeSelectStmt(
 	}
 
 	switch v := whereClause.(type) {
-	case parsers.ComparisonExpression, parsers.LogicalExpression, parsers.Constant, parsers.SelectItem:
+	case parsers.ComparisonExpression, parsers.LikeExpression, parsers.LogicalExpression, parsers.Constant, parsers.SelectItem:
 		selectStmt.Filters = v
 	}
 
@@ -172,6 +181,38 @@ func joinStrings(array []interface{}) string {
 	return strings.Join(stringsArray, "")
 }
 
+// Combines a \u escape with the optional escape following it
+// when the two form a UTF-16 surrogate pair
+func decodeUnicodeEscape(high rune, low interface{}) string {
+	if low == nil {
+		return string(high)
+	}
+
+	if decoded := utf16.DecodeRune(high, low.(rune)); decoded != unicode.ReplacementChar {
+		return string(decoded)
+	}
+
+	return string(high) + string(low.(rune))
+}
+
+func combineBinaryExpressions(ex1 interface{}, exs interface{}) (interface{}, error) {
+	result := ex1.(parsers.SelectItem)
+	operations, _ := exs.([]interface{})
+	for _, operation := range operations {
+		operatorAndOperand := operation.([]interface{})
+		result = parsers.SelectItem{
+			Type: parsers.SelectItemTypeBinaryExpression,
+			Value: parsers.BinaryExpression{
+				Left:      result,
+				Right:     operatorAndOperand[1].(parsers.SelectItem),
+				Operation: operatorAndOperand[0].(string),
+			},
+		}
+	}
+
+	return result, nil
+}
+
 func combineExpressions(ex1 interface{}, exs interface{}, operation parsers.LogicalExpressionType) (interface{}, error) {
 	if exs == nil || len(exs.([]interface{})) < 1 {
 		return ex1, nil
@@ -187,142 +228,155 @@ var g = &grammar{
 	rules: []*rule{
 		{
 			name: "Input",
-			pos:  position{line: 172, col: 1, offset: 4681},
+			pos:  position{line: 214, col: 1, offset: 6012},
 			expr: &actionExpr{
-				pos: position{line: 172, col: 10, offset: 4690},
+				pos: position{line: 214, col: 10, offset: 6021},
 				run: (*parser).callonInput1,
-				expr: &labeledExpr{
-					pos:   position{line: 172, col: 10, offset: 4690},
-					label: "selectStmt",
-					expr: &ruleRefExpr{
-						pos:  position{line: 172, col: 21, offset: 4701},
-						name: "SelectStmt",
+				expr: &seqExpr{
+					pos: position{line: 214, col: 10, offset: 6021},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 214, col: 10, offset: 6021},
+							label: "selectStmt",
+							expr: &ruleRefExpr{
+								pos:  position{line: 214, col: 21, offset: 6032},
+								name: "SelectStmt",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 214, col: 32, offset: 6043},
+							name: "ws",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 214, col: 35, offset: 6046},
+							name: "EOF",
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "SelectStmt",
-			pos:  position{line: 176, col: 1, offset: 4744},
+			pos:  position{line: 218, col: 1, offset: 6082},
 			expr: &actionExpr{
-				pos: position{line: 176, col: 15, offset: 4758},
+				pos: position{line: 218, col: 15, offset: 6096},
 				run: (*parser).callonSelectStmt1,
 				expr: &seqExpr{
-					pos: position{line: 176, col: 15, offset: 4758},
+					pos: position{line: 218, col: 15, offset: 6096},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 176, col: 15, offset: 4758},
+							pos:  position{line: 218, col: 15, offset: 6096},
 							name: "Select",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 176, col: 22, offset: 4765},
+							pos:  position{line: 218, col: 22, offset: 6103},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 177, col: 5, offset: 4772},
+							pos:   position{line: 219, col: 5, offset: 6110},
 							label: "distinctClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 177, col: 20, offset: 4787},
+								pos: position{line: 219, col: 20, offset: 6125},
 								expr: &ruleRefExpr{
-									pos:  position{line: 177, col: 20, offset: 4787},
+									pos:  position{line: 219, col: 20, offset: 6125},
 									name: "DistinctClause",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 177, col: 36, offset: 4803},
+							pos:  position{line: 219, col: 36, offset: 6141},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 178, col: 5, offset: 4810},
+							pos:   position{line: 220, col: 5, offset: 6148},
 							label: "topClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 178, col: 15, offset: 4820},
+								pos: position{line: 220, col: 15, offset: 6158},
 								expr: &ruleRefExpr{
-									pos:  position{line: 178, col: 15, offset: 4820},
+									pos:  position{line: 220, col: 15, offset: 6158},
 									name: "TopClause",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 178, col: 26, offset: 4831},
+							pos:  position{line: 220, col: 26, offset: 6169},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 179, col: 5, offset: 4838},
+							pos:   position{line: 221, col: 5, offset: 6176},
 							label: "columns",
 							expr: &ruleRefExpr{
-								pos:  position{line: 179, col: 13, offset: 4846},
+								pos:  position{line: 221, col: 13, offset: 6184},
 								name: "Selection",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 179, col: 23, offset: 4856},
+							pos:  position{line: 221, col: 23, offset: 6194},
 							name: "ws",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 180, col: 5, offset: 4863},
+							pos:  position{line: 222, col: 5, offset: 6201},
 							name: "From",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 180, col: 10, offset: 4868},
+							pos:  position{line: 222, col: 10, offset: 6206},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 180, col: 13, offset: 4871},
+							pos:   position{line: 222, col: 13, offset: 6209},
 							label: "table",
 							expr: &ruleRefExpr{
-								pos:  position{line: 180, col: 19, offset: 4877},
-								name: "TableName",
+								pos:  position{line: 222, col: 19, offset: 6215},
+								name: "FromSource",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 180, col: 29, offset: 4887},
+							pos:  position{line: 222, col: 30, offset: 6226},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 181, col: 5, offset: 4894},
+							pos:   position{line: 223, col: 5, offset: 6233},
 							label: "joinClauses",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 181, col: 17, offset: 4906},
+								pos: position{line: 223, col: 17, offset: 6245},
 								expr: &ruleRefExpr{
-									pos:  position{line: 181, col: 17, offset: 4906},
+									pos:  position{line: 223, col: 17, offset: 6245},
 									name: "JoinClause",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 181, col: 29, offset: 4918},
+							pos:  position{line: 223, col: 29, offset: 6257},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 182, col: 5, offset: 4925},
+							pos:   position{line: 224, col: 5, offset: 6264},
 							label: "whereClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 182, col: 17, offset: 4937},
+								pos: position{line: 224, col: 17, offset: 6276},
 								expr: &actionExpr{
-									pos: position{line: 182, col: 18, offset: 4938},
+									pos: position{line: 224, col: 18, offset: 6277},
 									run: (*parser).callonSelectStmt27,
 									expr: &seqExpr{
-										pos: position{line: 182, col: 18, offset: 4938},
+										pos: position{line: 224, col: 18, offset: 6277},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 182, col: 18, offset: 4938},
+												pos:  position{line: 224, col: 18, offset: 6277},
 												name: "ws",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 182, col: 21, offset: 4941},
+												pos:  position{line: 224, col: 21, offset: 6280},
 												name: "Where",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 182, col: 27, offset: 4947},
+												pos:  position{line: 224, col: 27, offset: 6286},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 182, col: 30, offset: 4950},
+												pos:   position{line: 224, col: 30, offset: 6289},
 												label: "condition",
 												expr: &ruleRefExpr{
-													pos:  position{line: 182, col: 40, offset: 4960},
+													pos:  position{line: 224, col: 40, offset: 6299},
 													name: "Condition",
 												},
 											},
@@ -332,33 +386,33 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 183, col: 5, offset: 5002},
+							pos:   position{line: 225, col: 5, offset: 6341},
 							label: "groupByClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 183, col: 19, offset: 5016},
+								pos: position{line: 225, col: 19, offset: 6355},
 								expr: &actionExpr{
-									pos: position{line: 183, col: 20, offset: 5017},
+									pos: position{line: 225, col: 20, offset: 6356},
 									run: (*parser).callonSelectStmt36,
 									expr: &seqExpr{
-										pos: position{line: 183, col: 20, offset: 5017},
+										pos: position{line: 225, col: 20, offset: 6356},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 183, col: 20, offset: 5017},
+												pos:  position{line: 225, col: 20, offset: 6356},
 												name: "ws",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 183, col: 23, offset: 5020},
+												pos:  position{line: 225, col: 23, offset: 6359},
 												name: "GroupBy",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 183, col: 31, offset: 5028},
+												pos:  position{line: 225, col: 31, offset: 6367},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 183, col: 34, offset: 5031},
+												pos:   position{line: 225, col: 34, offset: 6370},
 												label: "columns",
 												expr: &ruleRefExpr{
-													pos:  position{line: 183, col: 42, offset: 5039},
+													pos:  position{line: 225, col: 42, offset: 6378},
 													name: "ColumnList",
 												},
 											},
@@ -367,24 +421,32 @@ var g = &grammar{
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 225, col: 79, offset: 6415},
+							name: "ws",
+						},
 						&labeledExpr{
-							pos:   position{line: 184, col: 5, offset: 5080},
+							pos:   position{line: 226, col: 5, offset: 6422},
 							label: "orderByClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 184, col: 19, offset: 5094},
+								pos: position{line: 226, col: 19, offset: 6436},
 								expr: &ruleRefExpr{
-									pos:  position{line: 184, col: 19, offset: 5094},
+									pos:  position{line: 226, col: 19, offset: 6436},
 									name: "OrderByClause",
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 226, col: 34, offset: 6451},
+							name: "ws",
+						},
 						&labeledExpr{
-							pos:   position{line: 185, col: 5, offset: 5113},
+							pos:   position{line: 227, col: 5, offset: 6458},
 							label: "offsetClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 185, col: 18, offset: 5126},
+								pos: position{line: 227, col: 18, offset: 6471},
 								expr: &ruleRefExpr{
-									pos:  position{line: 185, col: 18, offset: 5126},
+									pos:  position{line: 227, col: 18, offset: 6471},
 									name: "OffsetClause",
 								},
 							},
@@ -395,36 +457,48 @@ var g = &grammar{
 		},
 		{
 			name: "DistinctClause",
-			pos:  position{line: 190, col: 1, offset: 5292},
-			expr: &litMatcher{
-				pos:        position{line: 190, col: 19, offset: 5310},
-				val:        "distinct",
-				ignoreCase: true,
-				want:       "\"DISTINCT\"i",
+			pos:  position{line: 232, col: 1, offset: 6637},
+			expr: &seqExpr{
+				pos: position{line: 232, col: 19, offset: 6655},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 232, col: 19, offset: 6655},
+						val:        "distinct",
+						ignoreCase: true,
+						want:       "\"DISTINCT\"i",
+					},
+					&notExpr{
+						pos: position{line: 232, col: 31, offset: 6667},
+						expr: &ruleRefExpr{
+							pos:  position{line: 232, col: 32, offset: 6668},
+							name: "IdentifierChar",
+						},
+					},
+				},
 			},
 		},
 		{
 			name: "TopClause",
-			pos:  position{line: 192, col: 1, offset: 5323},
+			pos:  position{line: 234, col: 1, offset: 6684},
 			expr: &actionExpr{
-				pos: position{line: 192, col: 14, offset: 5336},
+				pos: position{line: 234, col: 14, offset: 6697},
 				run: (*parser).callonTopClause1,
 				expr: &seqExpr{
-					pos: position{line: 192, col: 14, offset: 5336},
+					pos: position{line: 234, col: 14, offset: 6697},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 192, col: 14, offset: 5336},
+							pos:  position{line: 234, col: 14, offset: 6697},
 							name: "Top",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 192, col: 18, offset: 5340},
+							pos:  position{line: 234, col: 18, offset: 6701},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 192, col: 21, offset: 5343},
+							pos:   position{line: 234, col: 21, offset: 6704},
 							label: "count",
 							expr: &ruleRefExpr{
-								pos:  position{line: 192, col: 27, offset: 5349},
+								pos:  position{line: 234, col: 27, offset: 6710},
 								name: "Integer",
 							},
 						},
@@ -434,48 +508,55 @@ var g = &grammar{
 		},
 		{
 			name: "JoinClause",
-			pos:  position{line: 196, col: 1, offset: 5384},
+			pos:  position{line: 238, col: 1, offset: 6745},
 			expr: &actionExpr{
-				pos: position{line: 196, col: 15, offset: 5398},
+				pos: position{line: 238, col: 15, offset: 6759},
 				run: (*parser).callonJoinClause1,
 				expr: &seqExpr{
-					pos: position{line: 196, col: 15, offset: 5398},
+					pos: position{line: 238, col: 15, offset: 6759},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 196, col: 15, offset: 5398},
+							pos:  position{line: 238, col: 15, offset: 6759},
 							name: "Join",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 196, col: 20, offset: 5403},
+							pos:  position{line: 238, col: 20, offset: 6764},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 196, col: 23, offset: 5406},
+							pos:   position{line: 238, col: 23, offset: 6767},
 							label: "table",
 							expr: &ruleRefExpr{
-								pos:  position{line: 196, col: 29, offset: 5412},
+								pos:  position{line: 238, col: 29, offset: 6773},
 								name: "TableName",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 196, col: 39, offset: 5422},
+							pos:  position{line: 238, col: 39, offset: 6783},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 196, col: 42, offset: 5425},
+							pos:        position{line: 238, col: 42, offset: 6786},
 							val:        "in",
 							ignoreCase: true,
 							want:       "\"IN\"i",
 						},
+						&notExpr{
+							pos: position{line: 238, col: 48, offset: 6792},
+							expr: &ruleRefExpr{
+								pos:  position{line: 238, col: 49, offset: 6793},
+								name: "IdentifierChar",
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 196, col: 48, offset: 5431},
+							pos:  position{line: 238, col: 64, offset: 6808},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 196, col: 51, offset: 5434},
+							pos:   position{line: 238, col: 67, offset: 6811},
 							label: "column",
 							expr: &ruleRefExpr{
-								pos:  position{line: 196, col: 58, offset: 5441},
+								pos:  position{line: 238, col: 74, offset: 6818},
 								name: "SelectItem",
 							},
 						},
@@ -485,50 +566,64 @@ var g = &grammar{
 		},
 		{
 			name: "OffsetClause",
-			pos:  position{line: 200, col: 1, offset: 5492},
+			pos:  position{line: 242, col: 1, offset: 6869},
 			expr: &actionExpr{
-				pos: position{line: 200, col: 17, offset: 5508},
+				pos: position{line: 242, col: 17, offset: 6885},
 				run: (*parser).callonOffsetClause1,
 				expr: &seqExpr{
-					pos: position{line: 200, col: 17, offset: 5508},
+					pos: position{line: 242, col: 17, offset: 6885},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 200, col: 17, offset: 5508},
+							pos:        position{line: 242, col: 17, offset: 6885},
 							val:        "offset",
 							ignoreCase: true,
 							want:       "\"OFFSET\"i",
 						},
+						&notExpr{
+							pos: position{line: 242, col: 27, offset: 6895},
+							expr: &ruleRefExpr{
+								pos:  position{line: 242, col: 28, offset: 6896},
+								name: "IdentifierChar",
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 200, col: 27, offset: 5518},
+							pos:  position{line: 242, col: 43, offset: 6911},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 200, col: 30, offset: 5521},
+							pos:   position{line: 242, col: 46, offset: 6914},
 							label: "offset",
 							expr: &ruleRefExpr{
-								pos:  position{line: 200, col: 37, offset: 5528},
+								pos:  position{line: 242, col: 53, offset: 6921},
 								name: "IntegerLiteral",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 200, col: 52, offset: 5543},
+							pos:  position{line: 242, col: 68, offset: 6936},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 200, col: 55, offset: 5546},
+							pos:        position{line: 242, col: 71, offset: 6939},
 							val:        "limit",
 							ignoreCase: true,
 							want:       "\"LIMIT\"i",
 						},
+						&notExpr{
+							pos: position{line: 242, col: 80, offset: 6948},
+							expr: &ruleRefExpr{
+								pos:  position{line: 242, col: 81, offset: 6949},
+								name: "IdentifierChar",
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 200, col: 64, offset: 5555},
+							pos:  position{line: 242, col: 96, offset: 6964},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 200, col: 67, offset: 5558},
+							pos:   position{line: 242, col: 99, offset: 6967},
 							label: "limit",
 							expr: &ruleRefExpr{
-								pos:  position{line: 200, col: 73, offset: 5564},
+								pos:  position{line: 242, col: 105, offset: 6973},
 								name: "IntegerLiteral",
 							},
 						},
@@ -538,20 +633,20 @@ var g = &grammar{
 		},
 		{
 			name: "Selection",
-			pos:  position{line: 204, col: 1, offset: 5679},
+			pos:  position{line: 246, col: 1, offset: 7088},
 			expr: &choiceExpr{
-				pos: position{line: 204, col: 14, offset: 5692},
+				pos: position{line: 246, col: 14, offset: 7101},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 204, col: 14, offset: 5692},
+						pos:  position{line: 246, col: 14, offset: 7101},
 						name: "SelectValueSpec",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 204, col: 32, offset: 5710},
+						pos:  position{line: 246, col: 32, offset: 7119},
 						name: "ColumnList",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 204, col: 45, offset: 5723},
+						pos:  position{line: 246, col: 45, offset: 7132},
 						name: "SelectAsterisk",
 					},
 				},
@@ -559,12 +654,12 @@ var g = &grammar{
 		},
 		{
 			name: "SelectAsterisk",
-			pos:  position{line: 206, col: 1, offset: 5739},
+			pos:  position{line: 248, col: 1, offset: 7148},
 			expr: &actionExpr{
-				pos: position{line: 206, col: 19, offset: 5757},
+				pos: position{line: 248, col: 19, offset: 7166},
 				run: (*parser).callonSelectAsterisk1,
 				expr: &litMatcher{
-					pos:        position{line: 206, col: 19, offset: 5757},
+					pos:        position{line: 248, col: 19, offset: 7166},
 					val:        "*",
 					ignoreCase: false,
 					want:       "\"*\"",
@@ -573,51 +668,51 @@ var g = &grammar{
 		},
 		{
 			name: "ColumnList",
-			pos:  position{line: 212, col: 1, offset: 5952},
+			pos:  position{line: 254, col: 1, offset: 7361},
 			expr: &actionExpr{
-				pos: position{line: 212, col: 15, offset: 5966},
+				pos: position{line: 254, col: 15, offset: 7375},
 				run: (*parser).callonColumnList1,
 				expr: &seqExpr{
-					pos: position{line: 212, col: 15, offset: 5966},
+					pos: position{line: 254, col: 15, offset: 7375},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 212, col: 15, offset: 5966},
+							pos:   position{line: 254, col: 15, offset: 7375},
 							label: "column",
 							expr: &ruleRefExpr{
-								pos:  position{line: 212, col: 22, offset: 5973},
+								pos:  position{line: 254, col: 22, offset: 7382},
 								name: "SelectItem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 212, col: 33, offset: 5984},
+							pos:   position{line: 254, col: 33, offset: 7393},
 							label: "other_columns",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 212, col: 47, offset: 5998},
+								pos: position{line: 254, col: 47, offset: 7407},
 								expr: &actionExpr{
-									pos: position{line: 212, col: 48, offset: 5999},
+									pos: position{line: 254, col: 48, offset: 7408},
 									run: (*parser).callonColumnList7,
 									expr: &seqExpr{
-										pos: position{line: 212, col: 48, offset: 5999},
+										pos: position{line: 254, col: 48, offset: 7408},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 212, col: 48, offset: 5999},
+												pos:  position{line: 254, col: 48, offset: 7408},
 												name: "ws",
 											},
 											&litMatcher{
-												pos:        position{line: 212, col: 51, offset: 6002},
+												pos:        position{line: 254, col: 51, offset: 7411},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 212, col: 55, offset: 6006},
+												pos:  position{line: 254, col: 55, offset: 7415},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 212, col: 58, offset: 6009},
+												pos:   position{line: 254, col: 58, offset: 7418},
 												label: "coll",
 												expr: &ruleRefExpr{
-													pos:  position{line: 212, col: 63, offset: 6014},
+													pos:  position{line: 254, col: 63, offset: 7423},
 													name: "SelectItem",
 												},
 											},
@@ -632,28 +727,35 @@ var g = &grammar{
 		},
 		{
 			name: "SelectValueSpec",
-			pos:  position{line: 216, col: 1, offset: 6101},
+			pos:  position{line: 258, col: 1, offset: 7510},
 			expr: &actionExpr{
-				pos: position{line: 216, col: 20, offset: 6120},
+				pos: position{line: 258, col: 20, offset: 7529},
 				run: (*parser).callonSelectValueSpec1,
 				expr: &seqExpr{
-					pos: position{line: 216, col: 20, offset: 6120},
+					pos: position{line: 258, col: 20, offset: 7529},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 216, col: 20, offset: 6120},
+							pos:        position{line: 258, col: 20, offset: 7529},
 							val:        "value",
 							ignoreCase: true,
 							want:       "\"VALUE\"i",
 						},
+						&notExpr{
+							pos: position{line: 258, col: 29, offset: 7538},
+							expr: &ruleRefExpr{
+								pos:  position{line: 258, col: 30, offset: 7539},
+								name: "IdentifierChar",
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 216, col: 29, offset: 6129},
+							pos:  position{line: 258, col: 45, offset: 7554},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 216, col: 32, offset: 6132},
+							pos:   position{line: 258, col: 48, offset: 7557},
 							label: "column",
 							expr: &ruleRefExpr{
-								pos:  position{line: 216, col: 39, offset: 6139},
+								pos:  position{line: 258, col: 55, offset: 7564},
 								name: "SelectItem",
 							},
 						},
@@ -661,17 +763,141 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "FromSource",
+			pos:  position{line: 264, col: 1, offset: 7718},
+			expr: &choiceExpr{
+				pos: position{line: 264, col: 15, offset: 7732},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 264, col: 15, offset: 7732},
+						run: (*parser).callonFromSource2,
+						expr: &seqExpr{
+							pos: position{line: 264, col: 15, offset: 7732},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 264, col: 15, offset: 7732},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 264, col: 19, offset: 7736},
+									name: "ws",
+								},
+								&labeledExpr{
+									pos:   position{line: 264, col: 22, offset: 7739},
+									label: "subQuery",
+									expr: &ruleRefExpr{
+										pos:  position{line: 264, col: 31, offset: 7748},
+										name: "SelectStmt",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 264, col: 42, offset: 7759},
+									name: "ws",
+								},
+								&litMatcher{
+									pos:        position{line: 264, col: 45, offset: 7762},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 264, col: 49, offset: 7766},
+									name: "ws",
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 264, col: 52, offset: 7769},
+									expr: &seqExpr{
+										pos: position{line: 264, col: 53, offset: 7770},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 264, col: 53, offset: 7770},
+												name: "As",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 264, col: 56, offset: 7773},
+												name: "ws",
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 264, col: 61, offset: 7778},
+									label: "alias",
+									expr: &ruleRefExpr{
+										pos:  position{line: 264, col: 67, offset: 7784},
+										name: "Identifier",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 267, col: 5, offset: 7925},
+						run: (*parser).callonFromSource17,
+						expr: &seqExpr{
+							pos: position{line: 267, col: 5, offset: 7925},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 267, col: 5, offset: 7925},
+									label: "alias",
+									expr: &ruleRefExpr{
+										pos:  position{line: 267, col: 11, offset: 7931},
+										name: "Identifier",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 267, col: 22, offset: 7942},
+									name: "ws",
+								},
+								&litMatcher{
+									pos:        position{line: 267, col: 25, offset: 7945},
+									val:        "in",
+									ignoreCase: true,
+									want:       "\"IN\"i",
+								},
+								&notExpr{
+									pos: position{line: 267, col: 31, offset: 7951},
+									expr: &ruleRefExpr{
+										pos:  position{line: 267, col: 32, offset: 7952},
+										name: "IdentifierChar",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 267, col: 47, offset: 7967},
+									name: "ws",
+								},
+								&labeledExpr{
+									pos:   position{line: 267, col: 50, offset: 7970},
+									label: "source",
+									expr: &ruleRefExpr{
+										pos:  position{line: 267, col: 57, offset: 7977},
+										name: "SelectItem",
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 270, col: 5, offset: 8114},
+						name: "TableName",
+					},
+				},
+			},
+		},
 		{
 			name: "TableName",
-			pos:  position{line: 222, col: 1, offset: 6293},
+			pos:  position{line: 272, col: 1, offset: 8125},
 			expr: &actionExpr{
-				pos: position{line: 222, col: 14, offset: 6306},
+				pos: position{line: 272, col: 14, offset: 8138},
 				run: (*parser).callonTableName1,
 				expr: &labeledExpr{
-					pos:   position{line: 222, col: 14, offset: 6306},
+					pos:   position{line: 272, col: 14, offset: 8138},
 					label: "key",
 					expr: &ruleRefExpr{
-						pos:  position{line: 222, col: 18, offset: 6310},
+						pos:  position{line: 272, col: 18, offset: 8142},
 						name: "Identifier",
 					},
 				},
@@ -679,37 +905,37 @@ var g = &grammar{
 		},
 		{
 			name: "SelectArray",
-			pos:  position{line: 226, col: 1, offset: 6377},
+			pos:  position{line: 276, col: 1, offset: 8209},
 			expr: &actionExpr{
-				pos: position{line: 226, col: 16, offset: 6392},
+				pos: position{line: 276, col: 16, offset: 8224},
 				run: (*parser).callonSelectArray1,
 				expr: &seqExpr{
-					pos: position{line: 226, col: 16, offset: 6392},
+					pos: position{line: 276, col: 16, offset: 8224},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 226, col: 16, offset: 6392},
+							pos:        position{line: 276, col: 16, offset: 8224},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 226, col: 20, offset: 6396},
+							pos:  position{line: 276, col: 20, offset: 8228},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 226, col: 23, offset: 6399},
+							pos:   position{line: 276, col: 23, offset: 8231},
 							label: "columns",
 							expr: &ruleRefExpr{
-								pos:  position{line: 226, col: 31, offset: 6407},
+								pos:  position{line: 276, col: 31, offset: 8239},
 								name: "ColumnList",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 226, col: 42, offset: 6418},
+							pos:  position{line: 276, col: 42, offset: 8250},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 226, col: 45, offset: 6421},
+							pos:        position{line: 276, col: 45, offset: 8253},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -720,65 +946,65 @@ var g = &grammar{
 		},
 		{
 			name: "SelectObject",
-			pos:  position{line: 230, col: 1, offset: 6466},
+			pos:  position{line: 280, col: 1, offset: 8298},
 			expr: &actionExpr{
-				pos: position{line: 230, col: 17, offset: 6482},
+				pos: position{line: 280, col: 17, offset: 8314},
 				run: (*parser).callonSelectObject1,
 				expr: &seqExpr{
-					pos: position{line: 230, col: 17, offset: 6482},
+					pos: position{line: 280, col: 17, offset: 8314},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 230, col: 17, offset: 6482},
+							pos:        position{line: 280, col: 17, offset: 8314},
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 230, col: 21, offset: 6486},
+							pos:  position{line: 280, col: 21, offset: 8318},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 230, col: 24, offset: 6489},
+							pos:   position{line: 280, col: 24, offset: 8321},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 230, col: 30, offset: 6495},
+								pos:  position{line: 280, col: 30, offset: 8327},
 								name: "SelectObjectField",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 230, col: 48, offset: 6513},
+							pos:  position{line: 280, col: 48, offset: 8345},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 230, col: 51, offset: 6516},
+							pos:   position{line: 280, col: 51, offset: 8348},
 							label: "other_fields",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 230, col: 64, offset: 6529},
+								pos: position{line: 280, col: 64, offset: 8361},
 								expr: &actionExpr{
-									pos: position{line: 230, col: 65, offset: 6530},
+									pos: position{line: 280, col: 65, offset: 8362},
 									run: (*parser).callonSelectObject10,
 									expr: &seqExpr{
-										pos: position{line: 230, col: 65, offset: 6530},
+										pos: position{line: 280, col: 65, offset: 8362},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 230, col: 65, offset: 6530},
+												pos:  position{line: 280, col: 65, offset: 8362},
 												name: "ws",
 											},
 											&litMatcher{
-												pos:        position{line: 230, col: 68, offset: 6533},
+												pos:        position{line: 280, col: 68, offset: 8365},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 230, col: 72, offset: 6537},
+												pos:  position{line: 280, col: 72, offset: 8369},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 230, col: 75, offset: 6540},
+												pos:   position{line: 280, col: 75, offset: 8372},
 												label: "coll",
 												expr: &ruleRefExpr{
-													pos:  position{line: 230, col: 80, offset: 6545},
+													pos:  position{line: 280, col: 80, offset: 8377},
 													name: "SelectObjectField",
 												},
 											},
@@ -788,11 +1014,11 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 230, col: 120, offset: 6585},
+							pos:  position{line: 280, col: 120, offset: 8417},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 230, col: 123, offset: 6588},
+							pos:        position{line: 280, col: 123, offset: 8420},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -803,45 +1029,45 @@ var g = &grammar{
 		},
 		{
 			name: "SelectObjectField",
-			pos:  position{line: 234, col: 1, offset: 6646},
+			pos:  position{line: 284, col: 1, offset: 8478},
 			expr: &actionExpr{
-				pos: position{line: 234, col: 22, offset: 6667},
+				pos: position{line: 284, col: 22, offset: 8499},
 				run: (*parser).callonSelectObjectField1,
 				expr: &seqExpr{
-					pos: position{line: 234, col: 22, offset: 6667},
+					pos: position{line: 284, col: 22, offset: 8499},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 234, col: 22, offset: 6667},
+							pos:   position{line: 284, col: 22, offset: 8499},
 							label: "name",
 							expr: &choiceExpr{
-								pos: position{line: 234, col: 28, offset: 6673},
+								pos: position{line: 284, col: 28, offset: 8505},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 234, col: 28, offset: 6673},
+										pos:  position{line: 284, col: 28, offset: 8505},
 										name: "Identifier",
 									},
 									&actionExpr{
-										pos: position{line: 234, col: 41, offset: 6686},
+										pos: position{line: 284, col: 41, offset: 8518},
 										run: (*parser).callonSelectObjectField6,
 										expr: &seqExpr{
-											pos: position{line: 234, col: 41, offset: 6686},
+											pos: position{line: 284, col: 41, offset: 8518},
 											exprs: []any{
 												&litMatcher{
-													pos:        position{line: 234, col: 41, offset: 6686},
+													pos:        position{line: 284, col: 41, offset: 8518},
 													val:        "\"",
 													ignoreCase: false,
 													want:       "\"\\\"\"",
 												},
 												&labeledExpr{
-													pos:   position{line: 234, col: 46, offset: 6691},
+													pos:   position{line: 284, col: 46, offset: 8523},
 													label: "key",
 													expr: &ruleRefExpr{
-														pos:  position{line: 234, col: 50, offset: 6695},
+														pos:  position{line: 284, col: 50, offset: 8527},
 														name: "Identifier",
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 234, col: 61, offset: 6706},
+													pos:        position{line: 284, col: 61, offset: 8538},
 													val:        "\"",
 													ignoreCase: false,
 													want:       "\"\\\"\"",
@@ -853,24 +1079,24 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 234, col: 87, offset: 6732},
+							pos:  position{line: 284, col: 87, offset: 8564},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 234, col: 90, offset: 6735},
+							pos:        position{line: 284, col: 90, offset: 8567},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 234, col: 94, offset: 6739},
+							pos:  position{line: 284, col: 94, offset: 8571},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 234, col: 97, offset: 6742},
+							pos:   position{line: 284, col: 97, offset: 8574},
 							label: "selectItem",
 							expr: &ruleRefExpr{
-								pos:  position{line: 234, col: 108, offset: 6753},
+								pos:  position{line: 284, col: 108, offset: 8585},
 								name: "SelectItem",
 							},
 						},
@@ -880,35 +1106,35 @@ var g = &grammar{
 		},
 		{
 			name: "SelectProperty",
-			pos:  position{line: 240, col: 1, offset: 6859},
+			pos:  position{line: 290, col: 1, offset: 8691},
 			expr: &actionExpr{
-				pos: position{line: 240, col: 19, offset: 6877},
+				pos: position{line: 290, col: 19, offset: 8709},
 				run: (*parser).callonSelectProperty1,
 				expr: &seqExpr{
-					pos: position{line: 240, col: 19, offset: 6877},
+					pos: position{line: 290, col: 19, offset: 8709},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 240, col: 19, offset: 6877},
+							pos:   position{line: 290, col: 19, offset: 8709},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 240, col: 24, offset: 6882},
+								pos:  position{line: 290, col: 24, offset: 8714},
 								name: "Identifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 240, col: 35, offset: 6893},
+							pos:   position{line: 290, col: 35, offset: 8725},
 							label: "path",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 240, col: 40, offset: 6898},
+								pos: position{line: 290, col: 40, offset: 8730},
 								expr: &choiceExpr{
-									pos: position{line: 240, col: 41, offset: 6899},
+									pos: position{line: 290, col: 41, offset: 8731},
 									alternatives: []any{
 										&ruleRefExpr{
-											pos:  position{line: 240, col: 41, offset: 6899},
+											pos:  position{line: 290, col: 41, offset: 8731},
 											name: "DotFieldAccess",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 240, col: 58, offset: 6916},
+											pos:  position{line: 290, col: 58, offset: 8748},
 											name: "ArrayFieldAccess",
 										},
 									},
@@ -921,49 +1147,28 @@ var g = &grammar{
 		},
 		{
 			name: "SelectItem",
-			pos:  position{line: 244, col: 1, offset: 7007},
+			pos:  position{line: 294, col: 1, offset: 8839},
 			expr: &actionExpr{
-				pos: position{line: 244, col: 15, offset: 7021},
+				pos: position{line: 294, col: 15, offset: 8853},
 				run: (*parser).callonSelectItem1,
 				expr: &seqExpr{
-					pos: position{line: 244, col: 15, offset: 7021},
+					pos: position{line: 294, col: 15, offset: 8853},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 244, col: 15, offset: 7021},
+							pos:   position{line: 294, col: 15, offset: 8853},
 							label: "selectItem",
-							expr: &choiceExpr{
-								pos: position{line: 244, col: 27, offset: 7033},
-								alternatives: []any{
-									&ruleRefExpr{
-										pos:  position{line: 244, col: 27, offset: 7033},
-										name: "Literal",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 244, col: 37, offset: 7043},
-										name: "FunctionCall",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 244, col: 52, offset: 7058},
-										name: "SelectArray",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 244, col: 66, offset: 7072},
-										name: "SelectObject",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 244, col: 81, offset: 7087},
-										name: "SelectProperty",
-									},
-								},
+							expr: &ruleRefExpr{
+								pos:  position{line: 294, col: 26, offset: 8864},
+								name: "ScalarExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 244, col: 97, offset: 7103},
+							pos:   position{line: 294, col: 43, offset: 8881},
 							label: "asClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 244, col: 106, offset: 7112},
+								pos: position{line: 294, col: 52, offset: 8890},
 								expr: &ruleRefExpr{
-									pos:  position{line: 244, col: 106, offset: 7112},
+									pos:  position{line: 294, col: 52, offset: 8890},
 									name: "AsClause",
 								},
 							},
@@ -973,32 +1178,60 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "AsClause",
-			pos:  position{line: 268, col: 1, offset: 7710},
+			name: "ScalarExpression",
+			pos:  position{line: 304, col: 1, offset: 9166},
 			expr: &actionExpr{
-				pos: position{line: 268, col: 13, offset: 7722},
-				run: (*parser).callonAsClause1,
+				pos: position{line: 304, col: 21, offset: 9186},
+				run: (*parser).callonScalarExpression1,
 				expr: &seqExpr{
-					pos: position{line: 268, col: 13, offset: 7722},
+					pos: position{line: 304, col: 21, offset: 9186},
 					exprs: []any{
-						&ruleRefExpr{
-							pos:  position{line: 268, col: 13, offset: 7722},
-							name: "ws",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 268, col: 16, offset: 7725},
-							name: "As",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 268, col: 19, offset: 7728},
-							name: "ws",
-						},
 						&labeledExpr{
-							pos:   position{line: 268, col: 22, offset: 7731},
-							label: "alias",
+							pos:   position{line: 304, col: 21, offset: 9186},
+							label: "ex1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 268, col: 28, offset: 7737},
-								name: "Identifier",
+								pos:  position{line: 304, col: 25, offset: 9190},
+								name: "MultiplicativeExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 304, col: 50, offset: 9215},
+							label: "ex2",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 304, col: 54, offset: 9219},
+								expr: &actionExpr{
+									pos: position{line: 304, col: 55, offset: 9220},
+									run: (*parser).callonScalarExpression7,
+									expr: &seqExpr{
+										pos: position{line: 304, col: 55, offset: 9220},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 304, col: 55, offset: 9220},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 304, col: 58, offset: 9223},
+												label: "op",
+												expr: &ruleRefExpr{
+													pos:  position{line: 304, col: 61, offset: 9226},
+													name: "AdditiveOperator",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 304, col: 78, offset: 9243},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 304, col: 81, offset: 9246},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 304, col: 84, offset: 9249},
+													name: "MultiplicativeExpression",
+												},
+											},
+										},
+									},
+								},
 							},
 						},
 					},
@@ -1006,89 +1239,371 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "DotFieldAccess",
-			pos:  position{line: 270, col: 1, offset: 7771},
+			name: "MultiplicativeExpression",
+			pos:  position{line: 308, col: 1, offset: 9365},
 			expr: &actionExpr{
-				pos: position{line: 270, col: 19, offset: 7789},
-				run: (*parser).callonDotFieldAccess1,
+				pos: position{line: 308, col: 29, offset: 9393},
+				run: (*parser).callonMultiplicativeExpression1,
 				expr: &seqExpr{
-					pos: position{line: 270, col: 19, offset: 7789},
+					pos: position{line: 308, col: 29, offset: 9393},
 					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 270, col: 19, offset: 7789},
-							val:        ".",
-							ignoreCase: false,
-							want:       "\".\"",
-						},
 						&labeledExpr{
-							pos:   position{line: 270, col: 23, offset: 7793},
-							label: "id",
+							pos:   position{line: 308, col: 29, offset: 9393},
+							label: "ex1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 270, col: 26, offset: 7796},
-								name: "Identifier",
+								pos:  position{line: 308, col: 33, offset: 9397},
+								name: "PrimaryExpression",
 							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "ArrayFieldAccess",
-			pos:  position{line: 274, col: 1, offset: 7831},
-			expr: &choiceExpr{
-				pos: position{line: 274, col: 21, offset: 7851},
-				alternatives: []any{
-					&actionExpr{
-						pos: position{line: 274, col: 21, offset: 7851},
-						run: (*parser).callonArrayFieldAccess2,
-						expr: &seqExpr{
-							pos: position{line: 274, col: 21, offset: 7851},
-							exprs: []any{
-								&litMatcher{
-									pos:        position{line: 274, col: 21, offset: 7851},
-									val:        "[\"",
-									ignoreCase: false,
-									want:       "\"[\\\"\"",
-								},
-								&labeledExpr{
-									pos:   position{line: 274, col: 27, offset: 7857},
-									label: "id",
-									expr: &ruleRefExpr{
-										pos:  position{line: 274, col: 30, offset: 7860},
-										name: "Identifier",
+						&labeledExpr{
+							pos:   position{line: 308, col: 51, offset: 9415},
+							label: "ex2",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 308, col: 55, offset: 9419},
+								expr: &actionExpr{
+									pos: position{line: 308, col: 56, offset: 9420},
+									run: (*parser).callonMultiplicativeExpression7,
+									expr: &seqExpr{
+										pos: position{line: 308, col: 56, offset: 9420},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 308, col: 56, offset: 9420},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 308, col: 59, offset: 9423},
+												label: "op",
+												expr: &ruleRefExpr{
+													pos:  position{line: 308, col: 62, offset: 9426},
+													name: "MultiplicativeOperator",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 308, col: 85, offset: 9449},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 308, col: 88, offset: 9452},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 308, col: 91, offset: 9455},
+													name: "PrimaryExpression",
+												},
+											},
+										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 274, col: 41, offset: 7871},
-									val:        "\"]",
-									ignoreCase: false,
-									want:       "\"\\\"]\"",
-								},
 							},
 						},
 					},
-					&actionExpr{
-						pos: position{line: 275, col: 5, offset: 7900},
-						run: (*parser).callonArrayFieldAccess8,
-						expr: &seqExpr{
-							pos: position{line: 275, col: 5, offset: 7900},
-							exprs: []any{
-								&litMatcher{
-									pos:        position{line: 275, col: 5, offset: 7900},
-									val:        "[",
+				},
+			},
+		},
+		{
+			name: "AdditiveOperator",
+			pos:  position{line: 312, col: 1, offset: 9564},
+			expr: &actionExpr{
+				pos: position{line: 312, col: 21, offset: 9584},
+				run: (*parser).callonAdditiveOperator1,
+				expr: &choiceExpr{
+					pos: position{line: 312, col: 22, offset: 9585},
+					alternatives: []any{
+						&litMatcher{
+							pos:        position{line: 312, col: 22, offset: 9585},
+							val:        "+",
+							ignoreCase: false,
+							want:       "\"+\"",
+						},
+						&litMatcher{
+							pos:        position{line: 312, col: 28, offset: 9591},
+							val:        "-",
+							ignoreCase: false,
+							want:       "\"-\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MultiplicativeOperator",
+			pos:  position{line: 314, col: 1, offset: 9628},
+			expr: &actionExpr{
+				pos: position{line: 314, col: 27, offset: 9654},
+				run: (*parser).callonMultiplicativeOperator1,
+				expr: &choiceExpr{
+					pos: position{line: 314, col: 28, offset: 9655},
+					alternatives: []any{
+						&litMatcher{
+							pos:        position{line: 314, col: 28, offset: 9655},
+							val:        "*",
+							ignoreCase: false,
+							want:       "\"*\"",
+						},
+						&litMatcher{
+							pos:        position{line: 314, col: 34, offset: 9661},
+							val:        "/",
+							ignoreCase: false,
+							want:       "\"/\"",
+						},
+						&litMatcher{
+							pos:        position{line: 314, col: 40, offset: 9667},
+							val:        "%",
+							ignoreCase: false,
+							want:       "\"%\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PrimaryExpression",
+			pos:  position{line: 317, col: 1, offset: 9798},
+			expr: &choiceExpr{
+				pos: position{line: 317, col: 22, offset: 9819},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 317, col: 22, offset: 9819},
+						run: (*parser).callonPrimaryExpression2,
+						expr: &seqExpr{
+							pos: position{line: 317, col: 22, offset: 9819},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 317, col: 22, offset: 9819},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 317, col: 26, offset: 9823},
+									name: "ws",
+								},
+								&labeledExpr{
+									pos:   position{line: 317, col: 29, offset: 9826},
+									label: "subQuery",
+									expr: &ruleRefExpr{
+										pos:  position{line: 317, col: 38, offset: 9835},
+										name: "SelectStmt",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 317, col: 49, offset: 9846},
+									name: "ws",
+								},
+								&litMatcher{
+									pos:        position{line: 317, col: 52, offset: 9849},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 323, col: 5, offset: 9974},
+						run: (*parser).callonPrimaryExpression10,
+						expr: &seqExpr{
+							pos: position{line: 323, col: 5, offset: 9974},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 323, col: 5, offset: 9974},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 323, col: 9, offset: 9978},
+									name: "ws",
+								},
+								&labeledExpr{
+									pos:   position{line: 323, col: 12, offset: 9981},
+									label: "ex",
+									expr: &ruleRefExpr{
+										pos:  position{line: 323, col: 15, offset: 9984},
+										name: "ScalarExpression",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 323, col: 32, offset: 10001},
+									name: "ws",
+								},
+								&litMatcher{
+									pos:        position{line: 323, col: 35, offset: 10004},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 324, col: 5, offset: 10031},
+						run: (*parser).callonPrimaryExpression18,
+						expr: &labeledExpr{
+							pos:   position{line: 324, col: 5, offset: 10031},
+							label: "selectItem",
+							expr: &choiceExpr{
+								pos: position{line: 324, col: 17, offset: 10043},
+								alternatives: []any{
+									&ruleRefExpr{
+										pos:  position{line: 324, col: 17, offset: 10043},
+										name: "Literal",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 324, col: 27, offset: 10053},
+										name: "FunctionCall",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 324, col: 42, offset: 10068},
+										name: "SelectArray",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 324, col: 56, offset: 10082},
+										name: "SelectObject",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 324, col: 71, offset: 10097},
+										name: "SelectProperty",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AsClause",
+			pos:  position{line: 344, col: 1, offset: 10619},
+			expr: &actionExpr{
+				pos: position{line: 344, col: 13, offset: 10631},
+				run: (*parser).callonAsClause1,
+				expr: &seqExpr{
+					pos: position{line: 344, col: 13, offset: 10631},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 344, col: 13, offset: 10631},
+							name: "ws",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 344, col: 16, offset: 10634},
+							name: "As",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 344, col: 19, offset: 10637},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 344, col: 22, offset: 10640},
+							label: "alias",
+							expr: &ruleRefExpr{
+								pos:  position{line: 344, col: 28, offset: 10646},
+								name: "Identifier",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DotFieldAccess",
+			pos:  position{line: 346, col: 1, offset: 10680},
+			expr: &actionExpr{
+				pos: position{line: 346, col: 19, offset: 10698},
+				run: (*parser).callonDotFieldAccess1,
+				expr: &seqExpr{
+					pos: position{line: 346, col: 19, offset: 10698},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 346, col: 19, offset: 10698},
+							val:        ".",
+							ignoreCase: false,
+							want:       "\".\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 346, col: 23, offset: 10702},
+							label: "id",
+							expr: &ruleRefExpr{
+								pos:  position{line: 346, col: 26, offset: 10705},
+								name: "Identifier",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ArrayFieldAccess",
+			pos:  position{line: 351, col: 1, offset: 10812},
+			expr: &choiceExpr{
+				pos: position{line: 351, col: 21, offset: 10832},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 351, col: 21, offset: 10832},
+						run: (*parser).callonArrayFieldAccess2,
+						expr: &seqExpr{
+							pos: position{line: 351, col: 21, offset: 10832},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 351, col: 21, offset: 10832},
+									val:        "[",
+									ignoreCase: false,
+									want:       "\"[\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 351, col: 25, offset: 10836},
+									name: "ws",
+								},
+								&labeledExpr{
+									pos:   position{line: 351, col: 28, offset: 10839},
+									label: "key",
+									expr: &ruleRefExpr{
+										pos:  position{line: 351, col: 32, offset: 10843},
+										name: "StringLiteral",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 351, col: 46, offset: 10857},
+									name: "ws",
+								},
+								&litMatcher{
+									pos:        position{line: 351, col: 49, offset: 10860},
+									val:        "]",
+									ignoreCase: false,
+									want:       "\"]\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 352, col: 5, offset: 10913},
+						run: (*parser).callonArrayFieldAccess10,
+						expr: &seqExpr{
+							pos: position{line: 352, col: 5, offset: 10913},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 352, col: 5, offset: 10913},
+									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
+								&ruleRefExpr{
+									pos:  position{line: 352, col: 9, offset: 10917},
+									name: "ws",
+								},
 								&labeledExpr{
-									pos:   position{line: 275, col: 9, offset: 7904},
-									label: "id",
+									pos:   position{line: 352, col: 12, offset: 10920},
+									label: "index",
 									expr: &ruleRefExpr{
-										pos:  position{line: 275, col: 12, offset: 7907},
-										name: "Integer",
+										pos:  position{line: 352, col: 18, offset: 10926},
+										name: "IntegerLiteral",
 									},
 								},
+								&ruleRefExpr{
+									pos:  position{line: 352, col: 33, offset: 10941},
+									name: "ws",
+								},
 								&litMatcher{
-									pos:        position{line: 275, col: 20, offset: 7915},
+									pos:        position{line: 352, col: 36, offset: 10944},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -1101,15 +1616,15 @@ var g = &grammar{
 		},
 		{
 			name: "Identifier",
-			pos:  position{line: 277, col: 1, offset: 7959},
+			pos:  position{line: 354, col: 1, offset: 11016},
 			expr: &actionExpr{
-				pos: position{line: 277, col: 15, offset: 7973},
+				pos: position{line: 354, col: 15, offset: 11030},
 				run: (*parser).callonIdentifier1,
 				expr: &seqExpr{
-					pos: position{line: 277, col: 15, offset: 7973},
+					pos: position{line: 354, col: 15, offset: 11030},
 					exprs: []any{
 						&charClassMatcher{
-							pos:        position{line: 277, col: 15, offset: 7973},
+							pos:        position{line: 354, col: 15, offset: 11030},
 							val:        "[a-zA-Z_]",
 							chars:      []rune{'_'},
 							ranges:     []rune{'a', 'z', 'A', 'Z'},
@@ -1117,9 +1632,9 @@ var g = &grammar{
 							inverted:   false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 277, col: 24, offset: 7982},
+							pos: position{line: 354, col: 24, offset: 11039},
 							expr: &charClassMatcher{
-								pos:        position{line: 277, col: 24, offset: 7982},
+								pos:        position{line: 354, col: 24, offset: 11039},
 								val:        "[a-zA-Z0-9_]",
 								chars:      []rune{'_'},
 								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -1133,15 +1648,15 @@ var g = &grammar{
 		},
 		{
 			name: "Condition",
-			pos:  position{line: 281, col: 1, offset: 8032},
+			pos:  position{line: 358, col: 1, offset: 11089},
 			expr: &actionExpr{
-				pos: position{line: 281, col: 14, offset: 8045},
+				pos: position{line: 358, col: 14, offset: 11102},
 				run: (*parser).callonCondition1,
 				expr: &labeledExpr{
-					pos:   position{line: 281, col: 14, offset: 8045},
+					pos:   position{line: 358, col: 14, offset: 11102},
 					label: "expression",
 					expr: &ruleRefExpr{
-						pos:  position{line: 281, col: 25, offset: 8056},
+						pos:  position{line: 358, col: 25, offset: 11113},
 						name: "OrExpression",
 					},
 				},
@@ -1149,49 +1664,49 @@ var g = &grammar{
 		},
 		{
 			name: "OrExpression",
-			pos:  position{line: 285, col: 1, offset: 8101},
+			pos:  position{line: 362, col: 1, offset: 11158},
 			expr: &actionExpr{
-				pos: position{line: 285, col: 17, offset: 8117},
+				pos: position{line: 362, col: 17, offset: 11174},
 				run: (*parser).callonOrExpression1,
 				expr: &seqExpr{
-					pos: position{line: 285, col: 17, offset: 8117},
+					pos: position{line: 362, col: 17, offset: 11174},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 285, col: 17, offset: 8117},
+							pos:   position{line: 362, col: 17, offset: 11174},
 							label: "ex1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 285, col: 21, offset: 8121},
+								pos:  position{line: 362, col: 21, offset: 11178},
 								name: "AndExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 285, col: 35, offset: 8135},
+							pos:   position{line: 362, col: 35, offset: 11192},
 							label: "ex2",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 285, col: 39, offset: 8139},
+								pos: position{line: 362, col: 39, offset: 11196},
 								expr: &actionExpr{
-									pos: position{line: 285, col: 40, offset: 8140},
+									pos: position{line: 362, col: 40, offset: 11197},
 									run: (*parser).callonOrExpression7,
 									expr: &seqExpr{
-										pos: position{line: 285, col: 40, offset: 8140},
+										pos: position{line: 362, col: 40, offset: 11197},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 285, col: 40, offset: 8140},
+												pos:  position{line: 362, col: 40, offset: 11197},
 												name: "ws",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 285, col: 43, offset: 8143},
+												pos:  position{line: 362, col: 43, offset: 11200},
 												name: "Or",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 285, col: 46, offset: 8146},
+												pos:  position{line: 362, col: 46, offset: 11203},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 285, col: 49, offset: 8149},
+												pos:   position{line: 362, col: 49, offset: 11206},
 												label: "ex",
 												expr: &ruleRefExpr{
-													pos:  position{line: 285, col: 52, offset: 8152},
+													pos:  position{line: 362, col: 52, offset: 11209},
 													name: "AndExpression",
 												},
 											},
@@ -1206,50 +1721,50 @@ var g = &grammar{
 		},
 		{
 			name: "AndExpression",
-			pos:  position{line: 289, col: 1, offset: 8265},
+			pos:  position{line: 366, col: 1, offset: 11322},
 			expr: &actionExpr{
-				pos: position{line: 289, col: 18, offset: 8282},
+				pos: position{line: 366, col: 18, offset: 11339},
 				run: (*parser).callonAndExpression1,
 				expr: &seqExpr{
-					pos: position{line: 289, col: 18, offset: 8282},
+					pos: position{line: 366, col: 18, offset: 11339},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 289, col: 18, offset: 8282},
+							pos:   position{line: 366, col: 18, offset: 11339},
 							label: "ex1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 289, col: 22, offset: 8286},
-								name: "ComparisonExpression",
+								pos:  position{line: 366, col: 22, offset: 11343},
+								name: "NotExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 289, col: 43, offset: 8307},
+							pos:   position{line: 366, col: 36, offset: 11357},
 							label: "ex2",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 289, col: 47, offset: 8311},
+								pos: position{line: 366, col: 40, offset: 11361},
 								expr: &actionExpr{
-									pos: position{line: 289, col: 48, offset: 8312},
+									pos: position{line: 366, col: 41, offset: 11362},
 									run: (*parser).callonAndExpression7,
 									expr: &seqExpr{
-										pos: position{line: 289, col: 48, offset: 8312},
+										pos: position{line: 366, col: 41, offset: 11362},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 289, col: 48, offset: 8312},
+												pos:  position{line: 366, col: 41, offset: 11362},
 												name: "ws",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 289, col: 51, offset: 8315},
+												pos:  position{line: 366, col: 44, offset: 11365},
 												name: "And",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 289, col: 55, offset: 8319},
+												pos:  position{line: 366, col: 48, offset: 11369},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 289, col: 58, offset: 8322},
+												pos:   position{line: 366, col: 51, offset: 11372},
 												label: "ex",
 												expr: &ruleRefExpr{
-													pos:  position{line: 289, col: 61, offset: 8325},
-													name: "ComparisonExpression",
+													pos:  position{line: 366, col: 54, offset: 11375},
+													name: "NotExpression",
 												},
 											},
 										},
@@ -1262,83 +1777,92 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ComparisonExpression",
-			pos:  position{line: 293, col: 1, offset: 8446},
+			name: "NotExpression",
+			pos:  position{line: 371, col: 1, offset: 11555},
 			expr: &choiceExpr{
-				pos: position{line: 293, col: 25, offset: 8470},
+				pos: position{line: 371, col: 18, offset: 11572},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 293, col: 25, offset: 8470},
-						run: (*parser).callonComparisonExpression2,
+						pos: position{line: 371, col: 18, offset: 11572},
+						run: (*parser).callonNotExpression2,
 						expr: &seqExpr{
-							pos: position{line: 293, col: 25, offset: 8470},
+							pos: position{line: 371, col: 18, offset: 11572},
 							exprs: []any{
-								&litMatcher{
-									pos:        position{line: 293, col: 25, offset: 8470},
-									val:        "(",
-									ignoreCase: false,
-									want:       "\"(\"",
+								&ruleRefExpr{
+									pos:  position{line: 371, col: 18, offset: 11572},
+									name: "Not",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 293, col: 29, offset: 8474},
+									pos:  position{line: 371, col: 22, offset: 11576},
 									name: "ws",
 								},
 								&labeledExpr{
-									pos:   position{line: 293, col: 32, offset: 8477},
+									pos:   position{line: 371, col: 25, offset: 11579},
 									label: "ex",
 									expr: &ruleRefExpr{
-										pos:  position{line: 293, col: 35, offset: 8480},
-										name: "OrExpression",
+										pos:  position{line: 371, col: 28, offset: 11582},
+										name: "NotExpression",
 									},
 								},
-								&ruleRefExpr{
-									pos:  position{line: 293, col: 48, offset: 8493},
-									name: "ws",
-								},
-								&litMatcher{
-									pos:        position{line: 293, col: 51, offset: 8496},
-									val:        ")",
-									ignoreCase: false,
-									want:       "\")\"",
-								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 294, col: 7, offset: 8525},
-						run: (*parser).callonComparisonExpression10,
+						pos: position{line: 373, col: 5, offset: 11721},
+						run: (*parser).callonNotExpression8,
+						expr: &labeledExpr{
+							pos:   position{line: 373, col: 5, offset: 11721},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 373, col: 8, offset: 11724},
+								name: "ComparisonExpression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ComparisonExpression",
+			pos:  position{line: 376, col: 1, offset: 11867},
+			expr: &choiceExpr{
+				pos: position{line: 376, col: 25, offset: 11891},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 376, col: 25, offset: 11891},
+						run: (*parser).callonComparisonExpression2,
 						expr: &seqExpr{
-							pos: position{line: 294, col: 7, offset: 8525},
+							pos: position{line: 376, col: 25, offset: 11891},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 294, col: 7, offset: 8525},
+									pos:   position{line: 376, col: 25, offset: 11891},
 									label: "left",
 									expr: &ruleRefExpr{
-										pos:  position{line: 294, col: 12, offset: 8530},
+										pos:  position{line: 376, col: 30, offset: 11896},
 										name: "SelectItem",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 294, col: 23, offset: 8541},
+									pos:  position{line: 376, col: 41, offset: 11907},
 									name: "ws",
 								},
 								&labeledExpr{
-									pos:   position{line: 294, col: 26, offset: 8544},
+									pos:   position{line: 376, col: 44, offset: 11910},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 294, col: 29, offset: 8547},
+										pos:  position{line: 376, col: 47, offset: 11913},
 										name: "ComparisonOperator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 294, col: 48, offset: 8566},
+									pos:  position{line: 376, col: 66, offset: 11932},
 									name: "ws",
 								},
 								&labeledExpr{
-									pos:   position{line: 294, col: 51, offset: 8569},
+									pos:   position{line: 376, col: 69, offset: 11935},
 									label: "right",
 									expr: &ruleRefExpr{
-										pos:  position{line: 294, col: 57, offset: 8575},
+										pos:  position{line: 376, col: 75, offset: 11941},
 										name: "SelectItem",
 									},
 								},
@@ -1346,87 +1870,217 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 296, col: 5, offset: 8682},
-						run: (*parser).callonComparisonExpression20,
-						expr: &labeledExpr{
-							pos:   position{line: 296, col: 5, offset: 8682},
-							label: "ex",
-							expr: &ruleRefExpr{
-								pos:  position{line: 296, col: 8, offset: 8685},
-								name: "BooleanLiteral",
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 297, col: 5, offset: 8723},
-						run: (*parser).callonComparisonExpression23,
-						expr: &labeledExpr{
-							pos:   position{line: 297, col: 5, offset: 8723},
-							label: "ex",
-							expr: &ruleRefExpr{
-								pos:  position{line: 297, col: 8, offset: 8726},
-								name: "SelectItem",
-							},
-						},
-					},
-				},
-			},
-		},
+						pos: position{line: 378, col: 5, offset: 12048},
+						run: (*parser).callonComparisonExpression12,
+						expr: &seqExpr{
+							pos: position{line: 378, col: 5, offset: 12048},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 378, col: 5, offset: 12048},
+									label: "left",
+									expr: &ruleRefExpr{
+										pos:  position{line: 378, col: 10, offset: 12053},
+										name: "SelectItem",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 378, col: 21, offset: 12064},
+									name: "ws",
+								},
+								&labeledExpr{
+									pos:   position{line: 378, col: 24, offset: 12067},
+									label: "not",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 378, col: 28, offset: 12071},
+										expr: &seqExpr{
+											pos: position{line: 378, col: 29, offset: 12072},
+											exprs: []any{
+												&ruleRefExpr{
+													pos:  position{line: 378, col: 29, offset: 12072},
+													name: "Not",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 378, col: 33, offset: 12076},
+													name: "ws",
+												},
+											},
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 378, col: 38, offset: 12081},
+									name: "Like",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 378, col: 43, offset: 12086},
+									name: "ws",
+								},
+								&labeledExpr{
+									pos:   position{line: 378, col: 46, offset: 12089},
+									label: "pattern",
+									expr: &ruleRefExpr{
+										pos:  position{line: 378, col: 54, offset: 12097},
+										name: "SelectItem",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 378, col: 65, offset: 12108},
+									label: "escape",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 378, col: 72, offset: 12115},
+										expr: &actionExpr{
+											pos: position{line: 378, col: 73, offset: 12116},
+											run: (*parser).callonComparisonExpression28,
+											expr: &seqExpr{
+												pos: position{line: 378, col: 73, offset: 12116},
+												exprs: []any{
+													&ruleRefExpr{
+														pos:  position{line: 378, col: 73, offset: 12116},
+														name: "ws",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 378, col: 76, offset: 12119},
+														name: "Escape",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 378, col: 83, offset: 12126},
+														name: "ws",
+													},
+													&labeledExpr{
+														pos:   position{line: 378, col: 86, offset: 12129},
+														label: "ex",
+														expr: &ruleRefExpr{
+															pos:  position{line: 378, col: 89, offset: 12132},
+															name: "SelectItem",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 380, col: 5, offset: 12272},
+						run: (*parser).callonComparisonExpression35,
+						expr: &seqExpr{
+							pos: position{line: 380, col: 5, offset: 12272},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 380, col: 5, offset: 12272},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 380, col: 9, offset: 12276},
+									name: "ws",
+								},
+								&labeledExpr{
+									pos:   position{line: 380, col: 12, offset: 12279},
+									label: "ex",
+									expr: &ruleRefExpr{
+										pos:  position{line: 380, col: 15, offset: 12282},
+										name: "OrExpression",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 380, col: 28, offset: 12295},
+									name: "ws",
+								},
+								&litMatcher{
+									pos:        position{line: 380, col: 31, offset: 12298},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 381, col: 5, offset: 12325},
+						run: (*parser).callonComparisonExpression43,
+						expr: &labeledExpr{
+							pos:   position{line: 381, col: 5, offset: 12325},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 381, col: 8, offset: 12328},
+								name: "BooleanLiteral",
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 382, col: 5, offset: 12366},
+						run: (*parser).callonComparisonExpression46,
+						expr: &labeledExpr{
+							pos:   position{line: 382, col: 5, offset: 12366},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 382, col: 8, offset: 12369},
+								name: "SelectItem",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "OrderByClause",
-			pos:  position{line: 299, col: 1, offset: 8757},
+			pos:  position{line: 384, col: 1, offset: 12400},
 			expr: &actionExpr{
-				pos: position{line: 299, col: 18, offset: 8774},
+				pos: position{line: 384, col: 18, offset: 12417},
 				run: (*parser).callonOrderByClause1,
 				expr: &seqExpr{
-					pos: position{line: 299, col: 18, offset: 8774},
+					pos: position{line: 384, col: 18, offset: 12417},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 299, col: 18, offset: 8774},
+							pos:  position{line: 384, col: 18, offset: 12417},
 							name: "OrderBy",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 299, col: 26, offset: 8782},
+							pos:  position{line: 384, col: 26, offset: 12425},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 299, col: 29, offset: 8785},
+							pos:   position{line: 384, col: 29, offset: 12428},
 							label: "ex1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 299, col: 33, offset: 8789},
+								pos:  position{line: 384, col: 33, offset: 12432},
 								name: "OrderExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 299, col: 49, offset: 8805},
+							pos:   position{line: 384, col: 49, offset: 12448},
 							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 299, col: 56, offset: 8812},
+								pos: position{line: 384, col: 56, offset: 12455},
 								expr: &actionExpr{
-									pos: position{line: 299, col: 57, offset: 8813},
+									pos: position{line: 384, col: 57, offset: 12456},
 									run: (*parser).callonOrderByClause9,
 									expr: &seqExpr{
-										pos: position{line: 299, col: 57, offset: 8813},
+										pos: position{line: 384, col: 57, offset: 12456},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 299, col: 57, offset: 8813},
+												pos:  position{line: 384, col: 57, offset: 12456},
 												name: "ws",
 											},
 											&litMatcher{
-												pos:        position{line: 299, col: 60, offset: 8816},
+												pos:        position{line: 384, col: 60, offset: 12459},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 299, col: 64, offset: 8820},
+												pos:  position{line: 384, col: 64, offset: 12463},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 299, col: 67, offset: 8823},
+												pos:   position{line: 384, col: 67, offset: 12466},
 												label: "ex",
 												expr: &ruleRefExpr{
-													pos:  position{line: 299, col: 70, offset: 8826},
+													pos:  position{line: 384, col: 70, offset: 12469},
 													name: "OrderExpression",
 												},
 											},
@@ -1441,32 +2095,32 @@ var g = &grammar{
 		},
 		{
 			name: "OrderExpression",
-			pos:  position{line: 303, col: 1, offset: 8910},
+			pos:  position{line: 388, col: 1, offset: 12553},
 			expr: &actionExpr{
-				pos: position{line: 303, col: 20, offset: 8929},
+				pos: position{line: 388, col: 20, offset: 12572},
 				run: (*parser).callonOrderExpression1,
 				expr: &seqExpr{
-					pos: position{line: 303, col: 20, offset: 8929},
+					pos: position{line: 388, col: 20, offset: 12572},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 303, col: 20, offset: 8929},
+							pos:   position{line: 388, col: 20, offset: 12572},
 							label: "field",
 							expr: &ruleRefExpr{
-								pos:  position{line: 303, col: 26, offset: 8935},
-								name: "SelectProperty",
+								pos:  position{line: 388, col: 26, offset: 12578},
+								name: "ScalarExpression",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 303, col: 41, offset: 8950},
+							pos:  position{line: 388, col: 43, offset: 12595},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 303, col: 44, offset: 8953},
+							pos:   position{line: 388, col: 46, offset: 12598},
 							label: "order",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 303, col: 50, offset: 8959},
+								pos: position{line: 388, col: 52, offset: 12604},
 								expr: &ruleRefExpr{
-									pos:  position{line: 303, col: 50, offset: 8959},
+									pos:  position{line: 388, col: 52, offset: 12604},
 									name: "OrderDirection",
 								},
 							},
@@ -1477,24 +2131,36 @@ var g = &grammar{
 		},
 		{
 			name: "OrderDirection",
-			pos:  position{line: 307, col: 1, offset: 9025},
+			pos:  position{line: 392, col: 1, offset: 12670},
 			expr: &actionExpr{
-				pos: position{line: 307, col: 19, offset: 9043},
+				pos: position{line: 392, col: 19, offset: 12688},
 				run: (*parser).callonOrderDirection1,
-				expr: &choiceExpr{
-					pos: position{line: 307, col: 20, offset: 9044},
-					alternatives: []any{
-						&litMatcher{
-							pos:        position{line: 307, col: 20, offset: 9044},
-							val:        "asc",
-							ignoreCase: true,
-							want:       "\"ASC\"i",
+				expr: &seqExpr{
+					pos: position{line: 392, col: 19, offset: 12688},
+					exprs: []any{
+						&choiceExpr{
+							pos: position{line: 392, col: 20, offset: 12689},
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 392, col: 20, offset: 12689},
+									val:        "asc",
+									ignoreCase: true,
+									want:       "\"ASC\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 392, col: 29, offset: 12698},
+									val:        "desc",
+									ignoreCase: true,
+									want:       "\"DESC\"i",
+								},
+							},
 						},
-						&litMatcher{
-							pos:        position{line: 307, col: 29, offset: 9053},
-							val:        "desc",
-							ignoreCase: true,
-							want:       "\"DESC\"i",
+						&notExpr{
+							pos: position{line: 392, col: 38, offset: 12707},
+							expr: &ruleRefExpr{
+								pos:  position{line: 392, col: 39, offset: 12708},
+								name: "IdentifierChar",
+							},
 						},
 					},
 				},
@@ -1502,178 +2168,368 @@ var g = &grammar{
 		},
 		{
 			name: "Select",
-			pos:  position{line: 315, col: 1, offset: 9205},
-			expr: &litMatcher{
-				pos:        position{line: 315, col: 11, offset: 9215},
-				val:        "select",
-				ignoreCase: true,
-				want:       "\"SELECT\"i",
+			pos:  position{line: 400, col: 1, offset: 12866},
+			expr: &seqExpr{
+				pos: position{line: 400, col: 11, offset: 12876},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 400, col: 11, offset: 12876},
+						val:        "select",
+						ignoreCase: true,
+						want:       "\"SELECT\"i",
+					},
+					&notExpr{
+						pos: position{line: 400, col: 21, offset: 12886},
+						expr: &ruleRefExpr{
+							pos:  position{line: 400, col: 22, offset: 12887},
+							name: "IdentifierChar",
+						},
+					},
+				},
 			},
 		},
 		{
 			name: "Top",
-			pos:  position{line: 317, col: 1, offset: 9226},
-			expr: &litMatcher{
-				pos:        position{line: 317, col: 8, offset: 9233},
-				val:        "top",
-				ignoreCase: true,
-				want:       "\"TOP\"i",
+			pos:  position{line: 402, col: 1, offset: 12903},
+			expr: &seqExpr{
+				pos: position{line: 402, col: 8, offset: 12910},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 402, col: 8, offset: 12910},
+						val:        "top",
+						ignoreCase: true,
+						want:       "\"TOP\"i",
+					},
+					&notExpr{
+						pos: position{line: 402, col: 15, offset: 12917},
+						expr: &ruleRefExpr{
+							pos:  position{line: 402, col: 16, offset: 12918},
+							name: "IdentifierChar",
+						},
+					},
+				},
 			},
 		},
 		{
 			name: "As",
-			pos:  position{line: 319, col: 1, offset: 9241},
-			expr: &litMatcher{
-				pos:        position{line: 319, col: 7, offset: 9247},
-				val:        "as",
-				ignoreCase: true,
-				want:       "\"AS\"i",
+			pos:  position{line: 404, col: 1, offset: 12934},
+			expr: &seqExpr{
+				pos: position{line: 404, col: 7, offset: 12940},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 404, col: 7, offset: 12940},
+						val:        "as",
+						ignoreCase: true,
+						want:       "\"AS\"i",
+					},
+					&notExpr{
+						pos: position{line: 404, col: 13, offset: 12946},
+						expr: &ruleRefExpr{
+							pos:  position{line: 404, col: 14, offset: 12947},
+							name: "IdentifierChar",
+						},
+					},
+				},
 			},
 		},
 		{
 			name: "From",
-			pos:  position{line: 321, col: 1, offset: 9254},
-			expr: &litMatcher{
-				pos:        position{line: 321, col: 9, offset: 9262},
-				val:        "from",
-				ignoreCase: true,
-				want:       "\"FROM\"i",
+			pos:  position{line: 406, col: 1, offset: 12963},
+			expr: &seqExpr{
+				pos: position{line: 406, col: 9, offset: 12971},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 406, col: 9, offset: 12971},
+						val:        "from",
+						ignoreCase: true,
+						want:       "\"FROM\"i",
+					},
+					&notExpr{
+						pos: position{line: 406, col: 17, offset: 12979},
+						expr: &ruleRefExpr{
+							pos:  position{line: 406, col: 18, offset: 12980},
+							name: "IdentifierChar",
+						},
+					},
+				},
 			},
 		},
 		{
 			name: "Join",
-			pos:  position{line: 323, col: 1, offset: 9271},
-			expr: &litMatcher{
-				pos:        position{line: 323, col: 9, offset: 9279},
-				val:        "join",
-				ignoreCase: true,
-				want:       "\"JOIN\"i",
+			pos:  position{line: 408, col: 1, offset: 12996},
+			expr: &seqExpr{
+				pos: position{line: 408, col: 9, offset: 13004},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 408, col: 9, offset: 13004},
+						val:        "join",
+						ignoreCase: true,
+						want:       "\"JOIN\"i",
+					},
+					&notExpr{
+						pos: position{line: 408, col: 17, offset: 13012},
+						expr: &ruleRefExpr{
+							pos:  position{line: 408, col: 18, offset: 13013},
+							name: "IdentifierChar",
+						},
+					},
+				},
 			},
 		},
 		{
 			name: "Where",
-			pos:  position{line: 325, col: 1, offset: 9288},
-			expr: &litMatcher{
-				pos:        position{line: 325, col: 10, offset: 9297},
-				val:        "where",
-				ignoreCase: true,
-				want:       "\"WHERE\"i",
+			pos:  position{line: 410, col: 1, offset: 13029},
+			expr: &seqExpr{
+				pos: position{line: 410, col: 10, offset: 13038},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 410, col: 10, offset: 13038},
+						val:        "where",
+						ignoreCase: true,
+						want:       "\"WHERE\"i",
+					},
+					&notExpr{
+						pos: position{line: 410, col: 19, offset: 13047},
+						expr: &ruleRefExpr{
+							pos:  position{line: 410, col: 20, offset: 13048},
+							name: "IdentifierChar",
+						},
+					},
+				},
 			},
 		},
 		{
 			name: "And",
-			pos:  position{line: 327, col: 1, offset: 9307},
-			expr: &litMatcher{
-				pos:        position{line: 327, col: 8, offset: 9314},
-				val:        "and",
-				ignoreCase: true,
-				want:       "\"AND\"i",
+			pos:  position{line: 412, col: 1, offset: 13064},
+			expr: &seqExpr{
+				pos: position{line: 412, col: 8, offset: 13071},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 412, col: 8, offset: 13071},
+						val:        "and",
+						ignoreCase: true,
+						want:       "\"AND\"i",
+					},
+					&notExpr{
+						pos: position{line: 412, col: 15, offset: 13078},
+						expr: &ruleRefExpr{
+							pos:  position{line: 412, col: 16, offset: 13079},
+							name: "IdentifierChar",
+						},
+					},
+				},
 			},
 		},
 		{
 			name: "Or",
-			pos:  position{line: 329, col: 1, offset: 9322},
-			expr: &litMatcher{
-				pos:        position{line: 329, col: 7, offset: 9328},
-				val:        "or",
-				ignoreCase: true,
-				want:       "\"OR\"i",
+			pos:  position{line: 414, col: 1, offset: 13095},
+			expr: &seqExpr{
+				pos: position{line: 414, col: 7, offset: 13101},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 414, col: 7, offset: 13101},
+						val:        "or",
+						ignoreCase: true,
+						want:       "\"OR\"i",
+					},
+					&notExpr{
+						pos: position{line: 414, col: 13, offset: 13107},
+						expr: &ruleRefExpr{
+							pos:  position{line: 414, col: 14, offset: 13108},
+							name: "IdentifierChar",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Not",
+			pos:  position{line: 416, col: 1, offset: 13124},
+			expr: &seqExpr{
+				pos: position{line: 416, col: 8, offset: 13131},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 416, col: 8, offset: 13131},
+						val:        "not",
+						ignoreCase: true,
+						want:       "\"NOT\"i",
+					},
+					&notExpr{
+						pos: position{line: 416, col: 15, offset: 13138},
+						expr: &ruleRefExpr{
+							pos:  position{line: 416, col: 16, offset: 13139},
+							name: "IdentifierChar",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Like",
+			pos:  position{line: 418, col: 1, offset: 13155},
+			expr: &seqExpr{
+				pos: position{line: 418, col: 9, offset: 13163},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 418, col: 9, offset: 13163},
+						val:        "like",
+						ignoreCase: true,
+						want:       "\"LIKE\"i",
+					},
+					&notExpr{
+						pos: position{line: 418, col: 17, offset: 13171},
+						expr: &ruleRefExpr{
+							pos:  position{line: 418, col: 18, offset: 13172},
+							name: "IdentifierChar",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Escape",
+			pos:  position{line: 420, col: 1, offset: 13188},
+			expr: &seqExpr{
+				pos: position{line: 420, col: 11, offset: 13198},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 420, col: 11, offset: 13198},
+						val:        "escape",
+						ignoreCase: true,
+						want:       "\"ESCAPE\"i",
+					},
+					&notExpr{
+						pos: position{line: 420, col: 21, offset: 13208},
+						expr: &ruleRefExpr{
+							pos:  position{line: 420, col: 22, offset: 13209},
+							name: "IdentifierChar",
+						},
+					},
+				},
 			},
 		},
 		{
 			name: "GroupBy",
-			pos:  position{line: 331, col: 1, offset: 9335},
+			pos:  position{line: 422, col: 1, offset: 13225},
 			expr: &seqExpr{
-				pos: position{line: 331, col: 12, offset: 9346},
+				pos: position{line: 422, col: 12, offset: 13236},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 331, col: 12, offset: 9346},
+						pos:        position{line: 422, col: 12, offset: 13236},
 						val:        "group",
 						ignoreCase: true,
 						want:       "\"GROUP\"i",
 					},
+					&notExpr{
+						pos: position{line: 422, col: 21, offset: 13245},
+						expr: &ruleRefExpr{
+							pos:  position{line: 422, col: 22, offset: 13246},
+							name: "IdentifierChar",
+						},
+					},
 					&ruleRefExpr{
-						pos:  position{line: 331, col: 21, offset: 9355},
+						pos:  position{line: 422, col: 37, offset: 13261},
 						name: "ws",
 					},
 					&litMatcher{
-						pos:        position{line: 331, col: 24, offset: 9358},
+						pos:        position{line: 422, col: 40, offset: 13264},
 						val:        "by",
 						ignoreCase: true,
 						want:       "\"BY\"i",
 					},
+					&notExpr{
+						pos: position{line: 422, col: 46, offset: 13270},
+						expr: &ruleRefExpr{
+							pos:  position{line: 422, col: 47, offset: 13271},
+							name: "IdentifierChar",
+						},
+					},
 				},
 			},
 		},
 		{
 			name: "OrderBy",
-			pos:  position{line: 333, col: 1, offset: 9365},
+			pos:  position{line: 424, col: 1, offset: 13287},
 			expr: &seqExpr{
-				pos: position{line: 333, col: 12, offset: 9376},
+				pos: position{line: 424, col: 12, offset: 13298},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 333, col: 12, offset: 9376},
+						pos:        position{line: 424, col: 12, offset: 13298},
 						val:        "order",
 						ignoreCase: true,
 						want:       "\"ORDER\"i",
 					},
+					&notExpr{
+						pos: position{line: 424, col: 21, offset: 13307},
+						expr: &ruleRefExpr{
+							pos:  position{line: 424, col: 22, offset: 13308},
+							name: "IdentifierChar",
+						},
+					},
 					&ruleRefExpr{
-						pos:  position{line: 333, col: 21, offset: 9385},
+						pos:  position{line: 424, col: 37, offset: 13323},
 						name: "ws",
 					},
 					&litMatcher{
-						pos:        position{line: 333, col: 24, offset: 9388},
+						pos:        position{line: 424, col: 40, offset: 13326},
 						val:        "by",
 						ignoreCase: true,
 						want:       "\"BY\"i",
 					},
+					&notExpr{
+						pos: position{line: 424, col: 46, offset: 13332},
+						expr: &ruleRefExpr{
+							pos:  position{line: 424, col: 47, offset: 13333},
+							name: "IdentifierChar",
+						},
+					},
 				},
 			},
 		},
 		{
 			name: "ComparisonOperator",
-			pos:  position{line: 335, col: 1, offset: 9395},
+			pos:  position{line: 426, col: 1, offset: 13349},
 			expr: &actionExpr{
-				pos: position{line: 335, col: 23, offset: 9417},
+				pos: position{line: 426, col: 23, offset: 13371},
 				run: (*parser).callonComparisonOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 335, col: 24, offset: 9418},
+					pos: position{line: 426, col: 24, offset: 13372},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 335, col: 24, offset: 9418},
+							pos:        position{line: 426, col: 24, offset: 13372},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&litMatcher{
-							pos:        position{line: 335, col: 30, offset: 9424},
+							pos:        position{line: 426, col: 30, offset: 13378},
 							val:        "!=",
 							ignoreCase: false,
 							want:       "\"!=\"",
 						},
 						&litMatcher{
-							pos:        position{line: 335, col: 37, offset: 9431},
-							val:        "<",
+							pos:        position{line: 426, col: 37, offset: 13385},
+							val:        "<=",
 							ignoreCase: false,
-							want:       "\"<\"",
+							want:       "\"<=\"",
 						},
 						&litMatcher{
-							pos:        position{line: 335, col: 43, offset: 9437},
-							val:        "<=",
+							pos:        position{line: 426, col: 44, offset: 13392},
+							val:        ">=",
 							ignoreCase: false,
-							want:       "\"<=\"",
+							want:       "\">=\"",
 						},
 						&litMatcher{
-							pos:        position{line: 335, col: 50, offset: 9444},
-							val:        ">",
+							pos:        position{line: 426, col: 51, offset: 13399},
+							val:        "<",
 							ignoreCase: false,
-							want:       "\">\"",
+							want:       "\"<\"",
 						},
 						&litMatcher{
-							pos:        position{line: 335, col: 56, offset: 9450},
-							val:        ">=",
+							pos:        position{line: 426, col: 57, offset: 13405},
+							val:        ">",
 							ignoreCase: false,
-							want:       "\">=\"",
+							want:       "\">\"",
 						},
 					},
 				},
@@ -1681,32 +2537,32 @@ var g = &grammar{
 		},
 		{
 			name: "Literal",
-			pos:  position{line: 339, col: 1, offset: 9492},
+			pos:  position{line: 430, col: 1, offset: 13446},
 			expr: &choiceExpr{
-				pos: position{line: 339, col: 12, offset: 9503},
+				pos: position{line: 430, col: 12, offset: 13457},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 339, col: 12, offset: 9503},
+						pos:  position{line: 430, col: 12, offset: 13457},
 						name: "FloatLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 339, col: 27, offset: 9518},
+						pos:  position{line: 430, col: 27, offset: 13472},
 						name: "IntegerLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 339, col: 44, offset: 9535},
+						pos:  position{line: 430, col: 44, offset: 13489},
 						name: "StringLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 339, col: 60, offset: 9551},
+						pos:  position{line: 430, col: 60, offset: 13505},
 						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 339, col: 77, offset: 9568},
+						pos:  position{line: 430, col: 77, offset: 13522},
 						name: "ParameterConstant",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 339, col: 97, offset: 9588},
+						pos:  position{line: 430, col: 97, offset: 13542},
 						name: "NullConstant",
 					},
 				},
@@ -1714,21 +2570,21 @@ var g = &grammar{
 		},
 		{
 			name: "ParameterConstant",
-			pos:  position{line: 341, col: 1, offset: 9602},
+			pos:  position{line: 432, col: 1, offset: 13556},
 			expr: &actionExpr{
-				pos: position{line: 341, col: 22, offset: 9623},
+				pos: position{line: 432, col: 22, offset: 13577},
 				run: (*parser).callonParameterConstant1,
 				expr: &seqExpr{
-					pos: position{line: 341, col: 22, offset: 9623},
+					pos: position{line: 432, col: 22, offset: 13577},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 341, col: 22, offset: 9623},
+							pos:        position{line: 432, col: 22, offset: 13577},
 							val:        "@",
 							ignoreCase: false,
 							want:       "\"@\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 341, col: 26, offset: 9627},
+							pos:  position{line: 432, col: 26, offset: 13581},
 							name: "Identifier",
 						},
 					},
@@ -1737,65 +2593,130 @@ var g = &grammar{
 		},
 		{
 			name: "NullConstant",
-			pos:  position{line: 344, col: 1, offset: 9743},
+			pos:  position{line: 435, col: 1, offset: 13697},
 			expr: &actionExpr{
-				pos: position{line: 344, col: 17, offset: 9759},
+				pos: position{line: 435, col: 17, offset: 13713},
 				run: (*parser).callonNullConstant1,
-				expr: &litMatcher{
-					pos:        position{line: 344, col: 17, offset: 9759},
-					val:        "null",
-					ignoreCase: true,
-					want:       "\"null\"i",
+				expr: &seqExpr{
+					pos: position{line: 435, col: 17, offset: 13713},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 435, col: 17, offset: 13713},
+							val:        "null",
+							ignoreCase: true,
+							want:       "\"null\"i",
+						},
+						&notExpr{
+							pos: position{line: 435, col: 25, offset: 13721},
+							expr: &ruleRefExpr{
+								pos:  position{line: 435, col: 26, offset: 13722},
+								name: "IdentifierChar",
+							},
+						},
+					},
 				},
 			},
 		},
 		{
 			name: "IntegerLiteral",
-			pos:  position{line: 348, col: 1, offset: 9817},
+			pos:  position{line: 439, col: 1, offset: 13787},
 			expr: &actionExpr{
-				pos: position{line: 348, col: 19, offset: 9835},
+				pos: position{line: 439, col: 19, offset: 13805},
 				run: (*parser).callonIntegerLiteral1,
-				expr: &labeledExpr{
-					pos:   position{line: 348, col: 19, offset: 9835},
-					label: "number",
-					expr: &ruleRefExpr{
-						pos:  position{line: 348, col: 26, offset: 9842},
-						name: "Integer",
+				expr: &seqExpr{
+					pos: position{line: 439, col: 19, offset: 13805},
+					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 439, col: 19, offset: 13805},
+							expr: &litMatcher{
+								pos:        position{line: 439, col: 19, offset: 13805},
+								val:        "-",
+								ignoreCase: false,
+								want:       "\"-\"",
+							},
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 439, col: 24, offset: 13810},
+							expr: &charClassMatcher{
+								pos:        position{line: 439, col: 24, offset: 13810},
+								val:        "[0-9]",
+								ranges:     []rune{'0', '9'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "StringLiteral",
-			pos:  position{line: 351, col: 1, offset: 9943},
-			expr: &actionExpr{
-				pos: position{line: 351, col: 18, offset: 9960},
-				run: (*parser).callonStringLiteral1,
-				expr: &seqExpr{
-					pos: position{line: 351, col: 18, offset: 9960},
-					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 351, col: 18, offset: 9960},
-							val:        "\"",
-							ignoreCase: false,
-							want:       "\"\\\"\"",
-						},
-						&labeledExpr{
-							pos:   position{line: 351, col: 23, offset: 9965},
-							label: "chars",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 351, col: 29, offset: 9971},
-								expr: &ruleRefExpr{
-									pos:  position{line: 351, col: 29, offset: 9971},
-									name: "StringCharacter",
+			pos:  position{line: 442, col: 1, offset: 13931},
+			expr: &choiceExpr{
+				pos: position{line: 442, col: 18, offset: 13948},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 442, col: 18, offset: 13948},
+						run: (*parser).callonStringLiteral2,
+						expr: &seqExpr{
+							pos: position{line: 442, col: 18, offset: 13948},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 442, col: 18, offset: 13948},
+									val:        "\"",
+									ignoreCase: false,
+									want:       "\"\\\"\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 442, col: 23, offset: 13953},
+									label: "chars",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 442, col: 29, offset: 13959},
+										expr: &ruleRefExpr{
+											pos:  position{line: 442, col: 29, offset: 13959},
+											name: "DoubleQuotedStringCharacter",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 442, col: 58, offset: 13988},
+									val:        "\"",
+									ignoreCase: false,
+									want:       "\"\\\"\"",
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 351, col: 46, offset: 9988},
-							val:        "\"",
-							ignoreCase: false,
-							want:       "\"\\\"\"",
+					},
+					&actionExpr{
+						pos: position{line: 444, col: 5, offset: 14108},
+						run: (*parser).callonStringLiteral9,
+						expr: &seqExpr{
+							pos: position{line: 444, col: 5, offset: 14108},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 444, col: 5, offset: 14108},
+									val:        "'",
+									ignoreCase: false,
+									want:       "\"'\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 444, col: 9, offset: 14112},
+									label: "chars",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 444, col: 15, offset: 14118},
+										expr: &ruleRefExpr{
+											pos:  position{line: 444, col: 15, offset: 14118},
+											name: "SingleQuotedStringCharacter",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 444, col: 44, offset: 14147},
+									val:        "'",
+									ignoreCase: false,
+									want:       "\"'\"",
+								},
+							},
 						},
 					},
 				},
@@ -1803,17 +2724,26 @@ var g = &grammar{
 		},
 		{
 			name: "FloatLiteral",
-			pos:  position{line: 354, col: 1, offset: 10106},
+			pos:  position{line: 447, col: 1, offset: 14264},
 			expr: &actionExpr{
-				pos: position{line: 354, col: 17, offset: 10122},
+				pos: position{line: 447, col: 17, offset: 14280},
 				run: (*parser).callonFloatLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 354, col: 17, offset: 10122},
+					pos: position{line: 447, col: 17, offset: 14280},
 					exprs: []any{
+						&zeroOrOneExpr{
+							pos: position{line: 447, col: 17, offset: 14280},
+							expr: &litMatcher{
+								pos:        position{line: 447, col: 17, offset: 14280},
+								val:        "-",
+								ignoreCase: false,
+								want:       "\"-\"",
+							},
+						},
 						&oneOrMoreExpr{
-							pos: position{line: 354, col: 17, offset: 10122},
+							pos: position{line: 447, col: 22, offset: 14285},
 							expr: &charClassMatcher{
-								pos:        position{line: 354, col: 17, offset: 10122},
+								pos:        position{line: 447, col: 22, offset: 14285},
 								val:        "[0-9]",
 								ranges:     []rune{'0', '9'},
 								ignoreCase: false,
@@ -1821,15 +2751,15 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 354, col: 23, offset: 10128},
+							pos:        position{line: 447, col: 28, offset: 14291},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 354, col: 26, offset: 10131},
+							pos: position{line: 447, col: 31, offset: 14294},
 							expr: &charClassMatcher{
-								pos:        position{line: 354, col: 26, offset: 10131},
+								pos:        position{line: 447, col: 31, offset: 14294},
 								val:        "[0-9]",
 								ranges:     []rune{'0', '9'},
 								ignoreCase: false,
@@ -1842,24 +2772,36 @@ var g = &grammar{
 		},
 		{
 			name: "BooleanLiteral",
-			pos:  position{line: 358, col: 1, offset: 10287},
+			pos:  position{line: 450, col: 1, offset: 14413},
 			expr: &actionExpr{
-				pos: position{line: 358, col: 19, offset: 10305},
+				pos: position{line: 450, col: 19, offset: 14431},
 				run: (*parser).callonBooleanLiteral1,
-				expr: &choiceExpr{
-					pos: position{line: 358, col: 20, offset: 10306},
-					alternatives: []any{
-						&litMatcher{
-							pos:        position{line: 358, col: 20, offset: 10306},
-							val:        "true",
-							ignoreCase: true,
-							want:       "\"true\"i",
+				expr: &seqExpr{
+					pos: position{line: 450, col: 19, offset: 14431},
+					exprs: []any{
+						&choiceExpr{
+							pos: position{line: 450, col: 20, offset: 14432},
+							alternatives: []any{
+								&litMatcher{
+									pos:        position{line: 450, col: 20, offset: 14432},
+									val:        "true",
+									ignoreCase: true,
+									want:       "\"true\"i",
+								},
+								&litMatcher{
+									pos:        position{line: 450, col: 30, offset: 14442},
+									val:        "false",
+									ignoreCase: true,
+									want:       "\"false\"i",
+								},
+							},
 						},
-						&litMatcher{
-							pos:        position{line: 358, col: 30, offset: 10316},
-							val:        "false",
-							ignoreCase: true,
-							want:       "\"false\"i",
+						&notExpr{
+							pos: position{line: 450, col: 40, offset: 14452},
+							expr: &ruleRefExpr{
+								pos:  position{line: 450, col: 41, offset: 14453},
+								name: "IdentifierChar",
+							},
 						},
 					},
 				},
@@ -1867,154 +2809,170 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionCall",
-			pos:  position{line: 363, col: 1, offset: 10471},
+			pos:  position{line: 455, col: 1, offset: 14630},
 			expr: &choiceExpr{
-				pos: position{line: 363, col: 17, offset: 10487},
+				pos: position{line: 455, col: 17, offset: 14646},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 363, col: 17, offset: 10487},
+						pos:  position{line: 455, col: 17, offset: 14646},
 						name: "StringFunctions",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 364, col: 7, offset: 10509},
+						pos:  position{line: 456, col: 7, offset: 14668},
 						name: "TypeCheckingFunctions",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 365, col: 7, offset: 10537},
+						pos:  position{line: 457, col: 7, offset: 14696},
 						name: "ArrayFunctions",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 366, col: 7, offset: 10558},
+						pos:  position{line: 458, col: 7, offset: 14717},
 						name: "InFunction",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 367, col: 7, offset: 10575},
+						pos:  position{line: 459, col: 7, offset: 14734},
+						name: "ExistsFunction",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 460, col: 7, offset: 14755},
 						name: "AggregateFunctions",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 368, col: 7, offset: 10600},
+						pos:  position{line: 461, col: 7, offset: 14780},
 						name: "MathFunctions",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 462, col: 7, offset: 14800},
+						name: "SpatialFunctions",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 463, col: 7, offset: 14823},
+						name: "DateTimeFunctions",
+					},
 				},
 			},
 		},
 		{
 			name: "StringFunctions",
-			pos:  position{line: 370, col: 1, offset: 10615},
+			pos:  position{line: 465, col: 1, offset: 14842},
 			expr: &choiceExpr{
-				pos: position{line: 370, col: 20, offset: 10634},
+				pos: position{line: 465, col: 20, offset: 14861},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 370, col: 20, offset: 10634},
+						pos:  position{line: 465, col: 20, offset: 14861},
 						name: "StringEqualsExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 371, col: 7, offset: 10663},
+						pos:  position{line: 466, col: 7, offset: 14890},
 						name: "ToStringExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 372, col: 7, offset: 10688},
+						pos:  position{line: 467, col: 7, offset: 14915},
 						name: "ConcatExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 373, col: 7, offset: 10711},
+						pos:  position{line: 468, col: 7, offset: 14938},
 						name: "ThreeArgumentStringFunctionExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 374, col: 7, offset: 10755},
+						pos:  position{line: 469, col: 7, offset: 14982},
 						name: "UpperExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 375, col: 7, offset: 10777},
+						pos:  position{line: 470, col: 7, offset: 15004},
 						name: "LowerExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 376, col: 7, offset: 10799},
+						pos:  position{line: 471, col: 7, offset: 15026},
 						name: "LeftExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 377, col: 7, offset: 10820},
+						pos:  position{line: 472, col: 7, offset: 15047},
 						name: "LengthExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 378, col: 7, offset: 10843},
+						pos:  position{line: 473, col: 7, offset: 15070},
 						name: "LTrimExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 379, col: 7, offset: 10865},
+						pos:  position{line: 474, col: 7, offset: 15092},
 						name: "ReplaceExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 380, col: 7, offset: 10889},
+						pos:  position{line: 475, col: 7, offset: 15116},
 						name: "ReplicateExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 381, col: 7, offset: 10915},
+						pos:  position{line: 476, col: 7, offset: 15142},
 						name: "ReverseExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 382, col: 7, offset: 10939},
+						pos:  position{line: 477, col: 7, offset: 15166},
 						name: "RightExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 383, col: 7, offset: 10961},
+						pos:  position{line: 478, col: 7, offset: 15188},
 						name: "RTrimExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 384, col: 7, offset: 10983},
+						pos:  position{line: 479, col: 7, offset: 15210},
 						name: "SubstringExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 385, col: 7, offset: 11009},
+						pos:  position{line: 480, col: 7, offset: 15236},
 						name: "TrimExpression",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 481, col: 7, offset: 15257},
+						name: "RegexMatchExpression",
+					},
 				},
 			},
 		},
 		{
 			name: "TypeCheckingFunctions",
-			pos:  position{line: 387, col: 1, offset: 11025},
+			pos:  position{line: 483, col: 1, offset: 15279},
 			expr: &choiceExpr{
-				pos: position{line: 387, col: 26, offset: 11050},
+				pos: position{line: 483, col: 26, offset: 15304},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 387, col: 26, offset: 11050},
+						pos:  position{line: 483, col: 26, offset: 15304},
 						name: "IsDefined",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 388, col: 7, offset: 11066},
+						pos:  position{line: 484, col: 7, offset: 15320},
 						name: "IsArray",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 389, col: 7, offset: 11080},
+						pos:  position{line: 485, col: 7, offset: 15334},
 						name: "IsBool",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 390, col: 7, offset: 11093},
+						pos:  position{line: 486, col: 7, offset: 15347},
 						name: "IsFiniteNumber",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 391, col: 7, offset: 11114},
+						pos:  position{line: 487, col: 7, offset: 15368},
 						name: "IsInteger",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 392, col: 7, offset: 11130},
+						pos:  position{line: 488, col: 7, offset: 15384},
 						name: "IsNull",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 393, col: 7, offset: 11143},
+						pos:  position{line: 489, col: 7, offset: 15397},
 						name: "IsNumber",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 394, col: 7, offset: 11158},
+						pos:  position{line: 490, col: 7, offset: 15412},
 						name: "IsObject",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 395, col: 7, offset: 11173},
+						pos:  position{line: 491, col: 7, offset: 15427},
 						name: "IsPrimitive",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 396, col: 7, offset: 11191},
+						pos:  position{line: 492, col: 7, offset: 15445},
 						name: "IsString",
 					},
 				},
@@ -2022,28 +2980,28 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateFunctions",
-			pos:  position{line: 398, col: 1, offset: 11201},
+			pos:  position{line: 494, col: 1, offset: 15455},
 			expr: &choiceExpr{
-				pos: position{line: 398, col: 23, offset: 11223},
+				pos: position{line: 494, col: 23, offset: 15477},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 398, col: 23, offset: 11223},
+						pos:  position{line: 494, col: 23, offset: 15477},
 						name: "AvgAggregateExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 399, col: 7, offset: 11252},
+						pos:  position{line: 495, col: 7, offset: 15506},
 						name: "CountAggregateExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 400, col: 7, offset: 11283},
+						pos:  position{line: 496, col: 7, offset: 15537},
 						name: "MaxAggregateExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 401, col: 7, offset: 11312},
+						pos:  position{line: 497, col: 7, offset: 15566},
 						name: "MinAggregateExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 402, col: 7, offset: 11341},
+						pos:  position{line: 498, col: 7, offset: 15595},
 						name: "SumAggregateExpression",
 					},
 				},
@@ -2051,181 +3009,243 @@ var g = &grammar{
 		},
 		{
 			name: "ArrayFunctions",
-			pos:  position{line: 404, col: 1, offset: 11365},
+			pos:  position{line: 500, col: 1, offset: 15619},
 			expr: &choiceExpr{
-				pos: position{line: 404, col: 19, offset: 11383},
+				pos: position{line: 500, col: 19, offset: 15637},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 404, col: 19, offset: 11383},
+						pos:  position{line: 500, col: 19, offset: 15637},
 						name: "ArrayConcatExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 405, col: 7, offset: 11411},
+						pos:  position{line: 501, col: 7, offset: 15665},
+						name: "ArrayContainsAnyExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 502, col: 7, offset: 15698},
+						name: "ArrayContainsAllExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 503, col: 7, offset: 15731},
+						name: "ArrayContainsExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 504, col: 7, offset: 15761},
 						name: "ArrayLengthExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 406, col: 7, offset: 11439},
+						pos:  position{line: 505, col: 7, offset: 15789},
 						name: "ArraySliceExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 407, col: 7, offset: 11466},
+						pos:  position{line: 506, col: 7, offset: 15816},
 						name: "SetIntersectExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 408, col: 7, offset: 11495},
+						pos:  position{line: 507, col: 7, offset: 15845},
 						name: "SetUnionExpression",
 					},
 				},
 			},
 		},
+		{
+			name: "SpatialFunctions",
+			pos:  position{line: 509, col: 1, offset: 15865},
+			expr: &choiceExpr{
+				pos: position{line: 509, col: 21, offset: 15885},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 509, col: 21, offset: 15885},
+						name: "StDistanceExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 510, col: 7, offset: 15912},
+						name: "StWithinExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 511, col: 7, offset: 15937},
+						name: "StIntersectsExpression",
+					},
+				},
+			},
+		},
+		{
+			name: "DateTimeFunctions",
+			pos:  position{line: 513, col: 1, offset: 15961},
+			expr: &choiceExpr{
+				pos: position{line: 513, col: 22, offset: 15982},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 513, col: 22, offset: 15982},
+						name: "DateTimeToTicksExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 514, col: 7, offset: 16014},
+						name: "DateTimeToTimestampExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 515, col: 7, offset: 16050},
+						name: "GetCurrentTicksExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 516, col: 7, offset: 16082},
+						name: "TicksToDateTimeExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 517, col: 7, offset: 16114},
+						name: "TimestampToDateTimeExpression",
+					},
+				},
+			},
+		},
 		{
 			name: "MathFunctions",
-			pos:  position{line: 410, col: 1, offset: 11515},
+			pos:  position{line: 519, col: 1, offset: 16145},
 			expr: &choiceExpr{
-				pos: position{line: 410, col: 18, offset: 11532},
+				pos: position{line: 519, col: 18, offset: 16162},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 410, col: 18, offset: 11532},
+						pos:  position{line: 519, col: 18, offset: 16162},
 						name: "MathAbsExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 411, col: 7, offset: 11556},
+						pos:  position{line: 520, col: 7, offset: 16186},
 						name: "MathAcosExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 412, col: 7, offset: 11581},
+						pos:  position{line: 521, col: 7, offset: 16211},
 						name: "MathAsinExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 413, col: 7, offset: 11606},
+						pos:  position{line: 522, col: 7, offset: 16236},
 						name: "MathAtanExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 414, col: 7, offset: 11631},
+						pos:  position{line: 523, col: 7, offset: 16261},
 						name: "MathCeilingExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 415, col: 7, offset: 11659},
+						pos:  position{line: 524, col: 7, offset: 16289},
 						name: "MathCosExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 416, col: 7, offset: 11683},
+						pos:  position{line: 525, col: 7, offset: 16313},
 						name: "MathCotExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 417, col: 7, offset: 11707},
+						pos:  position{line: 526, col: 7, offset: 16337},
 						name: "MathDegreesExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 418, col: 7, offset: 11735},
+						pos:  position{line: 527, col: 7, offset: 16365},
 						name: "MathExpExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 419, col: 7, offset: 11759},
+						pos:  position{line: 528, col: 7, offset: 16389},
 						name: "MathFloorExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 420, col: 7, offset: 11785},
+						pos:  position{line: 529, col: 7, offset: 16415},
 						name: "MathIntBitNotExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 421, col: 7, offset: 11815},
+						pos:  position{line: 530, col: 7, offset: 16445},
 						name: "MathLog10Expression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 422, col: 7, offset: 11841},
+						pos:  position{line: 531, col: 7, offset: 16471},
 						name: "MathRadiansExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 423, col: 7, offset: 11869},
+						pos:  position{line: 532, col: 7, offset: 16499},
 						name: "MathRoundExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 424, col: 7, offset: 11895},
+						pos:  position{line: 533, col: 7, offset: 16525},
 						name: "MathSignExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 425, col: 7, offset: 11920},
+						pos:  position{line: 534, col: 7, offset: 16550},
 						name: "MathSinExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 426, col: 7, offset: 11944},
+						pos:  position{line: 535, col: 7, offset: 16574},
 						name: "MathSqrtExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 427, col: 7, offset: 11969},
+						pos:  position{line: 536, col: 7, offset: 16599},
 						name: "MathSquareExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 428, col: 7, offset: 11996},
+						pos:  position{line: 537, col: 7, offset: 16626},
 						name: "MathTanExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 429, col: 7, offset: 12020},
+						pos:  position{line: 538, col: 7, offset: 16650},
 						name: "MathTruncExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 430, col: 7, offset: 12046},
+						pos:  position{line: 539, col: 7, offset: 16676},
 						name: "MathAtn2Expression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 431, col: 7, offset: 12071},
+						pos:  position{line: 540, col: 7, offset: 16701},
 						name: "MathIntAddExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 432, col: 7, offset: 12098},
+						pos:  position{line: 541, col: 7, offset: 16728},
 						name: "MathIntBitAndExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 433, col: 7, offset: 12128},
+						pos:  position{line: 542, col: 7, offset: 16758},
 						name: "MathIntBitLeftShiftExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 434, col: 7, offset: 12164},
+						pos:  position{line: 543, col: 7, offset: 16794},
 						name: "MathIntBitOrExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 435, col: 7, offset: 12193},
+						pos:  position{line: 544, col: 7, offset: 16823},
 						name: "MathIntBitRightShiftExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 436, col: 7, offset: 12230},
+						pos:  position{line: 545, col: 7, offset: 16860},
 						name: "MathIntBitXorExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 437, col: 7, offset: 12260},
+						pos:  position{line: 546, col: 7, offset: 16890},
 						name: "MathIntDivExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 438, col: 7, offset: 12287},
+						pos:  position{line: 547, col: 7, offset: 16917},
 						name: "MathIntModExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 439, col: 7, offset: 12314},
+						pos:  position{line: 548, col: 7, offset: 16944},
 						name: "MathIntMulExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 440, col: 7, offset: 12341},
+						pos:  position{line: 549, col: 7, offset: 16971},
 						name: "MathIntSubExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 441, col: 7, offset: 12368},
+						pos:  position{line: 550, col: 7, offset: 16998},
 						name: "MathPowerExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 442, col: 7, offset: 12394},
+						pos:  position{line: 551, col: 7, offset: 17024},
 						name: "MathLogExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 443, col: 7, offset: 12418},
+						pos:  position{line: 552, col: 7, offset: 17048},
 						name: "MathNumberBinExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 444, col: 7, offset: 12448},
+						pos:  position{line: 553, col: 7, offset: 17078},
 						name: "MathPiExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 445, col: 7, offset: 12471},
+						pos:  position{line: 554, col: 7, offset: 17101},
 						name: "MathRandExpression",
 					},
 				},
@@ -2233,39 +3253,47 @@ var g = &grammar{
 		},
 		{
 			name: "UpperExpression",
-			pos:  position{line: 447, col: 1, offset: 12491},
+			pos:  position{line: 556, col: 1, offset: 17121},
 			expr: &actionExpr{
-				pos: position{line: 447, col: 20, offset: 12510},
+				pos: position{line: 556, col: 20, offset: 17140},
 				run: (*parser).callonUpperExpression1,
 				expr: &seqExpr{
-					pos: position{line: 447, col: 20, offset: 12510},
+					pos: position{line: 556, col: 20, offset: 17140},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 447, col: 20, offset: 12510},
+							pos:        position{line: 556, col: 20, offset: 17140},
 							val:        "upper",
 							ignoreCase: true,
 							want:       "\"UPPER\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 447, col: 29, offset: 12519},
+							pos:  position{line: 556, col: 29, offset: 17149},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 447, col: 32, offset: 12522},
+							pos:        position{line: 556, col: 32, offset: 17152},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
+						&ruleRefExpr{
+							pos:  position{line: 556, col: 36, offset: 17156},
+							name: "ws",
+						},
 						&labeledExpr{
-							pos:   position{line: 447, col: 36, offset: 12526},
+							pos:   position{line: 556, col: 39, offset: 17159},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 447, col: 39, offset: 12529},
+								pos:  position{line: 556, col: 42, offset: 17162},
 								name: "SelectItem",
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 556, col: 53, offset: 17173},
+							name: "ws",
+						},
 						&litMatcher{
-							pos:        position{line: 447, col: 50, offset: 12540},
+							pos:        position{line: 556, col: 56, offset: 17176},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2276,39 +3304,47 @@ var g = &grammar{
 		},
 		{
 			name: "LowerExpression",
-			pos:  position{line: 451, col: 1, offset: 12625},
+			pos:  position{line: 560, col: 1, offset: 17261},
 			expr: &actionExpr{
-				pos: position{line: 451, col: 20, offset: 12644},
+				pos: position{line: 560, col: 20, offset: 17280},
 				run: (*parser).callonLowerExpression1,
 				expr: &seqExpr{
-					pos: position{line: 451, col: 20, offset: 12644},
+					pos: position{line: 560, col: 20, offset: 17280},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 451, col: 20, offset: 12644},
+							pos:        position{line: 560, col: 20, offset: 17280},
 							val:        "lower",
 							ignoreCase: true,
 							want:       "\"LOWER\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 451, col: 29, offset: 12653},
+							pos:  position{line: 560, col: 29, offset: 17289},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 451, col: 32, offset: 12656},
+							pos:        position{line: 560, col: 32, offset: 17292},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
+						&ruleRefExpr{
+							pos:  position{line: 560, col: 36, offset: 17296},
+							name: "ws",
+						},
 						&labeledExpr{
-							pos:   position{line: 451, col: 36, offset: 12660},
+							pos:   position{line: 560, col: 39, offset: 17299},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 451, col: 39, offset: 12663},
+								pos:  position{line: 560, col: 42, offset: 17302},
 								name: "SelectItem",
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 560, col: 53, offset: 17313},
+							name: "ws",
+						},
 						&litMatcher{
-							pos:        position{line: 451, col: 50, offset: 12674},
+							pos:        position{line: 560, col: 56, offset: 17316},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2319,93 +3355,93 @@ var g = &grammar{
 		},
 		{
 			name: "StringEqualsExpression",
-			pos:  position{line: 455, col: 1, offset: 12759},
+			pos:  position{line: 564, col: 1, offset: 17401},
 			expr: &actionExpr{
-				pos: position{line: 455, col: 27, offset: 12785},
+				pos: position{line: 564, col: 27, offset: 17427},
 				run: (*parser).callonStringEqualsExpression1,
 				expr: &seqExpr{
-					pos: position{line: 455, col: 27, offset: 12785},
+					pos: position{line: 564, col: 27, offset: 17427},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 455, col: 27, offset: 12785},
+							pos:        position{line: 564, col: 27, offset: 17427},
 							val:        "stringequals",
 							ignoreCase: true,
 							want:       "\"STRINGEQUALS\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 455, col: 43, offset: 12801},
+							pos:  position{line: 564, col: 43, offset: 17443},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 455, col: 46, offset: 12804},
+							pos:        position{line: 564, col: 46, offset: 17446},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 455, col: 50, offset: 12808},
+							pos:  position{line: 564, col: 50, offset: 17450},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 455, col: 53, offset: 12811},
+							pos:   position{line: 564, col: 53, offset: 17453},
 							label: "ex1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 455, col: 57, offset: 12815},
+								pos:  position{line: 564, col: 57, offset: 17457},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 455, col: 68, offset: 12826},
+							pos:  position{line: 564, col: 68, offset: 17468},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 455, col: 71, offset: 12829},
+							pos:        position{line: 564, col: 71, offset: 17471},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 455, col: 75, offset: 12833},
+							pos:  position{line: 564, col: 75, offset: 17475},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 455, col: 78, offset: 12836},
+							pos:   position{line: 564, col: 78, offset: 17478},
 							label: "ex2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 455, col: 82, offset: 12840},
+								pos:  position{line: 564, col: 82, offset: 17482},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 455, col: 93, offset: 12851},
+							pos:  position{line: 564, col: 93, offset: 17493},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 455, col: 96, offset: 12854},
+							pos:   position{line: 564, col: 96, offset: 17496},
 							label: "ignoreCase",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 455, col: 107, offset: 12865},
+								pos: position{line: 564, col: 107, offset: 17507},
 								expr: &actionExpr{
-									pos: position{line: 455, col: 108, offset: 12866},
+									pos: position{line: 564, col: 108, offset: 17508},
 									run: (*parser).callonStringEqualsExpression17,
 									expr: &seqExpr{
-										pos: position{line: 455, col: 108, offset: 12866},
+										pos: position{line: 564, col: 108, offset: 17508},
 										exprs: []any{
 											&litMatcher{
-												pos:        position{line: 455, col: 108, offset: 12866},
+												pos:        position{line: 564, col: 108, offset: 17508},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 455, col: 112, offset: 12870},
+												pos:  position{line: 564, col: 112, offset: 17512},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 455, col: 115, offset: 12873},
+												pos:   position{line: 564, col: 115, offset: 17515},
 												label: "boolean",
 												expr: &ruleRefExpr{
-													pos:  position{line: 455, col: 123, offset: 12881},
+													pos:  position{line: 564, col: 123, offset: 17523},
 													name: "SelectItem",
 												},
 											},
@@ -2414,8 +3450,12 @@ var g = &grammar{
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 564, col: 160, offset: 17560},
+							name: "ws",
+						},
 						&litMatcher{
-							pos:        position{line: 455, col: 160, offset: 12918},
+							pos:        position{line: 564, col: 163, offset: 17563},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2426,47 +3466,479 @@ var g = &grammar{
 		},
 		{
 			name: "ToStringExpression",
-			pos:  position{line: 459, col: 1, offset: 13028},
+			pos:  position{line: 568, col: 1, offset: 17673},
 			expr: &actionExpr{
-				pos: position{line: 459, col: 23, offset: 13050},
+				pos: position{line: 568, col: 23, offset: 17695},
 				run: (*parser).callonToStringExpression1,
 				expr: &seqExpr{
-					pos: position{line: 459, col: 23, offset: 13050},
+					pos: position{line: 568, col: 23, offset: 17695},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 459, col: 23, offset: 13050},
+							pos:        position{line: 568, col: 23, offset: 17695},
 							val:        "tostring",
 							ignoreCase: true,
 							want:       "\"TOSTRING\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 459, col: 35, offset: 13062},
+							pos:  position{line: 568, col: 35, offset: 17707},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 568, col: 38, offset: 17710},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 568, col: 42, offset: 17714},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 568, col: 45, offset: 17717},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 568, col: 48, offset: 17720},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 568, col: 59, offset: 17731},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 568, col: 62, offset: 17734},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ConcatExpression",
+			pos:  position{line: 572, col: 1, offset: 17822},
+			expr: &actionExpr{
+				pos: position{line: 572, col: 21, offset: 17842},
+				run: (*parser).callonConcatExpression1,
+				expr: &seqExpr{
+					pos: position{line: 572, col: 21, offset: 17842},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 572, col: 21, offset: 17842},
+							val:        "concat",
+							ignoreCase: true,
+							want:       "\"CONCAT\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 572, col: 31, offset: 17852},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 572, col: 34, offset: 17855},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 572, col: 38, offset: 17859},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 572, col: 41, offset: 17862},
+							label: "ex1",
+							expr: &ruleRefExpr{
+								pos:  position{line: 572, col: 45, offset: 17866},
+								name: "SelectItem",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 572, col: 56, offset: 17877},
+							label: "others",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 572, col: 63, offset: 17884},
+								expr: &actionExpr{
+									pos: position{line: 572, col: 64, offset: 17885},
+									run: (*parser).callonConcatExpression11,
+									expr: &seqExpr{
+										pos: position{line: 572, col: 64, offset: 17885},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 572, col: 64, offset: 17885},
+												name: "ws",
+											},
+											&litMatcher{
+												pos:        position{line: 572, col: 67, offset: 17888},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 572, col: 71, offset: 17892},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 572, col: 74, offset: 17895},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 572, col: 77, offset: 17898},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 572, col: 109, offset: 17930},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 572, col: 112, offset: 17933},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LeftExpression",
+			pos:  position{line: 577, col: 1, offset: 18082},
+			expr: &actionExpr{
+				pos: position{line: 577, col: 19, offset: 18100},
+				run: (*parser).callonLeftExpression1,
+				expr: &seqExpr{
+					pos: position{line: 577, col: 19, offset: 18100},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 577, col: 19, offset: 18100},
+							val:        "left",
+							ignoreCase: true,
+							want:       "\"LEFT\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 577, col: 27, offset: 18108},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 577, col: 30, offset: 18111},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 577, col: 34, offset: 18115},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 577, col: 37, offset: 18118},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 577, col: 40, offset: 18121},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 577, col: 51, offset: 18132},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 577, col: 54, offset: 18135},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 577, col: 58, offset: 18139},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 577, col: 61, offset: 18142},
+							label: "length",
+							expr: &ruleRefExpr{
+								pos:  position{line: 577, col: 68, offset: 18149},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 577, col: 79, offset: 18160},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 577, col: 82, offset: 18163},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LengthExpression",
+			pos:  position{line: 581, col: 1, offset: 18255},
+			expr: &actionExpr{
+				pos: position{line: 581, col: 21, offset: 18275},
+				run: (*parser).callonLengthExpression1,
+				expr: &seqExpr{
+					pos: position{line: 581, col: 21, offset: 18275},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 581, col: 21, offset: 18275},
+							val:        "length",
+							ignoreCase: true,
+							want:       "\"LENGTH\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 581, col: 31, offset: 18285},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 581, col: 34, offset: 18288},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 581, col: 38, offset: 18292},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 581, col: 41, offset: 18295},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 581, col: 44, offset: 18298},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 581, col: 55, offset: 18309},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 581, col: 58, offset: 18312},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LTrimExpression",
+			pos:  position{line: 585, col: 1, offset: 18398},
+			expr: &actionExpr{
+				pos: position{line: 585, col: 20, offset: 18417},
+				run: (*parser).callonLTrimExpression1,
+				expr: &seqExpr{
+					pos: position{line: 585, col: 20, offset: 18417},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 585, col: 20, offset: 18417},
+							val:        "ltrim",
+							ignoreCase: true,
+							want:       "\"LTRIM\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 585, col: 29, offset: 18426},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 585, col: 32, offset: 18429},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 585, col: 36, offset: 18433},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 585, col: 39, offset: 18436},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 585, col: 42, offset: 18439},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 585, col: 53, offset: 18450},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 585, col: 56, offset: 18453},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ReplaceExpression",
+			pos:  position{line: 589, col: 1, offset: 18538},
+			expr: &actionExpr{
+				pos: position{line: 589, col: 22, offset: 18559},
+				run: (*parser).callonReplaceExpression1,
+				expr: &seqExpr{
+					pos: position{line: 589, col: 22, offset: 18559},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 589, col: 22, offset: 18559},
+							val:        "replace",
+							ignoreCase: true,
+							want:       "\"REPLACE\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 589, col: 33, offset: 18570},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 589, col: 36, offset: 18573},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 589, col: 40, offset: 18577},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 589, col: 43, offset: 18580},
+							label: "ex1",
+							expr: &ruleRefExpr{
+								pos:  position{line: 589, col: 47, offset: 18584},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 589, col: 58, offset: 18595},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 589, col: 61, offset: 18598},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 589, col: 65, offset: 18602},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 589, col: 68, offset: 18605},
+							label: "ex2",
+							expr: &ruleRefExpr{
+								pos:  position{line: 589, col: 72, offset: 18609},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 589, col: 83, offset: 18620},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 589, col: 86, offset: 18623},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 589, col: 90, offset: 18627},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 589, col: 93, offset: 18630},
+							label: "ex3",
+							expr: &ruleRefExpr{
+								pos:  position{line: 589, col: 97, offset: 18634},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 589, col: 108, offset: 18645},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 589, col: 111, offset: 18648},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ReplicateExpression",
+			pos:  position{line: 593, col: 1, offset: 18746},
+			expr: &actionExpr{
+				pos: position{line: 593, col: 24, offset: 18769},
+				run: (*parser).callonReplicateExpression1,
+				expr: &seqExpr{
+					pos: position{line: 593, col: 24, offset: 18769},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 593, col: 24, offset: 18769},
+							val:        "replicate",
+							ignoreCase: true,
+							want:       "\"REPLICATE\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 593, col: 37, offset: 18782},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 593, col: 40, offset: 18785},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 593, col: 44, offset: 18789},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 593, col: 47, offset: 18792},
+							label: "ex1",
+							expr: &ruleRefExpr{
+								pos:  position{line: 593, col: 51, offset: 18796},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 593, col: 62, offset: 18807},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 459, col: 38, offset: 13065},
-							val:        "(",
+							pos:        position{line: 593, col: 65, offset: 18810},
+							val:        ",",
 							ignoreCase: false,
-							want:       "\"(\"",
+							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 459, col: 42, offset: 13069},
+							pos:  position{line: 593, col: 69, offset: 18814},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 459, col: 45, offset: 13072},
-							label: "ex",
+							pos:   position{line: 593, col: 72, offset: 18817},
+							label: "ex2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 459, col: 48, offset: 13075},
+								pos:  position{line: 593, col: 76, offset: 18821},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 459, col: 59, offset: 13086},
+							pos:  position{line: 593, col: 87, offset: 18832},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 459, col: 62, offset: 13089},
+							pos:        position{line: 593, col: 90, offset: 18835},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2476,86 +3948,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ConcatExpression",
-			pos:  position{line: 463, col: 1, offset: 13177},
+			name: "ReverseExpression",
+			pos:  position{line: 597, col: 1, offset: 18930},
 			expr: &actionExpr{
-				pos: position{line: 463, col: 21, offset: 13197},
-				run: (*parser).callonConcatExpression1,
+				pos: position{line: 597, col: 22, offset: 18951},
+				run: (*parser).callonReverseExpression1,
 				expr: &seqExpr{
-					pos: position{line: 463, col: 21, offset: 13197},
+					pos: position{line: 597, col: 22, offset: 18951},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 463, col: 21, offset: 13197},
-							val:        "concat",
+							pos:        position{line: 597, col: 22, offset: 18951},
+							val:        "reverse",
 							ignoreCase: true,
-							want:       "\"CONCAT\"i",
+							want:       "\"REVERSE\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 463, col: 31, offset: 13207},
+							pos:  position{line: 597, col: 33, offset: 18962},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 463, col: 34, offset: 13210},
+							pos:        position{line: 597, col: 36, offset: 18965},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 463, col: 38, offset: 13214},
+							pos:  position{line: 597, col: 40, offset: 18969},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 463, col: 41, offset: 13217},
-							label: "ex1",
+							pos:   position{line: 597, col: 43, offset: 18972},
+							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 463, col: 45, offset: 13221},
+								pos:  position{line: 597, col: 46, offset: 18975},
 								name: "SelectItem",
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 463, col: 56, offset: 13232},
-							label: "others",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 463, col: 63, offset: 13239},
-								expr: &actionExpr{
-									pos: position{line: 463, col: 64, offset: 13240},
-									run: (*parser).callonConcatExpression11,
-									expr: &seqExpr{
-										pos: position{line: 463, col: 64, offset: 13240},
-										exprs: []any{
-											&ruleRefExpr{
-												pos:  position{line: 463, col: 64, offset: 13240},
-												name: "ws",
-											},
-											&litMatcher{
-												pos:        position{line: 463, col: 67, offset: 13243},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 463, col: 71, offset: 13247},
-												name: "ws",
-											},
-											&labeledExpr{
-												pos:   position{line: 463, col: 74, offset: 13250},
-												label: "ex",
-												expr: &ruleRefExpr{
-													pos:  position{line: 463, col: 77, offset: 13253},
-													name: "SelectItem",
-												},
-											},
-										},
-									},
-								},
-							},
-						},
 						&ruleRefExpr{
-							pos:  position{line: 463, col: 109, offset: 13285},
+							pos:  position{line: 597, col: 57, offset: 18986},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 463, col: 112, offset: 13288},
+							pos:        position{line: 597, col: 60, offset: 18989},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2565,70 +3999,70 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "LeftExpression",
-			pos:  position{line: 468, col: 1, offset: 13437},
+			name: "RightExpression",
+			pos:  position{line: 601, col: 1, offset: 19076},
 			expr: &actionExpr{
-				pos: position{line: 468, col: 19, offset: 13455},
-				run: (*parser).callonLeftExpression1,
+				pos: position{line: 601, col: 20, offset: 19095},
+				run: (*parser).callonRightExpression1,
 				expr: &seqExpr{
-					pos: position{line: 468, col: 19, offset: 13455},
+					pos: position{line: 601, col: 20, offset: 19095},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 468, col: 19, offset: 13455},
-							val:        "left",
+							pos:        position{line: 601, col: 20, offset: 19095},
+							val:        "right",
 							ignoreCase: true,
-							want:       "\"LEFT\"i",
+							want:       "\"RIGHT\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 468, col: 27, offset: 13463},
+							pos:  position{line: 601, col: 29, offset: 19104},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 468, col: 30, offset: 13466},
+							pos:        position{line: 601, col: 32, offset: 19107},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 468, col: 34, offset: 13470},
+							pos:  position{line: 601, col: 36, offset: 19111},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 468, col: 37, offset: 13473},
+							pos:   position{line: 601, col: 39, offset: 19114},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 468, col: 40, offset: 13476},
+								pos:  position{line: 601, col: 42, offset: 19117},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 468, col: 51, offset: 13487},
+							pos:  position{line: 601, col: 53, offset: 19128},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 468, col: 54, offset: 13490},
+							pos:        position{line: 601, col: 56, offset: 19131},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 468, col: 58, offset: 13494},
+							pos:  position{line: 601, col: 60, offset: 19135},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 468, col: 61, offset: 13497},
+							pos:   position{line: 601, col: 63, offset: 19138},
 							label: "length",
 							expr: &ruleRefExpr{
-								pos:  position{line: 468, col: 68, offset: 13504},
+								pos:  position{line: 601, col: 70, offset: 19145},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 468, col: 79, offset: 13515},
+							pos:  position{line: 601, col: 81, offset: 19156},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 468, col: 82, offset: 13518},
+							pos:        position{line: 601, col: 84, offset: 19159},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2638,48 +4072,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "LengthExpression",
-			pos:  position{line: 472, col: 1, offset: 13610},
+			name: "RTrimExpression",
+			pos:  position{line: 605, col: 1, offset: 19252},
 			expr: &actionExpr{
-				pos: position{line: 472, col: 21, offset: 13630},
-				run: (*parser).callonLengthExpression1,
+				pos: position{line: 605, col: 20, offset: 19271},
+				run: (*parser).callonRTrimExpression1,
 				expr: &seqExpr{
-					pos: position{line: 472, col: 21, offset: 13630},
+					pos: position{line: 605, col: 20, offset: 19271},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 472, col: 21, offset: 13630},
-							val:        "length",
+							pos:        position{line: 605, col: 20, offset: 19271},
+							val:        "rtrim",
 							ignoreCase: true,
-							want:       "\"LENGTH\"i",
+							want:       "\"RTRIM\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 472, col: 31, offset: 13640},
+							pos:  position{line: 605, col: 29, offset: 19280},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 472, col: 34, offset: 13643},
+							pos:        position{line: 605, col: 32, offset: 19283},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 472, col: 38, offset: 13647},
+							pos:  position{line: 605, col: 36, offset: 19287},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 472, col: 41, offset: 13650},
+							pos:   position{line: 605, col: 39, offset: 19290},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 472, col: 44, offset: 13653},
+								pos:  position{line: 605, col: 42, offset: 19293},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 472, col: 55, offset: 13664},
+							pos:  position{line: 605, col: 53, offset: 19304},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 472, col: 58, offset: 13667},
+							pos:        position{line: 605, col: 56, offset: 19307},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2689,48 +4123,92 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "LTrimExpression",
-			pos:  position{line: 476, col: 1, offset: 13753},
+			name: "SubstringExpression",
+			pos:  position{line: 609, col: 1, offset: 19392},
 			expr: &actionExpr{
-				pos: position{line: 476, col: 20, offset: 13772},
-				run: (*parser).callonLTrimExpression1,
+				pos: position{line: 609, col: 24, offset: 19415},
+				run: (*parser).callonSubstringExpression1,
 				expr: &seqExpr{
-					pos: position{line: 476, col: 20, offset: 13772},
+					pos: position{line: 609, col: 24, offset: 19415},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 476, col: 20, offset: 13772},
-							val:        "ltrim",
+							pos:        position{line: 609, col: 24, offset: 19415},
+							val:        "substring",
 							ignoreCase: true,
-							want:       "\"LTRIM\"i",
+							want:       "\"SUBSTRING\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 476, col: 29, offset: 13781},
+							pos:  position{line: 609, col: 37, offset: 19428},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 476, col: 32, offset: 13784},
+							pos:        position{line: 609, col: 40, offset: 19431},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 476, col: 36, offset: 13788},
+							pos:  position{line: 609, col: 44, offset: 19435},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 476, col: 39, offset: 13791},
+							pos:   position{line: 609, col: 47, offset: 19438},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 476, col: 42, offset: 13794},
+								pos:  position{line: 609, col: 50, offset: 19441},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 609, col: 61, offset: 19452},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 609, col: 64, offset: 19455},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 609, col: 68, offset: 19459},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 609, col: 71, offset: 19462},
+							label: "startPos",
+							expr: &ruleRefExpr{
+								pos:  position{line: 609, col: 80, offset: 19471},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 609, col: 91, offset: 19482},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 609, col: 94, offset: 19485},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 609, col: 98, offset: 19489},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 609, col: 101, offset: 19492},
+							label: "length",
+							expr: &ruleRefExpr{
+								pos:  position{line: 609, col: 108, offset: 19499},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 476, col: 53, offset: 13805},
+							pos:  position{line: 609, col: 119, offset: 19510},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 476, col: 56, offset: 13808},
+							pos:        position{line: 609, col: 122, offset: 19513},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2740,92 +4218,159 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ReplaceExpression",
-			pos:  position{line: 480, col: 1, offset: 13893},
+			name: "TrimExpression",
+			pos:  position{line: 613, col: 1, offset: 19620},
 			expr: &actionExpr{
-				pos: position{line: 480, col: 22, offset: 13914},
-				run: (*parser).callonReplaceExpression1,
+				pos: position{line: 613, col: 19, offset: 19638},
+				run: (*parser).callonTrimExpression1,
 				expr: &seqExpr{
-					pos: position{line: 480, col: 22, offset: 13914},
+					pos: position{line: 613, col: 19, offset: 19638},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 480, col: 22, offset: 13914},
-							val:        "replace",
+							pos:        position{line: 613, col: 19, offset: 19638},
+							val:        "trim",
 							ignoreCase: true,
-							want:       "\"REPLACE\"i",
+							want:       "\"TRIM\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 480, col: 33, offset: 13925},
+							pos:  position{line: 613, col: 27, offset: 19646},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 480, col: 36, offset: 13928},
+							pos:        position{line: 613, col: 30, offset: 19649},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 480, col: 40, offset: 13932},
+							pos:  position{line: 613, col: 34, offset: 19653},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 480, col: 43, offset: 13935},
-							label: "ex1",
+							pos:   position{line: 613, col: 37, offset: 19656},
+							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 480, col: 47, offset: 13939},
+								pos:  position{line: 613, col: 40, offset: 19659},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 480, col: 58, offset: 13950},
+							pos:  position{line: 613, col: 51, offset: 19670},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 480, col: 61, offset: 13953},
-							val:        ",",
+							pos:        position{line: 613, col: 54, offset: 19673},
+							val:        ")",
 							ignoreCase: false,
-							want:       "\",\"",
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "RegexMatchExpression",
+			pos:  position{line: 617, col: 1, offset: 19757},
+			expr: &actionExpr{
+				pos: position{line: 617, col: 25, offset: 19781},
+				run: (*parser).callonRegexMatchExpression1,
+				expr: &seqExpr{
+					pos: position{line: 617, col: 25, offset: 19781},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 617, col: 25, offset: 19781},
+							val:        "regexmatch",
+							ignoreCase: true,
+							want:       "\"RegexMatch\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 617, col: 39, offset: 19795},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 617, col: 42, offset: 19798},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 480, col: 65, offset: 13957},
+							pos:  position{line: 617, col: 46, offset: 19802},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 480, col: 68, offset: 13960},
-							label: "ex2",
+							pos:   position{line: 617, col: 49, offset: 19805},
+							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 480, col: 72, offset: 13964},
+								pos:  position{line: 617, col: 52, offset: 19808},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 480, col: 83, offset: 13975},
+							pos:  position{line: 617, col: 63, offset: 19819},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 480, col: 86, offset: 13978},
+							pos:        position{line: 617, col: 66, offset: 19822},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 480, col: 90, offset: 13982},
+							pos:  position{line: 617, col: 70, offset: 19826},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 480, col: 93, offset: 13985},
-							label: "ex3",
+							pos:   position{line: 617, col: 73, offset: 19829},
+							label: "pattern",
 							expr: &ruleRefExpr{
-								pos:  position{line: 480, col: 97, offset: 13989},
+								pos:  position{line: 617, col: 81, offset: 19837},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 480, col: 108, offset: 14000},
+							pos:  position{line: 617, col: 92, offset: 19848},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 617, col: 95, offset: 19851},
+							label: "modifiers",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 617, col: 105, offset: 19861},
+								expr: &actionExpr{
+									pos: position{line: 617, col: 106, offset: 19862},
+									run: (*parser).callonRegexMatchExpression17,
+									expr: &seqExpr{
+										pos: position{line: 617, col: 106, offset: 19862},
+										exprs: []any{
+											&litMatcher{
+												pos:        position{line: 617, col: 106, offset: 19862},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 617, col: 110, offset: 19866},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 617, col: 113, offset: 19869},
+												label: "m",
+												expr: &ruleRefExpr{
+													pos:  position{line: 617, col: 115, offset: 19871},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 617, col: 146, offset: 19902},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 480, col: 111, offset: 14003},
+							pos:        position{line: 617, col: 149, offset: 19905},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2835,70 +4380,110 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ReplicateExpression",
-			pos:  position{line: 484, col: 1, offset: 14101},
+			name: "ThreeArgumentStringFunctionExpression",
+			pos:  position{line: 621, col: 1, offset: 20015},
 			expr: &actionExpr{
-				pos: position{line: 484, col: 24, offset: 14124},
-				run: (*parser).callonReplicateExpression1,
+				pos: position{line: 621, col: 42, offset: 20056},
+				run: (*parser).callonThreeArgumentStringFunctionExpression1,
 				expr: &seqExpr{
-					pos: position{line: 484, col: 24, offset: 14124},
+					pos: position{line: 621, col: 42, offset: 20056},
 					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 484, col: 24, offset: 14124},
-							val:        "replicate",
-							ignoreCase: true,
-							want:       "\"REPLICATE\"i",
+						&labeledExpr{
+							pos:   position{line: 621, col: 42, offset: 20056},
+							label: "function",
+							expr: &ruleRefExpr{
+								pos:  position{line: 621, col: 51, offset: 20065},
+								name: "ThreeArgumentStringFunction",
+							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 484, col: 37, offset: 14137},
+							pos:  position{line: 621, col: 79, offset: 20093},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 484, col: 40, offset: 14140},
+							pos:        position{line: 621, col: 82, offset: 20096},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 484, col: 44, offset: 14144},
+							pos:  position{line: 621, col: 86, offset: 20100},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 484, col: 47, offset: 14147},
+							pos:   position{line: 621, col: 89, offset: 20103},
 							label: "ex1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 484, col: 51, offset: 14151},
+								pos:  position{line: 621, col: 93, offset: 20107},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 484, col: 62, offset: 14162},
+							pos:  position{line: 621, col: 104, offset: 20118},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 484, col: 65, offset: 14165},
+							pos:        position{line: 621, col: 107, offset: 20121},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 484, col: 69, offset: 14169},
+							pos:  position{line: 621, col: 111, offset: 20125},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 484, col: 72, offset: 14172},
+							pos:   position{line: 621, col: 114, offset: 20128},
 							label: "ex2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 484, col: 76, offset: 14176},
+								pos:  position{line: 621, col: 118, offset: 20132},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 484, col: 87, offset: 14187},
+							pos:  position{line: 621, col: 129, offset: 20143},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 621, col: 132, offset: 20146},
+							label: "ignoreCase",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 621, col: 143, offset: 20157},
+								expr: &actionExpr{
+									pos: position{line: 621, col: 144, offset: 20158},
+									run: (*parser).callonThreeArgumentStringFunctionExpression18,
+									expr: &seqExpr{
+										pos: position{line: 621, col: 144, offset: 20158},
+										exprs: []any{
+											&litMatcher{
+												pos:        position{line: 621, col: 144, offset: 20158},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 621, col: 148, offset: 20162},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 621, col: 151, offset: 20165},
+												label: "boolean",
+												expr: &ruleRefExpr{
+													pos:  position{line: 621, col: 159, offset: 20173},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 621, col: 196, offset: 20210},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 484, col: 90, offset: 14190},
+							pos:        position{line: 621, col: 199, offset: 20213},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2908,121 +4493,85 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ReverseExpression",
-			pos:  position{line: 488, col: 1, offset: 14285},
+			name: "ThreeArgumentStringFunction",
+			pos:  position{line: 639, col: 1, offset: 20735},
 			expr: &actionExpr{
-				pos: position{line: 488, col: 22, offset: 14306},
-				run: (*parser).callonReverseExpression1,
-				expr: &seqExpr{
-					pos: position{line: 488, col: 22, offset: 14306},
-					exprs: []any{
+				pos: position{line: 639, col: 32, offset: 20766},
+				run: (*parser).callonThreeArgumentStringFunction1,
+				expr: &choiceExpr{
+					pos: position{line: 639, col: 33, offset: 20767},
+					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 488, col: 22, offset: 14306},
-							val:        "reverse",
+							pos:        position{line: 639, col: 33, offset: 20767},
+							val:        "contains",
 							ignoreCase: true,
-							want:       "\"REVERSE\"i",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 488, col: 33, offset: 14317},
-							name: "ws",
+							want:       "\"CONTAINS\"i",
 						},
 						&litMatcher{
-							pos:        position{line: 488, col: 36, offset: 14320},
-							val:        "(",
-							ignoreCase: false,
-							want:       "\"(\"",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 488, col: 40, offset: 14324},
-							name: "ws",
-						},
-						&labeledExpr{
-							pos:   position{line: 488, col: 43, offset: 14327},
-							label: "ex",
-							expr: &ruleRefExpr{
-								pos:  position{line: 488, col: 46, offset: 14330},
-								name: "SelectItem",
-							},
+							pos:        position{line: 639, col: 47, offset: 20781},
+							val:        "endswith",
+							ignoreCase: true,
+							want:       "\"ENDSWITH\"i",
 						},
-						&ruleRefExpr{
-							pos:  position{line: 488, col: 57, offset: 14341},
-							name: "ws",
+						&litMatcher{
+							pos:        position{line: 639, col: 61, offset: 20795},
+							val:        "startswith",
+							ignoreCase: true,
+							want:       "\"STARTSWITH\"i",
 						},
 						&litMatcher{
-							pos:        position{line: 488, col: 60, offset: 14344},
-							val:        ")",
-							ignoreCase: false,
-							want:       "\")\"",
+							pos:        position{line: 639, col: 77, offset: 20811},
+							val:        "index_of",
+							ignoreCase: true,
+							want:       "\"INDEX_OF\"i",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "RightExpression",
-			pos:  position{line: 492, col: 1, offset: 14431},
+			name: "IsDefined",
+			pos:  position{line: 643, col: 1, offset: 20860},
 			expr: &actionExpr{
-				pos: position{line: 492, col: 20, offset: 14450},
-				run: (*parser).callonRightExpression1,
+				pos: position{line: 643, col: 14, offset: 20873},
+				run: (*parser).callonIsDefined1,
 				expr: &seqExpr{
-					pos: position{line: 492, col: 20, offset: 14450},
+					pos: position{line: 643, col: 14, offset: 20873},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 492, col: 20, offset: 14450},
-							val:        "right",
+							pos:        position{line: 643, col: 14, offset: 20873},
+							val:        "is_defined",
 							ignoreCase: true,
-							want:       "\"RIGHT\"i",
+							want:       "\"IS_DEFINED\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 492, col: 29, offset: 14459},
+							pos:  position{line: 643, col: 28, offset: 20887},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 492, col: 32, offset: 14462},
+							pos:        position{line: 643, col: 31, offset: 20890},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 492, col: 36, offset: 14466},
+							pos:  position{line: 643, col: 35, offset: 20894},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 492, col: 39, offset: 14469},
+							pos:   position{line: 643, col: 38, offset: 20897},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 492, col: 42, offset: 14472},
-								name: "SelectItem",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 492, col: 53, offset: 14483},
-							name: "ws",
-						},
-						&litMatcher{
-							pos:        position{line: 492, col: 56, offset: 14486},
-							val:        ",",
-							ignoreCase: false,
-							want:       "\",\"",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 492, col: 60, offset: 14490},
-							name: "ws",
-						},
-						&labeledExpr{
-							pos:   position{line: 492, col: 63, offset: 14493},
-							label: "length",
-							expr: &ruleRefExpr{
-								pos:  position{line: 492, col: 70, offset: 14500},
+								pos:  position{line: 643, col: 41, offset: 20900},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 492, col: 81, offset: 14511},
+							pos:  position{line: 643, col: 52, offset: 20911},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 492, col: 84, offset: 14514},
+							pos:        position{line: 643, col: 55, offset: 20914},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3032,48 +4581,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "RTrimExpression",
-			pos:  position{line: 496, col: 1, offset: 14607},
+			name: "IsArray",
+			pos:  position{line: 647, col: 1, offset: 21003},
 			expr: &actionExpr{
-				pos: position{line: 496, col: 20, offset: 14626},
-				run: (*parser).callonRTrimExpression1,
+				pos: position{line: 647, col: 12, offset: 21014},
+				run: (*parser).callonIsArray1,
 				expr: &seqExpr{
-					pos: position{line: 496, col: 20, offset: 14626},
+					pos: position{line: 647, col: 12, offset: 21014},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 496, col: 20, offset: 14626},
-							val:        "rtrim",
+							pos:        position{line: 647, col: 12, offset: 21014},
+							val:        "is_array",
 							ignoreCase: true,
-							want:       "\"RTRIM\"i",
+							want:       "\"IS_ARRAY\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 496, col: 29, offset: 14635},
+							pos:  position{line: 647, col: 24, offset: 21026},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 496, col: 32, offset: 14638},
+							pos:        position{line: 647, col: 27, offset: 21029},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 496, col: 36, offset: 14642},
+							pos:  position{line: 647, col: 31, offset: 21033},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 496, col: 39, offset: 14645},
+							pos:   position{line: 647, col: 34, offset: 21036},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 496, col: 42, offset: 14648},
+								pos:  position{line: 647, col: 37, offset: 21039},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 496, col: 53, offset: 14659},
+							pos:  position{line: 647, col: 48, offset: 21050},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 496, col: 56, offset: 14662},
+							pos:        position{line: 647, col: 51, offset: 21053},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3083,92 +4632,99 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SubstringExpression",
-			pos:  position{line: 500, col: 1, offset: 14747},
+			name: "IsBool",
+			pos:  position{line: 651, col: 1, offset: 21140},
 			expr: &actionExpr{
-				pos: position{line: 500, col: 24, offset: 14770},
-				run: (*parser).callonSubstringExpression1,
+				pos: position{line: 651, col: 11, offset: 21150},
+				run: (*parser).callonIsBool1,
 				expr: &seqExpr{
-					pos: position{line: 500, col: 24, offset: 14770},
+					pos: position{line: 651, col: 11, offset: 21150},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 500, col: 24, offset: 14770},
-							val:        "substring",
+							pos:        position{line: 651, col: 11, offset: 21150},
+							val:        "is_bool",
 							ignoreCase: true,
-							want:       "\"SUBSTRING\"i",
+							want:       "\"IS_BOOL\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 500, col: 37, offset: 14783},
+							pos:  position{line: 651, col: 22, offset: 21161},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 500, col: 40, offset: 14786},
+							pos:        position{line: 651, col: 25, offset: 21164},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 500, col: 44, offset: 14790},
+							pos:  position{line: 651, col: 29, offset: 21168},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 500, col: 47, offset: 14793},
+							pos:   position{line: 651, col: 32, offset: 21171},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 500, col: 50, offset: 14796},
+								pos:  position{line: 651, col: 35, offset: 21174},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 500, col: 61, offset: 14807},
+							pos:  position{line: 651, col: 46, offset: 21185},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 500, col: 64, offset: 14810},
-							val:        ",",
+							pos:        position{line: 651, col: 49, offset: 21188},
+							val:        ")",
 							ignoreCase: false,
-							want:       "\",\"",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 500, col: 68, offset: 14814},
-							name: "ws",
+							want:       "\")\"",
 						},
-						&labeledExpr{
-							pos:   position{line: 500, col: 71, offset: 14817},
-							label: "startPos",
-							expr: &ruleRefExpr{
-								pos:  position{line: 500, col: 80, offset: 14826},
-								name: "SelectItem",
-							},
+					},
+				},
+			},
+		},
+		{
+			name: "IsFiniteNumber",
+			pos:  position{line: 655, col: 1, offset: 21274},
+			expr: &actionExpr{
+				pos: position{line: 655, col: 19, offset: 21292},
+				run: (*parser).callonIsFiniteNumber1,
+				expr: &seqExpr{
+					pos: position{line: 655, col: 19, offset: 21292},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 655, col: 19, offset: 21292},
+							val:        "is_finite_number",
+							ignoreCase: true,
+							want:       "\"IS_FINITE_NUMBER\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 500, col: 91, offset: 14837},
+							pos:  position{line: 655, col: 39, offset: 21312},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 500, col: 94, offset: 14840},
-							val:        ",",
+							pos:        position{line: 655, col: 42, offset: 21315},
+							val:        "(",
 							ignoreCase: false,
-							want:       "\",\"",
+							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 500, col: 98, offset: 14844},
+							pos:  position{line: 655, col: 46, offset: 21319},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 500, col: 101, offset: 14847},
-							label: "length",
+							pos:   position{line: 655, col: 49, offset: 21322},
+							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 500, col: 108, offset: 14854},
+								pos:  position{line: 655, col: 52, offset: 21325},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 500, col: 119, offset: 14865},
+							pos:  position{line: 655, col: 63, offset: 21336},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 500, col: 122, offset: 14868},
+							pos:        position{line: 655, col: 66, offset: 21339},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3178,48 +4734,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "TrimExpression",
-			pos:  position{line: 504, col: 1, offset: 14975},
+			name: "IsInteger",
+			pos:  position{line: 659, col: 1, offset: 21433},
 			expr: &actionExpr{
-				pos: position{line: 504, col: 19, offset: 14993},
-				run: (*parser).callonTrimExpression1,
+				pos: position{line: 659, col: 14, offset: 21446},
+				run: (*parser).callonIsInteger1,
 				expr: &seqExpr{
-					pos: position{line: 504, col: 19, offset: 14993},
+					pos: position{line: 659, col: 14, offset: 21446},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 504, col: 19, offset: 14993},
-							val:        "trim",
+							pos:        position{line: 659, col: 14, offset: 21446},
+							val:        "is_integer",
 							ignoreCase: true,
-							want:       "\"TRIM\"i",
+							want:       "\"IS_INTEGER\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 504, col: 27, offset: 15001},
+							pos:  position{line: 659, col: 28, offset: 21460},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 504, col: 30, offset: 15004},
+							pos:        position{line: 659, col: 31, offset: 21463},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 504, col: 34, offset: 15008},
+							pos:  position{line: 659, col: 35, offset: 21467},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 504, col: 37, offset: 15011},
+							pos:   position{line: 659, col: 38, offset: 21470},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 504, col: 40, offset: 15014},
+								pos:  position{line: 659, col: 41, offset: 21473},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 504, col: 51, offset: 15025},
+							pos:  position{line: 659, col: 52, offset: 21484},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 504, col: 54, offset: 15028},
+							pos:        position{line: 659, col: 55, offset: 21487},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3229,106 +4785,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ThreeArgumentStringFunctionExpression",
-			pos:  position{line: 508, col: 1, offset: 15112},
+			name: "IsNull",
+			pos:  position{line: 663, col: 1, offset: 21576},
 			expr: &actionExpr{
-				pos: position{line: 508, col: 42, offset: 15153},
-				run: (*parser).callonThreeArgumentStringFunctionExpression1,
+				pos: position{line: 663, col: 11, offset: 21586},
+				run: (*parser).callonIsNull1,
 				expr: &seqExpr{
-					pos: position{line: 508, col: 42, offset: 15153},
+					pos: position{line: 663, col: 11, offset: 21586},
 					exprs: []any{
-						&labeledExpr{
-							pos:   position{line: 508, col: 42, offset: 15153},
-							label: "function",
-							expr: &ruleRefExpr{
-								pos:  position{line: 508, col: 51, offset: 15162},
-								name: "ThreeArgumentStringFunction",
-							},
+						&litMatcher{
+							pos:        position{line: 663, col: 11, offset: 21586},
+							val:        "is_null",
+							ignoreCase: true,
+							want:       "\"IS_NULL\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 508, col: 79, offset: 15190},
+							pos:  position{line: 663, col: 22, offset: 21597},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 508, col: 82, offset: 15193},
+							pos:        position{line: 663, col: 25, offset: 21600},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 508, col: 86, offset: 15197},
-							name: "ws",
-						},
-						&labeledExpr{
-							pos:   position{line: 508, col: 89, offset: 15200},
-							label: "ex1",
-							expr: &ruleRefExpr{
-								pos:  position{line: 508, col: 93, offset: 15204},
-								name: "SelectItem",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 508, col: 104, offset: 15215},
-							name: "ws",
-						},
-						&litMatcher{
-							pos:        position{line: 508, col: 107, offset: 15218},
-							val:        ",",
-							ignoreCase: false,
-							want:       "\",\"",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 508, col: 111, offset: 15222},
+							pos:  position{line: 663, col: 29, offset: 21604},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 508, col: 114, offset: 15225},
-							label: "ex2",
+							pos:   position{line: 663, col: 32, offset: 21607},
+							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 508, col: 118, offset: 15229},
+								pos:  position{line: 663, col: 35, offset: 21610},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 508, col: 129, offset: 15240},
+							pos:  position{line: 663, col: 46, offset: 21621},
 							name: "ws",
 						},
-						&labeledExpr{
-							pos:   position{line: 508, col: 132, offset: 15243},
-							label: "ignoreCase",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 508, col: 143, offset: 15254},
-								expr: &actionExpr{
-									pos: position{line: 508, col: 144, offset: 15255},
-									run: (*parser).callonThreeArgumentStringFunctionExpression18,
-									expr: &seqExpr{
-										pos: position{line: 508, col: 144, offset: 15255},
-										exprs: []any{
-											&litMatcher{
-												pos:        position{line: 508, col: 144, offset: 15255},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 508, col: 148, offset: 15259},
-												name: "ws",
-											},
-											&labeledExpr{
-												pos:   position{line: 508, col: 151, offset: 15262},
-												label: "boolean",
-												expr: &ruleRefExpr{
-													pos:  position{line: 508, col: 159, offset: 15270},
-													name: "SelectItem",
-												},
-											},
-										},
-									},
-								},
-							},
-						},
 						&litMatcher{
-							pos:        position{line: 508, col: 196, offset: 15307},
+							pos:        position{line: 663, col: 49, offset: 21624},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3338,85 +4836,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ThreeArgumentStringFunction",
-			pos:  position{line: 526, col: 1, offset: 15829},
-			expr: &actionExpr{
-				pos: position{line: 526, col: 32, offset: 15860},
-				run: (*parser).callonThreeArgumentStringFunction1,
-				expr: &choiceExpr{
-					pos: position{line: 526, col: 33, offset: 15861},
-					alternatives: []any{
-						&litMatcher{
-							pos:        position{line: 526, col: 33, offset: 15861},
-							val:        "contains",
-							ignoreCase: true,
-							want:       "\"CONTAINS\"i",
-						},
-						&litMatcher{
-							pos:        position{line: 526, col: 47, offset: 15875},
-							val:        "endswith",
-							ignoreCase: true,
-							want:       "\"ENDSWITH\"i",
-						},
-						&litMatcher{
-							pos:        position{line: 526, col: 61, offset: 15889},
-							val:        "startswith",
-							ignoreCase: true,
-							want:       "\"STARTSWITH\"i",
-						},
-						&litMatcher{
-							pos:        position{line: 526, col: 77, offset: 15905},
-							val:        "index_of",
-							ignoreCase: true,
-							want:       "\"INDEX_OF\"i",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "IsDefined",
-			pos:  position{line: 530, col: 1, offset: 15954},
+			name: "IsNumber",
+			pos:  position{line: 667, col: 1, offset: 21710},
 			expr: &actionExpr{
-				pos: position{line: 530, col: 14, offset: 15967},
-				run: (*parser).callonIsDefined1,
+				pos: position{line: 667, col: 13, offset: 21722},
+				run: (*parser).callonIsNumber1,
 				expr: &seqExpr{
-					pos: position{line: 530, col: 14, offset: 15967},
+					pos: position{line: 667, col: 13, offset: 21722},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 530, col: 14, offset: 15967},
-							val:        "is_defined",
+							pos:        position{line: 667, col: 13, offset: 21722},
+							val:        "is_number",
 							ignoreCase: true,
-							want:       "\"IS_DEFINED\"i",
+							want:       "\"IS_NUMBER\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 530, col: 28, offset: 15981},
+							pos:  position{line: 667, col: 26, offset: 21735},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 530, col: 31, offset: 15984},
+							pos:        position{line: 667, col: 29, offset: 21738},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 530, col: 35, offset: 15988},
+							pos:  position{line: 667, col: 33, offset: 21742},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 530, col: 38, offset: 15991},
+							pos:   position{line: 667, col: 36, offset: 21745},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 530, col: 41, offset: 15994},
+								pos:  position{line: 667, col: 39, offset: 21748},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 530, col: 52, offset: 16005},
+							pos:  position{line: 667, col: 50, offset: 21759},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 530, col: 55, offset: 16008},
+							pos:        position{line: 667, col: 53, offset: 21762},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3426,48 +4887,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IsArray",
-			pos:  position{line: 534, col: 1, offset: 16097},
+			name: "IsObject",
+			pos:  position{line: 671, col: 1, offset: 21850},
 			expr: &actionExpr{
-				pos: position{line: 534, col: 12, offset: 16108},
-				run: (*parser).callonIsArray1,
+				pos: position{line: 671, col: 13, offset: 21862},
+				run: (*parser).callonIsObject1,
 				expr: &seqExpr{
-					pos: position{line: 534, col: 12, offset: 16108},
+					pos: position{line: 671, col: 13, offset: 21862},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 534, col: 12, offset: 16108},
-							val:        "is_array",
+							pos:        position{line: 671, col: 13, offset: 21862},
+							val:        "is_object",
 							ignoreCase: true,
-							want:       "\"IS_ARRAY\"i",
+							want:       "\"IS_OBJECT\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 534, col: 24, offset: 16120},
+							pos:  position{line: 671, col: 26, offset: 21875},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 534, col: 27, offset: 16123},
+							pos:        position{line: 671, col: 29, offset: 21878},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 534, col: 31, offset: 16127},
+							pos:  position{line: 671, col: 33, offset: 21882},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 534, col: 34, offset: 16130},
+							pos:   position{line: 671, col: 36, offset: 21885},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 534, col: 37, offset: 16133},
+								pos:  position{line: 671, col: 39, offset: 21888},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 534, col: 48, offset: 16144},
+							pos:  position{line: 671, col: 50, offset: 21899},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 534, col: 51, offset: 16147},
+							pos:        position{line: 671, col: 53, offset: 21902},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3477,48 +4938,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IsBool",
-			pos:  position{line: 538, col: 1, offset: 16234},
+			name: "IsPrimitive",
+			pos:  position{line: 675, col: 1, offset: 21990},
 			expr: &actionExpr{
-				pos: position{line: 538, col: 11, offset: 16244},
-				run: (*parser).callonIsBool1,
+				pos: position{line: 675, col: 16, offset: 22005},
+				run: (*parser).callonIsPrimitive1,
 				expr: &seqExpr{
-					pos: position{line: 538, col: 11, offset: 16244},
+					pos: position{line: 675, col: 16, offset: 22005},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 538, col: 11, offset: 16244},
-							val:        "is_bool",
+							pos:        position{line: 675, col: 16, offset: 22005},
+							val:        "is_primitive",
 							ignoreCase: true,
-							want:       "\"IS_BOOL\"i",
+							want:       "\"IS_PRIMITIVE\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 538, col: 22, offset: 16255},
+							pos:  position{line: 675, col: 32, offset: 22021},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 538, col: 25, offset: 16258},
+							pos:        position{line: 675, col: 35, offset: 22024},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 538, col: 29, offset: 16262},
+							pos:  position{line: 675, col: 39, offset: 22028},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 538, col: 32, offset: 16265},
+							pos:   position{line: 675, col: 42, offset: 22031},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 538, col: 35, offset: 16268},
+								pos:  position{line: 675, col: 45, offset: 22034},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 538, col: 46, offset: 16279},
+							pos:  position{line: 675, col: 56, offset: 22045},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 538, col: 49, offset: 16282},
+							pos:        position{line: 675, col: 59, offset: 22048},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3528,48 +4989,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IsFiniteNumber",
-			pos:  position{line: 542, col: 1, offset: 16368},
+			name: "IsString",
+			pos:  position{line: 679, col: 1, offset: 22139},
 			expr: &actionExpr{
-				pos: position{line: 542, col: 19, offset: 16386},
-				run: (*parser).callonIsFiniteNumber1,
+				pos: position{line: 679, col: 13, offset: 22151},
+				run: (*parser).callonIsString1,
 				expr: &seqExpr{
-					pos: position{line: 542, col: 19, offset: 16386},
+					pos: position{line: 679, col: 13, offset: 22151},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 542, col: 19, offset: 16386},
-							val:        "is_finite_number",
+							pos:        position{line: 679, col: 13, offset: 22151},
+							val:        "is_string",
 							ignoreCase: true,
-							want:       "\"IS_FINITE_NUMBER\"i",
+							want:       "\"IS_STRING\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 542, col: 39, offset: 16406},
+							pos:  position{line: 679, col: 26, offset: 22164},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 542, col: 42, offset: 16409},
+							pos:        position{line: 679, col: 29, offset: 22167},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 542, col: 46, offset: 16413},
+							pos:  position{line: 679, col: 33, offset: 22171},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 542, col: 49, offset: 16416},
+							pos:   position{line: 679, col: 36, offset: 22174},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 542, col: 52, offset: 16419},
+								pos:  position{line: 679, col: 39, offset: 22177},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 542, col: 63, offset: 16430},
+							pos:  position{line: 679, col: 50, offset: 22188},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 542, col: 66, offset: 16433},
+							pos:        position{line: 679, col: 53, offset: 22191},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3579,48 +5040,86 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IsInteger",
-			pos:  position{line: 546, col: 1, offset: 16527},
+			name: "ArrayConcatExpression",
+			pos:  position{line: 683, col: 1, offset: 22279},
 			expr: &actionExpr{
-				pos: position{line: 546, col: 14, offset: 16540},
-				run: (*parser).callonIsInteger1,
+				pos: position{line: 683, col: 26, offset: 22304},
+				run: (*parser).callonArrayConcatExpression1,
 				expr: &seqExpr{
-					pos: position{line: 546, col: 14, offset: 16540},
+					pos: position{line: 683, col: 26, offset: 22304},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 546, col: 14, offset: 16540},
-							val:        "is_integer",
+							pos:        position{line: 683, col: 26, offset: 22304},
+							val:        "array_concat",
 							ignoreCase: true,
-							want:       "\"IS_INTEGER\"i",
+							want:       "\"ARRAY_CONCAT\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 546, col: 28, offset: 16554},
+							pos:  position{line: 683, col: 42, offset: 22320},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 546, col: 31, offset: 16557},
+							pos:        position{line: 683, col: 45, offset: 22323},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 546, col: 35, offset: 16561},
+							pos:  position{line: 683, col: 49, offset: 22327},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 546, col: 38, offset: 16564},
-							label: "ex",
+							pos:   position{line: 683, col: 52, offset: 22330},
+							label: "arrays",
 							expr: &ruleRefExpr{
-								pos:  position{line: 546, col: 41, offset: 16567},
+								pos:  position{line: 683, col: 59, offset: 22337},
 								name: "SelectItem",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 683, col: 70, offset: 22348},
+							label: "others",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 683, col: 77, offset: 22355},
+								expr: &actionExpr{
+									pos: position{line: 683, col: 78, offset: 22356},
+									run: (*parser).callonArrayConcatExpression11,
+									expr: &seqExpr{
+										pos: position{line: 683, col: 78, offset: 22356},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 683, col: 78, offset: 22356},
+												name: "ws",
+											},
+											&litMatcher{
+												pos:        position{line: 683, col: 81, offset: 22359},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 683, col: 85, offset: 22363},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 683, col: 88, offset: 22366},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 683, col: 91, offset: 22369},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 546, col: 52, offset: 16578},
+							pos:  position{line: 683, col: 123, offset: 22401},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 546, col: 55, offset: 16581},
+							pos:        position{line: 683, col: 126, offset: 22404},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3630,48 +5129,108 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IsNull",
-			pos:  position{line: 550, col: 1, offset: 16670},
+			name: "ArrayContainsExpression",
+			pos:  position{line: 687, col: 1, offset: 22534},
 			expr: &actionExpr{
-				pos: position{line: 550, col: 11, offset: 16680},
-				run: (*parser).callonIsNull1,
+				pos: position{line: 687, col: 28, offset: 22561},
+				run: (*parser).callonArrayContainsExpression1,
 				expr: &seqExpr{
-					pos: position{line: 550, col: 11, offset: 16680},
+					pos: position{line: 687, col: 28, offset: 22561},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 550, col: 11, offset: 16680},
-							val:        "is_null",
+							pos:        position{line: 687, col: 28, offset: 22561},
+							val:        "array_contains",
 							ignoreCase: true,
-							want:       "\"IS_NULL\"i",
+							want:       "\"ARRAY_CONTAINS\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 550, col: 22, offset: 16691},
+							pos:  position{line: 687, col: 46, offset: 22579},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 550, col: 25, offset: 16694},
+							pos:        position{line: 687, col: 49, offset: 22582},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 550, col: 29, offset: 16698},
+							pos:  position{line: 687, col: 53, offset: 22586},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 687, col: 56, offset: 22589},
+							label: "array",
+							expr: &ruleRefExpr{
+								pos:  position{line: 687, col: 62, offset: 22595},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 687, col: 73, offset: 22606},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 687, col: 76, offset: 22609},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 687, col: 80, offset: 22613},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 550, col: 32, offset: 16701},
-							label: "ex",
+							pos:   position{line: 687, col: 83, offset: 22616},
+							label: "item",
 							expr: &ruleRefExpr{
-								pos:  position{line: 550, col: 35, offset: 16704},
+								pos:  position{line: 687, col: 88, offset: 22621},
 								name: "SelectItem",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 687, col: 99, offset: 22632},
+							label: "partialMatch",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 687, col: 112, offset: 22645},
+								expr: &actionExpr{
+									pos: position{line: 687, col: 113, offset: 22646},
+									run: (*parser).callonArrayContainsExpression16,
+									expr: &seqExpr{
+										pos: position{line: 687, col: 113, offset: 22646},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 687, col: 113, offset: 22646},
+												name: "ws",
+											},
+											&litMatcher{
+												pos:        position{line: 687, col: 116, offset: 22649},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 687, col: 120, offset: 22653},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 687, col: 123, offset: 22656},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 687, col: 126, offset: 22659},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 550, col: 46, offset: 16715},
+							pos:  position{line: 687, col: 158, offset: 22691},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 550, col: 49, offset: 16718},
+							pos:        position{line: 687, col: 161, offset: 22694},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3681,48 +5240,86 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IsNumber",
-			pos:  position{line: 554, col: 1, offset: 16804},
+			name: "ArrayContainsAnyExpression",
+			pos:  position{line: 691, col: 1, offset: 22810},
 			expr: &actionExpr{
-				pos: position{line: 554, col: 13, offset: 16816},
-				run: (*parser).callonIsNumber1,
+				pos: position{line: 691, col: 31, offset: 22840},
+				run: (*parser).callonArrayContainsAnyExpression1,
 				expr: &seqExpr{
-					pos: position{line: 554, col: 13, offset: 16816},
+					pos: position{line: 691, col: 31, offset: 22840},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 554, col: 13, offset: 16816},
-							val:        "is_number",
+							pos:        position{line: 691, col: 31, offset: 22840},
+							val:        "array_contains_any",
 							ignoreCase: true,
-							want:       "\"IS_NUMBER\"i",
+							want:       "\"ARRAY_CONTAINS_ANY\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 554, col: 26, offset: 16829},
+							pos:  position{line: 691, col: 53, offset: 22862},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 554, col: 29, offset: 16832},
+							pos:        position{line: 691, col: 56, offset: 22865},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 554, col: 33, offset: 16836},
+							pos:  position{line: 691, col: 60, offset: 22869},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 554, col: 36, offset: 16839},
-							label: "ex",
+							pos:   position{line: 691, col: 63, offset: 22872},
+							label: "array",
 							expr: &ruleRefExpr{
-								pos:  position{line: 554, col: 39, offset: 16842},
+								pos:  position{line: 691, col: 69, offset: 22878},
 								name: "SelectItem",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 691, col: 80, offset: 22889},
+							label: "items",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 691, col: 86, offset: 22895},
+								expr: &actionExpr{
+									pos: position{line: 691, col: 87, offset: 22896},
+									run: (*parser).callonArrayContainsAnyExpression11,
+									expr: &seqExpr{
+										pos: position{line: 691, col: 87, offset: 22896},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 691, col: 87, offset: 22896},
+												name: "ws",
+											},
+											&litMatcher{
+												pos:        position{line: 691, col: 90, offset: 22899},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 691, col: 94, offset: 22903},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 691, col: 97, offset: 22906},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 691, col: 100, offset: 22909},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 554, col: 50, offset: 16853},
+							pos:  position{line: 691, col: 132, offset: 22941},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 554, col: 53, offset: 16856},
+							pos:        position{line: 691, col: 135, offset: 22944},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3732,48 +5329,86 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IsObject",
-			pos:  position{line: 558, col: 1, offset: 16944},
+			name: "ArrayContainsAllExpression",
+			pos:  position{line: 695, col: 1, offset: 23077},
 			expr: &actionExpr{
-				pos: position{line: 558, col: 13, offset: 16956},
-				run: (*parser).callonIsObject1,
+				pos: position{line: 695, col: 31, offset: 23107},
+				run: (*parser).callonArrayContainsAllExpression1,
 				expr: &seqExpr{
-					pos: position{line: 558, col: 13, offset: 16956},
+					pos: position{line: 695, col: 31, offset: 23107},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 558, col: 13, offset: 16956},
-							val:        "is_object",
+							pos:        position{line: 695, col: 31, offset: 23107},
+							val:        "array_contains_all",
 							ignoreCase: true,
-							want:       "\"IS_OBJECT\"i",
+							want:       "\"ARRAY_CONTAINS_ALL\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 558, col: 26, offset: 16969},
+							pos:  position{line: 695, col: 53, offset: 23129},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 558, col: 29, offset: 16972},
+							pos:        position{line: 695, col: 56, offset: 23132},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 558, col: 33, offset: 16976},
+							pos:  position{line: 695, col: 60, offset: 23136},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 558, col: 36, offset: 16979},
-							label: "ex",
+							pos:   position{line: 695, col: 63, offset: 23139},
+							label: "array",
 							expr: &ruleRefExpr{
-								pos:  position{line: 558, col: 39, offset: 16982},
+								pos:  position{line: 695, col: 69, offset: 23145},
 								name: "SelectItem",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 695, col: 80, offset: 23156},
+							label: "items",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 695, col: 86, offset: 23162},
+								expr: &actionExpr{
+									pos: position{line: 695, col: 87, offset: 23163},
+									run: (*parser).callonArrayContainsAllExpression11,
+									expr: &seqExpr{
+										pos: position{line: 695, col: 87, offset: 23163},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 695, col: 87, offset: 23163},
+												name: "ws",
+											},
+											&litMatcher{
+												pos:        position{line: 695, col: 90, offset: 23166},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 695, col: 94, offset: 23170},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 695, col: 97, offset: 23173},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 695, col: 100, offset: 23176},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 558, col: 50, offset: 16993},
+							pos:  position{line: 695, col: 132, offset: 23208},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 558, col: 53, offset: 16996},
+							pos:        position{line: 695, col: 135, offset: 23211},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3783,48 +5418,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IsPrimitive",
-			pos:  position{line: 562, col: 1, offset: 17084},
+			name: "ArrayLengthExpression",
+			pos:  position{line: 699, col: 1, offset: 23344},
 			expr: &actionExpr{
-				pos: position{line: 562, col: 16, offset: 17099},
-				run: (*parser).callonIsPrimitive1,
+				pos: position{line: 699, col: 26, offset: 23369},
+				run: (*parser).callonArrayLengthExpression1,
 				expr: &seqExpr{
-					pos: position{line: 562, col: 16, offset: 17099},
+					pos: position{line: 699, col: 26, offset: 23369},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 562, col: 16, offset: 17099},
-							val:        "is_primitive",
+							pos:        position{line: 699, col: 26, offset: 23369},
+							val:        "array_length",
 							ignoreCase: true,
-							want:       "\"IS_PRIMITIVE\"i",
+							want:       "\"ARRAY_LENGTH\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 562, col: 32, offset: 17115},
+							pos:  position{line: 699, col: 42, offset: 23385},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 562, col: 35, offset: 17118},
+							pos:        position{line: 699, col: 45, offset: 23388},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 562, col: 39, offset: 17122},
+							pos:  position{line: 699, col: 49, offset: 23392},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 562, col: 42, offset: 17125},
-							label: "ex",
+							pos:   position{line: 699, col: 52, offset: 23395},
+							label: "array",
 							expr: &ruleRefExpr{
-								pos:  position{line: 562, col: 45, offset: 17128},
+								pos:  position{line: 699, col: 58, offset: 23401},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 562, col: 56, offset: 17139},
+							pos:  position{line: 699, col: 69, offset: 23412},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 562, col: 59, offset: 17142},
+							pos:        position{line: 699, col: 72, offset: 23415},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3834,123 +5469,94 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IsString",
-			pos:  position{line: 566, col: 1, offset: 17233},
+			name: "ArraySliceExpression",
+			pos:  position{line: 703, col: 1, offset: 23509},
 			expr: &actionExpr{
-				pos: position{line: 566, col: 13, offset: 17245},
-				run: (*parser).callonIsString1,
+				pos: position{line: 703, col: 25, offset: 23533},
+				run: (*parser).callonArraySliceExpression1,
 				expr: &seqExpr{
-					pos: position{line: 566, col: 13, offset: 17245},
+					pos: position{line: 703, col: 25, offset: 23533},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 566, col: 13, offset: 17245},
-							val:        "is_string",
+							pos:        position{line: 703, col: 25, offset: 23533},
+							val:        "array_slice",
 							ignoreCase: true,
-							want:       "\"IS_STRING\"i",
+							want:       "\"ARRAY_SLICE\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 566, col: 26, offset: 17258},
+							pos:  position{line: 703, col: 40, offset: 23548},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 566, col: 29, offset: 17261},
+							pos:        position{line: 703, col: 43, offset: 23551},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 566, col: 33, offset: 17265},
+							pos:  position{line: 703, col: 47, offset: 23555},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 566, col: 36, offset: 17268},
-							label: "ex",
+							pos:   position{line: 703, col: 50, offset: 23558},
+							label: "array",
 							expr: &ruleRefExpr{
-								pos:  position{line: 566, col: 39, offset: 17271},
+								pos:  position{line: 703, col: 56, offset: 23564},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 566, col: 50, offset: 17282},
-							name: "ws",
-						},
-						&litMatcher{
-							pos:        position{line: 566, col: 53, offset: 17285},
-							val:        ")",
-							ignoreCase: false,
-							want:       "\")\"",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "ArrayConcatExpression",
-			pos:  position{line: 570, col: 1, offset: 17373},
-			expr: &actionExpr{
-				pos: position{line: 570, col: 26, offset: 17398},
-				run: (*parser).callonArrayConcatExpression1,
-				expr: &seqExpr{
-					pos: position{line: 570, col: 26, offset: 17398},
-					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 570, col: 26, offset: 17398},
-							val:        "array_concat",
-							ignoreCase: true,
-							want:       "\"ARRAY_CONCAT\"i",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 570, col: 42, offset: 17414},
+							pos:  position{line: 703, col: 67, offset: 23575},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 570, col: 45, offset: 17417},
-							val:        "(",
+							pos:        position{line: 703, col: 70, offset: 23578},
+							val:        ",",
 							ignoreCase: false,
-							want:       "\"(\"",
+							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 570, col: 49, offset: 17421},
+							pos:  position{line: 703, col: 74, offset: 23582},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 570, col: 52, offset: 17424},
-							label: "arrays",
+							pos:   position{line: 703, col: 77, offset: 23585},
+							label: "start",
 							expr: &ruleRefExpr{
-								pos:  position{line: 570, col: 59, offset: 17431},
+								pos:  position{line: 703, col: 83, offset: 23591},
 								name: "SelectItem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 570, col: 70, offset: 17442},
-							label: "others",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 570, col: 77, offset: 17449},
+							pos:   position{line: 703, col: 94, offset: 23602},
+							label: "length",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 703, col: 101, offset: 23609},
 								expr: &actionExpr{
-									pos: position{line: 570, col: 78, offset: 17450},
-									run: (*parser).callonArrayConcatExpression11,
+									pos: position{line: 703, col: 102, offset: 23610},
+									run: (*parser).callonArraySliceExpression16,
 									expr: &seqExpr{
-										pos: position{line: 570, col: 78, offset: 17450},
+										pos: position{line: 703, col: 102, offset: 23610},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 570, col: 78, offset: 17450},
+												pos:  position{line: 703, col: 102, offset: 23610},
 												name: "ws",
 											},
 											&litMatcher{
-												pos:        position{line: 570, col: 81, offset: 17453},
+												pos:        position{line: 703, col: 105, offset: 23613},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 570, col: 85, offset: 17457},
+												pos:  position{line: 703, col: 109, offset: 23617},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 570, col: 88, offset: 17460},
+												pos:   position{line: 703, col: 112, offset: 23620},
 												label: "ex",
 												expr: &ruleRefExpr{
-													pos:  position{line: 570, col: 91, offset: 17463},
+													pos:  position{line: 703, col: 115, offset: 23623},
 													name: "SelectItem",
 												},
 											},
@@ -3960,11 +5566,11 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 570, col: 123, offset: 17495},
+							pos:  position{line: 703, col: 147, offset: 23655},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 570, col: 126, offset: 17498},
+							pos:        position{line: 703, col: 150, offset: 23658},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3974,48 +5580,70 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ArrayLengthExpression",
-			pos:  position{line: 574, col: 1, offset: 17628},
+			name: "SetIntersectExpression",
+			pos:  position{line: 707, col: 1, offset: 23766},
 			expr: &actionExpr{
-				pos: position{line: 574, col: 26, offset: 17653},
-				run: (*parser).callonArrayLengthExpression1,
+				pos: position{line: 707, col: 27, offset: 23792},
+				run: (*parser).callonSetIntersectExpression1,
 				expr: &seqExpr{
-					pos: position{line: 574, col: 26, offset: 17653},
+					pos: position{line: 707, col: 27, offset: 23792},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 574, col: 26, offset: 17653},
-							val:        "array_length",
+							pos:        position{line: 707, col: 27, offset: 23792},
+							val:        "setintersect",
 							ignoreCase: true,
-							want:       "\"ARRAY_LENGTH\"i",
+							want:       "\"SetIntersect\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 574, col: 42, offset: 17669},
+							pos:  position{line: 707, col: 43, offset: 23808},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 574, col: 45, offset: 17672},
+							pos:        position{line: 707, col: 46, offset: 23811},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 574, col: 49, offset: 17676},
+							pos:  position{line: 707, col: 50, offset: 23815},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 574, col: 52, offset: 17679},
-							label: "array",
+							pos:   position{line: 707, col: 53, offset: 23818},
+							label: "set1",
+							expr: &ruleRefExpr{
+								pos:  position{line: 707, col: 58, offset: 23823},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 707, col: 69, offset: 23834},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 707, col: 72, offset: 23837},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 707, col: 76, offset: 23841},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 707, col: 79, offset: 23844},
+							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 574, col: 58, offset: 17685},
+								pos:  position{line: 707, col: 84, offset: 23849},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 574, col: 69, offset: 17696},
+							pos:  position{line: 707, col: 95, offset: 23860},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 574, col: 72, offset: 17699},
+							pos:        position{line: 707, col: 98, offset: 23863},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4025,108 +5653,143 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ArraySliceExpression",
-			pos:  position{line: 578, col: 1, offset: 17793},
+			name: "SetUnionExpression",
+			pos:  position{line: 711, col: 1, offset: 23963},
 			expr: &actionExpr{
-				pos: position{line: 578, col: 25, offset: 17817},
-				run: (*parser).callonArraySliceExpression1,
+				pos: position{line: 711, col: 23, offset: 23985},
+				run: (*parser).callonSetUnionExpression1,
 				expr: &seqExpr{
-					pos: position{line: 578, col: 25, offset: 17817},
+					pos: position{line: 711, col: 23, offset: 23985},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 578, col: 25, offset: 17817},
-							val:        "array_slice",
+							pos:        position{line: 711, col: 23, offset: 23985},
+							val:        "setunion",
 							ignoreCase: true,
-							want:       "\"ARRAY_SLICE\"i",
+							want:       "\"SetUnion\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 578, col: 40, offset: 17832},
+							pos:  position{line: 711, col: 35, offset: 23997},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 578, col: 43, offset: 17835},
+							pos:        position{line: 711, col: 38, offset: 24000},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 578, col: 47, offset: 17839},
+							pos:  position{line: 711, col: 42, offset: 24004},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 578, col: 50, offset: 17842},
-							label: "array",
+							pos:   position{line: 711, col: 45, offset: 24007},
+							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 578, col: 56, offset: 17848},
+								pos:  position{line: 711, col: 50, offset: 24012},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 578, col: 67, offset: 17859},
+							pos:  position{line: 711, col: 61, offset: 24023},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 578, col: 70, offset: 17862},
+							pos:        position{line: 711, col: 64, offset: 24026},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 578, col: 74, offset: 17866},
+							pos:  position{line: 711, col: 68, offset: 24030},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 578, col: 77, offset: 17869},
-							label: "start",
+							pos:   position{line: 711, col: 71, offset: 24033},
+							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 578, col: 83, offset: 17875},
+								pos:  position{line: 711, col: 76, offset: 24038},
 								name: "SelectItem",
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 711, col: 87, offset: 24049},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 711, col: 90, offset: 24052},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "StDistanceExpression",
+			pos:  position{line: 715, col: 1, offset: 24148},
+			expr: &actionExpr{
+				pos: position{line: 715, col: 25, offset: 24172},
+				run: (*parser).callonStDistanceExpression1,
+				expr: &seqExpr{
+					pos: position{line: 715, col: 25, offset: 24172},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 715, col: 25, offset: 24172},
+							val:        "st_distance",
+							ignoreCase: true,
+							want:       "\"ST_DISTANCE\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 715, col: 40, offset: 24187},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 715, col: 43, offset: 24190},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 715, col: 47, offset: 24194},
+							name: "ws",
+						},
 						&labeledExpr{
-							pos:   position{line: 578, col: 94, offset: 17886},
-							label: "length",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 578, col: 101, offset: 17893},
-								expr: &actionExpr{
-									pos: position{line: 578, col: 102, offset: 17894},
-									run: (*parser).callonArraySliceExpression16,
-									expr: &seqExpr{
-										pos: position{line: 578, col: 102, offset: 17894},
-										exprs: []any{
-											&ruleRefExpr{
-												pos:  position{line: 578, col: 102, offset: 17894},
-												name: "ws",
-											},
-											&litMatcher{
-												pos:        position{line: 578, col: 105, offset: 17897},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 578, col: 109, offset: 17901},
-												name: "ws",
-											},
-											&labeledExpr{
-												pos:   position{line: 578, col: 112, offset: 17904},
-												label: "ex",
-												expr: &ruleRefExpr{
-													pos:  position{line: 578, col: 115, offset: 17907},
-													name: "SelectItem",
-												},
-											},
-										},
-									},
-								},
+							pos:   position{line: 715, col: 50, offset: 24197},
+							label: "ex1",
+							expr: &ruleRefExpr{
+								pos:  position{line: 715, col: 54, offset: 24201},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 715, col: 65, offset: 24212},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 715, col: 68, offset: 24215},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 715, col: 72, offset: 24219},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 715, col: 75, offset: 24222},
+							label: "ex2",
+							expr: &ruleRefExpr{
+								pos:  position{line: 715, col: 79, offset: 24226},
+								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 578, col: 147, offset: 17939},
+							pos:  position{line: 715, col: 90, offset: 24237},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 578, col: 150, offset: 17942},
+							pos:        position{line: 715, col: 93, offset: 24240},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4136,70 +5799,70 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SetIntersectExpression",
-			pos:  position{line: 582, col: 1, offset: 18050},
+			name: "StWithinExpression",
+			pos:  position{line: 719, col: 1, offset: 24341},
 			expr: &actionExpr{
-				pos: position{line: 582, col: 27, offset: 18076},
-				run: (*parser).callonSetIntersectExpression1,
+				pos: position{line: 719, col: 23, offset: 24363},
+				run: (*parser).callonStWithinExpression1,
 				expr: &seqExpr{
-					pos: position{line: 582, col: 27, offset: 18076},
+					pos: position{line: 719, col: 23, offset: 24363},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 582, col: 27, offset: 18076},
-							val:        "setintersect",
+							pos:        position{line: 719, col: 23, offset: 24363},
+							val:        "st_within",
 							ignoreCase: true,
-							want:       "\"SetIntersect\"i",
+							want:       "\"ST_WITHIN\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 582, col: 43, offset: 18092},
+							pos:  position{line: 719, col: 36, offset: 24376},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 582, col: 46, offset: 18095},
+							pos:        position{line: 719, col: 39, offset: 24379},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 582, col: 50, offset: 18099},
+							pos:  position{line: 719, col: 43, offset: 24383},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 582, col: 53, offset: 18102},
-							label: "set1",
+							pos:   position{line: 719, col: 46, offset: 24386},
+							label: "ex1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 582, col: 58, offset: 18107},
+								pos:  position{line: 719, col: 50, offset: 24390},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 582, col: 69, offset: 18118},
+							pos:  position{line: 719, col: 61, offset: 24401},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 582, col: 72, offset: 18121},
+							pos:        position{line: 719, col: 64, offset: 24404},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 582, col: 76, offset: 18125},
+							pos:  position{line: 719, col: 68, offset: 24408},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 582, col: 79, offset: 18128},
-							label: "set2",
+							pos:   position{line: 719, col: 71, offset: 24411},
+							label: "ex2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 582, col: 84, offset: 18133},
+								pos:  position{line: 719, col: 75, offset: 24415},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 582, col: 95, offset: 18144},
+							pos:  position{line: 719, col: 86, offset: 24426},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 582, col: 98, offset: 18147},
+							pos:        position{line: 719, col: 89, offset: 24429},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4209,70 +5872,70 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SetUnionExpression",
-			pos:  position{line: 586, col: 1, offset: 18247},
+			name: "StIntersectsExpression",
+			pos:  position{line: 723, col: 1, offset: 24528},
 			expr: &actionExpr{
-				pos: position{line: 586, col: 23, offset: 18269},
-				run: (*parser).callonSetUnionExpression1,
+				pos: position{line: 723, col: 27, offset: 24554},
+				run: (*parser).callonStIntersectsExpression1,
 				expr: &seqExpr{
-					pos: position{line: 586, col: 23, offset: 18269},
+					pos: position{line: 723, col: 27, offset: 24554},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 586, col: 23, offset: 18269},
-							val:        "setunion",
+							pos:        position{line: 723, col: 27, offset: 24554},
+							val:        "st_intersects",
 							ignoreCase: true,
-							want:       "\"SetUnion\"i",
+							want:       "\"ST_INTERSECTS\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 586, col: 35, offset: 18281},
+							pos:  position{line: 723, col: 44, offset: 24571},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 586, col: 38, offset: 18284},
+							pos:        position{line: 723, col: 47, offset: 24574},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 586, col: 42, offset: 18288},
+							pos:  position{line: 723, col: 51, offset: 24578},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 586, col: 45, offset: 18291},
-							label: "set1",
+							pos:   position{line: 723, col: 54, offset: 24581},
+							label: "ex1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 586, col: 50, offset: 18296},
+								pos:  position{line: 723, col: 58, offset: 24585},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 586, col: 61, offset: 18307},
+							pos:  position{line: 723, col: 69, offset: 24596},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 586, col: 64, offset: 18310},
+							pos:        position{line: 723, col: 72, offset: 24599},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 586, col: 68, offset: 18314},
+							pos:  position{line: 723, col: 76, offset: 24603},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 586, col: 71, offset: 18317},
-							label: "set2",
+							pos:   position{line: 723, col: 79, offset: 24606},
+							label: "ex2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 586, col: 76, offset: 18322},
+								pos:  position{line: 723, col: 83, offset: 24610},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 586, col: 87, offset: 18333},
+							pos:  position{line: 723, col: 94, offset: 24621},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 586, col: 90, offset: 18336},
+							pos:        position{line: 723, col: 97, offset: 24624},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4283,47 +5946,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathAbsExpression",
-			pos:  position{line: 590, col: 1, offset: 18432},
+			pos:  position{line: 727, col: 1, offset: 24727},
 			expr: &actionExpr{
-				pos: position{line: 590, col: 22, offset: 18453},
+				pos: position{line: 727, col: 22, offset: 24748},
 				run: (*parser).callonMathAbsExpression1,
 				expr: &seqExpr{
-					pos: position{line: 590, col: 22, offset: 18453},
+					pos: position{line: 727, col: 22, offset: 24748},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 590, col: 22, offset: 18453},
+							pos:        position{line: 727, col: 22, offset: 24748},
 							val:        "abs",
 							ignoreCase: true,
 							want:       "\"ABS\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 590, col: 29, offset: 18460},
+							pos:  position{line: 727, col: 29, offset: 24755},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 590, col: 32, offset: 18463},
+							pos:        position{line: 727, col: 32, offset: 24758},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 590, col: 36, offset: 18467},
+							pos:  position{line: 727, col: 36, offset: 24762},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 590, col: 39, offset: 18470},
+							pos:   position{line: 727, col: 39, offset: 24765},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 590, col: 42, offset: 18473},
+								pos:  position{line: 727, col: 42, offset: 24768},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 590, col: 53, offset: 18484},
+							pos:  position{line: 727, col: 53, offset: 24779},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 590, col: 56, offset: 18487},
+							pos:        position{line: 727, col: 56, offset: 24782},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4334,47 +5997,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathAcosExpression",
-			pos:  position{line: 591, col: 1, offset: 18569},
+			pos:  position{line: 728, col: 1, offset: 24864},
 			expr: &actionExpr{
-				pos: position{line: 591, col: 23, offset: 18591},
+				pos: position{line: 728, col: 23, offset: 24886},
 				run: (*parser).callonMathAcosExpression1,
 				expr: &seqExpr{
-					pos: position{line: 591, col: 23, offset: 18591},
+					pos: position{line: 728, col: 23, offset: 24886},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 591, col: 23, offset: 18591},
+							pos:        position{line: 728, col: 23, offset: 24886},
 							val:        "acos",
 							ignoreCase: true,
 							want:       "\"ACOS\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 591, col: 31, offset: 18599},
+							pos:  position{line: 728, col: 31, offset: 24894},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 591, col: 34, offset: 18602},
+							pos:        position{line: 728, col: 34, offset: 24897},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 591, col: 38, offset: 18606},
+							pos:  position{line: 728, col: 38, offset: 24901},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 591, col: 41, offset: 18609},
+							pos:   position{line: 728, col: 41, offset: 24904},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 591, col: 44, offset: 18612},
+								pos:  position{line: 728, col: 44, offset: 24907},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 591, col: 55, offset: 18623},
+							pos:  position{line: 728, col: 55, offset: 24918},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 591, col: 58, offset: 18626},
+							pos:        position{line: 728, col: 58, offset: 24921},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4385,47 +6048,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathAsinExpression",
-			pos:  position{line: 592, col: 1, offset: 18709},
+			pos:  position{line: 729, col: 1, offset: 25004},
 			expr: &actionExpr{
-				pos: position{line: 592, col: 23, offset: 18731},
+				pos: position{line: 729, col: 23, offset: 25026},
 				run: (*parser).callonMathAsinExpression1,
 				expr: &seqExpr{
-					pos: position{line: 592, col: 23, offset: 18731},
+					pos: position{line: 729, col: 23, offset: 25026},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 592, col: 23, offset: 18731},
+							pos:        position{line: 729, col: 23, offset: 25026},
 							val:        "asin",
 							ignoreCase: true,
 							want:       "\"ASIN\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 592, col: 31, offset: 18739},
+							pos:  position{line: 729, col: 31, offset: 25034},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 592, col: 34, offset: 18742},
+							pos:        position{line: 729, col: 34, offset: 25037},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 592, col: 38, offset: 18746},
+							pos:  position{line: 729, col: 38, offset: 25041},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 592, col: 41, offset: 18749},
+							pos:   position{line: 729, col: 41, offset: 25044},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 592, col: 44, offset: 18752},
+								pos:  position{line: 729, col: 44, offset: 25047},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 592, col: 55, offset: 18763},
+							pos:  position{line: 729, col: 55, offset: 25058},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 592, col: 58, offset: 18766},
+							pos:        position{line: 729, col: 58, offset: 25061},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4436,47 +6099,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathAtanExpression",
-			pos:  position{line: 593, col: 1, offset: 18849},
+			pos:  position{line: 730, col: 1, offset: 25144},
 			expr: &actionExpr{
-				pos: position{line: 593, col: 23, offset: 18871},
+				pos: position{line: 730, col: 23, offset: 25166},
 				run: (*parser).callonMathAtanExpression1,
 				expr: &seqExpr{
-					pos: position{line: 593, col: 23, offset: 18871},
+					pos: position{line: 730, col: 23, offset: 25166},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 593, col: 23, offset: 18871},
+							pos:        position{line: 730, col: 23, offset: 25166},
 							val:        "atan",
 							ignoreCase: true,
 							want:       "\"ATAN\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 593, col: 31, offset: 18879},
+							pos:  position{line: 730, col: 31, offset: 25174},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 593, col: 34, offset: 18882},
+							pos:        position{line: 730, col: 34, offset: 25177},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 593, col: 38, offset: 18886},
+							pos:  position{line: 730, col: 38, offset: 25181},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 593, col: 41, offset: 18889},
+							pos:   position{line: 730, col: 41, offset: 25184},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 593, col: 44, offset: 18892},
+								pos:  position{line: 730, col: 44, offset: 25187},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 593, col: 55, offset: 18903},
+							pos:  position{line: 730, col: 55, offset: 25198},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 593, col: 58, offset: 18906},
+							pos:        position{line: 730, col: 58, offset: 25201},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4487,47 +6150,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathCeilingExpression",
-			pos:  position{line: 594, col: 1, offset: 18989},
+			pos:  position{line: 731, col: 1, offset: 25284},
 			expr: &actionExpr{
-				pos: position{line: 594, col: 26, offset: 19014},
+				pos: position{line: 731, col: 26, offset: 25309},
 				run: (*parser).callonMathCeilingExpression1,
 				expr: &seqExpr{
-					pos: position{line: 594, col: 26, offset: 19014},
+					pos: position{line: 731, col: 26, offset: 25309},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 594, col: 26, offset: 19014},
+							pos:        position{line: 731, col: 26, offset: 25309},
 							val:        "ceiling",
 							ignoreCase: true,
 							want:       "\"CEILING\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 594, col: 37, offset: 19025},
+							pos:  position{line: 731, col: 37, offset: 25320},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 594, col: 40, offset: 19028},
+							pos:        position{line: 731, col: 40, offset: 25323},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 594, col: 44, offset: 19032},
+							pos:  position{line: 731, col: 44, offset: 25327},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 594, col: 47, offset: 19035},
+							pos:   position{line: 731, col: 47, offset: 25330},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 594, col: 50, offset: 19038},
+								pos:  position{line: 731, col: 50, offset: 25333},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 594, col: 61, offset: 19049},
+							pos:  position{line: 731, col: 61, offset: 25344},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 594, col: 64, offset: 19052},
+							pos:        position{line: 731, col: 64, offset: 25347},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4538,47 +6201,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathCosExpression",
-			pos:  position{line: 595, col: 1, offset: 19138},
+			pos:  position{line: 732, col: 1, offset: 25433},
 			expr: &actionExpr{
-				pos: position{line: 595, col: 22, offset: 19159},
+				pos: position{line: 732, col: 22, offset: 25454},
 				run: (*parser).callonMathCosExpression1,
 				expr: &seqExpr{
-					pos: position{line: 595, col: 22, offset: 19159},
+					pos: position{line: 732, col: 22, offset: 25454},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 595, col: 22, offset: 19159},
+							pos:        position{line: 732, col: 22, offset: 25454},
 							val:        "cos",
 							ignoreCase: true,
 							want:       "\"COS\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 595, col: 29, offset: 19166},
+							pos:  position{line: 732, col: 29, offset: 25461},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 595, col: 32, offset: 19169},
+							pos:        position{line: 732, col: 32, offset: 25464},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 595, col: 36, offset: 19173},
+							pos:  position{line: 732, col: 36, offset: 25468},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 595, col: 39, offset: 19176},
+							pos:   position{line: 732, col: 39, offset: 25471},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 595, col: 42, offset: 19179},
+								pos:  position{line: 732, col: 42, offset: 25474},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 595, col: 53, offset: 19190},
+							pos:  position{line: 732, col: 53, offset: 25485},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 595, col: 56, offset: 19193},
+							pos:        position{line: 732, col: 56, offset: 25488},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4589,47 +6252,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathCotExpression",
-			pos:  position{line: 596, col: 1, offset: 19275},
+			pos:  position{line: 733, col: 1, offset: 25570},
 			expr: &actionExpr{
-				pos: position{line: 596, col: 22, offset: 19296},
+				pos: position{line: 733, col: 22, offset: 25591},
 				run: (*parser).callonMathCotExpression1,
 				expr: &seqExpr{
-					pos: position{line: 596, col: 22, offset: 19296},
+					pos: position{line: 733, col: 22, offset: 25591},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 596, col: 22, offset: 19296},
+							pos:        position{line: 733, col: 22, offset: 25591},
 							val:        "cot",
 							ignoreCase: true,
 							want:       "\"COT\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 596, col: 29, offset: 19303},
+							pos:  position{line: 733, col: 29, offset: 25598},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 596, col: 32, offset: 19306},
+							pos:        position{line: 733, col: 32, offset: 25601},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 596, col: 36, offset: 19310},
+							pos:  position{line: 733, col: 36, offset: 25605},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 596, col: 39, offset: 19313},
+							pos:   position{line: 733, col: 39, offset: 25608},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 596, col: 42, offset: 19316},
+								pos:  position{line: 733, col: 42, offset: 25611},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 596, col: 53, offset: 19327},
+							pos:  position{line: 733, col: 53, offset: 25622},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 596, col: 56, offset: 19330},
+							pos:        position{line: 733, col: 56, offset: 25625},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4640,47 +6303,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathDegreesExpression",
-			pos:  position{line: 597, col: 1, offset: 19412},
+			pos:  position{line: 734, col: 1, offset: 25707},
 			expr: &actionExpr{
-				pos: position{line: 597, col: 26, offset: 19437},
+				pos: position{line: 734, col: 26, offset: 25732},
 				run: (*parser).callonMathDegreesExpression1,
 				expr: &seqExpr{
-					pos: position{line: 597, col: 26, offset: 19437},
+					pos: position{line: 734, col: 26, offset: 25732},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 597, col: 26, offset: 19437},
+							pos:        position{line: 734, col: 26, offset: 25732},
 							val:        "degrees",
 							ignoreCase: true,
 							want:       "\"DEGREES\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 597, col: 37, offset: 19448},
+							pos:  position{line: 734, col: 37, offset: 25743},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 597, col: 40, offset: 19451},
+							pos:        position{line: 734, col: 40, offset: 25746},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 597, col: 44, offset: 19455},
+							pos:  position{line: 734, col: 44, offset: 25750},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 597, col: 47, offset: 19458},
+							pos:   position{line: 734, col: 47, offset: 25753},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 597, col: 50, offset: 19461},
+								pos:  position{line: 734, col: 50, offset: 25756},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 597, col: 61, offset: 19472},
+							pos:  position{line: 734, col: 61, offset: 25767},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 597, col: 64, offset: 19475},
+							pos:        position{line: 734, col: 64, offset: 25770},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4691,47 +6354,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathExpExpression",
-			pos:  position{line: 598, col: 1, offset: 19561},
+			pos:  position{line: 735, col: 1, offset: 25856},
 			expr: &actionExpr{
-				pos: position{line: 598, col: 22, offset: 19582},
+				pos: position{line: 735, col: 22, offset: 25877},
 				run: (*parser).callonMathExpExpression1,
 				expr: &seqExpr{
-					pos: position{line: 598, col: 22, offset: 19582},
+					pos: position{line: 735, col: 22, offset: 25877},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 598, col: 22, offset: 19582},
+							pos:        position{line: 735, col: 22, offset: 25877},
 							val:        "exp",
 							ignoreCase: true,
 							want:       "\"EXP\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 598, col: 29, offset: 19589},
+							pos:  position{line: 735, col: 29, offset: 25884},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 598, col: 32, offset: 19592},
+							pos:        position{line: 735, col: 32, offset: 25887},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 598, col: 36, offset: 19596},
+							pos:  position{line: 735, col: 36, offset: 25891},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 598, col: 39, offset: 19599},
+							pos:   position{line: 735, col: 39, offset: 25894},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 598, col: 42, offset: 19602},
+								pos:  position{line: 735, col: 42, offset: 25897},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 598, col: 53, offset: 19613},
+							pos:  position{line: 735, col: 53, offset: 25908},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 598, col: 56, offset: 19616},
+							pos:        position{line: 735, col: 56, offset: 25911},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4742,47 +6405,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathFloorExpression",
-			pos:  position{line: 599, col: 1, offset: 19698},
+			pos:  position{line: 736, col: 1, offset: 25993},
 			expr: &actionExpr{
-				pos: position{line: 599, col: 24, offset: 19721},
+				pos: position{line: 736, col: 24, offset: 26016},
 				run: (*parser).callonMathFloorExpression1,
 				expr: &seqExpr{
-					pos: position{line: 599, col: 24, offset: 19721},
+					pos: position{line: 736, col: 24, offset: 26016},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 599, col: 24, offset: 19721},
+							pos:        position{line: 736, col: 24, offset: 26016},
 							val:        "floor",
 							ignoreCase: true,
 							want:       "\"FLOOR\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 599, col: 33, offset: 19730},
+							pos:  position{line: 736, col: 33, offset: 26025},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 599, col: 36, offset: 19733},
+							pos:        position{line: 736, col: 36, offset: 26028},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 599, col: 40, offset: 19737},
+							pos:  position{line: 736, col: 40, offset: 26032},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 599, col: 43, offset: 19740},
+							pos:   position{line: 736, col: 43, offset: 26035},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 599, col: 46, offset: 19743},
+								pos:  position{line: 736, col: 46, offset: 26038},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 599, col: 57, offset: 19754},
+							pos:  position{line: 736, col: 57, offset: 26049},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 599, col: 60, offset: 19757},
+							pos:        position{line: 736, col: 60, offset: 26052},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4793,47 +6456,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntBitNotExpression",
-			pos:  position{line: 600, col: 1, offset: 19841},
+			pos:  position{line: 737, col: 1, offset: 26136},
 			expr: &actionExpr{
-				pos: position{line: 600, col: 28, offset: 19868},
+				pos: position{line: 737, col: 28, offset: 26163},
 				run: (*parser).callonMathIntBitNotExpression1,
 				expr: &seqExpr{
-					pos: position{line: 600, col: 28, offset: 19868},
+					pos: position{line: 737, col: 28, offset: 26163},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 600, col: 28, offset: 19868},
+							pos:        position{line: 737, col: 28, offset: 26163},
 							val:        "intbitnot",
 							ignoreCase: true,
 							want:       "\"IntBitNot\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 600, col: 41, offset: 19881},
+							pos:  position{line: 737, col: 41, offset: 26176},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 600, col: 44, offset: 19884},
+							pos:        position{line: 737, col: 44, offset: 26179},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 600, col: 48, offset: 19888},
+							pos:  position{line: 737, col: 48, offset: 26183},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 600, col: 51, offset: 19891},
+							pos:   position{line: 737, col: 51, offset: 26186},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 600, col: 54, offset: 19894},
+								pos:  position{line: 737, col: 54, offset: 26189},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 600, col: 65, offset: 19905},
+							pos:  position{line: 737, col: 65, offset: 26200},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 600, col: 68, offset: 19908},
+							pos:        position{line: 737, col: 68, offset: 26203},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4844,47 +6507,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathLog10Expression",
-			pos:  position{line: 601, col: 1, offset: 19996},
+			pos:  position{line: 738, col: 1, offset: 26291},
 			expr: &actionExpr{
-				pos: position{line: 601, col: 24, offset: 20019},
+				pos: position{line: 738, col: 24, offset: 26314},
 				run: (*parser).callonMathLog10Expression1,
 				expr: &seqExpr{
-					pos: position{line: 601, col: 24, offset: 20019},
+					pos: position{line: 738, col: 24, offset: 26314},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 601, col: 24, offset: 20019},
+							pos:        position{line: 738, col: 24, offset: 26314},
 							val:        "log10",
 							ignoreCase: true,
 							want:       "\"LOG10\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 601, col: 33, offset: 20028},
+							pos:  position{line: 738, col: 33, offset: 26323},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 601, col: 36, offset: 20031},
+							pos:        position{line: 738, col: 36, offset: 26326},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 601, col: 40, offset: 20035},
+							pos:  position{line: 738, col: 40, offset: 26330},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 601, col: 43, offset: 20038},
+							pos:   position{line: 738, col: 43, offset: 26333},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 601, col: 46, offset: 20041},
+								pos:  position{line: 738, col: 46, offset: 26336},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 601, col: 57, offset: 20052},
+							pos:  position{line: 738, col: 57, offset: 26347},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 601, col: 60, offset: 20055},
+							pos:        position{line: 738, col: 60, offset: 26350},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4895,47 +6558,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathRadiansExpression",
-			pos:  position{line: 602, col: 1, offset: 20139},
+			pos:  position{line: 739, col: 1, offset: 26434},
 			expr: &actionExpr{
-				pos: position{line: 602, col: 26, offset: 20164},
+				pos: position{line: 739, col: 26, offset: 26459},
 				run: (*parser).callonMathRadiansExpression1,
 				expr: &seqExpr{
-					pos: position{line: 602, col: 26, offset: 20164},
+					pos: position{line: 739, col: 26, offset: 26459},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 602, col: 26, offset: 20164},
+							pos:        position{line: 739, col: 26, offset: 26459},
 							val:        "radians",
 							ignoreCase: true,
 							want:       "\"RADIANS\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 602, col: 37, offset: 20175},
+							pos:  position{line: 739, col: 37, offset: 26470},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 602, col: 40, offset: 20178},
+							pos:        position{line: 739, col: 40, offset: 26473},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 602, col: 44, offset: 20182},
+							pos:  position{line: 739, col: 44, offset: 26477},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 602, col: 47, offset: 20185},
+							pos:   position{line: 739, col: 47, offset: 26480},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 602, col: 50, offset: 20188},
+								pos:  position{line: 739, col: 50, offset: 26483},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 602, col: 61, offset: 20199},
+							pos:  position{line: 739, col: 61, offset: 26494},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 602, col: 64, offset: 20202},
+							pos:        position{line: 739, col: 64, offset: 26497},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4946,47 +6609,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathRoundExpression",
-			pos:  position{line: 603, col: 1, offset: 20288},
+			pos:  position{line: 740, col: 1, offset: 26583},
 			expr: &actionExpr{
-				pos: position{line: 603, col: 24, offset: 20311},
+				pos: position{line: 740, col: 24, offset: 26606},
 				run: (*parser).callonMathRoundExpression1,
 				expr: &seqExpr{
-					pos: position{line: 603, col: 24, offset: 20311},
+					pos: position{line: 740, col: 24, offset: 26606},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 603, col: 24, offset: 20311},
+							pos:        position{line: 740, col: 24, offset: 26606},
 							val:        "round",
 							ignoreCase: true,
 							want:       "\"ROUND\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 603, col: 33, offset: 20320},
+							pos:  position{line: 740, col: 33, offset: 26615},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 603, col: 36, offset: 20323},
+							pos:        position{line: 740, col: 36, offset: 26618},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 603, col: 40, offset: 20327},
+							pos:  position{line: 740, col: 40, offset: 26622},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 603, col: 43, offset: 20330},
+							pos:   position{line: 740, col: 43, offset: 26625},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 603, col: 46, offset: 20333},
+								pos:  position{line: 740, col: 46, offset: 26628},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 603, col: 57, offset: 20344},
+							pos:  position{line: 740, col: 57, offset: 26639},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 603, col: 60, offset: 20347},
+							pos:        position{line: 740, col: 60, offset: 26642},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4997,47 +6660,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathSignExpression",
-			pos:  position{line: 604, col: 1, offset: 20431},
+			pos:  position{line: 741, col: 1, offset: 26726},
 			expr: &actionExpr{
-				pos: position{line: 604, col: 23, offset: 20453},
+				pos: position{line: 741, col: 23, offset: 26748},
 				run: (*parser).callonMathSignExpression1,
 				expr: &seqExpr{
-					pos: position{line: 604, col: 23, offset: 20453},
+					pos: position{line: 741, col: 23, offset: 26748},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 604, col: 23, offset: 20453},
+							pos:        position{line: 741, col: 23, offset: 26748},
 							val:        "sign",
 							ignoreCase: true,
 							want:       "\"SIGN\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 604, col: 31, offset: 20461},
+							pos:  position{line: 741, col: 31, offset: 26756},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 604, col: 34, offset: 20464},
+							pos:        position{line: 741, col: 34, offset: 26759},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 604, col: 38, offset: 20468},
+							pos:  position{line: 741, col: 38, offset: 26763},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 604, col: 41, offset: 20471},
+							pos:   position{line: 741, col: 41, offset: 26766},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 604, col: 44, offset: 20474},
+								pos:  position{line: 741, col: 44, offset: 26769},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 604, col: 55, offset: 20485},
+							pos:  position{line: 741, col: 55, offset: 26780},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 604, col: 58, offset: 20488},
+							pos:        position{line: 741, col: 58, offset: 26783},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5048,47 +6711,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathSinExpression",
-			pos:  position{line: 605, col: 1, offset: 20571},
+			pos:  position{line: 742, col: 1, offset: 26866},
 			expr: &actionExpr{
-				pos: position{line: 605, col: 22, offset: 20592},
+				pos: position{line: 742, col: 22, offset: 26887},
 				run: (*parser).callonMathSinExpression1,
 				expr: &seqExpr{
-					pos: position{line: 605, col: 22, offset: 20592},
+					pos: position{line: 742, col: 22, offset: 26887},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 605, col: 22, offset: 20592},
+							pos:        position{line: 742, col: 22, offset: 26887},
 							val:        "sin",
 							ignoreCase: true,
 							want:       "\"SIN\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 605, col: 29, offset: 20599},
+							pos:  position{line: 742, col: 29, offset: 26894},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 605, col: 32, offset: 20602},
+							pos:        position{line: 742, col: 32, offset: 26897},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 605, col: 36, offset: 20606},
+							pos:  position{line: 742, col: 36, offset: 26901},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 605, col: 39, offset: 20609},
+							pos:   position{line: 742, col: 39, offset: 26904},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 605, col: 42, offset: 20612},
+								pos:  position{line: 742, col: 42, offset: 26907},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 605, col: 53, offset: 20623},
+							pos:  position{line: 742, col: 53, offset: 26918},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 605, col: 56, offset: 20626},
+							pos:        position{line: 742, col: 56, offset: 26921},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5099,47 +6762,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathSqrtExpression",
-			pos:  position{line: 606, col: 1, offset: 20708},
+			pos:  position{line: 743, col: 1, offset: 27003},
 			expr: &actionExpr{
-				pos: position{line: 606, col: 23, offset: 20730},
+				pos: position{line: 743, col: 23, offset: 27025},
 				run: (*parser).callonMathSqrtExpression1,
 				expr: &seqExpr{
-					pos: position{line: 606, col: 23, offset: 20730},
+					pos: position{line: 743, col: 23, offset: 27025},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 606, col: 23, offset: 20730},
+							pos:        position{line: 743, col: 23, offset: 27025},
 							val:        "sqrt",
 							ignoreCase: true,
 							want:       "\"SQRT\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 606, col: 31, offset: 20738},
+							pos:  position{line: 743, col: 31, offset: 27033},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 606, col: 34, offset: 20741},
+							pos:        position{line: 743, col: 34, offset: 27036},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 606, col: 38, offset: 20745},
+							pos:  position{line: 743, col: 38, offset: 27040},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 606, col: 41, offset: 20748},
+							pos:   position{line: 743, col: 41, offset: 27043},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 606, col: 44, offset: 20751},
+								pos:  position{line: 743, col: 44, offset: 27046},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 606, col: 55, offset: 20762},
+							pos:  position{line: 743, col: 55, offset: 27057},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 606, col: 58, offset: 20765},
+							pos:        position{line: 743, col: 58, offset: 27060},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5150,47 +6813,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathSquareExpression",
-			pos:  position{line: 607, col: 1, offset: 20848},
+			pos:  position{line: 744, col: 1, offset: 27143},
 			expr: &actionExpr{
-				pos: position{line: 607, col: 25, offset: 20872},
+				pos: position{line: 744, col: 25, offset: 27167},
 				run: (*parser).callonMathSquareExpression1,
 				expr: &seqExpr{
-					pos: position{line: 607, col: 25, offset: 20872},
+					pos: position{line: 744, col: 25, offset: 27167},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 607, col: 25, offset: 20872},
+							pos:        position{line: 744, col: 25, offset: 27167},
 							val:        "square",
 							ignoreCase: true,
 							want:       "\"SQUARE\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 607, col: 35, offset: 20882},
+							pos:  position{line: 744, col: 35, offset: 27177},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 607, col: 38, offset: 20885},
+							pos:        position{line: 744, col: 38, offset: 27180},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 607, col: 42, offset: 20889},
+							pos:  position{line: 744, col: 42, offset: 27184},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 607, col: 45, offset: 20892},
+							pos:   position{line: 744, col: 45, offset: 27187},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 607, col: 48, offset: 20895},
+								pos:  position{line: 744, col: 48, offset: 27190},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 607, col: 59, offset: 20906},
+							pos:  position{line: 744, col: 59, offset: 27201},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 607, col: 62, offset: 20909},
+							pos:        position{line: 744, col: 62, offset: 27204},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5201,47 +6864,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathTanExpression",
-			pos:  position{line: 608, col: 1, offset: 20994},
+			pos:  position{line: 745, col: 1, offset: 27289},
 			expr: &actionExpr{
-				pos: position{line: 608, col: 22, offset: 21015},
+				pos: position{line: 745, col: 22, offset: 27310},
 				run: (*parser).callonMathTanExpression1,
 				expr: &seqExpr{
-					pos: position{line: 608, col: 22, offset: 21015},
+					pos: position{line: 745, col: 22, offset: 27310},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 608, col: 22, offset: 21015},
+							pos:        position{line: 745, col: 22, offset: 27310},
 							val:        "tan",
 							ignoreCase: true,
 							want:       "\"TAN\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 608, col: 29, offset: 21022},
+							pos:  position{line: 745, col: 29, offset: 27317},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 608, col: 32, offset: 21025},
+							pos:        position{line: 745, col: 32, offset: 27320},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 608, col: 36, offset: 21029},
+							pos:  position{line: 745, col: 36, offset: 27324},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 608, col: 39, offset: 21032},
+							pos:   position{line: 745, col: 39, offset: 27327},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 608, col: 42, offset: 21035},
+								pos:  position{line: 745, col: 42, offset: 27330},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 608, col: 53, offset: 21046},
+							pos:  position{line: 745, col: 53, offset: 27341},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 608, col: 56, offset: 21049},
+							pos:        position{line: 745, col: 56, offset: 27344},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5252,47 +6915,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathTruncExpression",
-			pos:  position{line: 609, col: 1, offset: 21131},
+			pos:  position{line: 746, col: 1, offset: 27426},
 			expr: &actionExpr{
-				pos: position{line: 609, col: 24, offset: 21154},
+				pos: position{line: 746, col: 24, offset: 27449},
 				run: (*parser).callonMathTruncExpression1,
 				expr: &seqExpr{
-					pos: position{line: 609, col: 24, offset: 21154},
+					pos: position{line: 746, col: 24, offset: 27449},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 609, col: 24, offset: 21154},
+							pos:        position{line: 746, col: 24, offset: 27449},
 							val:        "trunc",
 							ignoreCase: true,
 							want:       "\"TRUNC\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 609, col: 33, offset: 21163},
+							pos:  position{line: 746, col: 33, offset: 27458},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 609, col: 36, offset: 21166},
+							pos:        position{line: 746, col: 36, offset: 27461},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 609, col: 40, offset: 21170},
+							pos:  position{line: 746, col: 40, offset: 27465},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 609, col: 43, offset: 21173},
+							pos:   position{line: 746, col: 43, offset: 27468},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 609, col: 46, offset: 21176},
+								pos:  position{line: 746, col: 46, offset: 27471},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 609, col: 57, offset: 21187},
+							pos:  position{line: 746, col: 57, offset: 27482},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 609, col: 60, offset: 21190},
+							pos:        position{line: 746, col: 60, offset: 27485},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5303,69 +6966,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathAtn2Expression",
-			pos:  position{line: 611, col: 1, offset: 21275},
+			pos:  position{line: 748, col: 1, offset: 27570},
 			expr: &actionExpr{
-				pos: position{line: 611, col: 23, offset: 21297},
+				pos: position{line: 748, col: 23, offset: 27592},
 				run: (*parser).callonMathAtn2Expression1,
 				expr: &seqExpr{
-					pos: position{line: 611, col: 23, offset: 21297},
+					pos: position{line: 748, col: 23, offset: 27592},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 611, col: 23, offset: 21297},
+							pos:        position{line: 748, col: 23, offset: 27592},
 							val:        "atn2",
 							ignoreCase: true,
 							want:       "\"ATN2\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 611, col: 31, offset: 21305},
+							pos:  position{line: 748, col: 31, offset: 27600},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 611, col: 34, offset: 21308},
+							pos:        position{line: 748, col: 34, offset: 27603},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 611, col: 38, offset: 21312},
+							pos:  position{line: 748, col: 38, offset: 27607},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 611, col: 41, offset: 21315},
+							pos:   position{line: 748, col: 41, offset: 27610},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 611, col: 46, offset: 21320},
+								pos:  position{line: 748, col: 46, offset: 27615},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 611, col: 57, offset: 21331},
+							pos:  position{line: 748, col: 57, offset: 27626},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 611, col: 60, offset: 21334},
+							pos:        position{line: 748, col: 60, offset: 27629},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 611, col: 64, offset: 21338},
+							pos:  position{line: 748, col: 64, offset: 27633},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 611, col: 67, offset: 21341},
+							pos:   position{line: 748, col: 67, offset: 27636},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 611, col: 72, offset: 21346},
+								pos:  position{line: 748, col: 72, offset: 27641},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 611, col: 83, offset: 21357},
+							pos:  position{line: 748, col: 83, offset: 27652},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 611, col: 86, offset: 21360},
+							pos:        position{line: 748, col: 86, offset: 27655},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5376,69 +7039,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntAddExpression",
-			pos:  position{line: 612, col: 1, offset: 21451},
+			pos:  position{line: 749, col: 1, offset: 27746},
 			expr: &actionExpr{
-				pos: position{line: 612, col: 25, offset: 21475},
+				pos: position{line: 749, col: 25, offset: 27770},
 				run: (*parser).callonMathIntAddExpression1,
 				expr: &seqExpr{
-					pos: position{line: 612, col: 25, offset: 21475},
+					pos: position{line: 749, col: 25, offset: 27770},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 612, col: 25, offset: 21475},
+							pos:        position{line: 749, col: 25, offset: 27770},
 							val:        "intadd",
 							ignoreCase: true,
 							want:       "\"IntAdd\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 612, col: 35, offset: 21485},
+							pos:  position{line: 749, col: 35, offset: 27780},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 612, col: 38, offset: 21488},
+							pos:        position{line: 749, col: 38, offset: 27783},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 612, col: 42, offset: 21492},
+							pos:  position{line: 749, col: 42, offset: 27787},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 612, col: 45, offset: 21495},
+							pos:   position{line: 749, col: 45, offset: 27790},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 612, col: 50, offset: 21500},
+								pos:  position{line: 749, col: 50, offset: 27795},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 612, col: 61, offset: 21511},
+							pos:  position{line: 749, col: 61, offset: 27806},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 612, col: 64, offset: 21514},
+							pos:        position{line: 749, col: 64, offset: 27809},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 612, col: 68, offset: 21518},
+							pos:  position{line: 749, col: 68, offset: 27813},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 612, col: 71, offset: 21521},
+							pos:   position{line: 749, col: 71, offset: 27816},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 612, col: 76, offset: 21526},
+								pos:  position{line: 749, col: 76, offset: 27821},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 612, col: 87, offset: 21537},
+							pos:  position{line: 749, col: 87, offset: 27832},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 612, col: 90, offset: 21540},
+							pos:        position{line: 749, col: 90, offset: 27835},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5449,69 +7112,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntBitAndExpression",
-			pos:  position{line: 613, col: 1, offset: 21633},
+			pos:  position{line: 750, col: 1, offset: 27928},
 			expr: &actionExpr{
-				pos: position{line: 613, col: 28, offset: 21660},
+				pos: position{line: 750, col: 28, offset: 27955},
 				run: (*parser).callonMathIntBitAndExpression1,
 				expr: &seqExpr{
-					pos: position{line: 613, col: 28, offset: 21660},
+					pos: position{line: 750, col: 28, offset: 27955},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 613, col: 28, offset: 21660},
+							pos:        position{line: 750, col: 28, offset: 27955},
 							val:        "intbitand",
 							ignoreCase: true,
 							want:       "\"IntBitAnd\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 613, col: 41, offset: 21673},
+							pos:  position{line: 750, col: 41, offset: 27968},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 613, col: 44, offset: 21676},
+							pos:        position{line: 750, col: 44, offset: 27971},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 613, col: 48, offset: 21680},
+							pos:  position{line: 750, col: 48, offset: 27975},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 613, col: 51, offset: 21683},
+							pos:   position{line: 750, col: 51, offset: 27978},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 613, col: 56, offset: 21688},
+								pos:  position{line: 750, col: 56, offset: 27983},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 613, col: 67, offset: 21699},
+							pos:  position{line: 750, col: 67, offset: 27994},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 613, col: 70, offset: 21702},
+							pos:        position{line: 750, col: 70, offset: 27997},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 613, col: 74, offset: 21706},
+							pos:  position{line: 750, col: 74, offset: 28001},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 613, col: 77, offset: 21709},
+							pos:   position{line: 750, col: 77, offset: 28004},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 613, col: 82, offset: 21714},
+								pos:  position{line: 750, col: 82, offset: 28009},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 613, col: 93, offset: 21725},
+							pos:  position{line: 750, col: 93, offset: 28020},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 613, col: 96, offset: 21728},
+							pos:        position{line: 750, col: 96, offset: 28023},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5522,69 +7185,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntBitLeftShiftExpression",
-			pos:  position{line: 614, col: 1, offset: 21824},
+			pos:  position{line: 751, col: 1, offset: 28119},
 			expr: &actionExpr{
-				pos: position{line: 614, col: 34, offset: 21857},
+				pos: position{line: 751, col: 34, offset: 28152},
 				run: (*parser).callonMathIntBitLeftShiftExpression1,
 				expr: &seqExpr{
-					pos: position{line: 614, col: 34, offset: 21857},
+					pos: position{line: 751, col: 34, offset: 28152},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 614, col: 34, offset: 21857},
+							pos:        position{line: 751, col: 34, offset: 28152},
 							val:        "intbitleftshift",
 							ignoreCase: true,
 							want:       "\"IntBitLeftShift\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 614, col: 53, offset: 21876},
+							pos:  position{line: 751, col: 53, offset: 28171},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 614, col: 56, offset: 21879},
+							pos:        position{line: 751, col: 56, offset: 28174},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 614, col: 60, offset: 21883},
+							pos:  position{line: 751, col: 60, offset: 28178},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 614, col: 63, offset: 21886},
+							pos:   position{line: 751, col: 63, offset: 28181},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 614, col: 68, offset: 21891},
+								pos:  position{line: 751, col: 68, offset: 28186},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 614, col: 79, offset: 21902},
+							pos:  position{line: 751, col: 79, offset: 28197},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 614, col: 82, offset: 21905},
+							pos:        position{line: 751, col: 82, offset: 28200},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 614, col: 86, offset: 21909},
+							pos:  position{line: 751, col: 86, offset: 28204},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 614, col: 89, offset: 21912},
+							pos:   position{line: 751, col: 89, offset: 28207},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 614, col: 94, offset: 21917},
+								pos:  position{line: 751, col: 94, offset: 28212},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 614, col: 105, offset: 21928},
+							pos:  position{line: 751, col: 105, offset: 28223},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 614, col: 108, offset: 21931},
+							pos:        position{line: 751, col: 108, offset: 28226},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5595,69 +7258,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntBitOrExpression",
-			pos:  position{line: 615, col: 1, offset: 22033},
+			pos:  position{line: 752, col: 1, offset: 28328},
 			expr: &actionExpr{
-				pos: position{line: 615, col: 27, offset: 22059},
+				pos: position{line: 752, col: 27, offset: 28354},
 				run: (*parser).callonMathIntBitOrExpression1,
 				expr: &seqExpr{
-					pos: position{line: 615, col: 27, offset: 22059},
+					pos: position{line: 752, col: 27, offset: 28354},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 615, col: 27, offset: 22059},
+							pos:        position{line: 752, col: 27, offset: 28354},
 							val:        "intbitor",
 							ignoreCase: true,
 							want:       "\"IntBitOr\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 615, col: 39, offset: 22071},
+							pos:  position{line: 752, col: 39, offset: 28366},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 615, col: 42, offset: 22074},
+							pos:        position{line: 752, col: 42, offset: 28369},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 615, col: 46, offset: 22078},
+							pos:  position{line: 752, col: 46, offset: 28373},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 615, col: 49, offset: 22081},
+							pos:   position{line: 752, col: 49, offset: 28376},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 615, col: 54, offset: 22086},
+								pos:  position{line: 752, col: 54, offset: 28381},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 615, col: 65, offset: 22097},
+							pos:  position{line: 752, col: 65, offset: 28392},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 615, col: 68, offset: 22100},
+							pos:        position{line: 752, col: 68, offset: 28395},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 615, col: 72, offset: 22104},
+							pos:  position{line: 752, col: 72, offset: 28399},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 615, col: 75, offset: 22107},
+							pos:   position{line: 752, col: 75, offset: 28402},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 615, col: 80, offset: 22112},
+								pos:  position{line: 752, col: 80, offset: 28407},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 615, col: 91, offset: 22123},
+							pos:  position{line: 752, col: 91, offset: 28418},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 615, col: 94, offset: 22126},
+							pos:        position{line: 752, col: 94, offset: 28421},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5668,69 +7331,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntBitRightShiftExpression",
-			pos:  position{line: 616, col: 1, offset: 22221},
+			pos:  position{line: 753, col: 1, offset: 28516},
 			expr: &actionExpr{
-				pos: position{line: 616, col: 35, offset: 22255},
+				pos: position{line: 753, col: 35, offset: 28550},
 				run: (*parser).callonMathIntBitRightShiftExpression1,
 				expr: &seqExpr{
-					pos: position{line: 616, col: 35, offset: 22255},
+					pos: position{line: 753, col: 35, offset: 28550},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 616, col: 35, offset: 22255},
+							pos:        position{line: 753, col: 35, offset: 28550},
 							val:        "intbitrightshift",
 							ignoreCase: true,
 							want:       "\"IntBitRightShift\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 616, col: 55, offset: 22275},
+							pos:  position{line: 753, col: 55, offset: 28570},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 616, col: 58, offset: 22278},
+							pos:        position{line: 753, col: 58, offset: 28573},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 616, col: 62, offset: 22282},
+							pos:  position{line: 753, col: 62, offset: 28577},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 616, col: 65, offset: 22285},
+							pos:   position{line: 753, col: 65, offset: 28580},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 616, col: 70, offset: 22290},
+								pos:  position{line: 753, col: 70, offset: 28585},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 616, col: 81, offset: 22301},
+							pos:  position{line: 753, col: 81, offset: 28596},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 616, col: 84, offset: 22304},
+							pos:        position{line: 753, col: 84, offset: 28599},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 616, col: 88, offset: 22308},
+							pos:  position{line: 753, col: 88, offset: 28603},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 616, col: 91, offset: 22311},
+							pos:   position{line: 753, col: 91, offset: 28606},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 616, col: 96, offset: 22316},
+								pos:  position{line: 753, col: 96, offset: 28611},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 616, col: 107, offset: 22327},
+							pos:  position{line: 753, col: 107, offset: 28622},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 616, col: 110, offset: 22330},
+							pos:        position{line: 753, col: 110, offset: 28625},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5741,69 +7404,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntBitXorExpression",
-			pos:  position{line: 617, col: 1, offset: 22433},
+			pos:  position{line: 754, col: 1, offset: 28728},
 			expr: &actionExpr{
-				pos: position{line: 617, col: 28, offset: 22460},
+				pos: position{line: 754, col: 28, offset: 28755},
 				run: (*parser).callonMathIntBitXorExpression1,
 				expr: &seqExpr{
-					pos: position{line: 617, col: 28, offset: 22460},
+					pos: position{line: 754, col: 28, offset: 28755},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 617, col: 28, offset: 22460},
+							pos:        position{line: 754, col: 28, offset: 28755},
 							val:        "intbitxor",
 							ignoreCase: true,
 							want:       "\"IntBitXor\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 617, col: 41, offset: 22473},
+							pos:  position{line: 754, col: 41, offset: 28768},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 617, col: 44, offset: 22476},
+							pos:        position{line: 754, col: 44, offset: 28771},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 617, col: 48, offset: 22480},
+							pos:  position{line: 754, col: 48, offset: 28775},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 617, col: 51, offset: 22483},
+							pos:   position{line: 754, col: 51, offset: 28778},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 617, col: 56, offset: 22488},
+								pos:  position{line: 754, col: 56, offset: 28783},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 617, col: 67, offset: 22499},
+							pos:  position{line: 754, col: 67, offset: 28794},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 617, col: 70, offset: 22502},
+							pos:        position{line: 754, col: 70, offset: 28797},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 617, col: 74, offset: 22506},
+							pos:  position{line: 754, col: 74, offset: 28801},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 617, col: 77, offset: 22509},
+							pos:   position{line: 754, col: 77, offset: 28804},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 617, col: 82, offset: 22514},
+								pos:  position{line: 754, col: 82, offset: 28809},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 617, col: 93, offset: 22525},
+							pos:  position{line: 754, col: 93, offset: 28820},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 617, col: 96, offset: 22528},
+							pos:        position{line: 754, col: 96, offset: 28823},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5814,69 +7477,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntDivExpression",
-			pos:  position{line: 618, col: 1, offset: 22624},
+			pos:  position{line: 755, col: 1, offset: 28919},
 			expr: &actionExpr{
-				pos: position{line: 618, col: 25, offset: 22648},
+				pos: position{line: 755, col: 25, offset: 28943},
 				run: (*parser).callonMathIntDivExpression1,
 				expr: &seqExpr{
-					pos: position{line: 618, col: 25, offset: 22648},
+					pos: position{line: 755, col: 25, offset: 28943},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 618, col: 25, offset: 22648},
+							pos:        position{line: 755, col: 25, offset: 28943},
 							val:        "intdiv",
 							ignoreCase: true,
 							want:       "\"IntDiv\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 618, col: 35, offset: 22658},
+							pos:  position{line: 755, col: 35, offset: 28953},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 618, col: 38, offset: 22661},
+							pos:        position{line: 755, col: 38, offset: 28956},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 618, col: 42, offset: 22665},
+							pos:  position{line: 755, col: 42, offset: 28960},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 618, col: 45, offset: 22668},
+							pos:   position{line: 755, col: 45, offset: 28963},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 618, col: 50, offset: 22673},
+								pos:  position{line: 755, col: 50, offset: 28968},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 618, col: 61, offset: 22684},
+							pos:  position{line: 755, col: 61, offset: 28979},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 618, col: 64, offset: 22687},
+							pos:        position{line: 755, col: 64, offset: 28982},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 618, col: 68, offset: 22691},
+							pos:  position{line: 755, col: 68, offset: 28986},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 618, col: 71, offset: 22694},
+							pos:   position{line: 755, col: 71, offset: 28989},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 618, col: 76, offset: 22699},
+								pos:  position{line: 755, col: 76, offset: 28994},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 618, col: 87, offset: 22710},
+							pos:  position{line: 755, col: 87, offset: 29005},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 618, col: 90, offset: 22713},
+							pos:        position{line: 755, col: 90, offset: 29008},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5887,69 +7550,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntModExpression",
-			pos:  position{line: 619, col: 1, offset: 22806},
+			pos:  position{line: 756, col: 1, offset: 29101},
 			expr: &actionExpr{
-				pos: position{line: 619, col: 25, offset: 22830},
+				pos: position{line: 756, col: 25, offset: 29125},
 				run: (*parser).callonMathIntModExpression1,
 				expr: &seqExpr{
-					pos: position{line: 619, col: 25, offset: 22830},
+					pos: position{line: 756, col: 25, offset: 29125},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 619, col: 25, offset: 22830},
+							pos:        position{line: 756, col: 25, offset: 29125},
 							val:        "intmod",
 							ignoreCase: true,
 							want:       "\"IntMod\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 619, col: 35, offset: 22840},
+							pos:  position{line: 756, col: 35, offset: 29135},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 619, col: 38, offset: 22843},
+							pos:        position{line: 756, col: 38, offset: 29138},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 619, col: 42, offset: 22847},
+							pos:  position{line: 756, col: 42, offset: 29142},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 619, col: 45, offset: 22850},
+							pos:   position{line: 756, col: 45, offset: 29145},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 619, col: 50, offset: 22855},
+								pos:  position{line: 756, col: 50, offset: 29150},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 619, col: 61, offset: 22866},
+							pos:  position{line: 756, col: 61, offset: 29161},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 619, col: 64, offset: 22869},
+							pos:        position{line: 756, col: 64, offset: 29164},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 619, col: 68, offset: 22873},
+							pos:  position{line: 756, col: 68, offset: 29168},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 619, col: 71, offset: 22876},
+							pos:   position{line: 756, col: 71, offset: 29171},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 619, col: 76, offset: 22881},
+								pos:  position{line: 756, col: 76, offset: 29176},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 619, col: 87, offset: 22892},
+							pos:  position{line: 756, col: 87, offset: 29187},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 619, col: 90, offset: 22895},
+							pos:        position{line: 756, col: 90, offset: 29190},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5960,69 +7623,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntMulExpression",
-			pos:  position{line: 620, col: 1, offset: 22988},
+			pos:  position{line: 757, col: 1, offset: 29283},
 			expr: &actionExpr{
-				pos: position{line: 620, col: 25, offset: 23012},
+				pos: position{line: 757, col: 25, offset: 29307},
 				run: (*parser).callonMathIntMulExpression1,
 				expr: &seqExpr{
-					pos: position{line: 620, col: 25, offset: 23012},
+					pos: position{line: 757, col: 25, offset: 29307},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 620, col: 25, offset: 23012},
+							pos:        position{line: 757, col: 25, offset: 29307},
 							val:        "intmul",
 							ignoreCase: true,
 							want:       "\"IntMul\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 620, col: 35, offset: 23022},
+							pos:  position{line: 757, col: 35, offset: 29317},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 620, col: 38, offset: 23025},
+							pos:        position{line: 757, col: 38, offset: 29320},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 620, col: 42, offset: 23029},
+							pos:  position{line: 757, col: 42, offset: 29324},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 620, col: 45, offset: 23032},
+							pos:   position{line: 757, col: 45, offset: 29327},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 620, col: 50, offset: 23037},
+								pos:  position{line: 757, col: 50, offset: 29332},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 620, col: 61, offset: 23048},
+							pos:  position{line: 757, col: 61, offset: 29343},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 620, col: 64, offset: 23051},
+							pos:        position{line: 757, col: 64, offset: 29346},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 620, col: 68, offset: 23055},
+							pos:  position{line: 757, col: 68, offset: 29350},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 620, col: 71, offset: 23058},
+							pos:   position{line: 757, col: 71, offset: 29353},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 620, col: 76, offset: 23063},
+								pos:  position{line: 757, col: 76, offset: 29358},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 620, col: 87, offset: 23074},
+							pos:  position{line: 757, col: 87, offset: 29369},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 620, col: 90, offset: 23077},
+							pos:        position{line: 757, col: 90, offset: 29372},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6033,69 +7696,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntSubExpression",
-			pos:  position{line: 621, col: 1, offset: 23170},
+			pos:  position{line: 758, col: 1, offset: 29465},
 			expr: &actionExpr{
-				pos: position{line: 621, col: 25, offset: 23194},
+				pos: position{line: 758, col: 25, offset: 29489},
 				run: (*parser).callonMathIntSubExpression1,
 				expr: &seqExpr{
-					pos: position{line: 621, col: 25, offset: 23194},
+					pos: position{line: 758, col: 25, offset: 29489},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 621, col: 25, offset: 23194},
+							pos:        position{line: 758, col: 25, offset: 29489},
 							val:        "intsub",
 							ignoreCase: true,
 							want:       "\"IntSub\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 621, col: 35, offset: 23204},
+							pos:  position{line: 758, col: 35, offset: 29499},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 621, col: 38, offset: 23207},
+							pos:        position{line: 758, col: 38, offset: 29502},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 621, col: 42, offset: 23211},
+							pos:  position{line: 758, col: 42, offset: 29506},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 621, col: 45, offset: 23214},
+							pos:   position{line: 758, col: 45, offset: 29509},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 621, col: 50, offset: 23219},
+								pos:  position{line: 758, col: 50, offset: 29514},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 621, col: 61, offset: 23230},
+							pos:  position{line: 758, col: 61, offset: 29525},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 621, col: 64, offset: 23233},
+							pos:        position{line: 758, col: 64, offset: 29528},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 621, col: 68, offset: 23237},
+							pos:  position{line: 758, col: 68, offset: 29532},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 621, col: 71, offset: 23240},
+							pos:   position{line: 758, col: 71, offset: 29535},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 621, col: 76, offset: 23245},
+								pos:  position{line: 758, col: 76, offset: 29540},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 621, col: 87, offset: 23256},
+							pos:  position{line: 758, col: 87, offset: 29551},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 621, col: 90, offset: 23259},
+							pos:        position{line: 758, col: 90, offset: 29554},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6106,69 +7769,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathPowerExpression",
-			pos:  position{line: 622, col: 1, offset: 23352},
+			pos:  position{line: 759, col: 1, offset: 29647},
 			expr: &actionExpr{
-				pos: position{line: 622, col: 24, offset: 23375},
+				pos: position{line: 759, col: 24, offset: 29670},
 				run: (*parser).callonMathPowerExpression1,
 				expr: &seqExpr{
-					pos: position{line: 622, col: 24, offset: 23375},
+					pos: position{line: 759, col: 24, offset: 29670},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 622, col: 24, offset: 23375},
+							pos:        position{line: 759, col: 24, offset: 29670},
 							val:        "power",
 							ignoreCase: true,
 							want:       "\"POWER\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 622, col: 33, offset: 23384},
+							pos:  position{line: 759, col: 33, offset: 29679},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 622, col: 36, offset: 23387},
+							pos:        position{line: 759, col: 36, offset: 29682},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 622, col: 40, offset: 23391},
+							pos:  position{line: 759, col: 40, offset: 29686},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 622, col: 43, offset: 23394},
+							pos:   position{line: 759, col: 43, offset: 29689},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 622, col: 48, offset: 23399},
+								pos:  position{line: 759, col: 48, offset: 29694},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 622, col: 59, offset: 23410},
+							pos:  position{line: 759, col: 59, offset: 29705},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 622, col: 62, offset: 23413},
+							pos:        position{line: 759, col: 62, offset: 29708},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 622, col: 66, offset: 23417},
+							pos:  position{line: 759, col: 66, offset: 29712},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 622, col: 69, offset: 23420},
+							pos:   position{line: 759, col: 69, offset: 29715},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 622, col: 74, offset: 23425},
+								pos:  position{line: 759, col: 74, offset: 29720},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 622, col: 85, offset: 23436},
+							pos:  position{line: 759, col: 85, offset: 29731},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 622, col: 88, offset: 23439},
+							pos:        position{line: 759, col: 88, offset: 29734},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6179,85 +7842,354 @@ var g = &grammar{
 		},
 		{
 			name: "MathLogExpression",
-			pos:  position{line: 624, col: 1, offset: 23532},
+			pos:  position{line: 761, col: 1, offset: 29827},
 			expr: &actionExpr{
-				pos: position{line: 624, col: 22, offset: 23553},
+				pos: position{line: 761, col: 22, offset: 29848},
 				run: (*parser).callonMathLogExpression1,
 				expr: &seqExpr{
-					pos: position{line: 624, col: 22, offset: 23553},
+					pos: position{line: 761, col: 22, offset: 29848},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 624, col: 22, offset: 23553},
+							pos:        position{line: 761, col: 22, offset: 29848},
 							val:        "log",
 							ignoreCase: true,
 							want:       "\"LOG\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 624, col: 29, offset: 23560},
+							pos:  position{line: 761, col: 29, offset: 29855},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 761, col: 32, offset: 29858},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 761, col: 36, offset: 29862},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 761, col: 39, offset: 29865},
+							label: "ex1",
+							expr: &ruleRefExpr{
+								pos:  position{line: 761, col: 43, offset: 29869},
+								name: "SelectItem",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 761, col: 54, offset: 29880},
+							label: "others",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 761, col: 61, offset: 29887},
+								expr: &actionExpr{
+									pos: position{line: 761, col: 62, offset: 29888},
+									run: (*parser).callonMathLogExpression11,
+									expr: &seqExpr{
+										pos: position{line: 761, col: 62, offset: 29888},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 761, col: 62, offset: 29888},
+												name: "ws",
+											},
+											&litMatcher{
+												pos:        position{line: 761, col: 65, offset: 29891},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 761, col: 69, offset: 29895},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 761, col: 72, offset: 29898},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 761, col: 75, offset: 29901},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 761, col: 107, offset: 29933},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 761, col: 110, offset: 29936},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MathNumberBinExpression",
+			pos:  position{line: 764, col: 1, offset: 30058},
+			expr: &actionExpr{
+				pos: position{line: 764, col: 28, offset: 30085},
+				run: (*parser).callonMathNumberBinExpression1,
+				expr: &seqExpr{
+					pos: position{line: 764, col: 28, offset: 30085},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 764, col: 28, offset: 30085},
+							val:        "numberbin",
+							ignoreCase: true,
+							want:       "\"NumberBin\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 764, col: 41, offset: 30098},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 764, col: 44, offset: 30101},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 764, col: 48, offset: 30105},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 764, col: 51, offset: 30108},
+							label: "ex1",
+							expr: &ruleRefExpr{
+								pos:  position{line: 764, col: 55, offset: 30112},
+								name: "SelectItem",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 764, col: 66, offset: 30123},
+							label: "others",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 764, col: 73, offset: 30130},
+								expr: &actionExpr{
+									pos: position{line: 764, col: 74, offset: 30131},
+									run: (*parser).callonMathNumberBinExpression11,
+									expr: &seqExpr{
+										pos: position{line: 764, col: 74, offset: 30131},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 764, col: 74, offset: 30131},
+												name: "ws",
+											},
+											&litMatcher{
+												pos:        position{line: 764, col: 77, offset: 30134},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 764, col: 81, offset: 30138},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 764, col: 84, offset: 30141},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 764, col: 87, offset: 30144},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 764, col: 119, offset: 30176},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 764, col: 122, offset: 30179},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MathPiExpression",
+			pos:  position{line: 767, col: 1, offset: 30307},
+			expr: &actionExpr{
+				pos: position{line: 767, col: 21, offset: 30327},
+				run: (*parser).callonMathPiExpression1,
+				expr: &seqExpr{
+					pos: position{line: 767, col: 21, offset: 30327},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 767, col: 21, offset: 30327},
+							val:        "pi",
+							ignoreCase: true,
+							want:       "\"PI\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 767, col: 27, offset: 30333},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 767, col: 30, offset: 30336},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 767, col: 34, offset: 30340},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 767, col: 37, offset: 30343},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MathRandExpression",
+			pos:  position{line: 768, col: 1, offset: 30422},
+			expr: &actionExpr{
+				pos: position{line: 768, col: 23, offset: 30444},
+				run: (*parser).callonMathRandExpression1,
+				expr: &seqExpr{
+					pos: position{line: 768, col: 23, offset: 30444},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 768, col: 23, offset: 30444},
+							val:        "rand",
+							ignoreCase: true,
+							want:       "\"RAND\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 768, col: 31, offset: 30452},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 768, col: 34, offset: 30455},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 768, col: 38, offset: 30459},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 768, col: 41, offset: 30462},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DateTimeToTicksExpression",
+			pos:  position{line: 770, col: 1, offset: 30544},
+			expr: &actionExpr{
+				pos: position{line: 770, col: 30, offset: 30573},
+				run: (*parser).callonDateTimeToTicksExpression1,
+				expr: &seqExpr{
+					pos: position{line: 770, col: 30, offset: 30573},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 770, col: 30, offset: 30573},
+							val:        "datetimetoticks",
+							ignoreCase: true,
+							want:       "\"DATETIMETOTICKS\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 770, col: 49, offset: 30592},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 770, col: 52, offset: 30595},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 770, col: 56, offset: 30599},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 770, col: 59, offset: 30602},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 770, col: 62, offset: 30605},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 770, col: 73, offset: 30616},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 770, col: 76, offset: 30619},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DateTimeToTimestampExpression",
+			pos:  position{line: 774, col: 1, offset: 30714},
+			expr: &actionExpr{
+				pos: position{line: 774, col: 34, offset: 30747},
+				run: (*parser).callonDateTimeToTimestampExpression1,
+				expr: &seqExpr{
+					pos: position{line: 774, col: 34, offset: 30747},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 774, col: 34, offset: 30747},
+							val:        "datetimetotimestamp",
+							ignoreCase: true,
+							want:       "\"DATETIMETOTIMESTAMP\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 774, col: 57, offset: 30770},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 624, col: 32, offset: 23563},
+							pos:        position{line: 774, col: 60, offset: 30773},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 624, col: 36, offset: 23567},
+							pos:  position{line: 774, col: 64, offset: 30777},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 624, col: 39, offset: 23570},
-							label: "ex1",
+							pos:   position{line: 774, col: 67, offset: 30780},
+							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 624, col: 43, offset: 23574},
+								pos:  position{line: 774, col: 70, offset: 30783},
 								name: "SelectItem",
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 624, col: 54, offset: 23585},
-							label: "others",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 624, col: 61, offset: 23592},
-								expr: &actionExpr{
-									pos: position{line: 624, col: 62, offset: 23593},
-									run: (*parser).callonMathLogExpression11,
-									expr: &seqExpr{
-										pos: position{line: 624, col: 62, offset: 23593},
-										exprs: []any{
-											&ruleRefExpr{
-												pos:  position{line: 624, col: 62, offset: 23593},
-												name: "ws",
-											},
-											&litMatcher{
-												pos:        position{line: 624, col: 65, offset: 23596},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 624, col: 69, offset: 23600},
-												name: "ws",
-											},
-											&labeledExpr{
-												pos:   position{line: 624, col: 72, offset: 23603},
-												label: "ex",
-												expr: &ruleRefExpr{
-													pos:  position{line: 624, col: 75, offset: 23606},
-													name: "SelectItem",
-												},
-											},
-										},
-									},
-								},
-							},
-						},
 						&ruleRefExpr{
-							pos:  position{line: 624, col: 107, offset: 23638},
+							pos:  position{line: 774, col: 81, offset: 30794},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 624, col: 110, offset: 23641},
+							pos:        position{line: 774, col: 84, offset: 30797},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6267,86 +8199,36 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "MathNumberBinExpression",
-			pos:  position{line: 627, col: 1, offset: 23763},
+			name: "GetCurrentTicksExpression",
+			pos:  position{line: 778, col: 1, offset: 30896},
 			expr: &actionExpr{
-				pos: position{line: 627, col: 28, offset: 23790},
-				run: (*parser).callonMathNumberBinExpression1,
+				pos: position{line: 778, col: 30, offset: 30925},
+				run: (*parser).callonGetCurrentTicksExpression1,
 				expr: &seqExpr{
-					pos: position{line: 627, col: 28, offset: 23790},
+					pos: position{line: 778, col: 30, offset: 30925},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 627, col: 28, offset: 23790},
-							val:        "numberbin",
+							pos:        position{line: 778, col: 30, offset: 30925},
+							val:        "getcurrentticks",
 							ignoreCase: true,
-							want:       "\"NumberBin\"i",
+							want:       "\"GETCURRENTTICKS\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 627, col: 41, offset: 23803},
+							pos:  position{line: 778, col: 49, offset: 30944},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 627, col: 44, offset: 23806},
+							pos:        position{line: 778, col: 52, offset: 30947},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 627, col: 48, offset: 23810},
-							name: "ws",
-						},
-						&labeledExpr{
-							pos:   position{line: 627, col: 51, offset: 23813},
-							label: "ex1",
-							expr: &ruleRefExpr{
-								pos:  position{line: 627, col: 55, offset: 23817},
-								name: "SelectItem",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 627, col: 66, offset: 23828},
-							label: "others",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 627, col: 73, offset: 23835},
-								expr: &actionExpr{
-									pos: position{line: 627, col: 74, offset: 23836},
-									run: (*parser).callonMathNumberBinExpression11,
-									expr: &seqExpr{
-										pos: position{line: 627, col: 74, offset: 23836},
-										exprs: []any{
-											&ruleRefExpr{
-												pos:  position{line: 627, col: 74, offset: 23836},
-												name: "ws",
-											},
-											&litMatcher{
-												pos:        position{line: 627, col: 77, offset: 23839},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 627, col: 81, offset: 23843},
-												name: "ws",
-											},
-											&labeledExpr{
-												pos:   position{line: 627, col: 84, offset: 23846},
-												label: "ex",
-												expr: &ruleRefExpr{
-													pos:  position{line: 627, col: 87, offset: 23849},
-													name: "SelectItem",
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 627, col: 119, offset: 23881},
+							pos:  position{line: 778, col: 56, offset: 30951},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 627, col: 122, offset: 23884},
+							pos:        position{line: 778, col: 59, offset: 30954},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6356,36 +8238,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "MathPiExpression",
-			pos:  position{line: 630, col: 1, offset: 24012},
+			name: "TicksToDateTimeExpression",
+			pos:  position{line: 782, col: 1, offset: 31047},
 			expr: &actionExpr{
-				pos: position{line: 630, col: 21, offset: 24032},
-				run: (*parser).callonMathPiExpression1,
+				pos: position{line: 782, col: 30, offset: 31076},
+				run: (*parser).callonTicksToDateTimeExpression1,
 				expr: &seqExpr{
-					pos: position{line: 630, col: 21, offset: 24032},
+					pos: position{line: 782, col: 30, offset: 31076},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 630, col: 21, offset: 24032},
-							val:        "pi",
+							pos:        position{line: 782, col: 30, offset: 31076},
+							val:        "tickstodatetime",
 							ignoreCase: true,
-							want:       "\"PI\"i",
+							want:       "\"TICKSTODATETIME\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 630, col: 27, offset: 24038},
+							pos:  position{line: 782, col: 49, offset: 31095},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 630, col: 30, offset: 24041},
+							pos:        position{line: 782, col: 52, offset: 31098},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 630, col: 34, offset: 24045},
+							pos:  position{line: 782, col: 56, offset: 31102},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 782, col: 59, offset: 31105},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 782, col: 62, offset: 31108},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 782, col: 73, offset: 31119},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 630, col: 37, offset: 24048},
+							pos:        position{line: 782, col: 76, offset: 31122},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6395,36 +8289,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "MathRandExpression",
-			pos:  position{line: 631, col: 1, offset: 24127},
+			name: "TimestampToDateTimeExpression",
+			pos:  position{line: 786, col: 1, offset: 31217},
 			expr: &actionExpr{
-				pos: position{line: 631, col: 23, offset: 24149},
-				run: (*parser).callonMathRandExpression1,
+				pos: position{line: 786, col: 34, offset: 31250},
+				run: (*parser).callonTimestampToDateTimeExpression1,
 				expr: &seqExpr{
-					pos: position{line: 631, col: 23, offset: 24149},
+					pos: position{line: 786, col: 34, offset: 31250},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 631, col: 23, offset: 24149},
-							val:        "rand",
+							pos:        position{line: 786, col: 34, offset: 31250},
+							val:        "timestamptodatetime",
 							ignoreCase: true,
-							want:       "\"RAND\"i",
+							want:       "\"TIMESTAMPTODATETIME\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 631, col: 31, offset: 24157},
+							pos:  position{line: 786, col: 57, offset: 31273},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 631, col: 34, offset: 24160},
+							pos:        position{line: 786, col: 60, offset: 31276},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 631, col: 38, offset: 24164},
+							pos:  position{line: 786, col: 64, offset: 31280},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 786, col: 67, offset: 31283},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 786, col: 70, offset: 31286},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 786, col: 81, offset: 31297},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 631, col: 41, offset: 24167},
+							pos:        position{line: 786, col: 84, offset: 31300},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6435,97 +8341,246 @@ var g = &grammar{
 		},
 		{
 			name: "InFunction",
-			pos:  position{line: 633, col: 1, offset: 24249},
+			pos:  position{line: 790, col: 1, offset: 31399},
+			expr: &choiceExpr{
+				pos: position{line: 790, col: 15, offset: 31413},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 790, col: 15, offset: 31413},
+						run: (*parser).callonInFunction2,
+						expr: &seqExpr{
+							pos: position{line: 790, col: 15, offset: 31413},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 790, col: 15, offset: 31413},
+									label: "ex1",
+									expr: &ruleRefExpr{
+										pos:  position{line: 790, col: 19, offset: 31417},
+										name: "SelectProperty",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 790, col: 34, offset: 31432},
+									name: "ws",
+								},
+								&litMatcher{
+									pos:        position{line: 790, col: 37, offset: 31435},
+									val:        "in",
+									ignoreCase: true,
+									want:       "\"IN\"i",
+								},
+								&notExpr{
+									pos: position{line: 790, col: 43, offset: 31441},
+									expr: &ruleRefExpr{
+										pos:  position{line: 790, col: 44, offset: 31442},
+										name: "IdentifierChar",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 790, col: 59, offset: 31457},
+									name: "ws",
+								},
+								&litMatcher{
+									pos:        position{line: 790, col: 62, offset: 31460},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 790, col: 66, offset: 31464},
+									name: "ws",
+								},
+								&labeledExpr{
+									pos:   position{line: 790, col: 69, offset: 31467},
+									label: "subQuery",
+									expr: &ruleRefExpr{
+										pos:  position{line: 790, col: 78, offset: 31476},
+										name: "SelectStmt",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 790, col: 89, offset: 31487},
+									name: "ws",
+								},
+								&litMatcher{
+									pos:        position{line: 790, col: 92, offset: 31490},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 795, col: 5, offset: 31673},
+						run: (*parser).callonInFunction17,
+						expr: &seqExpr{
+							pos: position{line: 795, col: 5, offset: 31673},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 795, col: 5, offset: 31673},
+									label: "ex1",
+									expr: &ruleRefExpr{
+										pos:  position{line: 795, col: 9, offset: 31677},
+										name: "SelectProperty",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 795, col: 24, offset: 31692},
+									name: "ws",
+								},
+								&litMatcher{
+									pos:        position{line: 795, col: 27, offset: 31695},
+									val:        "in",
+									ignoreCase: true,
+									want:       "\"IN\"i",
+								},
+								&notExpr{
+									pos: position{line: 795, col: 33, offset: 31701},
+									expr: &ruleRefExpr{
+										pos:  position{line: 795, col: 34, offset: 31702},
+										name: "IdentifierChar",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 795, col: 49, offset: 31717},
+									name: "ws",
+								},
+								&litMatcher{
+									pos:        position{line: 795, col: 52, offset: 31720},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 795, col: 56, offset: 31724},
+									name: "ws",
+								},
+								&labeledExpr{
+									pos:   position{line: 795, col: 59, offset: 31727},
+									label: "ex2",
+									expr: &ruleRefExpr{
+										pos:  position{line: 795, col: 63, offset: 31731},
+										name: "SelectItem",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 795, col: 74, offset: 31742},
+									label: "others",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 795, col: 81, offset: 31749},
+										expr: &actionExpr{
+											pos: position{line: 795, col: 82, offset: 31750},
+											run: (*parser).callonInFunction32,
+											expr: &seqExpr{
+												pos: position{line: 795, col: 82, offset: 31750},
+												exprs: []any{
+													&ruleRefExpr{
+														pos:  position{line: 795, col: 82, offset: 31750},
+														name: "ws",
+													},
+													&litMatcher{
+														pos:        position{line: 795, col: 85, offset: 31753},
+														val:        ",",
+														ignoreCase: false,
+														want:       "\",\"",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 795, col: 89, offset: 31757},
+														name: "ws",
+													},
+													&labeledExpr{
+														pos:   position{line: 795, col: 92, offset: 31760},
+														label: "ex",
+														expr: &ruleRefExpr{
+															pos:  position{line: 795, col: 95, offset: 31763},
+															name: "SelectItem",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 795, col: 127, offset: 31795},
+									name: "ws",
+								},
+								&litMatcher{
+									pos:        position{line: 795, col: 130, offset: 31798},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ExistsFunction",
+			pos:  position{line: 800, col: 1, offset: 32009},
 			expr: &actionExpr{
-				pos: position{line: 633, col: 15, offset: 24263},
-				run: (*parser).callonInFunction1,
+				pos: position{line: 800, col: 19, offset: 32027},
+				run: (*parser).callonExistsFunction1,
 				expr: &seqExpr{
-					pos: position{line: 633, col: 15, offset: 24263},
+					pos: position{line: 800, col: 19, offset: 32027},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 633, col: 15, offset: 24263},
-							label: "ex1",
-							expr: &ruleRefExpr{
-								pos:  position{line: 633, col: 19, offset: 24267},
-								name: "SelectProperty",
+							pos:   position{line: 800, col: 19, offset: 32027},
+							label: "not",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 800, col: 23, offset: 32031},
+								expr: &seqExpr{
+									pos: position{line: 800, col: 24, offset: 32032},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 800, col: 24, offset: 32032},
+											name: "Not",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 800, col: 28, offset: 32036},
+											name: "ws",
+										},
+									},
+								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 633, col: 34, offset: 24282},
-							name: "ws",
-						},
 						&litMatcher{
-							pos:        position{line: 633, col: 37, offset: 24285},
-							val:        "in",
+							pos:        position{line: 800, col: 33, offset: 32041},
+							val:        "exists",
 							ignoreCase: true,
-							want:       "\"IN\"i",
+							want:       "\"EXISTS\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 633, col: 43, offset: 24291},
+							pos:  position{line: 800, col: 43, offset: 32051},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 633, col: 46, offset: 24294},
+							pos:        position{line: 800, col: 46, offset: 32054},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 633, col: 50, offset: 24298},
+							pos:  position{line: 800, col: 50, offset: 32058},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 633, col: 53, offset: 24301},
-							label: "ex2",
+							pos:   position{line: 800, col: 53, offset: 32061},
+							label: "subQuery",
 							expr: &ruleRefExpr{
-								pos:  position{line: 633, col: 57, offset: 24305},
-								name: "SelectItem",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 633, col: 68, offset: 24316},
-							label: "others",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 633, col: 75, offset: 24323},
-								expr: &actionExpr{
-									pos: position{line: 633, col: 76, offset: 24324},
-									run: (*parser).callonInFunction14,
-									expr: &seqExpr{
-										pos: position{line: 633, col: 76, offset: 24324},
-										exprs: []any{
-											&ruleRefExpr{
-												pos:  position{line: 633, col: 76, offset: 24324},
-												name: "ws",
-											},
-											&litMatcher{
-												pos:        position{line: 633, col: 79, offset: 24327},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 633, col: 83, offset: 24331},
-												name: "ws",
-											},
-											&labeledExpr{
-												pos:   position{line: 633, col: 86, offset: 24334},
-												label: "ex",
-												expr: &ruleRefExpr{
-													pos:  position{line: 633, col: 89, offset: 24337},
-													name: "SelectItem",
-												},
-											},
-										},
-									},
-								},
+								pos:  position{line: 800, col: 62, offset: 32070},
+								name: "SelectStmt",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 633, col: 121, offset: 24369},
+							pos:  position{line: 800, col: 73, offset: 32081},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 633, col: 124, offset: 24372},
+							pos:        position{line: 800, col: 76, offset: 32084},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6536,43 +8591,47 @@ var g = &grammar{
 		},
 		{
 			name: "AvgAggregateExpression",
-			pos:  position{line: 637, col: 1, offset: 24495},
+			pos:  position{line: 812, col: 1, offset: 32378},
 			expr: &actionExpr{
-				pos: position{line: 637, col: 29, offset: 24523},
+				pos: position{line: 812, col: 29, offset: 32406},
 				run: (*parser).callonAvgAggregateExpression1,
 				expr: &seqExpr{
-					pos: position{line: 637, col: 29, offset: 24523},
+					pos: position{line: 812, col: 29, offset: 32406},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 637, col: 29, offset: 24523},
+							pos:        position{line: 812, col: 29, offset: 32406},
 							val:        "avg",
 							ignoreCase: true,
 							want:       "\"AVG\"i",
 						},
+						&ruleRefExpr{
+							pos:  position{line: 812, col: 36, offset: 32413},
+							name: "ws",
+						},
 						&litMatcher{
-							pos:        position{line: 637, col: 36, offset: 24530},
+							pos:        position{line: 812, col: 39, offset: 32416},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 637, col: 40, offset: 24534},
+							pos:  position{line: 812, col: 43, offset: 32420},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 637, col: 43, offset: 24537},
+							pos:   position{line: 812, col: 46, offset: 32423},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 637, col: 46, offset: 24540},
+								pos:  position{line: 812, col: 49, offset: 32426},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 637, col: 58, offset: 24552},
+							pos:  position{line: 812, col: 61, offset: 32438},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 637, col: 61, offset: 24555},
+							pos:        position{line: 812, col: 64, offset: 32441},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6583,43 +8642,47 @@ var g = &grammar{
 		},
 		{
 			name: "CountAggregateExpression",
-			pos:  position{line: 641, col: 1, offset: 24647},
+			pos:  position{line: 816, col: 1, offset: 32533},
 			expr: &actionExpr{
-				pos: position{line: 641, col: 29, offset: 24675},
+				pos: position{line: 816, col: 29, offset: 32561},
 				run: (*parser).callonCountAggregateExpression1,
 				expr: &seqExpr{
-					pos: position{line: 641, col: 29, offset: 24675},
+					pos: position{line: 816, col: 29, offset: 32561},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 641, col: 29, offset: 24675},
+							pos:        position{line: 816, col: 29, offset: 32561},
 							val:        "count",
 							ignoreCase: true,
 							want:       "\"COUNT\"i",
 						},
+						&ruleRefExpr{
+							pos:  position{line: 816, col: 38, offset: 32570},
+							name: "ws",
+						},
 						&litMatcher{
-							pos:        position{line: 641, col: 38, offset: 24684},
+							pos:        position{line: 816, col: 41, offset: 32573},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 641, col: 42, offset: 24688},
+							pos:  position{line: 816, col: 45, offset: 32577},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 641, col: 45, offset: 24691},
+							pos:   position{line: 816, col: 48, offset: 32580},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 641, col: 48, offset: 24694},
+								pos:  position{line: 816, col: 51, offset: 32583},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 641, col: 59, offset: 24705},
+							pos:  position{line: 816, col: 62, offset: 32594},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 641, col: 62, offset: 24708},
+							pos:        position{line: 816, col: 65, offset: 32597},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6630,43 +8693,47 @@ var g = &grammar{
 		},
 		{
 			name: "MaxAggregateExpression",
-			pos:  position{line: 645, col: 1, offset: 24802},
+			pos:  position{line: 820, col: 1, offset: 32691},
 			expr: &actionExpr{
-				pos: position{line: 645, col: 29, offset: 24830},
+				pos: position{line: 820, col: 29, offset: 32719},
 				run: (*parser).callonMaxAggregateExpression1,
 				expr: &seqExpr{
-					pos: position{line: 645, col: 29, offset: 24830},
+					pos: position{line: 820, col: 29, offset: 32719},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 645, col: 29, offset: 24830},
+							pos:        position{line: 820, col: 29, offset: 32719},
 							val:        "max",
 							ignoreCase: true,
 							want:       "\"MAX\"i",
 						},
+						&ruleRefExpr{
+							pos:  position{line: 820, col: 36, offset: 32726},
+							name: "ws",
+						},
 						&litMatcher{
-							pos:        position{line: 645, col: 36, offset: 24837},
+							pos:        position{line: 820, col: 39, offset: 32729},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 645, col: 40, offset: 24841},
+							pos:  position{line: 820, col: 43, offset: 32733},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 645, col: 43, offset: 24844},
+							pos:   position{line: 820, col: 46, offset: 32736},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 645, col: 46, offset: 24847},
+								pos:  position{line: 820, col: 49, offset: 32739},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 645, col: 57, offset: 24858},
+							pos:  position{line: 820, col: 60, offset: 32750},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 645, col: 60, offset: 24861},
+							pos:        position{line: 820, col: 63, offset: 32753},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6677,43 +8744,47 @@ var g = &grammar{
 		},
 		{
 			name: "MinAggregateExpression",
-			pos:  position{line: 649, col: 1, offset: 24953},
+			pos:  position{line: 824, col: 1, offset: 32845},
 			expr: &actionExpr{
-				pos: position{line: 649, col: 29, offset: 24981},
+				pos: position{line: 824, col: 29, offset: 32873},
 				run: (*parser).callonMinAggregateExpression1,
 				expr: &seqExpr{
-					pos: position{line: 649, col: 29, offset: 24981},
+					pos: position{line: 824, col: 29, offset: 32873},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 649, col: 29, offset: 24981},
+							pos:        position{line: 824, col: 29, offset: 32873},
 							val:        "min",
 							ignoreCase: true,
 							want:       "\"MIN\"i",
 						},
+						&ruleRefExpr{
+							pos:  position{line: 824, col: 36, offset: 32880},
+							name: "ws",
+						},
 						&litMatcher{
-							pos:        position{line: 649, col: 36, offset: 24988},
+							pos:        position{line: 824, col: 39, offset: 32883},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 649, col: 40, offset: 24992},
+							pos:  position{line: 824, col: 43, offset: 32887},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 649, col: 43, offset: 24995},
+							pos:   position{line: 824, col: 46, offset: 32890},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 649, col: 46, offset: 24998},
+								pos:  position{line: 824, col: 49, offset: 32893},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 649, col: 57, offset: 25009},
+							pos:  position{line: 824, col: 60, offset: 32904},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 649, col: 60, offset: 25012},
+							pos:        position{line: 824, col: 63, offset: 32907},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6724,43 +8795,47 @@ var g = &grammar{
 		},
 		{
 			name: "SumAggregateExpression",
-			pos:  position{line: 653, col: 1, offset: 25104},
+			pos:  position{line: 828, col: 1, offset: 32999},
 			expr: &actionExpr{
-				pos: position{line: 653, col: 29, offset: 25132},
+				pos: position{line: 828, col: 29, offset: 33027},
 				run: (*parser).callonSumAggregateExpression1,
 				expr: &seqExpr{
-					pos: position{line: 653, col: 29, offset: 25132},
+					pos: position{line: 828, col: 29, offset: 33027},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 653, col: 29, offset: 25132},
+							pos:        position{line: 828, col: 29, offset: 33027},
 							val:        "sum",
 							ignoreCase: true,
 							want:       "\"SUM\"i",
 						},
+						&ruleRefExpr{
+							pos:  position{line: 828, col: 36, offset: 33034},
+							name: "ws",
+						},
 						&litMatcher{
-							pos:        position{line: 653, col: 36, offset: 25139},
+							pos:        position{line: 828, col: 39, offset: 33037},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 653, col: 40, offset: 25143},
+							pos:  position{line: 828, col: 43, offset: 33041},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 653, col: 43, offset: 25146},
+							pos:   position{line: 828, col: 46, offset: 33044},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 653, col: 46, offset: 25149},
+								pos:  position{line: 828, col: 49, offset: 33047},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 653, col: 57, offset: 25160},
+							pos:  position{line: 828, col: 60, offset: 33058},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 653, col: 60, offset: 25163},
+							pos:        position{line: 828, col: 63, offset: 33061},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6771,14 +8846,14 @@ var g = &grammar{
 		},
 		{
 			name: "Integer",
-			pos:  position{line: 657, col: 1, offset: 25255},
+			pos:  position{line: 832, col: 1, offset: 33153},
 			expr: &actionExpr{
-				pos: position{line: 657, col: 12, offset: 25266},
+				pos: position{line: 832, col: 12, offset: 33164},
 				run: (*parser).callonInteger1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 657, col: 12, offset: 25266},
+					pos: position{line: 832, col: 12, offset: 33164},
 					expr: &charClassMatcher{
-						pos:        position{line: 657, col: 12, offset: 25266},
+						pos:        position{line: 832, col: 12, offset: 33164},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -6788,30 +8863,93 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "StringCharacter",
-			pos:  position{line: 661, col: 1, offset: 25318},
+			name: "DoubleQuotedStringCharacter",
+			pos:  position{line: 836, col: 1, offset: 33216},
 			expr: &choiceExpr{
-				pos: position{line: 661, col: 20, offset: 25337},
+				pos: position{line: 836, col: 32, offset: 33247},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 661, col: 20, offset: 25337},
-						run: (*parser).callonStringCharacter2,
+						pos: position{line: 836, col: 32, offset: 33247},
+						run: (*parser).callonDoubleQuotedStringCharacter2,
 						expr: &seqExpr{
-							pos: position{line: 661, col: 20, offset: 25337},
+							pos: position{line: 836, col: 32, offset: 33247},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 661, col: 20, offset: 25337},
+									pos: position{line: 836, col: 32, offset: 33247},
 									expr: &choiceExpr{
-										pos: position{line: 661, col: 22, offset: 25339},
+										pos: position{line: 836, col: 34, offset: 33249},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 661, col: 22, offset: 25339},
+												pos:        position{line: 836, col: 34, offset: 33249},
 												val:        "\"",
 												ignoreCase: false,
 												want:       "\"\\\"\"",
 											},
 											&litMatcher{
-												pos:        position{line: 661, col: 28, offset: 25345},
+												pos:        position{line: 836, col: 40, offset: 33255},
+												val:        "\\",
+												ignoreCase: false,
+												want:       "\"\\\\\"",
+											},
+										},
+									},
+								},
+								&anyMatcher{
+									line: 836, col: 46, offset: 33261,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 837, col: 5, offset: 33298},
+						run: (*parser).callonDoubleQuotedStringCharacter9,
+						expr: &labeledExpr{
+							pos:   position{line: 837, col: 5, offset: 33298},
+							label: "seq",
+							expr: &ruleRefExpr{
+								pos:  position{line: 837, col: 9, offset: 33302},
+								name: "EscapeSequence",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SingleQuotedStringCharacter",
+			pos:  position{line: 839, col: 1, offset: 33338},
+			expr: &choiceExpr{
+				pos: position{line: 839, col: 32, offset: 33369},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 839, col: 32, offset: 33369},
+						run: (*parser).callonSingleQuotedStringCharacter2,
+						expr: &litMatcher{
+							pos:        position{line: 839, col: 32, offset: 33369},
+							val:        "''",
+							ignoreCase: false,
+							want:       "\"''\"",
+						},
+					},
+					&actionExpr{
+						pos: position{line: 840, col: 5, offset: 33398},
+						run: (*parser).callonSingleQuotedStringCharacter4,
+						expr: &seqExpr{
+							pos: position{line: 840, col: 5, offset: 33398},
+							exprs: []any{
+								&notExpr{
+									pos: position{line: 840, col: 5, offset: 33398},
+									expr: &choiceExpr{
+										pos: position{line: 840, col: 7, offset: 33400},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 840, col: 7, offset: 33400},
+												val:        "'",
+												ignoreCase: false,
+												want:       "\"'\"",
+											},
+											&litMatcher{
+												pos:        position{line: 840, col: 13, offset: 33406},
 												val:        "\\",
 												ignoreCase: false,
 												want:       "\"\\\\\"",
@@ -6820,29 +8958,103 @@ var g = &grammar{
 									},
 								},
 								&anyMatcher{
-									line: 661, col: 34, offset: 25351,
+									line: 840, col: 19, offset: 33412,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 841, col: 5, offset: 33449},
+						run: (*parser).callonSingleQuotedStringCharacter11,
+						expr: &labeledExpr{
+							pos:   position{line: 841, col: 5, offset: 33449},
+							label: "seq",
+							expr: &ruleRefExpr{
+								pos:  position{line: 841, col: 9, offset: 33453},
+								name: "EscapeSequence",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EscapeSequence",
+			pos:  position{line: 843, col: 1, offset: 33489},
+			expr: &choiceExpr{
+				pos: position{line: 843, col: 19, offset: 33507},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 843, col: 19, offset: 33507},
+						run: (*parser).callonEscapeSequence2,
+						expr: &seqExpr{
+							pos: position{line: 843, col: 19, offset: 33507},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 843, col: 19, offset: 33507},
+									val:        "\\u",
+									ignoreCase: false,
+									want:       "\"\\\\u\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 843, col: 25, offset: 33513},
+									label: "high",
+									expr: &ruleRefExpr{
+										pos:  position{line: 843, col: 30, offset: 33518},
+										name: "HexQuad",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 843, col: 38, offset: 33526},
+									label: "low",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 843, col: 42, offset: 33530},
+										expr: &actionExpr{
+											pos: position{line: 843, col: 43, offset: 33531},
+											run: (*parser).callonEscapeSequence9,
+											expr: &seqExpr{
+												pos: position{line: 843, col: 43, offset: 33531},
+												exprs: []any{
+													&litMatcher{
+														pos:        position{line: 843, col: 43, offset: 33531},
+														val:        "\\u",
+														ignoreCase: false,
+														want:       "\"\\\\u\"",
+													},
+													&labeledExpr{
+														pos:   position{line: 843, col: 49, offset: 33537},
+														label: "quad",
+														expr: &ruleRefExpr{
+															pos:  position{line: 843, col: 54, offset: 33542},
+															name: "HexQuad",
+														},
+													},
+												},
+											},
+										},
+									},
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 662, col: 5, offset: 25388},
-						run: (*parser).callonStringCharacter9,
+						pos: position{line: 845, col: 5, offset: 33633},
+						run: (*parser).callonEscapeSequence14,
 						expr: &seqExpr{
-							pos: position{line: 662, col: 5, offset: 25388},
+							pos: position{line: 845, col: 5, offset: 33633},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 662, col: 5, offset: 25388},
+									pos:        position{line: 845, col: 5, offset: 33633},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 662, col: 10, offset: 25393},
-									label: "seq",
+									pos:   position{line: 845, col: 10, offset: 33638},
+									label: "char",
 									expr: &ruleRefExpr{
-										pos:  position{line: 662, col: 14, offset: 25397},
-										name: "EscapeSequenceCharacter",
+										pos:  position{line: 845, col: 15, offset: 33643},
+										name: "EscapeCharacter",
 									},
 								},
 							},
@@ -6851,87 +9063,97 @@ var g = &grammar{
 				},
 			},
 		},
-		{
-			name: "EscapeSequenceCharacter",
-			pos:  position{line: 664, col: 1, offset: 25442},
-			expr: &labeledExpr{
-				pos:   position{line: 664, col: 28, offset: 25469},
-				label: "char",
-				expr: &ruleRefExpr{
-					pos:  position{line: 664, col: 33, offset: 25474},
-					name: "EscapeCharacter",
-				},
-			},
-		},
 		{
 			name: "EscapeCharacter",
-			pos:  position{line: 666, col: 1, offset: 25491},
+			pos:  position{line: 847, col: 1, offset: 33681},
 			expr: &choiceExpr{
-				pos: position{line: 666, col: 20, offset: 25510},
+				pos: position{line: 847, col: 20, offset: 33700},
 				alternatives: []any{
-					&litMatcher{
-						pos:        position{line: 666, col: 20, offset: 25510},
-						val:        "'",
-						ignoreCase: false,
-						want:       "\"'\"",
+					&actionExpr{
+						pos: position{line: 847, col: 20, offset: 33700},
+						run: (*parser).callonEscapeCharacter2,
+						expr: &litMatcher{
+							pos:        position{line: 847, col: 20, offset: 33700},
+							val:        "'",
+							ignoreCase: false,
+							want:       "\"'\"",
+						},
 					},
-					&litMatcher{
-						pos:        position{line: 667, col: 5, offset: 25518},
-						val:        "\"",
-						ignoreCase: false,
-						want:       "\"\\\"\"",
+					&actionExpr{
+						pos: position{line: 848, col: 5, offset: 33728},
+						run: (*parser).callonEscapeCharacter4,
+						expr: &litMatcher{
+							pos:        position{line: 848, col: 5, offset: 33728},
+							val:        "\"",
+							ignoreCase: false,
+							want:       "\"\\\"\"",
+						},
 					},
-					&litMatcher{
-						pos:        position{line: 668, col: 5, offset: 25526},
-						val:        "\\",
-						ignoreCase: false,
-						want:       "\"\\\\\"",
+					&actionExpr{
+						pos: position{line: 849, col: 5, offset: 33757},
+						run: (*parser).callonEscapeCharacter6,
+						expr: &litMatcher{
+							pos:        position{line: 849, col: 5, offset: 33757},
+							val:        "\\",
+							ignoreCase: false,
+							want:       "\"\\\\\"",
+						},
+					},
+					&actionExpr{
+						pos: position{line: 850, col: 5, offset: 33787},
+						run: (*parser).callonEscapeCharacter8,
+						expr: &litMatcher{
+							pos:        position{line: 850, col: 5, offset: 33787},
+							val:        "/",
+							ignoreCase: false,
+							want:       "\"/\"",
+						},
 					},
 					&actionExpr{
-						pos: position{line: 669, col: 5, offset: 25535},
-						run: (*parser).callonEscapeCharacter5,
+						pos: position{line: 851, col: 5, offset: 33815},
+						run: (*parser).callonEscapeCharacter10,
 						expr: &litMatcher{
-							pos:        position{line: 669, col: 5, offset: 25535},
+							pos:        position{line: 851, col: 5, offset: 33815},
 							val:        "b",
 							ignoreCase: false,
 							want:       "\"b\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 670, col: 5, offset: 25564},
-						run: (*parser).callonEscapeCharacter7,
+						pos: position{line: 852, col: 5, offset: 33844},
+						run: (*parser).callonEscapeCharacter12,
 						expr: &litMatcher{
-							pos:        position{line: 670, col: 5, offset: 25564},
+							pos:        position{line: 852, col: 5, offset: 33844},
 							val:        "f",
 							ignoreCase: false,
 							want:       "\"f\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 671, col: 5, offset: 25593},
-						run: (*parser).callonEscapeCharacter9,
+						pos: position{line: 853, col: 5, offset: 33873},
+						run: (*parser).callonEscapeCharacter14,
 						expr: &litMatcher{
-							pos:        position{line: 671, col: 5, offset: 25593},
+							pos:        position{line: 853, col: 5, offset: 33873},
 							val:        "n",
 							ignoreCase: false,
 							want:       "\"n\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 672, col: 5, offset: 25622},
-						run: (*parser).callonEscapeCharacter11,
+						pos: position{line: 854, col: 5, offset: 33902},
+						run: (*parser).callonEscapeCharacter16,
 						expr: &litMatcher{
-							pos:        position{line: 672, col: 5, offset: 25622},
+							pos:        position{line: 854, col: 5, offset: 33902},
 							val:        "r",
 							ignoreCase: false,
 							want:       "\"r\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 673, col: 5, offset: 25651},
-						run: (*parser).callonEscapeCharacter13,
+						pos: position{line: 855, col: 5, offset: 33931},
+						run: (*parser).callonEscapeCharacter18,
 						expr: &litMatcher{
-							pos:        position{line: 673, col: 5, offset: 25651},
+							pos:        position{line: 855, col: 5, offset: 33931},
 							val:        "t",
 							ignoreCase: false,
 							want:       "\"t\"",
@@ -6940,54 +9162,141 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "HexQuad",
+			pos:  position{line: 857, col: 1, offset: 33957},
+			expr: &actionExpr{
+				pos: position{line: 857, col: 12, offset: 33968},
+				run: (*parser).callonHexQuad1,
+				expr: &seqExpr{
+					pos: position{line: 857, col: 12, offset: 33968},
+					exprs: []any{
+						&charClassMatcher{
+							pos:        position{line: 857, col: 12, offset: 33968},
+							val:        "[0-9a-fA-F]",
+							ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+							ignoreCase: false,
+							inverted:   false,
+						},
+						&charClassMatcher{
+							pos:        position{line: 857, col: 24, offset: 33980},
+							val:        "[0-9a-fA-F]",
+							ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+							ignoreCase: false,
+							inverted:   false,
+						},
+						&charClassMatcher{
+							pos:        position{line: 857, col: 36, offset: 33992},
+							val:        "[0-9a-fA-F]",
+							ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+							ignoreCase: false,
+							inverted:   false,
+						},
+						&charClassMatcher{
+							pos:        position{line: 857, col: 48, offset: 34004},
+							val:        "[0-9a-fA-F]",
+							ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+							ignoreCase: false,
+							inverted:   false,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "non_escape_character",
-			pos:  position{line: 675, col: 1, offset: 25677},
+			pos:  position{line: 862, col: 1, offset: 34105},
 			expr: &actionExpr{
-				pos: position{line: 675, col: 25, offset: 25701},
+				pos: position{line: 862, col: 25, offset: 34129},
 				run: (*parser).callonnon_escape_character1,
 				expr: &seqExpr{
-					pos: position{line: 675, col: 25, offset: 25701},
+					pos: position{line: 862, col: 25, offset: 34129},
 					exprs: []any{
 						&notExpr{
-							pos: position{line: 675, col: 25, offset: 25701},
+							pos: position{line: 862, col: 25, offset: 34129},
 							expr: &ruleRefExpr{
-								pos:  position{line: 675, col: 27, offset: 25703},
+								pos:  position{line: 862, col: 27, offset: 34131},
 								name: "escape_character",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 675, col: 45, offset: 25721},
+							pos:   position{line: 862, col: 45, offset: 34149},
 							label: "char",
 							expr: &anyMatcher{
-								line: 675, col: 50, offset: 25726,
+								line: 862, col: 50, offset: 34154,
 							},
 						},
 					},
 				},
 			},
 		},
+		{
+			name: "IdentifierChar",
+			pos:  position{line: 865, col: 1, offset: 34193},
+			expr: &charClassMatcher{
+				pos:        position{line: 865, col: 19, offset: 34211},
+				val:        "[a-zA-Z0-9_]",
+				chars:      []rune{'_'},
+				ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+				ignoreCase: false,
+				inverted:   false,
+			},
+		},
 		{
 			name: "ws",
-			pos:  position{line: 678, col: 1, offset: 25765},
+			pos:  position{line: 867, col: 1, offset: 34225},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 678, col: 7, offset: 25771},
-				expr: &charClassMatcher{
-					pos:        position{line: 678, col: 7, offset: 25771},
-					val:        "[ \\t\\n\\r]",
-					chars:      []rune{' ', '\t', '\n', '\r'},
-					ignoreCase: false,
-					inverted:   false,
+				pos: position{line: 867, col: 7, offset: 34231},
+				expr: &choiceExpr{
+					pos: position{line: 867, col: 8, offset: 34232},
+					alternatives: []any{
+						&charClassMatcher{
+							pos:        position{line: 867, col: 8, offset: 34232},
+							val:        "[ \\t\\n\\r]",
+							chars:      []rune{' ', '\t', '\n', '\r'},
+							ignoreCase: false,
+							inverted:   false,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 867, col: 20, offset: 34244},
+							name: "LineComment",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LineComment",
+			pos:  position{line: 869, col: 1, offset: 34259},
+			expr: &seqExpr{
+				pos: position{line: 869, col: 16, offset: 34274},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 869, col: 16, offset: 34274},
+						val:        "--",
+						ignoreCase: false,
+						want:       "\"--\"",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 869, col: 21, offset: 34279},
+						expr: &charClassMatcher{
+							pos:        position{line: 869, col: 21, offset: 34279},
+							val:        "[^\\n\\r]",
+							chars:      []rune{'\n', '\r'},
+							ignoreCase: false,
+							inverted:   true,
+						},
+					},
 				},
 			},
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 680, col: 1, offset: 25783},
+			pos:  position{line: 871, col: 1, offset: 34289},
 			expr: &notExpr{
-				pos: position{line: 680, col: 8, offset: 25790},
+				pos: position{line: 871, col: 8, offset: 34296},
 				expr: &anyMatcher{
-					line: 680, col: 9, offset: 25791,
+					line: 871, col: 9, offset: 34297,
 				},
 			},
 		},
@@ -7066,7 +9375,7 @@ func (p *parser) callonOffsetClause1() (any, error) {
 }
 
 func (c *current) onSelectAsterisk1() (any, error) {
-	selectItem, _ := makeSelectItem("c", make([]interface{}, 0), parsers.SelectItemTypeField)
+	selectItem, _ := makeSelectItem("*", make([]interface{}, 0), parsers.SelectItemTypeField)
 	selectItem.IsTopLevel = true
 	return makeColumnList(selectItem, make([]interface{}, 0))
 }
@@ -7109,6 +9418,28 @@ func (p *parser) callonSelectValueSpec1() (any, error) {
 	return p.cur.onSelectValueSpec1(stack["column"])
 }
 
+func (c *current) onFromSource2(subQuery, alias any) (any, error) {
+	selectStmt := subQuery.(parsers.SelectStmt)
+	return parsers.Table{Value: alias.(string), SubQuery: &selectStmt}, nil
+}
+
+func (p *parser) callonFromSource2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFromSource2(stack["subQuery"], stack["alias"])
+}
+
+func (c *current) onFromSource17(alias, source any) (any, error) {
+	sourceItem := source.(parsers.SelectItem)
+	return parsers.Table{Value: alias.(string), Source: &sourceItem}, nil
+}
+
+func (p *parser) callonFromSource17() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFromSource17(stack["alias"], stack["source"])
+}
+
 func (c *current) onTableName1(key any) (any, error) {
 	return parsers.Table{Value: key.(string)}, nil
 }
@@ -7116,72 +9447,170 @@ func (c *current) onTableName1(key any) (any, error) {
 func (p *parser) callonTableName1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTableName1(stack["key"])
+	return p.cur.onTableName1(stack["key"])
+}
+
+func (c *current) onSelectArray1(columns any) (any, error) {
+	return makeSelectArray(columns)
+}
+
+func (p *parser) callonSelectArray1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectArray1(stack["columns"])
+}
+
+func (c *current) onSelectObject10(coll any) (any, error) {
+	return coll, nil
+}
+
+func (p *parser) callonSelectObject10() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectObject10(stack["coll"])
+}
+
+func (c *current) onSelectObject1(field, other_fields any) (any, error) {
+	return makeSelectObject(field, other_fields)
+}
+
+func (p *parser) callonSelectObject1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectObject1(stack["field"], stack["other_fields"])
+}
+
+func (c *current) onSelectObjectField6(key any) (any, error) {
+	return key, nil
+}
+
+func (p *parser) callonSelectObjectField6() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectObjectField6(stack["key"])
+}
+
+func (c *current) onSelectObjectField1(name, selectItem any) (any, error) {
+	item := selectItem.(parsers.SelectItem)
+	item.Alias = name.(string)
+	return item, nil
+}
+
+func (p *parser) callonSelectObjectField1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectObjectField1(stack["name"], stack["selectItem"])
+}
+
+func (c *current) onSelectProperty1(name, path any) (any, error) {
+	return makeSelectItem(name, path, parsers.SelectItemTypeField)
+}
+
+func (p *parser) callonSelectProperty1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectProperty1(stack["name"], stack["path"])
+}
+
+func (c *current) onSelectItem1(selectItem, asClause any) (any, error) {
+	itemResult := selectItem.(parsers.SelectItem)
+	if aliasValue, ok := asClause.(string); ok {
+		itemResult.Alias = aliasValue
+	}
+
+	return itemResult, nil
+}
+
+func (p *parser) callonSelectItem1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectItem1(stack["selectItem"], stack["asClause"])
+}
+
+func (c *current) onScalarExpression7(op, ex any) (any, error) {
+	return []interface{}{op, ex}, nil
+}
+
+func (p *parser) callonScalarExpression7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onScalarExpression7(stack["op"], stack["ex"])
+}
+
+func (c *current) onScalarExpression1(ex1, ex2 any) (any, error) {
+	return combineBinaryExpressions(ex1, ex2)
+}
+
+func (p *parser) callonScalarExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onScalarExpression1(stack["ex1"], stack["ex2"])
 }
 
-func (c *current) onSelectArray1(columns any) (any, error) {
-	return makeSelectArray(columns)
+func (c *current) onMultiplicativeExpression7(op, ex any) (any, error) {
+	return []interface{}{op, ex}, nil
 }
 
-func (p *parser) callonSelectArray1() (any, error) {
+func (p *parser) callonMultiplicativeExpression7() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSelectArray1(stack["columns"])
+	return p.cur.onMultiplicativeExpression7(stack["op"], stack["ex"])
 }
 
-func (c *current) onSelectObject10(coll any) (any, error) {
-	return coll, nil
+func (c *current) onMultiplicativeExpression1(ex1, ex2 any) (any, error) {
+	return combineBinaryExpressions(ex1, ex2)
 }
 
-func (p *parser) callonSelectObject10() (any, error) {
+func (p *parser) callonMultiplicativeExpression1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSelectObject10(stack["coll"])
+	return p.cur.onMultiplicativeExpression1(stack["ex1"], stack["ex2"])
 }
 
-func (c *current) onSelectObject1(field, other_fields any) (any, error) {
-	return makeSelectObject(field, other_fields)
+func (c *current) onAdditiveOperator1() (any, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonSelectObject1() (any, error) {
+func (p *parser) callonAdditiveOperator1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSelectObject1(stack["field"], stack["other_fields"])
+	return p.cur.onAdditiveOperator1()
 }
 
-func (c *current) onSelectObjectField6(key any) (any, error) {
-	return key, nil
+func (c *current) onMultiplicativeOperator1() (any, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonSelectObjectField6() (any, error) {
+func (p *parser) callonMultiplicativeOperator1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSelectObjectField6(stack["key"])
+	return p.cur.onMultiplicativeOperator1()
 }
 
-func (c *current) onSelectObjectField1(name, selectItem any) (any, error) {
-	item := selectItem.(parsers.SelectItem)
-	item.Alias = name.(string)
-	return item, nil
+func (c *current) onPrimaryExpression2(subQuery any) (any, error) {
+	return parsers.SelectItem{
+		Type:  parsers.SelectItemTypeSubQuery,
+		Value: subQuery,
+	}, nil
 }
 
-func (p *parser) callonSelectObjectField1() (any, error) {
+func (p *parser) callonPrimaryExpression2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSelectObjectField1(stack["name"], stack["selectItem"])
+	return p.cur.onPrimaryExpression2(stack["subQuery"])
 }
 
-func (c *current) onSelectProperty1(name, path any) (any, error) {
-	return makeSelectItem(name, path, parsers.SelectItemTypeField)
+func (c *current) onPrimaryExpression10(ex any) (any, error) {
+	return ex, nil
 }
 
-func (p *parser) callonSelectProperty1() (any, error) {
+func (p *parser) callonPrimaryExpression10() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSelectProperty1(stack["name"], stack["path"])
+	return p.cur.onPrimaryExpression10(stack["ex"])
 }
 
-func (c *current) onSelectItem1(selectItem, asClause any) (any, error) {
+func (c *current) onPrimaryExpression18(selectItem any) (any, error) {
 	var itemResult parsers.SelectItem
 	switch typedValue := selectItem.(type) {
 	case parsers.SelectItem:
@@ -7198,17 +9627,13 @@ func (c *current) onSelectItem1(selectItem, asClause any) (any, error) {
 		}
 	}
 
-	if aliasValue, ok := asClause.(string); ok {
-		itemResult.Alias = aliasValue
-	}
-
 	return itemResult, nil
 }
 
-func (p *parser) callonSelectItem1() (any, error) {
+func (p *parser) callonPrimaryExpression18() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSelectItem1(stack["selectItem"], stack["asClause"])
+	return p.cur.onPrimaryExpression18(stack["selectItem"])
 }
 
 func (c *current) onAsClause1(alias any) (any, error) {
@@ -7231,24 +9656,24 @@ func (p *parser) callonDotFieldAccess1() (any, error) {
 	return p.cur.onDotFieldAccess1(stack["id"])
 }
 
-func (c *current) onArrayFieldAccess2(id any) (any, error) {
-	return id, nil
+func (c *current) onArrayFieldAccess2(key any) (any, error) {
+	return key.(parsers.Constant).Value, nil
 }
 
 func (p *parser) callonArrayFieldAccess2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onArrayFieldAccess2(stack["id"])
+	return p.cur.onArrayFieldAccess2(stack["key"])
 }
 
-func (c *current) onArrayFieldAccess8(id any) (any, error) {
-	return strconv.Itoa(id.(int)), nil
+func (c *current) onArrayFieldAccess10(index any) (any, error) {
+	return strconv.Itoa(index.(parsers.Constant).Value.(int)), nil
 }
 
-func (p *parser) callonArrayFieldAccess8() (any, error) {
+func (p *parser) callonArrayFieldAccess10() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onArrayFieldAccess8(stack["id"])
+	return p.cur.onArrayFieldAccess10(stack["index"])
 }
 
 func (c *current) onIdentifier1() (any, error) {
@@ -7311,44 +9736,84 @@ func (p *parser) callonAndExpression1() (any, error) {
 	return p.cur.onAndExpression1(stack["ex1"], stack["ex2"])
 }
 
-func (c *current) onComparisonExpression2(ex any) (any, error) {
+func (c *current) onNotExpression2(ex any) (any, error) {
+	return parsers.LogicalExpression{Expressions: []interface{}{ex}, Operation: parsers.LogicalExpressionTypeNot}, nil
+}
+
+func (p *parser) callonNotExpression2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNotExpression2(stack["ex"])
+}
+
+func (c *current) onNotExpression8(ex any) (any, error) {
 	return ex, nil
 }
 
-func (p *parser) callonComparisonExpression2() (any, error) {
+func (p *parser) callonNotExpression8() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onComparisonExpression2(stack["ex"])
+	return p.cur.onNotExpression8(stack["ex"])
 }
 
-func (c *current) onComparisonExpression10(left, op, right any) (any, error) {
+func (c *current) onComparisonExpression2(left, op, right any) (any, error) {
 	return parsers.ComparisonExpression{Left: left, Right: right, Operation: op.(string)}, nil
 }
 
-func (p *parser) callonComparisonExpression10() (any, error) {
+func (p *parser) callonComparisonExpression2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onComparisonExpression2(stack["left"], stack["op"], stack["right"])
+}
+
+func (c *current) onComparisonExpression28(ex any) (any, error) {
+	return ex, nil
+}
+
+func (p *parser) callonComparisonExpression28() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onComparisonExpression28(stack["ex"])
+}
+
+func (c *current) onComparisonExpression12(left, not, pattern, escape any) (any, error) {
+	return parsers.LikeExpression{Left: left, Pattern: pattern, Escape: escape, Not: not != nil}, nil
+}
+
+func (p *parser) callonComparisonExpression12() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onComparisonExpression12(stack["left"], stack["not"], stack["pattern"], stack["escape"])
+}
+
+func (c *current) onComparisonExpression35(ex any) (any, error) {
+	return ex, nil
+}
+
+func (p *parser) callonComparisonExpression35() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onComparisonExpression10(stack["left"], stack["op"], stack["right"])
+	return p.cur.onComparisonExpression35(stack["ex"])
 }
 
-func (c *current) onComparisonExpression20(ex any) (any, error) {
+func (c *current) onComparisonExpression43(ex any) (any, error) {
 	return ex, nil
 }
 
-func (p *parser) callonComparisonExpression20() (any, error) {
+func (p *parser) callonComparisonExpression43() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onComparisonExpression20(stack["ex"])
+	return p.cur.onComparisonExpression43(stack["ex"])
 }
 
-func (c *current) onComparisonExpression23(ex any) (any, error) {
+func (c *current) onComparisonExpression46(ex any) (any, error) {
 	return ex, nil
 }
 
-func (p *parser) callonComparisonExpression23() (any, error) {
+func (p *parser) callonComparisonExpression46() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onComparisonExpression23(stack["ex"])
+	return p.cur.onComparisonExpression46(stack["ex"])
 }
 
 func (c *current) onOrderByClause9(ex any) (any, error) {
@@ -7425,29 +9890,38 @@ func (p *parser) callonNullConstant1() (any, error) {
 	return p.cur.onNullConstant1()
 }
 
-func (c *current) onIntegerLiteral1(number any) (any, error) {
-	return parsers.Constant{Type: parsers.ConstantTypeInteger, Value: number.(int)}, nil
+func (c *current) onIntegerLiteral1() (any, error) {
+	return parsers.Constant{Type: parsers.ConstantTypeInteger, Value: jsonnumbers.Parse(string(c.text))}, nil
 }
 
 func (p *parser) callonIntegerLiteral1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onIntegerLiteral1(stack["number"])
+	return p.cur.onIntegerLiteral1()
+}
+
+func (c *current) onStringLiteral2(chars any) (any, error) {
+	return parsers.Constant{Type: parsers.ConstantTypeString, Value: joinStrings(chars.([]interface{}))}, nil
+}
+
+func (p *parser) callonStringLiteral2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStringLiteral2(stack["chars"])
 }
 
-func (c *current) onStringLiteral1(chars any) (any, error) {
+func (c *current) onStringLiteral9(chars any) (any, error) {
 	return parsers.Constant{Type: parsers.ConstantTypeString, Value: joinStrings(chars.([]interface{}))}, nil
 }
 
-func (p *parser) callonStringLiteral1() (any, error) {
+func (p *parser) callonStringLiteral9() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onStringLiteral1(stack["chars"])
+	return p.cur.onStringLiteral9(stack["chars"])
 }
 
 func (c *current) onFloatLiteral1() (any, error) {
-	floatValue, _ := strconv.ParseFloat(string(c.text), 64)
-	return parsers.Constant{Type: parsers.ConstantTypeFloat, Value: floatValue}, nil
+	return parsers.Constant{Type: parsers.ConstantTypeFloat, Value: jsonnumbers.Parse(string(c.text))}, nil
 }
 
 func (p *parser) callonFloatLiteral1() (any, error) {
@@ -7457,7 +9931,7 @@ func (p *parser) callonFloatLiteral1() (any, error) {
 }
 
 func (c *current) onBooleanLiteral1() (any, error) {
-	boolValue, _ := strconv.ParseBool(string(c.text))
+	boolValue, _ := strconv.ParseBool(strings.ToLower(string(c.text)))
 	return parsers.Constant{Type: parsers.ConstantTypeBoolean, Value: boolValue}, nil
 }
 
@@ -7638,6 +10112,26 @@ func (p *parser) callonTrimExpression1() (any, error) {
 	return p.cur.onTrimExpression1(stack["ex"])
 }
 
+func (c *current) onRegexMatchExpression17(m any) (any, error) {
+	return m, nil
+}
+
+func (p *parser) callonRegexMatchExpression17() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRegexMatchExpression17(stack["m"])
+}
+
+func (c *current) onRegexMatchExpression1(ex, pattern, modifiers any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallRegexMatch, []interface{}{ex, pattern, modifiers})
+}
+
+func (p *parser) callonRegexMatchExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRegexMatchExpression1(stack["ex"], stack["pattern"], stack["modifiers"])
+}
+
 func (c *current) onThreeArgumentStringFunctionExpression18(boolean any) (any, error) {
 	return boolean, nil
 }
@@ -7802,6 +10296,66 @@ func (p *parser) callonArrayConcatExpression1() (any, error) {
 	return p.cur.onArrayConcatExpression1(stack["arrays"], stack["others"])
 }
 
+func (c *current) onArrayContainsExpression16(ex any) (any, error) {
+	return ex, nil
+}
+
+func (p *parser) callonArrayContainsExpression16() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArrayContainsExpression16(stack["ex"])
+}
+
+func (c *current) onArrayContainsExpression1(array, item, partialMatch any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallArrayContains, []interface{}{array, item, partialMatch})
+}
+
+func (p *parser) callonArrayContainsExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArrayContainsExpression1(stack["array"], stack["item"], stack["partialMatch"])
+}
+
+func (c *current) onArrayContainsAnyExpression11(ex any) (any, error) {
+	return ex, nil
+}
+
+func (p *parser) callonArrayContainsAnyExpression11() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArrayContainsAnyExpression11(stack["ex"])
+}
+
+func (c *current) onArrayContainsAnyExpression1(array, items any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallArrayContainsAny, append([]interface{}{array}, items.([]interface{})...))
+}
+
+func (p *parser) callonArrayContainsAnyExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArrayContainsAnyExpression1(stack["array"], stack["items"])
+}
+
+func (c *current) onArrayContainsAllExpression11(ex any) (any, error) {
+	return ex, nil
+}
+
+func (p *parser) callonArrayContainsAllExpression11() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArrayContainsAllExpression11(stack["ex"])
+}
+
+func (c *current) onArrayContainsAllExpression1(array, items any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallArrayContainsAll, append([]interface{}{array}, items.([]interface{})...))
+}
+
+func (p *parser) callonArrayContainsAllExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArrayContainsAllExpression1(stack["array"], stack["items"])
+}
+
 func (c *current) onArrayLengthExpression1(array any) (any, error) {
 	return createFunctionCall(parsers.FunctionCallArrayLength, []interface{}{array})
 }
@@ -7852,6 +10406,36 @@ func (p *parser) callonSetUnionExpression1() (any, error) {
 	return p.cur.onSetUnionExpression1(stack["set1"], stack["set2"])
 }
 
+func (c *current) onStDistanceExpression1(ex1, ex2 any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallSpatialDistance, []interface{}{ex1, ex2})
+}
+
+func (p *parser) callonStDistanceExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStDistanceExpression1(stack["ex1"], stack["ex2"])
+}
+
+func (c *current) onStWithinExpression1(ex1, ex2 any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallSpatialWithin, []interface{}{ex1, ex2})
+}
+
+func (p *parser) callonStWithinExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStWithinExpression1(stack["ex1"], stack["ex2"])
+}
+
+func (c *current) onStIntersectsExpression1(ex1, ex2 any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallSpatialIntersects, []interface{}{ex1, ex2})
+}
+
+func (p *parser) callonStIntersectsExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStIntersectsExpression1(stack["ex1"], stack["ex2"])
+}
+
 func (c *current) onMathAbsExpression1(ex any) (any, error) {
 	return createFunctionCall(parsers.FunctionCallMathAbs, []interface{}{ex})
 }
@@ -8232,24 +10816,105 @@ func (p *parser) callonMathRandExpression1() (any, error) {
 	return p.cur.onMathRandExpression1()
 }
 
-func (c *current) onInFunction14(ex any) (any, error) {
+func (c *current) onDateTimeToTicksExpression1(ex any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallDateTimeToTicks, []interface{}{ex})
+}
+
+func (p *parser) callonDateTimeToTicksExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDateTimeToTicksExpression1(stack["ex"])
+}
+
+func (c *current) onDateTimeToTimestampExpression1(ex any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallDateTimeToTimestamp, []interface{}{ex})
+}
+
+func (p *parser) callonDateTimeToTimestampExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDateTimeToTimestampExpression1(stack["ex"])
+}
+
+func (c *current) onGetCurrentTicksExpression1() (any, error) {
+	return createFunctionCall(parsers.FunctionCallGetCurrentTicks, []interface{}{})
+}
+
+func (p *parser) callonGetCurrentTicksExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGetCurrentTicksExpression1()
+}
+
+func (c *current) onTicksToDateTimeExpression1(ex any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallTicksToDateTime, []interface{}{ex})
+}
+
+func (p *parser) callonTicksToDateTimeExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTicksToDateTimeExpression1(stack["ex"])
+}
+
+func (c *current) onTimestampToDateTimeExpression1(ex any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallTimestampToDateTime, []interface{}{ex})
+}
+
+func (p *parser) callonTimestampToDateTimeExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTimestampToDateTimeExpression1(stack["ex"])
+}
+
+func (c *current) onInFunction2(ex1, subQuery any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallIn, []interface{}{ex1, parsers.SelectItem{
+		Type:  parsers.SelectItemTypeSubQuery,
+		Value: subQuery,
+	}})
+}
+
+func (p *parser) callonInFunction2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInFunction2(stack["ex1"], stack["subQuery"])
+}
+
+func (c *current) onInFunction32(ex any) (any, error) {
 	return ex, nil
 }
 
-func (p *parser) callonInFunction14() (any, error) {
+func (p *parser) callonInFunction32() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onInFunction14(stack["ex"])
+	return p.cur.onInFunction32(stack["ex"])
 }
 
-func (c *current) onInFunction1(ex1, ex2, others any) (any, error) {
+func (c *current) onInFunction17(ex1, ex2, others any) (any, error) {
 	return createFunctionCall(parsers.FunctionCallIn, append([]interface{}{ex1, ex2}, others.([]interface{})...))
 }
 
-func (p *parser) callonInFunction1() (any, error) {
+func (p *parser) callonInFunction17() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInFunction17(stack["ex1"], stack["ex2"], stack["others"])
+}
+
+func (c *current) onExistsFunction1(not, subQuery any) (any, error) {
+	functionType := parsers.FunctionCallExists
+	if not != nil {
+		functionType = parsers.FunctionCallNotExists
+	}
+
+	return createFunctionCall(functionType, []interface{}{parsers.SelectItem{
+		Type:  parsers.SelectItemTypeSubQuery,
+		Value: subQuery,
+	}})
+}
+
+func (p *parser) callonExistsFunction1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onInFunction1(stack["ex1"], stack["ex2"], stack["others"])
+	return p.cur.onExistsFunction1(stack["not"], stack["subQuery"])
 }
 
 func (c *current) onAvgAggregateExpression1(ex any) (any, error) {
@@ -8312,74 +10977,185 @@ func (p *parser) callonInteger1() (any, error) {
 	return p.cur.onInteger1()
 }
 
-func (c *current) onStringCharacter2() (any, error) {
+func (c *current) onDoubleQuotedStringCharacter2() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonDoubleQuotedStringCharacter2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleQuotedStringCharacter2()
+}
+
+func (c *current) onDoubleQuotedStringCharacter9(seq any) (any, error) {
+	return seq, nil
+}
+
+func (p *parser) callonDoubleQuotedStringCharacter9() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleQuotedStringCharacter9(stack["seq"])
+}
+
+func (c *current) onSingleQuotedStringCharacter2() (any, error) {
+	return "'", nil
+}
+
+func (p *parser) callonSingleQuotedStringCharacter2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuotedStringCharacter2()
+}
+
+func (c *current) onSingleQuotedStringCharacter4() (any, error) {
 	return string(c.text), nil
 }
 
-func (p *parser) callonStringCharacter2() (any, error) {
+func (p *parser) callonSingleQuotedStringCharacter4() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onStringCharacter2()
+	return p.cur.onSingleQuotedStringCharacter4()
 }
 
-func (c *current) onStringCharacter9(seq any) (any, error) {
+func (c *current) onSingleQuotedStringCharacter11(seq any) (any, error) {
 	return seq, nil
 }
 
-func (p *parser) callonStringCharacter9() (any, error) {
+func (p *parser) callonSingleQuotedStringCharacter11() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuotedStringCharacter11(stack["seq"])
+}
+
+func (c *current) onEscapeSequence9(quad any) (any, error) {
+	return quad, nil
+}
+
+func (p *parser) callonEscapeSequence9() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEscapeSequence9(stack["quad"])
+}
+
+func (c *current) onEscapeSequence2(high, low any) (any, error) {
+	return decodeUnicodeEscape(high.(rune), low), nil
+}
+
+func (p *parser) callonEscapeSequence2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEscapeSequence2(stack["high"], stack["low"])
+}
+
+func (c *current) onEscapeSequence14(char any) (any, error) {
+	return char, nil
+}
+
+func (p *parser) callonEscapeSequence14() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEscapeSequence14(stack["char"])
+}
+
+func (c *current) onEscapeCharacter2() (any, error) {
+	return "'", nil
+}
+
+func (p *parser) callonEscapeCharacter2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEscapeCharacter2()
+}
+
+func (c *current) onEscapeCharacter4() (any, error) {
+	return "\"", nil
+}
+
+func (p *parser) callonEscapeCharacter4() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEscapeCharacter4()
+}
+
+func (c *current) onEscapeCharacter6() (any, error) {
+	return "\\", nil
+}
+
+func (p *parser) callonEscapeCharacter6() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEscapeCharacter6()
+}
+
+func (c *current) onEscapeCharacter8() (any, error) {
+	return "/", nil
+}
+
+func (p *parser) callonEscapeCharacter8() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onStringCharacter9(stack["seq"])
+	return p.cur.onEscapeCharacter8()
 }
 
-func (c *current) onEscapeCharacter5() (any, error) {
+func (c *current) onEscapeCharacter10() (any, error) {
 	return "\b", nil
 }
 
-func (p *parser) callonEscapeCharacter5() (any, error) {
+func (p *parser) callonEscapeCharacter10() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEscapeCharacter5()
+	return p.cur.onEscapeCharacter10()
 }
 
-func (c *current) onEscapeCharacter7() (any, error) {
+func (c *current) onEscapeCharacter12() (any, error) {
 	return "\f", nil
 }
 
-func (p *parser) callonEscapeCharacter7() (any, error) {
+func (p *parser) callonEscapeCharacter12() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEscapeCharacter7()
+	return p.cur.onEscapeCharacter12()
 }
 
-func (c *current) onEscapeCharacter9() (any, error) {
+func (c *current) onEscapeCharacter14() (any, error) {
 	return "\n", nil
 }
 
-func (p *parser) callonEscapeCharacter9() (any, error) {
+func (p *parser) callonEscapeCharacter14() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEscapeCharacter9()
+	return p.cur.onEscapeCharacter14()
 }
 
-func (c *current) onEscapeCharacter11() (any, error) {
+func (c *current) onEscapeCharacter16() (any, error) {
 	return "\r", nil
 }
 
-func (p *parser) callonEscapeCharacter11() (any, error) {
+func (p *parser) callonEscapeCharacter16() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEscapeCharacter11()
+	return p.cur.onEscapeCharacter16()
 }
 
-func (c *current) onEscapeCharacter13() (any, error) {
+func (c *current) onEscapeCharacter18() (any, error) {
 	return "\t", nil
 }
 
-func (p *parser) callonEscapeCharacter13() (any, error) {
+func (p *parser) callonEscapeCharacter18() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEscapeCharacter18()
+}
+
+func (c *current) onHexQuad1() (any, error) {
+	code, _ := strconv.ParseUint(string(c.text), 16, 32)
+	return rune(code), nil
+}
+
+func (p *parser) callonHexQuad1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onEscapeCharacter13()
+	return p.cur.onHexQuad1()
 }
 
 func (c *current) onnon_escape_character1(char any) (any, error) {
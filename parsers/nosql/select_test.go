@@ -22,6 +22,21 @@ func Test_Parse_Select(t *testing.T) {
 		)
 	})
 
+	t.Run("Should parse SELECT with bracket property access", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c["first name"], c['a.b'], c.items[0][ "x \"y\"" ] FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "first name"}},
+					{Path: []string{"c", "a.b"}},
+					{Path: []string{"c", "items", "0", "x \"y\""}},
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+		)
+	})
+
 	t.Run("Should parse SELECT DISTINCT", func(t *testing.T) {
 		testQueryParse(
 			t,
@@ -36,6 +51,20 @@ func Test_Parse_Select(t *testing.T) {
 		)
 	})
 
+	t.Run("Should parse SELECT DISTINCT VALUE", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT DISTINCT VALUE c.category FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "category"}, IsTopLevel: true},
+				},
+				Table:    parsers.Table{Value: "c"},
+				Distinct: true,
+			},
+		)
+	})
+
 	t.Run("Should parse SELECT TOP", func(t *testing.T) {
 		testQueryParse(
 			t,
@@ -91,6 +120,19 @@ func Test_Parse_Select(t *testing.T) {
 		)
 	})
 
+	t.Run("Should parse SELECT * with a custom table alias", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT * FROM root`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"root"}, IsTopLevel: true},
+				},
+				Table: parsers.Table{Value: "root"},
+			},
+		)
+	})
+
 	t.Run("Should parse SELECT array", func(t *testing.T) {
 		testQueryParse(
 			t,
@@ -0,0 +1,115 @@
+package nosql_test
+
+import (
+	"testing"
+
+	"github.com/pikami/cosmium/parsers"
+)
+
+func Test_Parse_SpatialFunctions(t *testing.T) {
+
+	t.Run("Should parse function ST_DISTANCE()", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT ST_DISTANCE(c.location, {"type": "Point", "coordinates": [-122.4, 37.7]}) FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Type: parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallSpatialDistance,
+							Arguments: []interface{}{
+								parsers.SelectItem{
+									Path: []string{"c", "location"},
+									Type: parsers.SelectItemTypeField,
+								},
+								parsers.SelectItem{
+									Type: parsers.SelectItemTypeObject,
+									SelectItems: []parsers.SelectItem{
+										{
+											Alias: "type",
+											Type:  parsers.SelectItemTypeConstant,
+											Value: parsers.Constant{Type: parsers.ConstantTypeString, Value: "Point"},
+										},
+										{
+											Alias: "coordinates",
+											Type:  parsers.SelectItemTypeArray,
+											SelectItems: []parsers.SelectItem{
+												{
+													Type:  parsers.SelectItemTypeConstant,
+													Value: parsers.Constant{Type: parsers.ConstantTypeFloat, Value: -122.4},
+												},
+												{
+													Type:  parsers.SelectItemTypeConstant,
+													Value: parsers.Constant{Type: parsers.ConstantTypeFloat, Value: 37.7},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+		)
+	})
+
+	t.Run("Should parse function ST_WITHIN()", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.id FROM c WHERE ST_WITHIN(c.location, @polygon)`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.SelectItem{
+					Type: parsers.SelectItemTypeFunctionCall,
+					Value: parsers.FunctionCall{
+						Type: parsers.FunctionCallSpatialWithin,
+						Arguments: []interface{}{
+							parsers.SelectItem{
+								Path: []string{"c", "location"},
+								Type: parsers.SelectItemTypeField,
+							},
+							parsers.SelectItem{
+								Type:  parsers.SelectItemTypeConstant,
+								Value: parsers.Constant{Type: parsers.ConstantTypeParameterConstant, Value: "@polygon"},
+							},
+						},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("Should parse function ST_INTERSECTS()", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT ST_INTERSECTS(c.area, @polygon) FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Type: parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallSpatialIntersects,
+							Arguments: []interface{}{
+								parsers.SelectItem{
+									Path: []string{"c", "area"},
+									Type: parsers.SelectItemTypeField,
+								},
+								parsers.SelectItem{
+									Type:  parsers.SelectItemTypeConstant,
+									Value: parsers.Constant{Type: parsers.ConstantTypeParameterConstant, Value: "@polygon"},
+								},
+							},
+						},
+					},
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+		)
+	})
+}
@@ -1,6 +1,7 @@
 package nosql_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/pikami/cosmium/parsers"
@@ -31,6 +32,51 @@ func Test_Parse_Were(t *testing.T) {
 		)
 	})
 
+	t.Run("Should parse SELECT with array indexes in WHERE condition", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.tags[0], c.tags[-1] FROM c WHERE c.matrix[1][2] > 5`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "tags", "0"}},
+					{Path: []string{"c", "tags", "-1"}},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.ComparisonExpression{
+					Operation: ">",
+					Left:      parsers.SelectItem{Path: []string{"c", "matrix", "1", "2"}},
+					Right: parsers.SelectItem{
+						Type:  parsers.SelectItemTypeConstant,
+						Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 5},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("Should parse SELECT with every comparison operator", func(t *testing.T) {
+		for _, operator := range []string{"=", "!=", "<", "<=", ">", ">="} {
+			testQueryParse(
+				t,
+				fmt.Sprintf(`SELECT c.id FROM c WHERE c._ts %s @since`, operator),
+				parsers.SelectStmt{
+					SelectItems: []parsers.SelectItem{
+						{Path: []string{"c", "id"}},
+					},
+					Table: parsers.Table{Value: "c"},
+					Filters: parsers.ComparisonExpression{
+						Operation: operator,
+						Left:      parsers.SelectItem{Path: []string{"c", "_ts"}},
+						Right: parsers.SelectItem{
+							Type:  parsers.SelectItemTypeConstant,
+							Value: parsers.Constant{Type: parsers.ConstantTypeParameterConstant, Value: "@since"},
+						},
+					},
+				},
+			)
+		}
+	})
+
 	t.Run("Should parse SELECT with multiple WHERE conditions", func(t *testing.T) {
 		testQueryParse(
 			t,
@@ -180,4 +226,180 @@ func Test_Parse_Were(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("Should parse negative number literals", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.id FROM c WHERE c.integer=-1 AND c.float=-6.9`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{{Path: []string{"c", "id"}}},
+				Table:       parsers.Table{Value: "c"},
+				Filters: parsers.LogicalExpression{
+					Expressions: []interface{}{
+						parsers.ComparisonExpression{
+							Left: parsers.SelectItem{Path: []string{"c", "integer"}},
+							Right: parsers.SelectItem{
+								Type:  parsers.SelectItemTypeConstant,
+								Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: -1},
+							},
+							Operation: "=",
+						},
+						parsers.ComparisonExpression{
+							Left: parsers.SelectItem{Path: []string{"c", "float"}},
+							Right: parsers.SelectItem{
+								Type:  parsers.SelectItemTypeConstant,
+								Value: parsers.Constant{Type: parsers.ConstantTypeFloat, Value: -6.9},
+							},
+							Operation: "=",
+						},
+					},
+					Operation: parsers.LogicalExpressionTypeAnd,
+				},
+			},
+		)
+	})
+
+	t.Run("Should parse string literals with escape sequences", func(t *testing.T) {
+		for query, expectedValue := range map[string]string{
+			`'O''Brien'`:               "O'Brien",
+			`'O\'Brien'`:               "O'Brien",
+			`"say \"hi\""`:             `say "hi"`,
+			`"C:\\temp\/file"`:         `C:\temp/file`,
+			`"tab\tnew\nline"`:         "tab\tnew\nline",
+			`"caf\u00e9 \ud83d\ude00"`: "café 😀",
+			`'\u0041\u0042'`:           "AB",
+		} {
+			testQueryParse(
+				t,
+				fmt.Sprintf(`SELECT c.id FROM c WHERE c.name = %s`, query),
+				parsers.SelectStmt{
+					SelectItems: []parsers.SelectItem{
+						{Path: []string{"c", "id"}},
+					},
+					Table: parsers.Table{Value: "c"},
+					Filters: parsers.ComparisonExpression{
+						Operation: "=",
+						Left:      parsers.SelectItem{Path: []string{"c", "name"}},
+						Right: parsers.SelectItem{
+							Type:  parsers.SelectItemTypeConstant,
+							Value: parsers.Constant{Type: parsers.ConstantTypeString, Value: expectedValue},
+						},
+					},
+				},
+			)
+		}
+	})
+
+	t.Run("Should parse LIKE, NOT LIKE and ESCAPE", func(t *testing.T) {
+		stringConstant := func(value string) parsers.SelectItem {
+			return parsers.SelectItem{
+				Type:  parsers.SelectItemTypeConstant,
+				Value: parsers.Constant{Type: parsers.ConstantTypeString, Value: value},
+			}
+		}
+
+		testQueryParse(
+			t,
+			`SELECT c.id FROM c WHERE c.name LIKE "A%son" AND c.code not like 'x!_%' ESCAPE '!'`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.LogicalExpression{
+					Operation: parsers.LogicalExpressionTypeAnd,
+					Expressions: []interface{}{
+						parsers.LikeExpression{
+							Left:    parsers.SelectItem{Path: []string{"c", "name"}},
+							Pattern: stringConstant("A%son"),
+						},
+						parsers.LikeExpression{
+							Left:    parsers.SelectItem{Path: []string{"c", "code"}},
+							Pattern: stringConstant("x!_%"),
+							Escape:  stringConstant("!"),
+							Not:     true,
+						},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("Should parse NOT with higher precedence than AND and OR", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.id FROM c WHERE NOT IS_DEFINED(c.deletedAt) AND not c.archived = true OR NOT NOT c.pinned`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.LogicalExpression{
+					Operation: parsers.LogicalExpressionTypeOr,
+					Expressions: []interface{}{
+						parsers.LogicalExpression{
+							Operation: parsers.LogicalExpressionTypeAnd,
+							Expressions: []interface{}{
+								parsers.LogicalExpression{
+									Operation: parsers.LogicalExpressionTypeNot,
+									Expressions: []interface{}{
+										parsers.SelectItem{
+											Type: parsers.SelectItemTypeFunctionCall,
+											Value: parsers.FunctionCall{
+												Type: parsers.FunctionCallIsDefined,
+												Arguments: []interface{}{
+													parsers.SelectItem{
+														Path: []string{"c", "deletedAt"},
+														Type: parsers.SelectItemTypeField,
+													},
+												},
+											},
+										},
+									},
+								},
+								parsers.LogicalExpression{
+									Operation: parsers.LogicalExpressionTypeNot,
+									Expressions: []interface{}{
+										parsers.ComparisonExpression{
+											Operation: "=",
+											Left:      parsers.SelectItem{Path: []string{"c", "archived"}},
+											Right: parsers.SelectItem{
+												Type:  parsers.SelectItemTypeConstant,
+												Value: parsers.Constant{Type: parsers.ConstantTypeBoolean, Value: true},
+											},
+										},
+									},
+								},
+							},
+						},
+						parsers.LogicalExpression{
+							Operation: parsers.LogicalExpressionTypeNot,
+							Expressions: []interface{}{
+								parsers.LogicalExpression{
+									Operation: parsers.LogicalExpressionTypeNot,
+									Expressions: []interface{}{
+										parsers.SelectItem{Path: []string{"c", "pinned"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("Should parse fields starting with not", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.id FROM c WHERE notes`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table:   parsers.Table{Value: "c"},
+				Filters: parsers.SelectItem{Path: []string{"notes"}},
+			},
+		)
+	})
 }
@@ -13,7 +13,7 @@ func (c memoryExecutorContext) aggregate_Avg(arguments []interface{}, row RowTyp
 
 	if array, isArray := row.([]RowWithJoins); isArray {
 		for _, item := range array {
-			value := c.getFieldValue(selectExpression, item)
+			value := normalizeNumber(c.getFieldValue(selectExpression, item))
 			if numericValue, ok := value.(float64); ok {
 				sum += numericValue
 				count++
@@ -38,7 +38,7 @@ func (c memoryExecutorContext) aggregate_Count(arguments []interface{}, row RowT
 	if array, isArray := row.([]RowWithJoins); isArray {
 		for _, item := range array {
 			value := c.getFieldValue(selectExpression, item)
-			if value != nil {
+			if !isUndefined(value) {
 				count++
 			}
 		}
@@ -54,7 +54,7 @@ func (c memoryExecutorContext) aggregate_Max(arguments []interface{}, row RowTyp
 
 	if array, isArray := row.([]RowWithJoins); isArray {
 		for _, item := range array {
-			value := c.getFieldValue(selectExpression, item)
+			value := normalizeNumber(c.getFieldValue(selectExpression, item))
 			if numericValue, ok := value.(float64); ok {
 				if numericValue > max {
 					max = numericValue
@@ -83,7 +83,7 @@ func (c memoryExecutorContext) aggregate_Min(arguments []interface{}, row RowTyp
 
 	if array, isArray := row.([]RowWithJoins); isArray {
 		for _, item := range array {
-			value := c.getFieldValue(selectExpression, item)
+			value := normalizeNumber(c.getFieldValue(selectExpression, item))
 			if numericValue, ok := value.(float64); ok {
 				if numericValue < min {
 					min = numericValue
@@ -112,7 +112,7 @@ func (c memoryExecutorContext) aggregate_Sum(arguments []interface{}, row RowTyp
 
 	if array, isArray := row.([]RowWithJoins); isArray {
 		for _, item := range array {
-			value := c.getFieldValue(selectExpression, item)
+			value := normalizeNumber(c.getFieldValue(selectExpression, item))
 			if numericValue, ok := value.(float64); ok {
 				sum += numericValue
 				count++
@@ -109,6 +109,32 @@ func Test_Execute_AggregateFunctions(t *testing.T) {
 		)
 	})
 
+	t.Run("Should execute function COUNT() on an empty set", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Type:       parsers.SelectItemTypeFunctionCall,
+						IsTopLevel: true,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallAggregateCount,
+							Arguments: []interface{}{
+								parsers.SelectItem{
+									Type:  parsers.SelectItemTypeConstant,
+									Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 1},
+								},
+							},
+						},
+					},
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+			[]memoryexecutor.RowType{},
+			[]memoryexecutor.RowType{0},
+		)
+	})
+
 	t.Run("Should execute function MAX()", func(t *testing.T) {
 		testQueryExecute(
 			t,
@@ -2,6 +2,7 @@ package memoryexecutor
 
 import (
 	"reflect"
+	"slices"
 
 	"github.com/pikami/cosmium/internal/logger"
 	"github.com/pikami/cosmium/parsers"
@@ -16,6 +17,100 @@ func (c memoryExecutorContext) array_Concat(arguments []interface{}, row RowType
 	return result
 }
 
+// Returns whether the array contains the value, with partial matching
+// object values match elements that contain all of their properties
+func (c memoryExecutorContext) array_Contains(arguments []interface{}, row RowType) interface{} {
+	arrValue := reflect.ValueOf(c.getFieldValue(arguments[0].(parsers.SelectItem), row))
+	if arrValue.Kind() != reflect.Slice {
+		logger.Debug("array_Contains - got parameters of wrong type")
+		return undefined
+	}
+
+	value := c.getFieldValue(arguments[1].(parsers.SelectItem), row)
+
+	partialMatch := false
+	if arguments[2] != nil {
+		var isBool bool
+		if partialMatch, isBool = c.getFieldValue(arguments[2].(parsers.SelectItem), row).(bool); !isBool {
+			logger.Debug("array_Contains - got partial match parameter of wrong type")
+			return undefined
+		}
+	}
+
+	for i := 0; i < arrValue.Len(); i++ {
+		element := arrValue.Index(i).Interface()
+		if partialMatch && isPartialMatch(element, value) || isDeepEqual(element, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Checks whether the array holds at least one of the values
+func (c memoryExecutorContext) array_ContainsAny(arguments []interface{}, row RowType) interface{} {
+	arrValue := reflect.ValueOf(c.getFieldValue(arguments[0].(parsers.SelectItem), row))
+	if arrValue.Kind() != reflect.Slice {
+		logger.Debug("array_ContainsAny - got parameters of wrong type")
+		return undefined
+	}
+
+	for _, argument := range arguments[1:] {
+		if containsDeepEqual(arrValue, c.getFieldValue(argument.(parsers.SelectItem), row)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Checks whether the array holds every one of the values
+func (c memoryExecutorContext) array_ContainsAll(arguments []interface{}, row RowType) interface{} {
+	arrValue := reflect.ValueOf(c.getFieldValue(arguments[0].(parsers.SelectItem), row))
+	if arrValue.Kind() != reflect.Slice {
+		logger.Debug("array_ContainsAll - got parameters of wrong type")
+		return undefined
+	}
+
+	for _, argument := range arguments[1:] {
+		if !containsDeepEqual(arrValue, c.getFieldValue(argument.(parsers.SelectItem), row)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsDeepEqual(arrValue reflect.Value, value interface{}) bool {
+	for i := 0; i < arrValue.Len(); i++ {
+		if isDeepEqual(arrValue.Index(i).Interface(), value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isPartialMatch(element interface{}, value interface{}) bool {
+	elementObject, isObject := element.(map[string]interface{})
+	if !isObject {
+		return false
+	}
+
+	valueObject, isObject := value.(map[string]interface{})
+	if !isObject {
+		return false
+	}
+
+	for key, expected := range valueObject {
+		if actual, found := elementObject[key]; !found || !isDeepEqual(actual, expected) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (c memoryExecutorContext) array_Length(arguments []interface{}, row RowType) int {
 	array := c.parseArray(arguments[0], row)
 	if array == nil {
@@ -65,22 +160,19 @@ func (c memoryExecutorContext) array_Slice(arguments []interface{}, row RowType)
 	return array[start:end]
 }
 
-func (c memoryExecutorContext) set_Intersect(arguments []interface{}, row RowType) []interface{} {
+// Returns the distinct items of the first array that are also in the second one,
+// in the order of the first array. Items are compared by their values, so objects
+// and arrays can be intersected too
+func (c memoryExecutorContext) set_Intersect(arguments []interface{}, row RowType) interface{} {
 	set1 := c.parseArray(arguments[0], row)
 	set2 := c.parseArray(arguments[1], row)
-
-	intersection := make(map[interface{}]struct{})
 	if set1 == nil || set2 == nil {
-		return []interface{}{}
+		return undefined
 	}
 
+	result := make([]interface{}, 0)
 	for _, item := range set1 {
-		intersection[item] = struct{}{}
-	}
-
-	var result []interface{}
-	for _, item := range set2 {
-		if _, exists := intersection[item]; exists {
+		if containsValue(set2, item) && !containsValue(result, item) {
 			result = append(result, item)
 		}
 	}
@@ -88,22 +180,17 @@ func (c memoryExecutorContext) set_Intersect(arguments []interface{}, row RowTyp
 	return result
 }
 
-func (c memoryExecutorContext) set_Union(arguments []interface{}, row RowType) []interface{} {
+// Returns the distinct items of both arrays, the items of the first array come first
+func (c memoryExecutorContext) set_Union(arguments []interface{}, row RowType) interface{} {
 	set1 := c.parseArray(arguments[0], row)
 	set2 := c.parseArray(arguments[1], row)
-
-	var result []interface{}
-	union := make(map[interface{}]struct{})
-	for _, item := range set1 {
-		if _, ok := union[item]; !ok {
-			union[item] = struct{}{}
-			result = append(result, item)
-		}
+	if set1 == nil || set2 == nil {
+		return undefined
 	}
 
-	for _, item := range set2 {
-		if _, ok := union[item]; !ok {
-			union[item] = struct{}{}
+	result := make([]interface{}, 0, len(set1)+len(set2))
+	for _, item := range append(set1, set2...) {
+		if !containsValue(result, item) {
 			result = append(result, item)
 		}
 	}
@@ -111,6 +198,12 @@ func (c memoryExecutorContext) set_Union(arguments []interface{}, row RowType) [
 	return result
 }
 
+func containsValue(items []interface{}, value interface{}) bool {
+	return slices.ContainsFunc(items, func(item interface{}) bool {
+		return isDeepEqual(item, value)
+	})
+}
+
 func (c memoryExecutorContext) parseArray(argument interface{}, row RowType) []interface{} {
 	exItem := argument.(parsers.SelectItem)
 	ex := c.getFieldValue(exItem, row)
@@ -52,6 +52,220 @@ func Test_Execute_ArrayFunctions(t *testing.T) {
 		)
 	})
 
+	t.Run("Should execute function ARRAY_CONTAINS() with an array parameter", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Path: []string{"c", "id"},
+						Type: parsers.SelectItemTypeField,
+					},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.SelectItem{
+					Type: parsers.SelectItemTypeFunctionCall,
+					Value: parsers.FunctionCall{
+						Type: parsers.FunctionCallArrayContains,
+						Arguments: []interface{}{
+							parsers.SelectItem{
+								Type:  parsers.SelectItemTypeConstant,
+								Value: parsers.Constant{Type: parsers.ConstantTypeParameterConstant, Value: "@ids"},
+							},
+							parsers.SelectItem{
+								Path: []string{"c", "id"},
+								Type: parsers.SelectItemTypeField,
+							},
+							nil,
+						},
+					},
+				},
+				Parameters: map[string]interface{}{
+					"@ids": []interface{}{"123", "789"},
+				},
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "123"},
+				map[string]interface{}{"id": "789"},
+			},
+		)
+	})
+
+	t.Run("Should execute function ARRAY_CONTAINS() with partial match", func(t *testing.T) {
+		objectData := []memoryexecutor.RowType{
+			map[string]interface{}{"id": "1", "tags": []interface{}{map[string]interface{}{"name": "a", "weight": 1.0}}},
+			map[string]interface{}{"id": "2", "tags": []interface{}{map[string]interface{}{"name": "b", "weight": 2.0}}},
+		}
+
+		arrayContains := func(alias string, partialMatch bool) parsers.SelectItem {
+			return parsers.SelectItem{
+				Alias: alias,
+				Type:  parsers.SelectItemTypeFunctionCall,
+				Value: parsers.FunctionCall{
+					Type: parsers.FunctionCallArrayContains,
+					Arguments: []interface{}{
+						parsers.SelectItem{
+							Path: []string{"c", "tags"},
+							Type: parsers.SelectItemTypeField,
+						},
+						parsers.SelectItem{
+							Type:  parsers.SelectItemTypeConstant,
+							Value: parsers.Constant{Type: parsers.ConstantTypeParameterConstant, Value: "@tag"},
+						},
+						parsers.SelectItem{
+							Type:  parsers.SelectItemTypeConstant,
+							Value: parsers.Constant{Type: parsers.ConstantTypeBoolean, Value: partialMatch},
+						},
+					},
+				},
+			}
+		}
+
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Path: []string{"c", "id"},
+						Type: parsers.SelectItemTypeField,
+					},
+					arrayContains("Contains", false),
+					arrayContains("Partial", true),
+				},
+				Table: parsers.Table{Value: "c"},
+				Parameters: map[string]interface{}{
+					"@tag": map[string]interface{}{"name": "a"},
+				},
+			},
+			objectData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "Contains": false, "Partial": true},
+				map[string]interface{}{"id": "2", "Contains": false, "Partial": false},
+			},
+		)
+	})
+
+	t.Run("Should execute function ARRAY_CONTAINS_ANY()", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Path: []string{"c", "id"},
+						Type: parsers.SelectItemTypeField,
+					},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.SelectItem{
+					Type: parsers.SelectItemTypeFunctionCall,
+					Value: parsers.FunctionCall{
+						Type: parsers.FunctionCallArrayContainsAny,
+						Arguments: []interface{}{
+							parsers.SelectItem{
+								Path: []string{"c", "arr1"},
+								Type: parsers.SelectItemTypeField,
+							},
+							parsers.SelectItem{
+								Type:  parsers.SelectItemTypeConstant,
+								Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 1},
+							},
+							parsers.SelectItem{
+								Type:  parsers.SelectItemTypeConstant,
+								Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 4},
+							},
+							parsers.SelectItem{
+								Type:  parsers.SelectItemTypeConstant,
+								Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 10},
+							},
+						},
+					},
+				},
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "123"},
+				map[string]interface{}{"id": "456"},
+			},
+		)
+	})
+
+	t.Run("Should execute function ARRAY_CONTAINS_ALL()", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Path: []string{"c", "id"},
+						Type: parsers.SelectItemTypeField,
+					},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.SelectItem{
+					Type: parsers.SelectItemTypeFunctionCall,
+					Value: parsers.FunctionCall{
+						Type: parsers.FunctionCallArrayContainsAll,
+						Arguments: []interface{}{
+							parsers.SelectItem{
+								Path: []string{"c", "arr2"},
+								Type: parsers.SelectItemTypeField,
+							},
+							parsers.SelectItem{
+								Type:  parsers.SelectItemTypeConstant,
+								Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 7},
+							},
+							parsers.SelectItem{
+								Type:  parsers.SelectItemTypeConstant,
+								Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 8},
+							},
+						},
+					},
+				},
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "456"},
+				map[string]interface{}{"id": "789"},
+			},
+		)
+	})
+
+	t.Run("Should return undefined from ARRAY_CONTAINS_ANY() for non-array values", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Path: []string{"c", "id"},
+						Type: parsers.SelectItemTypeField,
+					},
+					{
+						Alias: "ContainsAny",
+						Type:  parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallArrayContainsAny,
+							Arguments: []interface{}{
+								parsers.SelectItem{
+									Path: []string{"c", "id"},
+									Type: parsers.SelectItemTypeField,
+								},
+								parsers.SelectItem{
+									Type:  parsers.SelectItemTypeConstant,
+									Value: parsers.Constant{Type: parsers.ConstantTypeString, Value: "123"},
+								},
+							},
+						},
+					},
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+			mockData[:1],
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "123"},
+			},
+		)
+	})
+
 	t.Run("Should execute function ARRAY_LENGTH()", func(t *testing.T) {
 		testQueryExecute(
 			t,
@@ -209,4 +423,87 @@ func Test_Execute_ArrayFunctions(t *testing.T) {
 			},
 		)
 	})
+
+	setMockData := []memoryexecutor.RowType{
+		map[string]interface{}{
+			"id": "1",
+			"a":  []interface{}{2, 1, 2, map[string]interface{}{"x": 1}, []interface{}{1, 2}},
+			"b":  []interface{}{[]interface{}{1, 2}, 3, 1, 1, map[string]interface{}{"x": 1}},
+		},
+		map[string]interface{}{"id": "2", "a": "not an array", "b": []interface{}{1}},
+	}
+
+	t.Run("Should execute function SET_INTERSECT() with duplicates and objects", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Path: []string{"c", "id"},
+						Type: parsers.SelectItemTypeField,
+					},
+					{
+						Alias: "Intersection",
+						Type:  parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallSetIntersect,
+							Arguments: []interface{}{
+								parsers.SelectItem{
+									Path: []string{"c", "a"},
+									Type: parsers.SelectItemTypeField,
+								},
+								parsers.SelectItem{
+									Path: []string{"c", "b"},
+									Type: parsers.SelectItemTypeField,
+								},
+							},
+						},
+					},
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+			setMockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "Intersection": []interface{}{1, map[string]interface{}{"x": 1}, []interface{}{1, 2}}},
+				map[string]interface{}{"id": "2"},
+			},
+		)
+	})
+
+	t.Run("Should execute function SET_UNION() with duplicates and objects", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Path: []string{"c", "id"},
+						Type: parsers.SelectItemTypeField,
+					},
+					{
+						Alias: "Union",
+						Type:  parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallSetUnion,
+							Arguments: []interface{}{
+								parsers.SelectItem{
+									Path: []string{"c", "a"},
+									Type: parsers.SelectItemTypeField,
+								},
+								parsers.SelectItem{
+									Path: []string{"c", "b"},
+									Type: parsers.SelectItemTypeField,
+								},
+							},
+						},
+					},
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+			setMockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "Union": []interface{}{2, 1, map[string]interface{}{"x": 1}, []interface{}{1, 2}, 3}},
+				map[string]interface{}{"id": "2"},
+			},
+		)
+	})
 }
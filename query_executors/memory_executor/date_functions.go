@@ -0,0 +1,108 @@
+package memoryexecutor
+
+import (
+	"math"
+	"time"
+
+	"github.com/pikami/cosmium/internal/logger"
+	"github.com/pikami/cosmium/parsers"
+)
+
+// Date and time values are UTC ISO 8601 strings with seven fractional digits,
+// ticks are 100 nanosecond intervals and timestamps are milliseconds, both
+// counted from the Unix epoch
+const (
+	dateTimeFormat      = "2006-01-02T15:04:05.0000000Z"
+	dateTimeParseFormat = "2006-01-02T15:04:05Z"
+	ticksPerSecond      = 10_000_000
+	nanosecondsPerTick  = 100
+)
+
+var minDateTime = time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
+var maxDateTime = time.Date(9999, time.December, 31, 23, 59, 59, 999_999_900, time.UTC)
+
+func (c memoryExecutorContext) date_GetCurrentTicks() interface{} {
+	return int(dateTimeToTicks(time.Now().UTC()))
+}
+
+func (c memoryExecutorContext) date_DateTimeToTicks(arguments []interface{}, row RowType) interface{} {
+	dateTime, ok := c.parseDateTimeArgument(arguments[0], row)
+	if !ok {
+		return undefined
+	}
+
+	return int(dateTimeToTicks(dateTime))
+}
+
+func (c memoryExecutorContext) date_DateTimeToTimestamp(arguments []interface{}, row RowType) interface{} {
+	dateTime, ok := c.parseDateTimeArgument(arguments[0], row)
+	if !ok {
+		return undefined
+	}
+
+	return int(dateTime.UnixMilli())
+}
+
+func (c memoryExecutorContext) date_TicksToDateTime(arguments []interface{}, row RowType) interface{} {
+	ticks, ok := toInt64(c.getFieldValue(arguments[0].(parsers.SelectItem), row))
+	if !ok {
+		logger.Debug("date_TicksToDateTime - got parameters of wrong type")
+		return undefined
+	}
+
+	seconds, remainder := ticks/ticksPerSecond, ticks%ticksPerSecond
+	if remainder < 0 {
+		seconds, remainder = seconds-1, remainder+ticksPerSecond
+	}
+
+	return formatDateTime(time.Unix(seconds, remainder*nanosecondsPerTick))
+}
+
+func (c memoryExecutorContext) date_TimestampToDateTime(arguments []interface{}, row RowType) interface{} {
+	timestamp, ok := toInt64(c.getFieldValue(arguments[0].(parsers.SelectItem), row))
+	if !ok {
+		logger.Debug("date_TimestampToDateTime - got parameters of wrong type")
+		return undefined
+	}
+
+	return formatDateTime(time.UnixMilli(timestamp))
+}
+
+func (c memoryExecutorContext) parseDateTimeArgument(argument interface{}, row RowType) (time.Time, bool) {
+	value, ok := c.getFieldValue(argument.(parsers.SelectItem), row).(string)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	dateTime, err := time.Parse(dateTimeParseFormat, value)
+	return dateTime, err == nil
+}
+
+func dateTimeToTicks(dateTime time.Time) int64 {
+	return dateTime.Unix()*ticksPerSecond + int64(dateTime.Nanosecond()/nanosecondsPerTick)
+}
+
+// Values outside of the years 1 to 9999 yield undefined
+func formatDateTime(dateTime time.Time) interface{} {
+	dateTime = dateTime.UTC()
+	if dateTime.Before(minDateTime) || dateTime.After(maxDateTime) {
+		return undefined
+	}
+
+	return dateTime.Format(dateTimeFormat)
+}
+
+// Fractional values are truncated, values out of the int64 range are rejected
+func toInt64(value interface{}) (int64, bool) {
+	switch typedValue := normalizeNumber(value).(type) {
+	case int:
+		return int64(typedValue), true
+	case float64:
+		if math.IsNaN(typedValue) || typedValue < math.MinInt64 || typedValue >= math.MaxInt64 {
+			return 0, false
+		}
+		return int64(typedValue), true
+	}
+
+	return 0, false
+}
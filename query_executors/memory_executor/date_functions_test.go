@@ -0,0 +1,123 @@
+package memoryexecutor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pikami/cosmium/parsers"
+	memoryexecutor "github.com/pikami/cosmium/query_executors/memory_executor"
+)
+
+func Test_Execute_DateTimeFunctions(t *testing.T) {
+	mockData := []memoryexecutor.RowType{
+		map[string]interface{}{"id": "1", "dateTime": "2020-07-09T23:20:13.4575530Z", "timestamp": 1594336813457, "ticks": 15943368134575530},
+		map[string]interface{}{"id": "2", "dateTime": "1970-01-01T00:00:00Z", "timestamp": 0.0, "ticks": -1},
+		map[string]interface{}{"id": "3", "dateTime": "2020-07-09", "timestamp": 253402300800000, "ticks": "0"},
+		map[string]interface{}{"id": "4", "dateTime": 123, "timestamp": -62135596800001, "ticks": 1e300},
+	}
+
+	functionCall := func(alias string, functionType parsers.FunctionCallType, field string) parsers.SelectItem {
+		return parsers.SelectItem{
+			Alias: alias,
+			Type:  parsers.SelectItemTypeFunctionCall,
+			Value: parsers.FunctionCall{
+				Type: functionType,
+				Arguments: []interface{}{
+					parsers.SelectItem{
+						Path: []string{"c", field},
+						Type: parsers.SelectItemTypeField,
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("Should convert date times to ticks and timestamps", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+					functionCall("ticks", parsers.FunctionCallDateTimeToTicks, "dateTime"),
+					functionCall("timestamp", parsers.FunctionCallDateTimeToTimestamp, "dateTime"),
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "ticks": 15943368134575530, "timestamp": 1594336813457},
+				map[string]interface{}{"id": "2", "ticks": 0, "timestamp": 0},
+				map[string]interface{}{"id": "3"},
+				map[string]interface{}{"id": "4"},
+			},
+		)
+	})
+
+	t.Run("Should convert ticks and timestamps to date times", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+					functionCall("fromTicks", parsers.FunctionCallTicksToDateTime, "ticks"),
+					functionCall("fromTimestamp", parsers.FunctionCallTimestampToDateTime, "timestamp"),
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "fromTicks": "2020-07-09T23:20:13.4575530Z", "fromTimestamp": "2020-07-09T23:20:13.4570000Z"},
+				map[string]interface{}{"id": "2", "fromTicks": "1969-12-31T23:59:59.9999999Z", "fromTimestamp": "1970-01-01T00:00:00.0000000Z"},
+				map[string]interface{}{"id": "3"},
+				map[string]interface{}{"id": "4"},
+			},
+		)
+	})
+
+	t.Run("Should filter by converted timestamps", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.ComparisonExpression{
+					Operation: ">",
+					Left:      functionCall("", parsers.FunctionCallDateTimeToTimestamp, "dateTime"),
+					Right: parsers.SelectItem{
+						Type:  parsers.SelectItemTypeConstant,
+						Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 0},
+					},
+				},
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1"},
+			},
+		)
+	})
+
+	t.Run("Should return the current ticks", func(t *testing.T) {
+		before := time.Now().UnixNano() / 100
+		result := memoryexecutor.Execute(
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Alias: "ticks",
+						Type:  parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{Type: parsers.FunctionCallGetCurrentTicks, Arguments: []interface{}{}},
+					},
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+			mockData[:1],
+		)
+		after := time.Now().UnixNano() / 100
+
+		ticks := int64(result[0].(map[string]interface{})["ticks"].(int))
+		if ticks < before || ticks > after {
+			t.Errorf("expected ticks between %d and %d, got %d", before, after, ticks)
+		}
+	})
+}
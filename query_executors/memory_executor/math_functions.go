@@ -1,7 +1,9 @@
 package memoryexecutor
 
 import (
+	"encoding/json"
 	"math"
+	"math/big"
 	"math/rand"
 
 	"github.com/pikami/cosmium/internal/logger"
@@ -12,7 +14,7 @@ func (c memoryExecutorContext) math_Abs(arguments []interface{}, row RowType) in
 	exItem := arguments[0].(parsers.SelectItem)
 	ex := c.getFieldValue(exItem, row)
 
-	switch val := ex.(type) {
+	switch val := normalizeNumber(ex).(type) {
 	case float64:
 		return math.Abs(val)
 	case int:
@@ -79,7 +81,7 @@ func (c memoryExecutorContext) math_Ceiling(arguments []interface{}, row RowType
 	exItem := arguments[0].(parsers.SelectItem)
 	ex := c.getFieldValue(exItem, row)
 
-	switch val := ex.(type) {
+	switch val := normalizeNumber(ex).(type) {
 	case float64:
 		return math.Ceil(val)
 	case int:
@@ -151,7 +153,7 @@ func (c memoryExecutorContext) math_Floor(arguments []interface{}, row RowType)
 	exItem := arguments[0].(parsers.SelectItem)
 	ex := c.getFieldValue(exItem, row)
 
-	switch val := ex.(type) {
+	switch val := normalizeNumber(ex).(type) {
 	case float64:
 		return math.Floor(val)
 	case int:
@@ -166,7 +168,7 @@ func (c memoryExecutorContext) math_IntBitNot(arguments []interface{}, row RowTy
 	exItem := arguments[0].(parsers.SelectItem)
 	ex := c.getFieldValue(exItem, row)
 
-	switch val := ex.(type) {
+	switch val := normalizeNumber(ex).(type) {
 	case int:
 		return ^val
 	default:
@@ -210,7 +212,7 @@ func (c memoryExecutorContext) math_Round(arguments []interface{}, row RowType)
 	exItem := arguments[0].(parsers.SelectItem)
 	ex := c.getFieldValue(exItem, row)
 
-	switch val := ex.(type) {
+	switch val := normalizeNumber(ex).(type) {
 	case float64:
 		return math.Round(val)
 	case int:
@@ -225,7 +227,7 @@ func (c memoryExecutorContext) math_Sign(arguments []interface{}, row RowType) i
 	exItem := arguments[0].(parsers.SelectItem)
 	ex := c.getFieldValue(exItem, row)
 
-	switch val := ex.(type) {
+	switch val := normalizeNumber(ex).(type) {
 	case float64:
 		if val > 0 {
 			return 1
@@ -304,7 +306,7 @@ func (c memoryExecutorContext) math_Trunc(arguments []interface{}, row RowType)
 	exItem := arguments[0].(parsers.SelectItem)
 	ex := c.getFieldValue(exItem, row)
 
-	switch val := ex.(type) {
+	switch val := normalizeNumber(ex).(type) {
 	case float64:
 		return math.Trunc(val)
 	case int:
@@ -592,8 +594,68 @@ func (c memoryExecutorContext) math_Rand() interface{} {
 	return rand.Float64()
 }
 
+// Non-numeric operands and divisions by zero yield undefined. Integer operands
+// give integers, except for divisions that don't come out even
+func (c memoryExecutorContext) math_BinaryExpression(expression parsers.BinaryExpression, row interface{}) interface{} {
+	left := c.getFieldValue(expression.Left, row)
+	right := c.getFieldValue(expression.Right, row)
+
+	leftInt, leftIsInt := left.(int)
+	rightInt, rightIsInt := right.(int)
+	if leftIsInt && rightIsInt {
+		switch expression.Operation {
+		case "+":
+			return leftInt + rightInt
+		case "-":
+			return leftInt - rightInt
+		case "*":
+			return leftInt * rightInt
+		case "/":
+			if rightInt == 0 {
+				return undefined
+			}
+			if leftInt%rightInt == 0 {
+				return leftInt / rightInt
+			}
+		case "%":
+			if rightInt == 0 {
+				return undefined
+			}
+			return leftInt % rightInt
+		}
+	}
+
+	leftNumber, leftIsNumber := numToFloat64(left)
+	rightNumber, rightIsNumber := numToFloat64(right)
+	if !leftIsNumber || !rightIsNumber {
+		logger.Debug("math_BinaryExpression - operands must be numeric values")
+		return undefined
+	}
+
+	switch expression.Operation {
+	case "+":
+		return leftNumber + rightNumber
+	case "-":
+		return leftNumber - rightNumber
+	case "*":
+		return leftNumber * rightNumber
+	case "/":
+		if rightNumber == 0 {
+			return undefined
+		}
+		return leftNumber / rightNumber
+	case "%":
+		if rightNumber == 0 {
+			return undefined
+		}
+		return math.Mod(leftNumber, rightNumber)
+	}
+
+	return undefined
+}
+
 func numToInt(ex interface{}) (int, bool) {
-	switch val := ex.(type) {
+	switch val := normalizeNumber(ex).(type) {
 	case float64:
 		return int(val), true
 	case int:
@@ -609,7 +671,40 @@ func numToFloat64(num interface{}) (float64, bool) {
 		return val, true
 	case int:
 		return float64(val), true
+	case json.Number:
+		return normalizeNumber(val).(float64), true
 	default:
 		return 0, false
 	}
 }
+
+// Numbers that don't fit an int or float64 are stored as json.Number, functions
+// compute with their closest float while projections return every digit
+func normalizeNumber(value interface{}) interface{} {
+	if number, ok := value.(json.Number); ok {
+		// Out of range values parse to an infinity
+		float, _ := number.Float64()
+		return float
+	}
+
+	return value
+}
+
+// Converts numbers to their exact value, so json.Number values
+// can be compared without rounding them through float64
+func numToBigFloat(num interface{}) (*big.Float, bool) {
+	switch val := num.(type) {
+	case json.Number:
+		float, _, err := big.ParseFloat(string(val), 10, 256, big.ToNearestEven)
+		return float, err == nil
+	case float64:
+		if math.IsNaN(val) {
+			return nil, false
+		}
+		return new(big.Float).SetFloat64(val), true
+	case int:
+		return new(big.Float).SetInt64(int64(val)), true
+	default:
+		return nil, false
+	}
+}
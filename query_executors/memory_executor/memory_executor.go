@@ -1,8 +1,11 @@
 package memoryexecutor
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -16,17 +19,74 @@ type RowType interface{}
 type RowWithJoins map[string]RowType
 type ExpressionType interface{}
 
+// Value of properties that don't exist in the row, kept apart
+// from nil which stands for an explicit JSON null
+type undefinedValue struct{}
+
+var undefined = undefinedValue{}
+
+func isUndefined(value interface{}) bool {
+	_, ok := value.(undefinedValue)
+	return ok
+}
+
 type memoryExecutorContext struct {
 	parameters map[string]interface{}
 }
 
 func Execute(query parsers.SelectStmt, data []RowType) []RowType {
+	result, _ := ExecuteContext(context.Background(), query, data)
+	return result
+}
+
+// Executes the query like Execute, but stops evaluating rows and
+// returns the context error once the context is cancelled or expires
+func ExecuteContext(cancelCtx context.Context, query parsers.SelectStmt, data []RowType) ([]RowType, error) {
+	result, _, err := ExecuteContextWithStats(cancelCtx, query, data)
+	return result, err
+}
+
+// Numbers of rows a query went through, for tracing its execution
+type QueryStats struct {
+	// Rows the query was executed on
+	ScannedRows int
+	// Rows left after the joins and the WHERE clause, before they were grouped or limited
+	MatchedRows int
+}
+
+// Executes the query like ExecuteContext and counts the rows it went through
+func ExecuteContextWithStats(cancelCtx context.Context, query parsers.SelectStmt, data []RowType) ([]RowType, QueryStats, error) {
 	ctx := memoryExecutorContext{
 		parameters: query.Parameters,
 	}
+	stats := QueryStats{ScannedRows: len(data)}
+
+	// Subqueries in the FROM clause are materialized first, their results are the rows of the query
+	if query.Table.SubQuery != nil {
+		subQuery := *query.Table.SubQuery
+		if subQuery.Parameters == nil {
+			subQuery.Parameters = query.Parameters
+		}
+
+		// The OFFSET of top level queries is skipped by the SDKs, the rows of
+		// subqueries are skipped here, after the limit was extended to cover them
+		if subQuery.Offset > 0 && subQuery.Count > 0 {
+			subQuery.Count += subQuery.Offset
+		}
+
+		var err error
+		if data, err = ExecuteContext(cancelCtx, subQuery, data); err != nil {
+			return nil, QueryStats{}, err
+		}
+		data = data[min(subQuery.Offset, len(data)):]
+	}
 
 	joinedRows := make([]RowWithJoins, 0)
 	for _, row := range data {
+		if err := cancelCtx.Err(); err != nil {
+			return nil, QueryStats{}, err
+		}
+
 		// Perform joins
 		dataTables := map[string][]RowType{}
 
@@ -42,9 +102,7 @@ func Execute(query parsers.SelectStmt, data []RowType) []RowType {
 		}
 
 		// Generate flat rows
-		flatRows := []RowWithJoins{
-			{query.Table.Value: row},
-		}
+		flatRows := ctx.bindTableRows(query.Table, row)
 		for joinedTableName, joinedTable := range dataTables {
 			flatRows = zipRows(flatRows, joinedTableName, joinedTable)
 		}
@@ -52,6 +110,10 @@ func Execute(query parsers.SelectStmt, data []RowType) []RowType {
 		// Apply filters
 		filteredRows := []RowWithJoins{}
 		for _, rowWithJoins := range flatRows {
+			if err := cancelCtx.Err(); err != nil {
+				return nil, QueryStats{}, err
+			}
+
 			if ctx.evaluateFilters(query.Filters, rowWithJoins) {
 				filteredRows = append(filteredRows, rowWithJoins)
 			}
@@ -60,6 +122,12 @@ func Execute(query parsers.SelectStmt, data []RowType) []RowType {
 		joinedRows = append(joinedRows, filteredRows...)
 	}
 
+	if err := cancelCtx.Err(); err != nil {
+		return nil, QueryStats{}, err
+	}
+
+	stats.MatchedRows = len(joinedRows)
+
 	// Apply order
 	if query.OrderExpressions != nil && len(query.OrderExpressions) > 0 {
 		ctx.orderBy(query.OrderExpressions, joinedRows)
@@ -82,7 +150,14 @@ func Execute(query parsers.SelectStmt, data []RowType) []RowType {
 			selectedData = append(selectedData, ctx.selectRow(query.SelectItems, joinedRows))
 		} else {
 			for _, row := range joinedRows {
-				selectedData = append(selectedData, ctx.selectRow(query.SelectItems, row))
+				if err := cancelCtx.Err(); err != nil {
+					return nil, QueryStats{}, err
+				}
+
+				// Rows selecting an undefined value are left out of the result
+				if selectedRow := ctx.selectRow(query.SelectItems, row); !isUndefined(selectedRow) {
+					selectedData = append(selectedData, selectedRow)
+				}
 			}
 		}
 
@@ -105,7 +180,48 @@ func Execute(query parsers.SelectStmt, data []RowType) []RowType {
 		result = result[:count]
 	}
 
-	return result
+	return result, stats, nil
+}
+
+func (c memoryExecutorContext) evaluateLike(expression parsers.LikeExpression, row RowWithJoins) (bool, bool) {
+	value, isString := c.getExpressionParameterValue(expression.Left, row).(string)
+	if !isString {
+		return false, false
+	}
+
+	pattern, isString := c.getExpressionParameterValue(expression.Pattern, row).(string)
+	if !isString {
+		return false, false
+	}
+
+	var escape []rune
+	if expression.Escape != nil {
+		escapeValue, isString := c.getExpressionParameterValue(expression.Escape, row).(string)
+		if escape = []rune(escapeValue); !isString || len(escape) != 1 {
+			return false, false
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("^(?s:")
+	patternRunes := []rune(pattern)
+	for i := 0; i < len(patternRunes); i++ {
+		switch r := patternRunes[i]; {
+		case len(escape) == 1 && r == escape[0] && i+1 < len(patternRunes):
+			i++
+			sb.WriteString(regexp.QuoteMeta(string(patternRunes[i])))
+		case r == '%':
+			sb.WriteString(".*")
+		case r == '_':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString(")$")
+
+	matched, err := regexp.MatchString(sb.String(), value)
+	return matched, err == nil
 }
 
 func (c memoryExecutorContext) selectRow(selectItems []parsers.SelectItem, row interface{}) interface{} {
@@ -126,22 +242,38 @@ func (c memoryExecutorContext) selectRow(selectItems []parsers.SelectItem, row i
 			}
 		}
 
-		newRow[destinationName] = c.getFieldValue(column, row)
+		if value := c.getFieldValue(column, row); !isUndefined(value) {
+			newRow[destinationName] = value
+		}
 	}
 
 	return newRow
 }
 
+// Rows only match when the condition is true, conditions evaluating to false or undefined exclude them
 func (c memoryExecutorContext) evaluateFilters(expr ExpressionType, row RowWithJoins) bool {
 	if expr == nil {
 		return true
 	}
 
+	result, ok := c.evaluateCondition(expr, row).(bool)
+	return ok && result
+}
+
+// Evaluates the condition with the three-valued logic of Cosmos DB, the result
+// is either a boolean or undefined. Non-boolean operands of AND, OR and NOT
+// are undefined, which AND and OR only propagate when they can't short circuit
+func (c memoryExecutorContext) evaluateCondition(expr ExpressionType, row RowWithJoins) interface{} {
 	switch typedValue := expr.(type) {
 	case parsers.ComparisonExpression:
 		leftValue := c.getExpressionParameterValue(typedValue.Left, row)
 		rightValue := c.getExpressionParameterValue(typedValue.Right, row)
 
+		// Values of different types are ordered by their type
+		if isUndefined(leftValue) || isUndefined(rightValue) {
+			return undefined
+		}
+
 		cmp := compareValues(leftValue, rightValue)
 		switch typedValue.Operation {
 		case "=":
@@ -157,47 +289,53 @@ func (c memoryExecutorContext) evaluateFilters(expr ExpressionType, row RowWithJ
 		case ">=":
 			return cmp >= 0
 		}
+	case parsers.LikeExpression:
+		matched, ok := c.evaluateLike(typedValue, row)
+		if !ok {
+			return undefined
+		}
+		return matched != typedValue.Not
 	case parsers.LogicalExpression:
-		var result bool
-		for i, expression := range typedValue.Expressions {
-			expressionResult := c.evaluateFilters(expression, row)
-			if i == 0 {
-				result = expressionResult
+		switch typedValue.Operation {
+		case parsers.LogicalExpressionTypeNot:
+			if value, ok := c.evaluateCondition(typedValue.Expressions[0], row).(bool); ok {
+				return !value
 			}
-
-			switch typedValue.Operation {
-			case parsers.LogicalExpressionTypeAnd:
-				result = result && expressionResult
-				if !result {
-					return false
-				}
-			case parsers.LogicalExpressionTypeOr:
-				result = result || expressionResult
-				if result {
-					return true
+			return undefined
+		case parsers.LogicalExpressionTypeAnd, parsers.LogicalExpressionTypeOr:
+			// AND short circuits on false, OR on true
+			shortCircuitValue := typedValue.Operation == parsers.LogicalExpressionTypeOr
+			var result interface{} = !shortCircuitValue
+			for _, expression := range typedValue.Expressions {
+				value, ok := c.evaluateCondition(expression, row).(bool)
+				if !ok {
+					result = undefined
+				} else if value == shortCircuitValue {
+					return shortCircuitValue
 				}
 			}
+			return result
 		}
-		return result
 	case parsers.Constant:
 		if value, ok := typedValue.Value.(bool); ok {
 			return value
 		}
-		return false
 	case parsers.SelectItem:
-		resolvedValue := c.getFieldValue(typedValue, row)
-		if value, ok := resolvedValue.(bool); ok {
+		if value, ok := c.getFieldValue(typedValue, row).(bool); ok {
 			return value
 		}
 	}
-	return false
+
+	return undefined
 }
 
 func (c memoryExecutorContext) getFieldValue(field parsers.SelectItem, row interface{}) interface{} {
 	if field.Type == parsers.SelectItemTypeArray {
 		arrayValue := make([]interface{}, 0)
 		for _, selectItem := range field.SelectItems {
-			arrayValue = append(arrayValue, c.getFieldValue(selectItem, row))
+			if value := c.getFieldValue(selectItem, row); !isUndefined(value) {
+				arrayValue = append(arrayValue, value)
+			}
 		}
 		return arrayValue
 	}
@@ -205,7 +343,9 @@ func (c memoryExecutorContext) getFieldValue(field parsers.SelectItem, row inter
 	if field.Type == parsers.SelectItemTypeObject {
 		objectValue := make(map[string]interface{})
 		for _, selectItem := range field.SelectItems {
-			objectValue[selectItem.Alias] = c.getFieldValue(selectItem, row)
+			if value := c.getFieldValue(selectItem, row); !isUndefined(value) {
+				objectValue[selectItem.Alias] = value
+			}
 		}
 		return objectValue
 	}
@@ -218,20 +358,42 @@ func (c memoryExecutorContext) getFieldValue(field parsers.SelectItem, row inter
 			logger.Error("parsers.Constant has incorrect Value type")
 		}
 
-		if typedValue.Type == parsers.ConstantTypeParameterConstant &&
-			c.parameters != nil {
-			if key, ok := typedValue.Value.(string); ok {
-				return c.parameters[key]
+		// Parameters may hold any JSON value, referencing a missing one yields undefined
+		if typedValue.Type == parsers.ConstantTypeParameterConstant {
+			key, _ := typedValue.Value.(string)
+			if value, ok := c.parameters[key]; ok {
+				return value
 			}
+			return undefined
 		}
 
 		return typedValue.Value
 	}
 
+	// Operands are evaluated against the row as it is, so they may be aggregates
+	if field.Type == parsers.SelectItemTypeBinaryExpression {
+		typedValue, _ := field.Value.(parsers.BinaryExpression)
+		return c.math_BinaryExpression(typedValue, row)
+	}
+
 	rowValue := row
 	// Used for aggregates
 	if array, isArray := row.([]RowWithJoins); isArray {
-		rowValue = array[0]
+		// Aggregating an empty set leaves nothing to read plain fields from
+		if len(array) == 0 {
+			rowValue = RowWithJoins{}
+		} else {
+			rowValue = array[0]
+		}
+	}
+
+	// Scalar subqueries yield their single value, or undefined when they yield no rows
+	if field.Type == parsers.SelectItemTypeSubQuery {
+		results := c.executeSubQuery(field.Value.(parsers.SelectStmt), rowValue)
+		if len(results) == 0 {
+			return undefined
+		}
+		return results[0]
 	}
 
 	if field.Type == parsers.SelectItemTypeFunctionCall {
@@ -251,6 +413,8 @@ func (c memoryExecutorContext) getFieldValue(field parsers.SelectItem, row inter
 			return c.strings_EndsWith(typedValue.Arguments, rowValue)
 		case parsers.FunctionCallStartsWith:
 			return c.strings_StartsWith(typedValue.Arguments, rowValue)
+		case parsers.FunctionCallRegexMatch:
+			return c.strings_RegexMatch(typedValue.Arguments, rowValue)
 		case parsers.FunctionCallConcat:
 			return c.strings_Concat(typedValue.Arguments, rowValue)
 		case parsers.FunctionCallIndexOf:
@@ -303,6 +467,12 @@ func (c memoryExecutorContext) getFieldValue(field parsers.SelectItem, row inter
 		case parsers.FunctionCallIsString:
 			return c.typeChecking_IsString(typedValue.Arguments, rowValue)
 
+		case parsers.FunctionCallArrayContains:
+			return c.array_Contains(typedValue.Arguments, rowValue)
+		case parsers.FunctionCallArrayContainsAny:
+			return c.array_ContainsAny(typedValue.Arguments, rowValue)
+		case parsers.FunctionCallArrayContainsAll:
+			return c.array_ContainsAll(typedValue.Arguments, rowValue)
 		case parsers.FunctionCallArrayConcat:
 			return c.array_Concat(typedValue.Arguments, rowValue)
 		case parsers.FunctionCallArrayLength:
@@ -398,8 +568,30 @@ func (c memoryExecutorContext) getFieldValue(field parsers.SelectItem, row inter
 		case parsers.FunctionCallAggregateSum:
 			return c.aggregate_Sum(typedValue.Arguments, row)
 
+		case parsers.FunctionCallSpatialDistance:
+			return c.spatial_Distance(typedValue.Arguments, rowValue)
+		case parsers.FunctionCallSpatialWithin:
+			return c.spatial_Within(typedValue.Arguments, rowValue)
+		case parsers.FunctionCallSpatialIntersects:
+			return c.spatial_Intersects(typedValue.Arguments, rowValue)
+
+		case parsers.FunctionCallDateTimeToTicks:
+			return c.date_DateTimeToTicks(typedValue.Arguments, rowValue)
+		case parsers.FunctionCallDateTimeToTimestamp:
+			return c.date_DateTimeToTimestamp(typedValue.Arguments, rowValue)
+		case parsers.FunctionCallGetCurrentTicks:
+			return c.date_GetCurrentTicks()
+		case parsers.FunctionCallTicksToDateTime:
+			return c.date_TicksToDateTime(typedValue.Arguments, rowValue)
+		case parsers.FunctionCallTimestampToDateTime:
+			return c.date_TimestampToDateTime(typedValue.Arguments, rowValue)
+
 		case parsers.FunctionCallIn:
 			return c.misc_In(typedValue.Arguments, rowValue)
+		case parsers.FunctionCallExists:
+			return c.misc_Exists(typedValue.Arguments, rowValue)
+		case parsers.FunctionCallNotExists:
+			return !c.misc_Exists(typedValue.Arguments, rowValue)
 		}
 	}
 
@@ -410,24 +602,42 @@ func (c memoryExecutorContext) getFieldValue(field parsers.SelectItem, row inter
 
 	if len(field.Path) > 1 {
 		for _, pathSegment := range field.Path[1:] {
+			var found bool
 
 			switch nestedValue := value.(type) {
 			case map[string]interface{}:
-				value = nestedValue[pathSegment]
+				value, found = nestedValue[pathSegment]
 			case RowWithJoins:
-				value = nestedValue[pathSegment]
-			case []int, []string, []interface{}:
-				slice := reflect.ValueOf(nestedValue)
-				if arrayIndex, err := strconv.Atoi(pathSegment); err == nil && slice.Len() > arrayIndex {
-					value = slice.Index(arrayIndex).Interface()
-				} else {
-					return nil
-				}
+				value, found = nestedValue[pathSegment]
 			default:
-				return nil
+				// Negative and out of range indexes yield undefined, they don't wrap around
+				if slice := reflect.ValueOf(nestedValue); slice.Kind() == reflect.Slice {
+					if arrayIndex, err := strconv.Atoi(pathSegment); err == nil && arrayIndex >= 0 && slice.Len() > arrayIndex {
+						value, found = slice.Index(arrayIndex).Interface(), true
+					}
+				}
+			}
+
+			if !found {
+				return undefined
 			}
 		}
 	}
+	return normalizeStoredNumber(value)
+}
+
+// Documents written by the server hold their "_ts" timestamp as an int64, while
+// documents decoded from JSON hold ints. Both are read as the same number
+func normalizeStoredNumber(value interface{}) interface{} {
+	switch number := value.(type) {
+	case int64:
+		return int(number)
+	case int32:
+		return int(number)
+	case float32:
+		return float64(number)
+	}
+
 	return value
 }
 
@@ -446,12 +656,23 @@ func (c memoryExecutorContext) getExpressionParameterValue(
 }
 
 func (c memoryExecutorContext) orderBy(orderBy []parsers.OrderExpression, data []RowWithJoins) {
-	less := func(i, j int) bool {
-		for _, order := range orderBy {
-			val1 := c.getFieldValue(order.SelectItem, data[i])
-			val2 := c.getFieldValue(order.SelectItem, data[j])
+	// Order expressions may hold subqueries, so they are evaluated once per row
+	type sortedRow struct {
+		row    RowWithJoins
+		values []interface{}
+	}
+	sortedRows := make([]sortedRow, len(data))
+	for i, row := range data {
+		values := make([]interface{}, len(orderBy))
+		for j, order := range orderBy {
+			values[j] = c.getFieldValue(order.SelectItem, row)
+		}
+		sortedRows[i] = sortedRow{row: row, values: values}
+	}
 
-			cmp := compareValues(val1, val2)
+	less := func(i, j int) bool {
+		for k, order := range orderBy {
+			cmp := compareValues(sortedRows[i].values[k], sortedRows[j].values[k])
 			if cmp != 0 {
 				if order.Direction == parsers.OrderDirectionDesc {
 					return cmp > 0
@@ -459,10 +680,41 @@ func (c memoryExecutorContext) orderBy(orderBy []parsers.OrderExpression, data [
 				return cmp < 0
 			}
 		}
-		return i < j
+		// Equal rows keep their input order
+		return false
 	}
 
-	sort.SliceStable(data, less)
+	sort.SliceStable(sortedRows, less)
+	for i, sorted := range sortedRows {
+		data[i] = sorted.row
+	}
+}
+
+// Compares two lists of ORDER BY items shaped as [{"item": value}], the way rows
+// are sorted by the given expressions. Items without a value stand for undefined
+func CompareOrderByItems(orderBy []parsers.OrderExpression, items1 []interface{}, items2 []interface{}) int {
+	getItem := func(items []interface{}, index int) interface{} {
+		if index < len(items) {
+			if item, ok := items[index].(map[string]interface{}); ok {
+				if value, found := item["item"]; found {
+					return value
+				}
+			}
+		}
+		return undefined
+	}
+
+	for i, order := range orderBy {
+		cmp := compareValues(getItem(items1, i), getItem(items2, i))
+		if cmp != 0 {
+			if order.Direction == parsers.OrderDirectionDesc {
+				return -cmp
+			}
+			return cmp
+		}
+	}
+
+	return 0
 }
 
 func (c memoryExecutorContext) groupBy(selectStmt parsers.SelectStmt, data []RowWithJoins) []RowType {
@@ -506,11 +758,54 @@ func (c memoryExecutorContext) aggregateGroup(selectStmt parsers.SelectStmt, gro
 	return aggregatedRow
 }
 
+// Orders values of different types like Cosmos DB does:
+// undefined, null, boolean, number, string, array, object
+func getTypeOrder(value interface{}) int {
+	switch value.(type) {
+	case undefinedValue:
+		return 0
+	case nil:
+		return 1
+	case bool:
+		return 2
+	case int, float64, json.Number:
+		return 3
+	case string:
+		return 4
+	case []interface{}, []int, []string:
+		return 5
+	default:
+		return 6
+	}
+}
+
 func compareValues(val1, val2 interface{}) int {
-	if reflect.TypeOf(val1) != reflect.TypeOf(val2) {
+	if typeOrder1, typeOrder2 := getTypeOrder(val1), getTypeOrder(val2); typeOrder1 != typeOrder2 {
+		if typeOrder1 < typeOrder2 {
+			return -1
+		}
 		return 1
 	}
 
+	if _, ok := val1.(json.Number); ok {
+		return compareExactNumbers(val1, val2)
+	}
+	if _, ok := val2.(json.Number); ok {
+		return compareExactNumbers(val1, val2)
+	}
+
+	// Numbers may mix integers and floats, compare them as floats
+	if int1, ok := val1.(int); ok {
+		if float2, ok := val2.(float64); ok {
+			return compareValues(float64(int1), float2)
+		}
+	}
+	if float1, ok := val1.(float64); ok {
+		if int2, ok := val2.(int); ok {
+			return compareValues(float1, float64(int2))
+		}
+	}
+
 	switch val1 := val1.(type) {
 	case int:
 		val2 := val2.(int)
@@ -540,6 +835,11 @@ func compareValues(val1, val2 interface{}) int {
 		} else {
 			return -1
 		}
+	case []interface{}, map[string]interface{}:
+		if isDeepEqual(val1, val2) {
+			return 0
+		}
+		return 1
 	// TODO: Add more types
 	default:
 		if reflect.DeepEqual(val1, val2) {
@@ -549,13 +849,23 @@ func compareValues(val1, val2 interface{}) int {
 	}
 }
 
+func compareExactNumbers(val1, val2 interface{}) int {
+	big1, ok1 := numToBigFloat(val1)
+	big2, ok2 := numToBigFloat(val2)
+	if !ok1 || !ok2 {
+		return compareValues(normalizeNumber(val1), normalizeNumber(val2))
+	}
+
+	return big1.Cmp(big2)
+}
+
 func deduplicate(slice []RowType) []RowType {
-	var result []RowType
+	result := make([]RowType, 0)
 
 	for i := 0; i < len(slice); i++ {
 		unique := true
 		for j := 0; j < len(result); j++ {
-			if compareValues(slice[i], result[j]) == 0 {
+			if isDeepEqual(slice[i], result[j]) {
 				unique = false
 				break
 			}
@@ -569,6 +879,37 @@ func deduplicate(slice []RowType) []RowType {
 	return result
 }
 
+// Compares arrays and objects element by element, so nested
+// numbers are equal regardless of being stored as int or float
+func isDeepEqual(val1, val2 interface{}) bool {
+	switch val1 := val1.(type) {
+	case []interface{}:
+		val2, ok := val2.([]interface{})
+		if !ok || len(val1) != len(val2) {
+			return false
+		}
+		for i := range val1 {
+			if !isDeepEqual(val1[i], val2[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		val2, ok := val2.(map[string]interface{})
+		if !ok || len(val1) != len(val2) {
+			return false
+		}
+		for key, value := range val1 {
+			if value2, found := val2[key]; !found || !isDeepEqual(value, value2) {
+				return false
+			}
+		}
+		return true
+	default:
+		return compareValues(val1, val2) == 0
+	}
+}
+
 func hasAggregateFunctions(selectItems []parsers.SelectItem) bool {
 	if selectItems == nil {
 		return false
@@ -589,6 +930,32 @@ func hasAggregateFunctions(selectItems []parsers.SelectItem) bool {
 	return false
 }
 
+// Binds the row to the alias of the table, or each item of the array the source of
+// "FROM p IN c.parents" yields. The rows of correlated subqueries are bound to the
+// aliases of the outer query, which stay accessible next to the items
+func (c memoryExecutorContext) bindTableRows(table parsers.Table, row RowType) []RowWithJoins {
+	if table.Source == nil {
+		return []RowWithJoins{{table.Value: row}}
+	}
+
+	items := reflect.ValueOf(c.getFieldValue(*table.Source, row))
+	if items.Kind() != reflect.Slice {
+		return []RowWithJoins{}
+	}
+
+	boundRows := make([]RowWithJoins, 0, items.Len())
+	for i := 0; i < items.Len(); i++ {
+		boundRow := RowWithJoins{}
+		if outerRow, ok := row.(RowWithJoins); ok {
+			boundRow = copyMap(outerRow)
+		}
+		boundRow[table.Value] = items.Index(i).Interface()
+		boundRows = append(boundRows, boundRow)
+	}
+
+	return boundRows
+}
+
 func zipRows(current []RowWithJoins, joinedTableName string, rowsToZip []RowType) []RowWithJoins {
 	resultMap := make([]RowWithJoins, 0)
 
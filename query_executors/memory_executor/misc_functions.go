@@ -7,6 +7,15 @@ import (
 func (c memoryExecutorContext) misc_In(arguments []interface{}, row RowType) bool {
 	value := c.getFieldValue(arguments[0].(parsers.SelectItem), row)
 
+	if subQueryItem := arguments[1].(parsers.SelectItem); subQueryItem.Type == parsers.SelectItemTypeSubQuery {
+		for _, compareValue := range c.executeSubQuery(subQueryItem.Value.(parsers.SelectStmt), row) {
+			if compareValues(value, compareValue) == 0 {
+				return true
+			}
+		}
+		return false
+	}
+
 	for i := 1; i < len(arguments); i++ {
 		compareValue := c.getFieldValue(arguments[i].(parsers.SelectItem), row)
 		if compareValues(value, compareValue) == 0 {
@@ -16,3 +25,20 @@ func (c memoryExecutorContext) misc_In(arguments []interface{}, row RowType) boo
 
 	return false
 }
+
+// EXISTS is true when the subquery evaluated against the row has any result
+func (c memoryExecutorContext) misc_Exists(arguments []interface{}, row RowType) bool {
+	subQueryItem := arguments[0].(parsers.SelectItem)
+
+	return len(c.executeSubQuery(subQueryItem.Value.(parsers.SelectStmt), row)) > 0
+}
+
+// Subqueries outside of the FROM clause are evaluated against the current row,
+// so they can refer to the aliases of the outer query
+func (c memoryExecutorContext) executeSubQuery(subQuery parsers.SelectStmt, row RowType) []RowType {
+	if subQuery.Parameters == nil {
+		subQuery.Parameters = c.parameters
+	}
+
+	return Execute(subQuery, []RowType{row})
+}
@@ -1,6 +1,7 @@
 package memoryexecutor_test
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -124,4 +125,489 @@ func Test_Execute(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("Should stop execution when the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		result, err := memoryexecutor.ExecuteContext(
+			ctx,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+			mockData,
+		)
+
+		if result != nil || err != context.Canceled {
+			t.Errorf("expected execution to be cancelled.\nGot: %+v, %v", result, err)
+		}
+	})
+
+	t.Run("Should count the scanned and matched rows", func(t *testing.T) {
+		result, stats, err := memoryexecutor.ExecuteContextWithStats(
+			context.Background(),
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.ComparisonExpression{
+					Operation: "=",
+					Left:      parsers.SelectItem{Path: []string{"c", "pk"}},
+					Right:     parsers.SelectItem{Type: parsers.SelectItemTypeConstant, Value: parsers.Constant{Value: 456}},
+				},
+				Count: 1,
+			},
+			mockData,
+		)
+
+		if err != nil || len(result) != 1 {
+			t.Errorf("expected a single row.\nGot: %+v, %v", result, err)
+		}
+
+		expectedStats := memoryexecutor.QueryStats{ScannedRows: len(mockData), MatchedRows: 3}
+		if stats != expectedStats {
+			t.Errorf("stats do not match.\nExpected: %+v\nGot: %+v", expectedStats, stats)
+		}
+	})
+}
+
+func Test_Execute_ComputedExpressions(t *testing.T) {
+	mockData := []memoryexecutor.RowType{
+		map[string]interface{}{"id": "a", "name": "Banana", "price": 2, "quantity": 5},
+		map[string]interface{}{"id": "b", "name": "apple", "price": 1.5, "quantity": 10},
+		map[string]interface{}{"id": "c", "name": "Cherry", "price": 4},
+		map[string]interface{}{"id": "d", "name": "date", "price": 3, "quantity": 2},
+	}
+
+	field := func(name string) parsers.SelectItem {
+		return parsers.SelectItem{Path: []string{"c", name}, Type: parsers.SelectItemTypeField}
+	}
+	constant := func(value interface{}) parsers.SelectItem {
+		return parsers.SelectItem{Type: parsers.SelectItemTypeConstant, Value: parsers.Constant{Value: value}}
+	}
+	binaryExpression := func(left parsers.SelectItem, operation string, right parsers.SelectItem) parsers.SelectItem {
+		return parsers.SelectItem{
+			Type:  parsers.SelectItemTypeBinaryExpression,
+			Value: parsers.BinaryExpression{Left: left, Right: right, Operation: operation},
+		}
+	}
+
+	t.Run("Should execute ORDER BY an arithmetic expression with undefined values first", func(t *testing.T) {
+		for _, testCase := range []struct {
+			direction   parsers.OrderDirection
+			expectedIds []string
+		}{
+			{parsers.OrderDirectionAsc, []string{"c", "d", "a", "b"}},
+			{parsers.OrderDirectionDesc, []string{"b", "a", "d", "c"}},
+		} {
+			expectedData := make([]memoryexecutor.RowType, 0)
+			for _, id := range testCase.expectedIds {
+				expectedData = append(expectedData, map[string]interface{}{"id": id})
+			}
+
+			testQueryExecute(
+				t,
+				parsers.SelectStmt{
+					SelectItems: []parsers.SelectItem{field("id")},
+					Table:       parsers.Table{Value: "c"},
+					OrderExpressions: []parsers.OrderExpression{
+						{
+							SelectItem: binaryExpression(field("price"), "*", field("quantity")),
+							Direction:  testCase.direction,
+						},
+					},
+				},
+				mockData,
+				expectedData,
+			)
+		}
+	})
+
+	t.Run("Should execute ORDER BY a function call", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{field("id")},
+				Table:       parsers.Table{Value: "c"},
+				OrderExpressions: []parsers.OrderExpression{
+					{
+						SelectItem: parsers.SelectItem{
+							Type: parsers.SelectItemTypeFunctionCall,
+							Value: parsers.FunctionCall{
+								Type:      parsers.FunctionCallLower,
+								Arguments: []interface{}{field("name")},
+							},
+						},
+						Direction: parsers.OrderDirectionAsc,
+					},
+				},
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "b"},
+				map[string]interface{}{"id": "a"},
+				map[string]interface{}{"id": "c"},
+				map[string]interface{}{"id": "d"},
+			},
+		)
+	})
+
+	t.Run("Should evaluate arithmetic operators", func(t *testing.T) {
+		selectItem := func(alias string, item parsers.SelectItem) parsers.SelectItem {
+			item.Alias = alias
+			return item
+		}
+
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					selectItem("sum", binaryExpression(constant(7), "+", constant(2))),
+					selectItem("difference", binaryExpression(constant(7), "-", constant(2.5))),
+					selectItem("product", binaryExpression(constant(7), "*", constant(2))),
+					selectItem("evenQuotient", binaryExpression(constant(8), "/", constant(2))),
+					selectItem("quotient", binaryExpression(constant(7), "/", constant(2))),
+					selectItem("remainder", binaryExpression(constant(7), "%", constant(2))),
+					selectItem("divisionByZero", binaryExpression(constant(7), "/", constant(0))),
+					selectItem("string", binaryExpression(constant("7"), "+", constant(2))),
+					selectItem("missing", binaryExpression(field("quantity"), "+", constant(2))),
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+			mockData[2:3],
+			[]memoryexecutor.RowType{
+				map[string]interface{}{
+					"sum":          9,
+					"difference":   4.5,
+					"product":      14,
+					"evenQuotient": 4,
+					"quotient":     3.5,
+					"remainder":    1,
+				},
+			},
+		)
+	})
+}
+
+func Test_Execute_SubQuery(t *testing.T) {
+	mockData := []memoryexecutor.RowType{
+		map[string]interface{}{"id": "1", "cat": "fruit"},
+		map[string]interface{}{"id": "2", "cat": "fruit"},
+		map[string]interface{}{"id": "3", "cat": "fruit"},
+		map[string]interface{}{"id": "4", "cat": "vegetable"},
+		map[string]interface{}{"id": "5", "cat": "nut"},
+		map[string]interface{}{"id": "6", "cat": "nut"},
+	}
+
+	groupedSubQuery := parsers.SelectStmt{
+		SelectItems: []parsers.SelectItem{
+			{Path: []string{"c", "cat"}, Alias: "cat"},
+			{
+				Alias: "n",
+				Type:  parsers.SelectItemTypeFunctionCall,
+				Value: parsers.FunctionCall{
+					Type: parsers.FunctionCallAggregateCount,
+					Arguments: []interface{}{
+						parsers.SelectItem{Type: parsers.SelectItemTypeConstant, Value: parsers.Constant{Value: 1}},
+					},
+				},
+			},
+		},
+		Table:   parsers.Table{Value: "c"},
+		GroupBy: []parsers.SelectItem{{Path: []string{"c", "cat"}}},
+	}
+
+	t.Run("Should filter grouped rows of a subquery", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"g"}, IsTopLevel: true},
+				},
+				Table: parsers.Table{Value: "g", SubQuery: &groupedSubQuery},
+				Filters: parsers.ComparisonExpression{
+					Operation: ">",
+					Left:      parsers.SelectItem{Path: []string{"g", "n"}},
+					Right:     parsers.SelectItem{Type: parsers.SelectItemTypeConstant, Value: parsers.Constant{Value: 1}},
+				},
+				OrderExpressions: []parsers.OrderExpression{
+					{SelectItem: parsers.SelectItem{Path: []string{"g", "cat"}}, Direction: parsers.OrderDirectionAsc},
+				},
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"cat": "fruit", "n": 3},
+				map[string]interface{}{"cat": "nut", "n": 2},
+			},
+		)
+	})
+
+	t.Run("Should pass parameters to the subquery", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"s", "id"}},
+				},
+				Table: parsers.Table{Value: "s", SubQuery: &parsers.SelectStmt{
+					SelectItems: []parsers.SelectItem{
+						{Path: []string{"c", "id"}, Alias: "id"},
+					},
+					Table: parsers.Table{Value: "c"},
+					Filters: parsers.ComparisonExpression{
+						Operation: "=",
+						Left:      parsers.SelectItem{Path: []string{"c", "cat"}},
+						Right: parsers.SelectItem{
+							Type:  parsers.SelectItemTypeConstant,
+							Value: parsers.Constant{Type: parsers.ConstantTypeParameterConstant, Value: "@cat"},
+						},
+					},
+				}},
+				Parameters: map[string]interface{}{"@cat": "nut"},
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "5"},
+				map[string]interface{}{"id": "6"},
+			},
+		)
+	})
+
+	t.Run("Should skip the offset of a subquery", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"s"}, IsTopLevel: true},
+				},
+				Table: parsers.Table{Value: "s", SubQuery: &parsers.SelectStmt{
+					SelectItems: []parsers.SelectItem{
+						{Path: []string{"c", "id"}, IsTopLevel: true},
+					},
+					Table:  parsers.Table{Value: "c"},
+					Offset: 1,
+					Count:  3,
+					OrderExpressions: []parsers.OrderExpression{
+						{SelectItem: parsers.SelectItem{Path: []string{"c", "id"}}, Direction: parsers.OrderDirectionAsc},
+					},
+				}},
+				OrderExpressions: []parsers.OrderExpression{
+					{SelectItem: parsers.SelectItem{Path: []string{"s"}}, Direction: parsers.OrderDirectionDesc},
+				},
+			},
+			mockData,
+			[]memoryexecutor.RowType{"4", "3", "2"},
+		)
+	})
+
+	familyData := []memoryexecutor.RowType{
+		map[string]interface{}{"id": "a", "parents": []interface{}{map[string]interface{}{"refId": "a"}}},
+		map[string]interface{}{"id": "b", "parents": []interface{}{map[string]interface{}{"refId": "x"}, map[string]interface{}{"refId": "b"}}},
+		map[string]interface{}{"id": "c", "parents": []interface{}{map[string]interface{}{"refId": "x"}}},
+		map[string]interface{}{"id": "d"},
+	}
+
+	t.Run("Should execute IN with a correlated subquery", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.SelectItem{
+					Type: parsers.SelectItemTypeFunctionCall,
+					Value: parsers.FunctionCall{
+						Type: parsers.FunctionCallIn,
+						Arguments: []interface{}{
+							parsers.SelectItem{Path: []string{"c", "id"}},
+							parsers.SelectItem{
+								Type: parsers.SelectItemTypeSubQuery,
+								Value: parsers.SelectStmt{
+									SelectItems: []parsers.SelectItem{
+										{Path: []string{"p", "refId"}, IsTopLevel: true},
+									},
+									Table: parsers.Table{
+										Value:  "p",
+										Source: &parsers.SelectItem{Path: []string{"c", "parents"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			familyData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "a"},
+				map[string]interface{}{"id": "b"},
+			},
+		)
+	})
+
+	t.Run("Should order by a correlated subquery", func(t *testing.T) {
+		countParents := parsers.SelectStmt{
+			SelectItems: []parsers.SelectItem{
+				{
+					Type: parsers.SelectItemTypeFunctionCall,
+					Value: parsers.FunctionCall{
+						Type: parsers.FunctionCallAggregateCount,
+						Arguments: []interface{}{
+							parsers.SelectItem{Type: parsers.SelectItemTypeConstant, Value: parsers.Constant{Value: 1}},
+						},
+					},
+					IsTopLevel: true,
+				},
+			},
+			Table: parsers.Table{
+				Value:  "p",
+				Source: &parsers.SelectItem{Path: []string{"c", "parents"}},
+			},
+		}
+
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}, IsTopLevel: true},
+				},
+				Table: parsers.Table{Value: "c"},
+				OrderExpressions: []parsers.OrderExpression{
+					{SelectItem: parsers.SelectItem{Type: parsers.SelectItemTypeSubQuery, Value: countParents}, Direction: parsers.OrderDirectionDesc},
+					{SelectItem: parsers.SelectItem{Path: []string{"c", "id"}}, Direction: parsers.OrderDirectionAsc},
+				},
+			},
+			familyData,
+			[]memoryexecutor.RowType{"b", "a", "c", "d"},
+		)
+	})
+
+	t.Run("Should order rows without subquery results first", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}, IsTopLevel: true},
+				},
+				Table: parsers.Table{Value: "c"},
+				OrderExpressions: []parsers.OrderExpression{
+					{
+						SelectItem: parsers.SelectItem{
+							Type: parsers.SelectItemTypeSubQuery,
+							Value: parsers.SelectStmt{
+								SelectItems: []parsers.SelectItem{
+									{Path: []string{"p", "refId"}, IsTopLevel: true},
+								},
+								Table: parsers.Table{
+									Value:  "p",
+									Source: &parsers.SelectItem{Path: []string{"c", "parents"}},
+								},
+							},
+						},
+						Direction: parsers.OrderDirectionAsc,
+					},
+				},
+			},
+			familyData,
+			[]memoryexecutor.RowType{"d", "a", "b", "c"},
+		)
+	})
+
+	parentXSubQuery := parsers.SelectItem{
+		Type: parsers.SelectItemTypeSubQuery,
+		Value: parsers.SelectStmt{
+			SelectItems: []parsers.SelectItem{
+				{Path: []string{"p"}, IsTopLevel: true},
+			},
+			Table: parsers.Table{
+				Value:  "p",
+				Source: &parsers.SelectItem{Path: []string{"c", "parents"}},
+			},
+			Filters: parsers.ComparisonExpression{
+				Operation: "=",
+				Left:      parsers.SelectItem{Path: []string{"p", "refId"}},
+				Right:     parsers.SelectItem{Type: parsers.SelectItemTypeConstant, Value: parsers.Constant{Value: "x"}},
+			},
+		},
+	}
+
+	t.Run("Should project EXISTS and NOT EXISTS of a correlated subquery", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+					{
+						Alias: "hasX",
+						Type:  parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type:      parsers.FunctionCallExists,
+							Arguments: []interface{}{parentXSubQuery},
+						},
+					},
+					{
+						Alias: "lacksX",
+						Type:  parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type:      parsers.FunctionCallNotExists,
+							Arguments: []interface{}{parentXSubQuery},
+						},
+					},
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+			familyData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "a", "hasX": false, "lacksX": true},
+				map[string]interface{}{"id": "b", "hasX": true, "lacksX": false},
+				map[string]interface{}{"id": "c", "hasX": true, "lacksX": false},
+				map[string]interface{}{"id": "d", "hasX": false, "lacksX": true},
+			},
+		)
+	})
+
+	t.Run("Should filter by NOT EXISTS", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}, IsTopLevel: true},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.LogicalExpression{
+					Operation: parsers.LogicalExpressionTypeNot,
+					Expressions: []interface{}{parsers.SelectItem{
+						Type: parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type:      parsers.FunctionCallExists,
+							Arguments: []interface{}{parentXSubQuery},
+						},
+					}},
+				},
+			},
+			familyData,
+			[]memoryexecutor.RowType{"a", "d"},
+		)
+	})
+
+	t.Run("Should select the items of an array source", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"p", "refId"}, IsTopLevel: true},
+				},
+				Table: parsers.Table{
+					Value:  "p",
+					Source: &parsers.SelectItem{Path: []string{"c", "parents"}},
+				},
+			},
+			familyData,
+			[]memoryexecutor.RowType{"a", "x", "b", "x"},
+		)
+	})
 }
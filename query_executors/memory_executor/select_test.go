@@ -35,6 +35,31 @@ func Test_Execute_Select(t *testing.T) {
 		)
 	})
 
+	t.Run("Should execute SELECT with literal keys and array indexes", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "first name"}},
+					{Path: []string{"c", "a.b"}},
+					{Path: []string{"c", "items", "1"}, Alias: "second"},
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{
+					"first name": "John",
+					"a.b":        1,
+					"a":          map[string]interface{}{"b": 2},
+					"items":      []interface{}{"x", "y"},
+				},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"first name": "John", "a.b": 1, "second": "y"},
+			},
+		)
+	})
+
 	t.Run("Should execute SELECT DISTINCT", func(t *testing.T) {
 		testQueryExecute(
 			t,
@@ -53,6 +78,62 @@ func Test_Execute_Select(t *testing.T) {
 		)
 	})
 
+	t.Run("Should execute SELECT DISTINCT VALUE", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "pk"}, IsTopLevel: true},
+				},
+				Table:    parsers.Table{Value: "c"},
+				Distinct: true,
+			},
+			mockData,
+			[]memoryexecutor.RowType{123, 456},
+		)
+	})
+
+	t.Run("Should execute SELECT DISTINCT VALUE with deep equality", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "category"}, IsTopLevel: true},
+				},
+				Table:    parsers.Table{Value: "c"},
+				Distinct: true,
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "category": map[string]interface{}{"name": "tools", "rank": 1}},
+				map[string]interface{}{"id": "2", "category": map[string]interface{}{"rank": 1.0, "name": "tools"}},
+				map[string]interface{}{"id": "3", "category": []interface{}{"tools", 1}},
+				map[string]interface{}{"id": "4", "category": []interface{}{"tools", 1.0}},
+				map[string]interface{}{"id": "5", "category": []interface{}{1, "tools"}},
+				map[string]interface{}{"id": "6"},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"name": "tools", "rank": 1},
+				[]interface{}{"tools", 1},
+				[]interface{}{1, "tools"},
+			},
+		)
+	})
+
+	t.Run("Should execute SELECT DISTINCT VALUE without results", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "nothing"}, IsTopLevel: true},
+				},
+				Table:    parsers.Table{Value: "c"},
+				Distinct: true,
+			},
+			mockData,
+			[]memoryexecutor.RowType{},
+		)
+	})
+
 	t.Run("Should execute SELECT TOP", func(t *testing.T) {
 		testQueryExecute(
 			t,
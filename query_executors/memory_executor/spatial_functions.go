@@ -0,0 +1,305 @@
+package memoryexecutor
+
+import (
+	"math"
+
+	"github.com/pikami/cosmium/internal/logger"
+	"github.com/pikami/cosmium/parsers"
+)
+
+const earthRadiusMeters = 6371000
+
+// Longitude and latitude of a GeoJSON position
+type geoPosition [2]float64
+
+// The first ring is the exterior of the polygon and the rest are holes.
+// A clockwise exterior ring stands for the region outside of it
+type geoPolygon struct {
+	rings    [][]geoPosition
+	inverted bool
+}
+
+// Returns the great-circle distance in meters between two GeoJSON points
+func (c memoryExecutorContext) spatial_Distance(arguments []interface{}, row RowType) interface{} {
+	ex1 := c.getFieldValue(arguments[0].(parsers.SelectItem), row)
+	ex2 := c.getFieldValue(arguments[1].(parsers.SelectItem), row)
+
+	point1, isPoint1 := getGeoJSONGeometry(ex1).(geoPosition)
+	point2, isPoint2 := getGeoJSONGeometry(ex2).(geoPosition)
+	if !isPoint1 || !isPoint2 {
+		logger.Debug("spatial_Distance - got parameters of wrong type")
+		return undefined
+	}
+
+	// Haversine formula
+	phi1 := point1[1] * math.Pi / 180
+	phi2 := point2[1] * math.Pi / 180
+	deltaPhi := (point2[1] - point1[1]) * math.Pi / 180
+	deltaLambda := (point2[0] - point1[0]) * math.Pi / 180
+
+	a := math.Sin(deltaPhi/2)*math.Sin(deltaPhi/2) +
+		math.Cos(phi1)*math.Cos(phi2)*math.Sin(deltaLambda/2)*math.Sin(deltaLambda/2)
+
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// Returns whether the first geometry lies within the second one,
+// points on the boundary of a polygon are not within it
+func (c memoryExecutorContext) spatial_Within(arguments []interface{}, row RowType) interface{} {
+	geometry1 := getGeoJSONGeometry(c.getFieldValue(arguments[0].(parsers.SelectItem), row))
+	geometry2 := getGeoJSONGeometry(c.getFieldValue(arguments[1].(parsers.SelectItem), row))
+	if geometry1 == nil || geometry2 == nil {
+		logger.Debug("spatial_Within - got parameters of wrong type")
+		return undefined
+	}
+
+	switch geometry1 := geometry1.(type) {
+	case geoPosition:
+		switch geometry2 := geometry2.(type) {
+		case geoPosition:
+			return geometry1 == geometry2
+		case geoPolygon:
+			return geometry2.locate(geometry1) > 0
+		}
+	case geoPolygon:
+		if geometry2, ok := geometry2.(geoPolygon); ok {
+			return geometry1.isWithin(geometry2)
+		}
+	}
+
+	return false
+}
+
+// Returns whether the geometries share at least one point
+func (c memoryExecutorContext) spatial_Intersects(arguments []interface{}, row RowType) interface{} {
+	geometry1 := getGeoJSONGeometry(c.getFieldValue(arguments[0].(parsers.SelectItem), row))
+	geometry2 := getGeoJSONGeometry(c.getFieldValue(arguments[1].(parsers.SelectItem), row))
+	if geometry1 == nil || geometry2 == nil {
+		logger.Debug("spatial_Intersects - got parameters of wrong type")
+		return undefined
+	}
+
+	switch geometry1 := geometry1.(type) {
+	case geoPosition:
+		switch geometry2 := geometry2.(type) {
+		case geoPosition:
+			return geometry1 == geometry2
+		case geoPolygon:
+			return geometry2.locate(geometry1) >= 0
+		}
+	case geoPolygon:
+		switch geometry2 := geometry2.(type) {
+		case geoPosition:
+			return geometry1.locate(geometry2) >= 0
+		case geoPolygon:
+			return geometry1.intersects(geometry2)
+		}
+	}
+
+	return false
+}
+
+// Returns 1 when the position is inside the polygon, 0 when it
+// is on its boundary and -1 when it is outside of the polygon
+func (polygon geoPolygon) locate(position geoPosition) int {
+	for _, ring := range polygon.rings {
+		for i := 1; i < len(ring); i++ {
+			if isOnSegment(position, ring[i-1], ring[i]) {
+				return 0
+			}
+		}
+	}
+
+	inside := isInRing(position, polygon.rings[0])
+	for _, hole := range polygon.rings[1:] {
+		if isInRing(position, hole) {
+			inside = false
+			break
+		}
+	}
+
+	if inside != polygon.inverted {
+		return 1
+	}
+	return -1
+}
+
+// A polygon is within another one when its boundary doesn't leave the other
+// polygon and the boundary of the other polygon doesn't enter its interior
+func (polygon geoPolygon) isWithin(other geoPolygon) bool {
+	for _, ring := range polygon.rings {
+		for _, position := range ring {
+			if other.locate(position) < 0 {
+				return false
+			}
+		}
+	}
+
+	for _, ring := range other.rings {
+		for _, position := range ring {
+			if polygon.locate(position) > 0 {
+				return false
+			}
+		}
+	}
+
+	return !polygon.hasEdgeIntersection(other, true)
+}
+
+func (polygon geoPolygon) intersects(other geoPolygon) bool {
+	if polygon.hasEdgeIntersection(other, false) {
+		return true
+	}
+
+	// Without crossing edges one polygon either contains the other or they are disjoint
+	return other.locate(polygon.rings[0][0]) >= 0 || polygon.locate(other.rings[0][0]) >= 0
+}
+
+// When proper is set, only edges crossing each other at a single interior point are counted
+func (polygon geoPolygon) hasEdgeIntersection(other geoPolygon, proper bool) bool {
+	for _, ring := range polygon.rings {
+		for i := 1; i < len(ring); i++ {
+			for _, otherRing := range other.rings {
+				for j := 1; j < len(otherRing); j++ {
+					if segmentsIntersect(ring[i-1], ring[i], otherRing[j-1], otherRing[j], proper) {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// Ray casting, positions on the boundary may be reported either way
+func isInRing(position geoPosition, ring []geoPosition) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		if (ring[i][1] > position[1]) != (ring[j][1] > position[1]) &&
+			position[0] < (ring[j][0]-ring[i][0])*(position[1]-ring[i][1])/(ring[j][1]-ring[i][1])+ring[i][0] {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func isOnSegment(position, start, end geoPosition) bool {
+	return orientation(start, end, position) == 0 &&
+		position[0] >= math.Min(start[0], end[0]) && position[0] <= math.Max(start[0], end[0]) &&
+		position[1] >= math.Min(start[1], end[1]) && position[1] <= math.Max(start[1], end[1])
+}
+
+func segmentsIntersect(p1, p2, q1, q2 geoPosition, proper bool) bool {
+	o1 := orientation(p1, p2, q1)
+	o2 := orientation(p1, p2, q2)
+	o3 := orientation(q1, q2, p1)
+	o4 := orientation(q1, q2, p2)
+
+	if o1*o2 < 0 && o3*o4 < 0 {
+		return true
+	}
+
+	if proper {
+		return false
+	}
+
+	return isOnSegment(q1, p1, p2) || isOnSegment(q2, p1, p2) ||
+		isOnSegment(p1, q1, q2) || isOnSegment(p2, q1, q2)
+}
+
+// Returns positive for counterclockwise turns, negative for clockwise turns and zero for collinear positions
+func orientation(a, b, c geoPosition) float64 {
+	return (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+}
+
+// Twice the signed area of the ring, positive for counterclockwise rings
+func ringArea(ring []geoPosition) float64 {
+	area := 0.0
+	for i := 1; i < len(ring); i++ {
+		area += ring[i-1][0]*ring[i][1] - ring[i][0]*ring[i-1][1]
+	}
+	return area
+}
+
+// Converts GeoJSON Point and Polygon geometries to a geoPosition or geoPolygon,
+// returns nil for other or malformed geometries. Polygon edges are treated as
+// straight lines between the coordinates rather than geodesics
+func getGeoJSONGeometry(geometry interface{}) interface{} {
+	object, isObject := geometry.(map[string]interface{})
+	if !isObject {
+		return nil
+	}
+
+	coordinates, isArray := object["coordinates"].([]interface{})
+	if !isArray {
+		return nil
+	}
+
+	switch object["type"] {
+	case "Point":
+		if position, ok := getGeoJSONPosition(coordinates); ok {
+			return position
+		}
+	case "Polygon":
+		if polygon, ok := getGeoJSONPolygon(coordinates); ok {
+			return polygon
+		}
+	}
+
+	return nil
+}
+
+func getGeoJSONPolygon(coordinates []interface{}) (geoPolygon, bool) {
+	if len(coordinates) == 0 {
+		return geoPolygon{}, false
+	}
+
+	polygon := geoPolygon{rings: make([][]geoPosition, 0, len(coordinates))}
+	for _, ringCoordinates := range coordinates {
+		positions, isArray := ringCoordinates.([]interface{})
+		if !isArray || len(positions) < 4 {
+			return geoPolygon{}, false
+		}
+
+		ring := make([]geoPosition, 0, len(positions))
+		for _, positionCoordinates := range positions {
+			position, ok := getGeoJSONPosition(positionCoordinates)
+			if !ok {
+				return geoPolygon{}, false
+			}
+			ring = append(ring, position)
+		}
+
+		// Rings must be closed
+		if ring[0] != ring[len(ring)-1] {
+			return geoPolygon{}, false
+		}
+
+		polygon.rings = append(polygon.rings, ring)
+	}
+
+	polygon.inverted = ringArea(polygon.rings[0]) < 0
+
+	return polygon, true
+}
+
+// Reads the longitude and latitude of a GeoJSON position, an optional altitude is ignored
+func getGeoJSONPosition(coordinates interface{}) (geoPosition, bool) {
+	values, isArray := coordinates.([]interface{})
+	if !isArray || len(values) < 2 || len(values) > 3 {
+		return geoPosition{}, false
+	}
+
+	longitude, isNumber := numToFloat64(values[0])
+	if !isNumber || longitude < -180 || longitude > 180 {
+		return geoPosition{}, false
+	}
+
+	latitude, isNumber := numToFloat64(values[1])
+	if !isNumber || latitude < -90 || latitude > 90 {
+		return geoPosition{}, false
+	}
+
+	return geoPosition{longitude, latitude}, true
+}
@@ -0,0 +1,250 @@
+package memoryexecutor_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pikami/cosmium/parsers"
+	memoryexecutor "github.com/pikami/cosmium/query_executors/memory_executor"
+)
+
+func Test_Execute_SpatialFunctions(t *testing.T) {
+	point := func(longitude, latitude interface{}) map[string]interface{} {
+		return map[string]interface{}{"type": "Point", "coordinates": []interface{}{longitude, latitude}}
+	}
+
+	mockData := []memoryexecutor.RowType{
+		map[string]interface{}{"id": "1", "location": point(0.0, 0.0)},
+		map[string]interface{}{"id": "2", "location": point(180, 0)},
+		map[string]interface{}{"id": "3", "location": point(0.03, 0.03)},
+		map[string]interface{}{"id": "4", "location": point(0.05, 0.0)},
+		map[string]interface{}{"id": "5", "location": point(200.0, 0.0)},
+		map[string]interface{}{"id": "6", "location": map[string]interface{}{"type": "LineString", "coordinates": []interface{}{}}},
+		map[string]interface{}{"id": "7"},
+	}
+
+	originPoint := parsers.SelectItem{
+		Type: parsers.SelectItemTypeObject,
+		SelectItems: []parsers.SelectItem{
+			{
+				Alias: "type",
+				Type:  parsers.SelectItemTypeConstant,
+				Value: parsers.Constant{Type: parsers.ConstantTypeString, Value: "Point"},
+			},
+			{
+				Alias: "coordinates",
+				Type:  parsers.SelectItemTypeArray,
+				SelectItems: []parsers.SelectItem{
+					{Type: parsers.SelectItemTypeConstant, Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 0}},
+					{Type: parsers.SelectItemTypeConstant, Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 0}},
+				},
+			},
+		},
+	}
+
+	distanceFromOrigin := parsers.SelectItem{
+		Alias: "distance",
+		Type:  parsers.SelectItemTypeFunctionCall,
+		Value: parsers.FunctionCall{
+			Type: parsers.FunctionCallSpatialDistance,
+			Arguments: []interface{}{
+				parsers.SelectItem{
+					Path: []string{"c", "location"},
+					Type: parsers.SelectItemTypeField,
+				},
+				originPoint,
+			},
+		},
+	}
+
+	t.Run("Should execute function ST_DISTANCE()", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Path: []string{"c", "id"},
+						Type: parsers.SelectItemTypeField,
+					},
+					distanceFromOrigin,
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+			[]memoryexecutor.RowType{mockData[0], mockData[1], mockData[4], mockData[5], mockData[6]},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "distance": 0.0},
+				map[string]interface{}{"id": "2", "distance": math.Pi * 6371000},
+				map[string]interface{}{"id": "5"},
+				map[string]interface{}{"id": "6"},
+				map[string]interface{}{"id": "7"},
+			},
+		)
+	})
+
+	t.Run("Should filter by function ST_DISTANCE()", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Path: []string{"c", "id"},
+						Type: parsers.SelectItemTypeField,
+					},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.ComparisonExpression{
+					Operation: "<",
+					Left:      distanceFromOrigin,
+					Right: parsers.SelectItem{
+						Type:  parsers.SelectItemTypeConstant,
+						Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 5000},
+					},
+				},
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1"},
+				map[string]interface{}{"id": "3"},
+			},
+		)
+	})
+
+	polygon := func(rings ...[]interface{}) map[string]interface{} {
+		coordinates := make([]interface{}, 0, len(rings))
+		for _, ring := range rings {
+			coordinates = append(coordinates, ring)
+		}
+		return map[string]interface{}{"type": "Polygon", "coordinates": coordinates}
+	}
+	square := func(minimum, maximum float64, clockwise bool) []interface{} {
+		ring := []interface{}{
+			[]interface{}{minimum, minimum},
+			[]interface{}{maximum, minimum},
+			[]interface{}{maximum, maximum},
+			[]interface{}{minimum, maximum},
+			[]interface{}{minimum, minimum},
+		}
+		if clockwise {
+			reversed := make([]interface{}, 0, len(ring))
+			for i := len(ring) - 1; i >= 0; i-- {
+				reversed = append(reversed, ring[i])
+			}
+			return reversed
+		}
+		return ring
+	}
+
+	parameters := map[string]interface{}{
+		"@polygonWithHole":  polygon(square(0, 10, false), square(4, 6, true)),
+		"@clockwisePolygon": polygon(square(0, 10, true)),
+		"@unclosedPolygon": polygon([]interface{}{
+			[]interface{}{0, 0}, []interface{}{10, 0}, []interface{}{10, 10}, []interface{}{0, 10},
+		}),
+	}
+
+	spatialFunction := func(alias string, functionType parsers.FunctionCallType, parameter string) parsers.SelectItem {
+		return parsers.SelectItem{
+			Alias: alias,
+			Type:  parsers.SelectItemTypeFunctionCall,
+			Value: parsers.FunctionCall{
+				Type: functionType,
+				Arguments: []interface{}{
+					parsers.SelectItem{
+						Path: []string{"c", "geometry"},
+						Type: parsers.SelectItemTypeField,
+					},
+					parsers.SelectItem{
+						Type:  parsers.SelectItemTypeConstant,
+						Value: parsers.Constant{Type: parsers.ConstantTypeParameterConstant, Value: parameter},
+					},
+				},
+			},
+		}
+	}
+
+	geometryData := []memoryexecutor.RowType{
+		map[string]interface{}{"id": "inside", "geometry": point(2, 2)},
+		map[string]interface{}{"id": "hole", "geometry": point(5, 5)},
+		map[string]interface{}{"id": "boundary", "geometry": point(0, 5)},
+		map[string]interface{}{"id": "outside", "geometry": point(20, 20)},
+		map[string]interface{}{"id": "contained", "geometry": polygon(square(1, 3, false))},
+		map[string]interface{}{"id": "overlapping", "geometry": polygon(square(3, 7, false))},
+		map[string]interface{}{"id": "containing", "geometry": polygon(square(-5, 15, false))},
+		map[string]interface{}{"id": "disjoint", "geometry": polygon(square(20, 30, false))},
+		map[string]interface{}{"id": "malformed", "geometry": map[string]interface{}{"type": "Polygon", "coordinates": []interface{}{}}},
+	}
+
+	t.Run("Should execute function ST_WITHIN() and ST_INTERSECTS()", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Path: []string{"c", "id"},
+						Type: parsers.SelectItemTypeField,
+					},
+					spatialFunction("within", parsers.FunctionCallSpatialWithin, "@polygonWithHole"),
+					spatialFunction("intersects", parsers.FunctionCallSpatialIntersects, "@polygonWithHole"),
+				},
+				Table:      parsers.Table{Value: "c"},
+				Parameters: parameters,
+			},
+			geometryData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "inside", "within": true, "intersects": true},
+				map[string]interface{}{"id": "hole", "within": false, "intersects": false},
+				map[string]interface{}{"id": "boundary", "within": false, "intersects": true},
+				map[string]interface{}{"id": "outside", "within": false, "intersects": false},
+				map[string]interface{}{"id": "contained", "within": true, "intersects": true},
+				map[string]interface{}{"id": "overlapping", "within": false, "intersects": true},
+				map[string]interface{}{"id": "containing", "within": false, "intersects": true},
+				map[string]interface{}{"id": "disjoint", "within": false, "intersects": false},
+				map[string]interface{}{"id": "malformed"},
+			},
+		)
+	})
+
+	t.Run("Should treat clockwise polygons as the region outside of them", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Path: []string{"c", "id"},
+						Type: parsers.SelectItemTypeField,
+					},
+					spatialFunction("within", parsers.FunctionCallSpatialWithin, "@clockwisePolygon"),
+				},
+				Table:      parsers.Table{Value: "c"},
+				Parameters: parameters,
+			},
+			[]memoryexecutor.RowType{geometryData[0], geometryData[3], geometryData[7]},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "inside", "within": false},
+				map[string]interface{}{"id": "outside", "within": true},
+				map[string]interface{}{"id": "disjoint", "within": true},
+			},
+		)
+	})
+
+	t.Run("Should return undefined for unclosed polygons", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Path: []string{"c", "id"},
+						Type: parsers.SelectItemTypeField,
+					},
+					spatialFunction("within", parsers.FunctionCallSpatialWithin, "@unclosedPolygon"),
+				},
+				Table:      parsers.Table{Value: "c"},
+				Parameters: parameters,
+			},
+			[]memoryexecutor.RowType{geometryData[0]},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "inside"},
+			},
+		)
+	})
+}
@@ -1,16 +1,28 @@
 package memoryexecutor
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
+	"unicode"
 
 	"github.com/pikami/cosmium/internal/logger"
 	"github.com/pikami/cosmium/parsers"
 )
 
-func (c memoryExecutorContext) strings_StringEquals(arguments []interface{}, row RowType) bool {
-	str1 := c.parseString(arguments[0], row)
-	str2 := c.parseString(arguments[1], row)
+// Returns undefined when either argument is not a string
+func (c memoryExecutorContext) strings_StringEquals(arguments []interface{}, row RowType) interface{} {
+	str1, isString := c.getFieldValue(arguments[0].(parsers.SelectItem), row).(string)
+	if !isString {
+		return undefined
+	}
+
+	str2, isString := c.getFieldValue(arguments[1].(parsers.SelectItem), row).(string)
+	if !isString {
+		return undefined
+	}
+
 	ignoreCase := c.getBoolFlag(arguments, row)
 
 	if ignoreCase {
@@ -59,6 +71,50 @@ func (c memoryExecutorContext) strings_StartsWith(arguments []interface{}, row R
 	return strings.HasPrefix(str1, str2)
 }
 
+// Returns undefined for non-string arguments, unknown modifiers and invalid patterns
+func (c memoryExecutorContext) strings_RegexMatch(arguments []interface{}, row RowType) interface{} {
+	str, isString := c.getFieldValue(arguments[0].(parsers.SelectItem), row).(string)
+	if !isString {
+		return undefined
+	}
+
+	pattern, isString := c.getFieldValue(arguments[1].(parsers.SelectItem), row).(string)
+	if !isString {
+		return undefined
+	}
+
+	flags := ""
+	if arguments[2] != nil {
+		modifiers, isString := c.getFieldValue(arguments[2].(parsers.SelectItem), row).(string)
+		if !isString {
+			return undefined
+		}
+
+		for _, modifier := range modifiers {
+			switch modifier {
+			case 'i', 'm', 's':
+				flags += string(modifier)
+			case 'x':
+				// RE2 has no free-spacing mode
+				pattern = removeRegexWhitespace(pattern)
+			default:
+				return undefined
+			}
+		}
+	}
+
+	if flags != "" {
+		pattern = fmt.Sprintf("(?%s)%s", flags, pattern)
+	}
+
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return undefined
+	}
+
+	return regex.MatchString(str)
+}
+
 func (c memoryExecutorContext) strings_Concat(arguments []interface{}, row RowType) string {
 	result := ""
 
@@ -274,6 +330,8 @@ func convertToString(value interface{}) string {
 		return v
 	case int:
 		return fmt.Sprintf("%d", v)
+	case json.Number:
+		return v.String()
 	case float32, float64:
 		return fmt.Sprintf("%f", v)
 	case bool:
@@ -281,3 +339,33 @@ func convertToString(value interface{}) string {
 	}
 	return ""
 }
+
+// Drops unescaped whitespace and "#" comments outside of character classes,
+// the way the "x" modifier makes them insignificant
+func removeRegexWhitespace(pattern string) string {
+	var sb strings.Builder
+	inClass, isEscaped, inComment := false, false, false
+	for _, r := range pattern {
+		switch {
+		case inComment:
+			inComment = r != '\n'
+			continue
+		case isEscaped:
+			isEscaped = false
+		case r == '\\':
+			isEscaped = true
+		case inClass:
+			inClass = r != ']'
+		case r == '[':
+			inClass = true
+		case r == '#':
+			inComment = true
+			continue
+		case unicode.IsSpace(r):
+			continue
+		}
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
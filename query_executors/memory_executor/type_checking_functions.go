@@ -1,7 +1,9 @@
 package memoryexecutor
 
 import (
+	"encoding/json"
 	"math"
+	"strings"
 
 	"github.com/pikami/cosmium/parsers"
 )
@@ -10,7 +12,7 @@ func (c memoryExecutorContext) typeChecking_IsDefined(arguments []interface{}, r
 	exItem := arguments[0].(parsers.SelectItem)
 	ex := c.getFieldValue(exItem, row)
 
-	return ex != nil
+	return !isUndefined(ex)
 }
 
 func (c memoryExecutorContext) typeChecking_IsArray(arguments []interface{}, row RowType) bool {
@@ -33,7 +35,7 @@ func (c memoryExecutorContext) typeChecking_IsFiniteNumber(arguments []interface
 	exItem := arguments[0].(parsers.SelectItem)
 	ex := c.getFieldValue(exItem, row)
 
-	switch num := ex.(type) {
+	switch num := normalizeNumber(ex).(type) {
 	case int:
 		return true
 	case float64:
@@ -47,6 +49,11 @@ func (c memoryExecutorContext) typeChecking_IsInteger(arguments []interface{}, r
 	exItem := arguments[0].(parsers.SelectItem)
 	ex := c.getFieldValue(exItem, row)
 
+	// Large integers are kept as json.Number, written without a fraction or exponent
+	if number, ok := ex.(json.Number); ok {
+		return !strings.ContainsAny(string(number), ".eE")
+	}
+
 	_, isInt := ex.(int)
 	return isInt
 }
@@ -64,7 +71,8 @@ func (c memoryExecutorContext) typeChecking_IsNumber(arguments []interface{}, ro
 
 	_, isFloat := ex.(float64)
 	_, isInt := ex.(int)
-	return isFloat || isInt
+	_, isNumber := ex.(json.Number)
+	return isFloat || isInt || isNumber
 }
 
 func (c memoryExecutorContext) typeChecking_IsObject(arguments []interface{}, row RowType) bool {
@@ -80,7 +88,7 @@ func (c memoryExecutorContext) typeChecking_IsPrimitive(arguments []interface{},
 	ex := c.getFieldValue(exItem, row)
 
 	switch ex.(type) {
-	case bool, string, float64, int, nil:
+	case bool, string, float64, int, json.Number, nil:
 		return true
 	default:
 		return false
@@ -44,7 +44,7 @@ func Test_Execute_TypeCheckingFunctions(t *testing.T) {
 			},
 			mockData,
 			[]memoryexecutor.RowType{
-				map[string]interface{}{"id": "1", "IsDefined": false},
+				map[string]interface{}{"id": "1", "IsDefined": true},
 				map[string]interface{}{"id": "2", "IsDefined": true},
 				map[string]interface{}{"id": "3", "IsDefined": true},
 				map[string]interface{}{"id": "4", "IsDefined": true},
@@ -56,6 +56,49 @@ func Test_Execute_TypeCheckingFunctions(t *testing.T) {
 		)
 	})
 
+	t.Run("Should execute function IS_DEFINED(path) and IS_NULL(path) on missing property", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+					{
+						Alias: "IsDefined",
+						Type:  parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallIsDefined,
+							Arguments: []interface{}{
+								parsers.SelectItem{
+									Path: []string{"c", "missing"},
+									Type: parsers.SelectItemTypeField,
+								},
+							},
+						},
+					},
+					{
+						Alias: "IsNull",
+						Type:  parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallIsNull,
+							Arguments: []interface{}{
+								parsers.SelectItem{
+									Path: []string{"c", "missing"},
+									Type: parsers.SelectItemTypeField,
+								},
+							},
+						},
+					},
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+			mockData[:2],
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "IsDefined": false, "IsNull": false},
+				map[string]interface{}{"id": "2", "IsDefined": false, "IsNull": false},
+			},
+		)
+	})
+
 	t.Run("Should execute function IS_ARRAY(path)", func(t *testing.T) {
 		testQueryExecute(
 			t,
@@ -1,6 +1,8 @@
 package memoryexecutor_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/pikami/cosmium/parsers"
@@ -41,6 +43,102 @@ func Test_Execute_Where(t *testing.T) {
 		)
 	})
 
+	t.Run("Should execute SELECT with array indexes in WHERE condition", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+					{Path: []string{"c", "tags", "0"}, Alias: "first"},
+					{Path: []string{"c", "tags", "-1"}, Alias: "negative"},
+					{Path: []string{"c", "tags", "5"}, Alias: "outOfRange"},
+					{Path: []string{"c", "id", "0"}, Alias: "notArray"},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.ComparisonExpression{
+					Operation: ">",
+					Left:      parsers.SelectItem{Path: []string{"c", "matrix", "1", "2"}},
+					Right: parsers.SelectItem{
+						Type:  parsers.SelectItemTypeConstant,
+						Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 5},
+					},
+				},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "tags": []string{"a", "b"}, "matrix": [][]float64{{1, 2, 3}, {4, 5, 6}}},
+				map[string]interface{}{"id": "2", "tags": []interface{}{"c"}, "matrix": []interface{}{[]interface{}{1}, []interface{}{1, 2, 3}}},
+				map[string]interface{}{"id": "3", "tags": []interface{}{}, "matrix": []interface{}{[]interface{}{1}}},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "first": "a"},
+			},
+		)
+	})
+
+	t.Run("Should compare high precision numbers without rounding them", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+					{Path: []string{"c", "value"}},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.ComparisonExpression{
+					Operation: ">",
+					Left:      parsers.SelectItem{Path: []string{"c", "value"}},
+					Right: parsers.SelectItem{
+						Type:  parsers.SelectItemTypeConstant,
+						Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 9223372036854775807},
+					},
+				},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "value": 9223372036854775807},
+				map[string]interface{}{"id": "2", "value": json.Number("9223372036854775808")},
+				map[string]interface{}{"id": "3", "value": json.Number("0.12345678901234567890123")},
+				map[string]interface{}{"id": "4", "value": json.Number("1e400")},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "2", "value": json.Number("9223372036854775808")},
+				map[string]interface{}{"id": "4", "value": json.Number("1e400")},
+			},
+		)
+	})
+
+	t.Run("Should compare system properties written by the server", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+					{Path: []string{"c", "_ts"}},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.ComparisonExpression{
+					Operation: ">",
+					Left:      parsers.SelectItem{Path: []string{"c", "_ts"}},
+					Right: parsers.SelectItem{
+						Type:  parsers.SelectItemTypeConstant,
+						Value: parsers.Constant{Type: parsers.ConstantTypeParameterConstant, Value: "@since"},
+					},
+				},
+				Parameters: map[string]interface{}{
+					"@since": 1700000000,
+				},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "_ts": int64(1600000000)},
+				map[string]interface{}{"id": "2", "_ts": int64(1800000000)},
+				map[string]interface{}{"id": "3", "_ts": 1900000000},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "2", "_ts": 1800000000},
+				map[string]interface{}{"id": "3", "_ts": 1900000000},
+			},
+		)
+	})
+
 	t.Run("Should execute SELECT with WHERE condition with defined parameter constant", func(t *testing.T) {
 		testQueryExecute(
 			t,
@@ -68,6 +166,63 @@ func Test_Execute_Where(t *testing.T) {
 		)
 	})
 
+	t.Run("Should execute SELECT with WHERE condition with object parameter constant", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.ComparisonExpression{
+					Operation: "=",
+					Left:      parsers.SelectItem{Path: []string{"c", "nested"}},
+					Right: parsers.SelectItem{
+						Type:  parsers.SelectItemTypeConstant,
+						Value: parsers.Constant{Type: parsers.ConstantTypeParameterConstant, Value: "@param_nested"},
+					},
+				},
+				Parameters: map[string]interface{}{
+					"@param_nested": map[string]interface{}{"values": []interface{}{1.0, 2.0}},
+				},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "nested": map[string]interface{}{"values": []interface{}{1, 2}}},
+				map[string]interface{}{"id": "2", "nested": map[string]interface{}{"values": []interface{}{2, 1}}},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1"},
+			},
+		)
+	})
+
+	t.Run("Should treat a missing parameter constant as undefined", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+					{
+						Alias: "missing",
+						Type:  parsers.SelectItemTypeConstant,
+						Value: parsers.Constant{Type: parsers.ConstantTypeParameterConstant, Value: "@missing"},
+					},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.ComparisonExpression{
+					Operation: "!=",
+					Left:      parsers.SelectItem{Path: []string{"c", "id"}},
+					Right: parsers.SelectItem{
+						Type:  parsers.SelectItemTypeConstant,
+						Value: parsers.Constant{Type: parsers.ConstantTypeParameterConstant, Value: "@missing"},
+					},
+				},
+			},
+			mockData,
+			[]memoryexecutor.RowType{},
+		)
+	})
+
 	t.Run("Should execute SELECT with multiple WHERE conditions", func(t *testing.T) {
 		testQueryExecute(
 			t,
@@ -157,3 +312,216 @@ func Test_Execute_Where(t *testing.T) {
 		)
 	})
 }
+
+func Test_Execute_Where_NullAndUndefined(t *testing.T) {
+	mockData := []memoryexecutor.RowType{
+		map[string]interface{}{"id": "missing"},
+		map[string]interface{}{"id": "null", "x": nil},
+		map[string]interface{}{"id": "int", "x": 5},
+		map[string]interface{}{"id": "float", "x": 5.0},
+		map[string]interface{}{"id": "smaller", "x": 3},
+		map[string]interface{}{"id": "string", "x": "5"},
+		map[string]interface{}{"id": "bool", "x": true},
+	}
+
+	nullConstant := parsers.SelectItem{
+		Type:  parsers.SelectItemTypeConstant,
+		Value: parsers.Constant{Value: nil},
+	}
+	fiveConstant := parsers.SelectItem{
+		Type:  parsers.SelectItemTypeConstant,
+		Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 5},
+	}
+	stringConstant := parsers.SelectItem{
+		Type:  parsers.SelectItemTypeConstant,
+		Value: parsers.Constant{Type: parsers.ConstantTypeString, Value: "a"},
+	}
+
+	truthTable := []struct {
+		operation   string
+		right       parsers.SelectItem
+		expectedIds []string
+	}{
+		{"=", nullConstant, []string{"null"}},
+		{"!=", nullConstant, []string{"int", "float", "smaller", "string", "bool"}},
+		{"<=", nullConstant, []string{"null"}},
+		{">", nullConstant, []string{"int", "float", "smaller", "string", "bool"}},
+		{"=", fiveConstant, []string{"int", "float"}},
+		{"!=", fiveConstant, []string{"null", "smaller", "string", "bool"}},
+		{"<", fiveConstant, []string{"null", "smaller", "bool"}},
+		{"<=", fiveConstant, []string{"null", "int", "float", "smaller", "bool"}},
+		{">", fiveConstant, []string{"string"}},
+		{">=", fiveConstant, []string{"int", "float", "string"}},
+		{"<", stringConstant, []string{"null", "int", "float", "smaller", "string", "bool"}},
+		{">", stringConstant, []string{}},
+	}
+
+	for _, row := range truthTable {
+		t.Run(fmt.Sprintf("Should execute WHERE c.x %s %v", row.operation, row.right.Value.(parsers.Constant).Value), func(t *testing.T) {
+			expectedData := make([]memoryexecutor.RowType, 0)
+			for _, id := range row.expectedIds {
+				expectedData = append(expectedData, map[string]interface{}{"id": id})
+			}
+
+			testQueryExecute(
+				t,
+				parsers.SelectStmt{
+					SelectItems: []parsers.SelectItem{
+						{Path: []string{"c", "id"}},
+					},
+					Table: parsers.Table{Value: "c"},
+					Filters: parsers.ComparisonExpression{
+						Operation: row.operation,
+						Left:      parsers.SelectItem{Path: []string{"c", "x"}},
+						Right:     row.right,
+					},
+				},
+				mockData,
+				expectedData,
+			)
+		})
+	}
+
+	t.Run("Should omit undefined properties from selected rows", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+					{Path: []string{"c", "x"}},
+				},
+				Table: parsers.Table{Value: "c"},
+			},
+			mockData[:2],
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "missing"},
+				map[string]interface{}{"id": "null", "x": nil},
+			},
+		)
+	})
+
+	t.Run("Should execute WHERE with LIKE patterns", func(t *testing.T) {
+		likeData := []memoryexecutor.RowType{
+			map[string]interface{}{"id": "1", "name": "Anderson"},
+			map[string]interface{}{"id": "2", "name": "anderson"},
+			map[string]interface{}{"id": "3", "name": "Ason"},
+			map[string]interface{}{"id": "4", "name": "A_son"},
+			map[string]interface{}{"id": "5", "name": 123},
+			map[string]interface{}{"id": "6"},
+		}
+		stringConstant := func(value string) parsers.SelectItem {
+			return parsers.SelectItem{
+				Type:  parsers.SelectItemTypeConstant,
+				Value: parsers.Constant{Type: parsers.ConstantTypeString, Value: value},
+			}
+		}
+
+		for _, testCase := range []struct {
+			pattern     string
+			escape      interface{}
+			not         bool
+			expectedIds []string
+		}{
+			{"A%son", nil, false, []string{"1", "3", "4"}},
+			{"A_son", nil, false, []string{"4"}},
+			{"A\\_son", stringConstant("\\"), false, []string{"4"}},
+			{"%", nil, false, []string{"1", "2", "3", "4"}},
+			{"A.son", nil, false, []string{}},
+			{"A%son", nil, true, []string{"2"}},
+		} {
+			expectedData := make([]memoryexecutor.RowType, 0)
+			for _, id := range testCase.expectedIds {
+				expectedData = append(expectedData, map[string]interface{}{"id": id})
+			}
+
+			testQueryExecute(
+				t,
+				parsers.SelectStmt{
+					SelectItems: []parsers.SelectItem{
+						{Path: []string{"c", "id"}},
+					},
+					Table: parsers.Table{Value: "c"},
+					Filters: parsers.LikeExpression{
+						Left:    parsers.SelectItem{Path: []string{"c", "name"}},
+						Pattern: stringConstant(testCase.pattern),
+						Escape:  testCase.escape,
+						Not:     testCase.not,
+					},
+				},
+				likeData,
+				expectedData,
+			)
+		}
+	})
+}
+
+func Test_Execute_Where_ThreeValuedLogic(t *testing.T) {
+	mockData := []memoryexecutor.RowType{
+		map[string]interface{}{"id": "true", "x": true},
+		map[string]interface{}{"id": "false", "x": false},
+		map[string]interface{}{"id": "missing"},
+		map[string]interface{}{"id": "number", "x": 5},
+	}
+
+	field := parsers.SelectItem{Path: []string{"c", "x"}}
+	constant := func(value bool) parsers.SelectItem {
+		return parsers.SelectItem{
+			Type:  parsers.SelectItemTypeConstant,
+			Value: parsers.Constant{Type: parsers.ConstantTypeBoolean, Value: value},
+		}
+	}
+	not := func(expression interface{}) parsers.LogicalExpression {
+		return parsers.LogicalExpression{Operation: parsers.LogicalExpressionTypeNot, Expressions: []interface{}{expression}}
+	}
+	and := func(expressions ...interface{}) parsers.LogicalExpression {
+		return parsers.LogicalExpression{Operation: parsers.LogicalExpressionTypeAnd, Expressions: expressions}
+	}
+	or := func(expressions ...interface{}) parsers.LogicalExpression {
+		return parsers.LogicalExpression{Operation: parsers.LogicalExpressionTypeOr, Expressions: expressions}
+	}
+	isDefined := parsers.SelectItem{
+		Type: parsers.SelectItemTypeFunctionCall,
+		Value: parsers.FunctionCall{
+			Type:      parsers.FunctionCallIsDefined,
+			Arguments: []interface{}{field},
+		},
+	}
+
+	truthTable := []struct {
+		name        string
+		filters     interface{}
+		expectedIds []string
+	}{
+		{"NOT c.x", not(field), []string{"false"}},
+		{"c.x AND true", and(field, constant(true)), []string{"true"}},
+		{"NOT (c.x AND true)", not(and(field, constant(true))), []string{"false"}},
+		{"c.x AND false", and(field, constant(false)), []string{}},
+		{"NOT (c.x AND false)", not(and(field, constant(false))), []string{"true", "false", "missing", "number"}},
+		{"c.x OR true", or(field, constant(true)), []string{"true", "false", "missing", "number"}},
+		{"NOT (c.x OR false)", not(or(field, constant(false))), []string{"false"}},
+		{"NOT IS_DEFINED(c.x)", not(isDefined), []string{"missing"}},
+		{"NOT IS_DEFINED(c.x) OR c.x", or(not(isDefined), field), []string{"true", "missing"}},
+	}
+
+	for _, row := range truthTable {
+		t.Run("Should execute WHERE "+row.name, func(t *testing.T) {
+			expectedData := make([]memoryexecutor.RowType, 0)
+			for _, id := range row.expectedIds {
+				expectedData = append(expectedData, map[string]interface{}{"id": id})
+			}
+
+			testQueryExecute(
+				t,
+				parsers.SelectStmt{
+					SelectItems: []parsers.SelectItem{
+						{Path: []string{"c", "id"}},
+					},
+					Table:   parsers.Table{Value: "c"},
+					Filters: row.filters,
+				},
+				mockData,
+				expectedData,
+			)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// Package server runs the emulator inside a Go process, so tests can start it
+// without spawning a subprocess. The configuration and the store are shared by
+// the whole process, only one server can be running at a time
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/pikami/cosmium/api"
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/internal/repositories"
+	repositorymodels "github.com/pikami/cosmium/internal/repository_models"
+)
+
+type State = repositorymodels.State
+type Database = repositorymodels.Database
+type Collection = repositorymodels.Collection
+type CollectionPartitionKey = repositorymodels.CollectionPartitionKey
+type Document = repositorymodels.Document
+
+type Server struct {
+	config       config.ServerConfig
+	initialState *State
+	httpServer   *http.Server
+	stopPurge    context.CancelFunc
+}
+
+var runningServerLock sync.Mutex
+var runningServer *Server
+
+// Creates a server with the given configuration, unset account settings and document size
+// limit default to the ones of the command line, a negative MaxDocumentSize disables the
+// limit. Port 0 binds a free port, which is reported by Port
+func New(serverConfig config.ServerConfig) *Server {
+	if serverConfig.Host == "" {
+		serverConfig.Host = "localhost"
+	}
+	if serverConfig.DatabaseAccount == "" {
+		serverConfig.DatabaseAccount = serverConfig.Host
+	}
+	if serverConfig.DatabaseDomain == "" {
+		serverConfig.DatabaseDomain = serverConfig.Host
+	}
+	if serverConfig.AccountKey == "" {
+		serverConfig.AccountKey = config.DefaultAccountKey
+	}
+	if serverConfig.MaxDocumentSize == 0 {
+		serverConfig.MaxDocumentSize = config.DefaultMaxDocumentSize
+	}
+
+	return &Server{config: serverConfig}
+}
+
+// Seeds the store with the given state when the server is started,
+// instead of loading the configured initial data or persisted files
+func (s *Server) WithState(state State) *Server {
+	s.initialState = &state
+	return s
+}
+
+// Replaces the store with the initial state and starts serving the API in
+// the background, an error is returned when the address can't be bound
+func (s *Server) Start() error {
+	runningServerLock.Lock()
+	defer runningServerLock.Unlock()
+
+	if runningServer != nil {
+		return errors.New("a cosmium server is already running in this process")
+	}
+
+	config.Config = s.config
+	config.SetPort(s.config.Port)
+
+	if s.initialState != nil {
+		if _, err := repositories.ReplaceState(*s.initialState); err != nil {
+			return fmt.Errorf("invalid initial state: %w", err)
+		}
+	} else {
+		repositories.ReplaceState(State{})
+		repositories.InitializeRepository()
+	}
+
+	httpServer, err := api.StartAPI()
+	if err != nil {
+		return err
+	}
+
+	s.httpServer = httpServer
+	s.config = config.Config
+	runningServer = s
+
+	purgeContext, stopPurge := context.WithCancel(context.Background())
+	s.stopPurge = stopPurge
+	if s.config.TTLPurgeInterval > 0 {
+		go repositories.RunExpiredDocumentsPurge(purgeContext, s.config.TTLPurgeInterval)
+	}
+
+	return nil
+}
+
+// Stops the server, waiting up to the configured shutdown timeout for in-flight
+// requests, with a zero timeout only the idle connections are waited for
+func (s *Server) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.ShutdownTimeout)
+	defer cancel()
+
+	return s.Shutdown(ctx)
+}
+
+// Stops accepting requests and waits for in-flight requests until the context is done,
+// the remaining connections are closed then. The state is persisted when configured
+func (s *Server) Shutdown(ctx context.Context) error {
+	runningServerLock.Lock()
+	defer runningServerLock.Unlock()
+
+	if runningServer != s {
+		return errors.New("the server is not running")
+	}
+	runningServer = nil
+	s.stopPurge()
+
+	var errs []error
+	if err := s.httpServer.Shutdown(ctx); err != nil {
+		s.httpServer.Close()
+		errs = append(errs, fmt.Errorf("forced shutdown, requests still in progress: %w", err))
+	}
+
+	if s.config.PersistDataFilePath != "" {
+		if err := repositories.SaveStateFS(s.config.PersistDataFilePath); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Returns the port the server is bound to
+func (s *Server) Port() int {
+	return s.config.Port
+}
+
+// Returns the account endpoint to point clients at, for example "http://localhost:8081/"
+func (s *Server) URL() string {
+	return s.config.DatabaseEndpoint
+}
+
+// Returns the account key clients authenticate with
+func (s *Server) AccountKey() string {
+	return s.config.AccountKey
+}
+
+// Removes the expired documents right away and returns their number, expired documents
+// are hidden from reads and queries before, but stay in the state until they are removed.
+// Without a TTLPurgeInterval this is the only way they are removed
+func (s *Server) PurgeExpiredDocuments() int {
+	return repositories.PurgeExpiredDocuments()
+}
+
+// Returns a deep copy of the store for assertions
+func (s *Server) State() State {
+	return repositories.CopyState()
+}